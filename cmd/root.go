@@ -28,6 +28,7 @@ func RootCommand(version string) *ffcli.Command {
 
 	versionFlag := root.FlagSet.Bool("version", false, "Print version and exit")
 	shared.BindRootFlags(root.FlagSet)
+	shared.BindTemplateFlags(root)
 
 	rootSubcommandNames := make([]string, 0, len(root.Subcommands))
 	for _, sub := range root.Subcommands {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/errfmt"
 )
 
@@ -18,24 +19,43 @@ func Run(args []string, versionInfo string) int {
 
 	if err := root.Parse(args); err != nil {
 		if err == flag.ErrHelp {
-			return 0
+			return shared.ExitSuccess
 		}
 		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
-		return 1
+		return shared.ExitUsage
+	}
+
+	shared.SetCommandPath(shared.CommandPath(root))
+
+	if err := shared.ApplyConfigDefaults(root); err != nil {
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return shared.ExitError
+	}
+
+	if err := shared.ConfigureLogging(); err != nil {
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return shared.ExitError
+	}
+
+	if shared.ConfigCheckEnabled() {
+		for _, warning := range shared.EnvVarWarnings(os.Environ()) {
+			shared.Warnf("%s", warning)
+		}
 	}
 
 	if err := root.Run(context.Background()); err != nil {
+		code := shared.ExitCodeFor(err)
 		var reported ReportedError
 		if errors.As(err, &reported) {
-			return 1
+			return code
 		}
 		if errors.Is(err, flag.ErrHelp) {
-			return 1
+			return code
 		}
-		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
-		return 1
+		errfmt.PrintStderr(err)
+		return code
 	}
 
-	return 0
+	return shared.ExitSuccess
 }
 
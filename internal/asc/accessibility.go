@@ -104,3 +104,21 @@ type AccessibilityDeclarationDeleteResult struct {
 	ID      string `json:"id"`
 	Deleted bool   `json:"deleted"`
 }
+
+// AccessibilityDeclarationBatchCreateResult represents CLI output for creating
+// declarations across several device families.
+type AccessibilityDeclarationBatchCreateResult struct {
+	AppID   string                                    `json:"appId"`
+	Total   int                                       `json:"total"`
+	Created int                                       `json:"created"`
+	Failed  int                                       `json:"failed"`
+	Results []AccessibilityDeclarationBatchCreateItem `json:"results"`
+}
+
+// AccessibilityDeclarationBatchCreateItem is the outcome for one device family.
+type AccessibilityDeclarationBatchCreateItem struct {
+	DeviceFamily DeviceFamily `json:"deviceFamily"`
+	ID           string       `json:"id,omitempty"`
+	Status       string       `json:"status"`
+	Error        string       `json:"error,omitempty"`
+}
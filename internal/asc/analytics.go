@@ -111,6 +111,7 @@ type AnalyticsReportRequestResponse struct {
 type AnalyticsReportRequestsResponse struct {
 	Data  []AnalyticsReportRequestResource `json:"data"`
 	Links Links                            `json:"links,omitempty"`
+	Meta  json.RawMessage                  `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination
@@ -1,5 +1,13 @@
 package asc
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
 // AppScreenshotSetAttributes describes a screenshot set resource.
 type AppScreenshotSetAttributes struct {
 	ScreenshotDisplayType string `json:"screenshotDisplayType"`
@@ -24,6 +32,57 @@ type ImageAsset struct {
 	Height      int    `json:"height"`
 }
 
+// ResolvedURL fills in the {w}, {h}, and {f} placeholders of the template URL
+// with the asset's native size and the given format (e.g. "png"). It returns
+// an empty string while the asset has no template URL yet.
+func (a *ImageAsset) ResolvedURL(format string) string {
+	if a == nil || strings.TrimSpace(a.TemplateURL) == "" {
+		return ""
+	}
+	return strings.NewReplacer(
+		"{w}", strconv.Itoa(a.Width),
+		"{h}", strconv.Itoa(a.Height),
+		"{f}", format,
+	).Replace(a.TemplateURL)
+}
+
+// DownloadImageAsset downloads a resolved image asset URL.
+func (c *Client) DownloadImageAsset(ctx context.Context, downloadURL string) (*ReportDownload, error) {
+	if err := validateImageAssetURL(downloadURL); err != nil {
+		return nil, fmt.Errorf("image asset download: %w", err)
+	}
+
+	resp, err := c.doStreamNoAuth(ctx, "GET", downloadURL, "image/*")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportDownload{Body: resp.Body, ContentLength: resp.ContentLength}, nil
+}
+
+// validateImageAssetURL only allows https URLs on Apple-owned hosts, where
+// image assets are served from (typically *.mzstatic.com).
+func validateImageAssetURL(downloadURL string) error {
+	if strings.TrimSpace(downloadURL) == "" {
+		return fmt.Errorf("empty download URL")
+	}
+	parsedURL, err := url.Parse(downloadURL)
+	if err != nil {
+		return fmt.Errorf("invalid download URL: %w", err)
+	}
+	if parsedURL.Scheme != "https" {
+		return fmt.Errorf("rejected download URL with insecure scheme %q (expected https)", parsedURL.Scheme)
+	}
+	host := strings.ToLower(parsedURL.Hostname())
+	if host == "" {
+		return fmt.Errorf("rejected image download URL with empty host")
+	}
+	if !isAllowedAnalyticsHost(host) {
+		return fmt.Errorf("rejected image download URL from untrusted host %q", parsedURL.Host)
+	}
+	return nil
+}
+
 // AssetDeliveryState describes the delivery state of an asset.
 type AssetDeliveryState struct {
 	State  string        `json:"state"`
@@ -0,0 +1,69 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestImageAssetResolvedURL(t *testing.T) {
+	asset := &ImageAsset{
+		TemplateURL: "https://is1-ssl.mzstatic.com/image/thumb/Purple/abc/{w}x{h}bb.{f}",
+		Width:       512,
+		Height:      256,
+	}
+	want := "https://is1-ssl.mzstatic.com/image/thumb/Purple/abc/512x256bb.png"
+	if got := asset.ResolvedURL("png"); got != want {
+		t.Fatalf("ResolvedURL() = %q, want %q", got, want)
+	}
+
+	var missing *ImageAsset
+	if got := missing.ResolvedURL("png"); got != "" {
+		t.Fatalf("expected empty URL for nil asset, got %q", got)
+	}
+	if got := (&ImageAsset{}).ResolvedURL("png"); got != "" {
+		t.Fatalf("expected empty URL without template, got %q", got)
+	}
+}
+
+func TestDownloadImageAsset_NoAuthHeader(t *testing.T) {
+	downloadURL := "https://is1-ssl.mzstatic.com/image/thumb/abc/512x256bb.png"
+	response := rawResponse(http.StatusOK, "png-data")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.String() != downloadURL {
+			t.Fatalf("expected URL %q, got %q", downloadURL, req.URL.String())
+		}
+		if req.Header.Get("Authorization") != "" {
+			t.Fatalf("expected no Authorization header")
+		}
+	}, response)
+
+	download, err := client.DownloadImageAsset(context.Background(), downloadURL)
+	if err != nil {
+		t.Fatalf("DownloadImageAsset() error: %v", err)
+	}
+	_ = download.Body.Close()
+}
+
+func TestDownloadImageAsset_RejectsUntrustedURLs(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr string
+	}{
+		{url: "http://is1-ssl.mzstatic.com/a.png", wantErr: "insecure scheme"},
+		{url: "https://example.com/a.png", wantErr: "untrusted host"},
+		{url: "https://mzstatic.com.example.com/a.png", wantErr: "untrusted host"},
+		{url: "", wantErr: "empty download URL"},
+	}
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected request to %s", req.URL)
+	}, rawResponse(http.StatusOK, ""))
+
+	for _, test := range tests {
+		_, err := client.DownloadImageAsset(context.Background(), test.url)
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Fatalf("DownloadImageAsset(%q) error = %v, want %q", test.url, err, test.wantErr)
+		}
+	}
+}
@@ -9,7 +9,10 @@ import (
 	"hash"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"path"
+	"path/filepath"
 	"strings"
 )
 
@@ -137,3 +140,84 @@ func ComputeChecksumFromReader(reader io.Reader, algorithm ChecksumAlgorithm) (*
 		Algorithm: algorithm,
 	}, nil
 }
+
+// downloadImageToTemp streams an https image URL into a new temporary
+// directory, keeping the last segment of the URL path as the file name, and
+// validates the result with ValidateImageFile. The returned cleanup removes the
+// temporary directory.
+func (c *Client) downloadImageToTemp(ctx context.Context, imageURL string) (string, func(), error) {
+	parsedURL, err := url.Parse(strings.TrimSpace(imageURL))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid image URL: %w", err)
+	}
+	if parsedURL.Scheme != "https" {
+		return "", nil, fmt.Errorf("rejected image URL with scheme %q (expected https)", parsedURL.Scheme)
+	}
+	if parsedURL.Hostname() == "" {
+		return "", nil, fmt.Errorf("rejected image URL with empty host")
+	}
+	fileName := path.Base(parsedURL.Path)
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return "", nil, fmt.Errorf("image URL %q does not end in a file name", imageURL)
+	}
+
+	resp, err := c.doStreamNoAuth(ctx, http.MethodGet, parsedURL.String(), "image/*")
+	if err != nil {
+		return "", nil, fmt.Errorf("download image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if contentType := resp.Header.Get("Content-Type"); !isImageDownloadContentType(contentType) {
+		return "", nil, fmt.Errorf("download image: unexpected content type %q", contentType)
+	}
+	if resp.ContentLength > maxAssetFileSize {
+		return "", nil, fmt.Errorf("download image: size exceeds %d bytes", maxAssetFileSize)
+	}
+
+	dir, err := os.MkdirTemp("", "asc-image-*")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { _ = os.RemoveAll(dir) }
+
+	filePath := filepath.Join(dir, fileName)
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	// Read one byte past the limit so oversized bodies fail validation.
+	_, copyErr := io.Copy(file, io.LimitReader(resp.Body, maxAssetFileSize+1))
+	closeErr := file.Close()
+	if copyErr != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("download image: %w", copyErr)
+	}
+	if closeErr != nil {
+		cleanup()
+		return "", nil, closeErr
+	}
+
+	if err := ValidateImageFile(filePath); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("invalid image file: %w", err)
+	}
+	return filePath, cleanup, nil
+}
+
+// isImageDownloadContentType reports whether a downloaded body may be an
+// image. Storage buckets often serve files as generic binary, so those are
+// accepted too.
+func isImageDownloadContentType(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch {
+	case mediaType == "":
+		return true
+	case strings.HasPrefix(mediaType, "image/"):
+		return true
+	case mediaType == "application/octet-stream", mediaType == "binary/octet-stream":
+		return true
+	default:
+		return false
+	}
+}
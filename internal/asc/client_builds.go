@@ -164,9 +164,10 @@ func (c *Client) GetBuilds(ctx context.Context, appID string, opts ...BuildsOpti
 		path = query.nextURL
 	} else {
 		values := url.Values{}
-		// Use /v1/builds endpoint when sorting, limiting, or filtering by preReleaseVersion,
+		// Use /v1/builds endpoint when sorting, limiting, filtering, or including,
 		// since /v1/apps/{id}/builds doesn't support these
-		if query.sort != "" || query.limit > 0 || query.preReleaseVersionID != "" {
+		if query.sort != "" || query.limit > 0 || query.preReleaseVersionID != "" ||
+			query.version != "" || len(query.processingStates) > 0 || len(query.include) > 0 {
 			path = "/v1/builds"
 			values.Set("filter[app]", appID)
 			if query.sort != "" {
@@ -178,6 +179,11 @@ func (c *Client) GetBuilds(ctx context.Context, appID string, opts ...BuildsOpti
 			if query.preReleaseVersionID != "" {
 				values.Set("filter[preReleaseVersion]", query.preReleaseVersionID)
 			}
+			if query.version != "" {
+				values.Set("filter[preReleaseVersion.version]", query.version)
+			}
+			addCSV(values, "filter[processingState]", query.processingStates)
+			addCSV(values, "include", query.include)
 		}
 		if queryString := values.Encode(); queryString != "" {
 			path += "?" + queryString
@@ -5,13 +5,14 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
-	"log/slog"
 	"math/rand"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
@@ -28,23 +29,17 @@ const (
 	// tokenLifetime is the JWT token lifetime for App Store Connect API authentication.
 	// 10 minutes is a good balance between security (shorter-lived tokens) and usability.
 	tokenLifetime = 10 * time.Minute
+	// tokenRefreshMargin is how long before expiry a cached JWT is replaced,
+	// so a reused token never expires mid-request.
+	tokenRefreshMargin = time.Minute
 
 	// Retry defaults
-	DefaultMaxRetries = 3
-	DefaultBaseDelay  = 1 * time.Second
-	DefaultMaxDelay   = 30 * time.Second
+	DefaultMaxRetries  = 3
+	DefaultBaseDelay   = 1 * time.Second
+	DefaultMaxDelay    = 30 * time.Second
+	DefaultRetryJitter = 0.25
 )
 
-var retryLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-	Level: slog.LevelInfo,
-	ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
-		if attr.Key == slog.TimeKey {
-			return slog.Attr{}
-		}
-		return attr
-	},
-}))
-
 var retryLogOverride struct {
 	mu  sync.RWMutex
 	val *bool
@@ -91,9 +86,12 @@ func envValue(name string) (string, bool) {
 }
 
 // RetryableError is returned when a request can be retried (e.g., rate limiting).
+// StatusCode is the HTTP status that made the request retryable, or 0 when the
+// failure happened before a response was received.
 type RetryableError struct {
 	Err        error
 	RetryAfter time.Duration
+	StatusCode int
 }
 
 func (e *RetryableError) Error() string {
@@ -110,6 +108,37 @@ func IsRetryable(err error) bool {
 	return errors.As(err, &re)
 }
 
+// IsTransientNetworkError reports whether err is a network failure worth
+// retrying, such as a dropped or refused connection. Context deadlines and
+// cancellations are not transient.
+func IsTransientNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// IsRateLimited reports whether an error is an HTTP 429 response, including
+// one that is no longer retryable because retries ran out. The API rejects
+// rate-limited requests before processing them, so they are safe to retry
+// for any method.
+func IsRateLimited(err error) bool {
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	var re *RetryableError
+	return errors.As(err, &re) && re.StatusCode == http.StatusTooManyRequests
+}
+
 // GetRetryAfter extracts the retry-after duration from an error.
 func GetRetryAfter(err error) time.Duration {
 	var re *RetryableError
@@ -124,23 +153,31 @@ func GetRetryAfter(err error) time.Duration {
 //     negative = use DefaultMaxRetries.
 //   - BaseDelay: Initial delay between retries (with exponential backoff).
 //   - MaxDelay: Maximum delay cap for backoff.
+//   - Jitter: Fraction (0-1) of each backoff delay that is randomized, so
+//     clients that fail together do not retry in lockstep. 0 = use
+//     DefaultRetryJitter, negative = no jitter.
 type RetryOptions struct {
 	MaxRetries int           // 0=disabled, negative=default, positive=retry count
 	BaseDelay  time.Duration // Initial delay for exponential backoff
 	MaxDelay   time.Duration // Maximum delay cap
+	Jitter     float64       // 0=default, negative=none, otherwise fraction of the delay
 }
 
 // ResolveRetryOptions returns retry options, optionally overridden by config/env.
+// ASC_RETRY_MAX and ASC_RETRY_BASE are accepted as alternatives to
+// ASC_MAX_RETRIES and ASC_BASE_DELAY; ASC_RETRY_JITTER sets the jitter
+// fraction (0 disables jitter).
 func ResolveRetryOptions() RetryOptions {
 	opts := RetryOptions{
 		MaxRetries: DefaultMaxRetries,
 		BaseDelay:  DefaultBaseDelay,
 		MaxDelay:   DefaultMaxDelay,
+		Jitter:     DefaultRetryJitter,
 	}
 
 	cfg := loadConfig()
 
-	if override, ok := envValue("ASC_MAX_RETRIES"); ok {
+	if override, ok := firstEnvValue("ASC_RETRY_MAX", "ASC_MAX_RETRIES"); ok {
 		if override != "" {
 			if parsed, err := strconv.Atoi(override); err == nil && parsed >= 0 {
 				opts.MaxRetries = parsed
@@ -154,7 +191,7 @@ func ResolveRetryOptions() RetryOptions {
 		}
 	}
 
-	if override, ok := envValue("ASC_BASE_DELAY"); ok {
+	if override, ok := firstEnvValue("ASC_RETRY_BASE", "ASC_BASE_DELAY"); ok {
 		if override != "" {
 			if parsed, err := time.ParseDuration(override); err == nil && parsed > 0 {
 				opts.BaseDelay = parsed
@@ -181,9 +218,51 @@ func ResolveRetryOptions() RetryOptions {
 			}
 		}
 	}
+
+	if override, ok := envValue("ASC_RETRY_JITTER"); ok && override != "" {
+		if parsed, err := strconv.ParseFloat(override, 64); err == nil && parsed >= 0 && parsed <= 1 {
+			opts.Jitter = parsed
+			if parsed == 0 {
+				opts.Jitter = -1
+			}
+		}
+	}
 	return opts
 }
 
+// firstEnvValue returns the value of the first of names that is set.
+func firstEnvValue(names ...string) (string, bool) {
+	for _, name := range names {
+		if value, ok := envValue(name); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// retryBackoff returns the delay before retry number retryCount+1: BaseDelay
+// doubled per retry, capped at MaxDelay, then randomized by ±Jitter of
+// itself. opts must already have defaults applied.
+func retryBackoff(opts RetryOptions, retryCount int) time.Duration {
+	expDelay := opts.BaseDelay
+	if retryCount > 0 && retryCount < 31 { // Prevent overflow for reasonable retry counts
+		expDelay = opts.BaseDelay * time.Duration(1<<retryCount)
+	}
+	if expDelay > opts.MaxDelay || expDelay <= 0 {
+		expDelay = opts.MaxDelay
+	}
+	if opts.Jitter <= 0 {
+		return expDelay
+	}
+
+	jitter := float64(expDelay) * opts.Jitter * (2*rand.Float64() - 1)
+	delay := expDelay + time.Duration(jitter)
+	if delay <= 0 {
+		delay = expDelay / 2 // minimum delay
+	}
+	return delay
+}
+
 // WithRetry executes a function with retry logic for rate limiting.
 // It uses exponential backoff with jitter and respects Retry-After headers.
 func WithRetry[T any](ctx context.Context, fn func() (T, error), opts RetryOptions) (T, error) {
@@ -203,6 +282,12 @@ func WithRetry[T any](ctx context.Context, fn func() (T, error), opts RetryOptio
 	if opts.MaxDelay <= 0 {
 		opts.MaxDelay = DefaultMaxDelay
 	}
+	if opts.Jitter == 0 {
+		opts.Jitter = DefaultRetryJitter
+	}
+	if opts.Jitter > 1 {
+		opts.Jitter = 1
+	}
 
 	retryCount := 0
 
@@ -219,26 +304,18 @@ func WithRetry[T any](ctx context.Context, fn func() (T, error), opts RetryOptio
 
 		// Check if we've exceeded max retries
 		if retryCount >= opts.MaxRetries {
-			return zero, fmt.Errorf("retry limit exceeded after %d retries: %w", retryCount+1, err)
+			return zero, fmt.Errorf("retry limit exceeded after %d retries: %w", retryCount+1, exhaustedRetryError(err))
 		}
 
 		// Calculate delay
 		delay := GetRetryAfter(err)
 		if delay == 0 {
-			// Exponential backoff with jitter, capped to prevent overflow
-			expDelay := opts.BaseDelay
-			if retryCount > 0 && retryCount < 31 { // Prevent overflow for reasonable retry counts
-				expDelay = opts.BaseDelay * time.Duration(1<<retryCount)
-			}
-			if expDelay > opts.MaxDelay || expDelay <= 0 {
-				expDelay = opts.MaxDelay
-			}
-			// Add jitter: ±25% of the delay
-			jitter := float64(expDelay) * 0.25 * (2*rand.Float64() - 1)
-			delay = expDelay + time.Duration(jitter)
-			if delay < 0 {
-				delay = expDelay / 2 // minimum delay
-			}
+			delay = retryBackoff(opts, retryCount)
+		}
+
+		// Don't sleep past the deadline only to be cancelled.
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return zero, fmt.Errorf("retry in %s would exceed the request deadline: %w", delay.Round(time.Millisecond), exhaustedRetryError(err))
 		}
 
 		if ResolveRetryLogEnabled() {
@@ -257,8 +334,60 @@ func WithRetry[T any](ctx context.Context, fn func() (T, error), opts RetryOptio
 	}
 }
 
+// exhaustedRetryError unwraps a RetryableError once retrying has given up, so
+// callers that wrap the request in their own WithRetry don't retry it again.
+// A 429 keeps ErrRateLimited in its chain so it can still be classified.
+func exhaustedRetryError(err error) error {
+	var re *RetryableError
+	if errors.As(err, &re) && re.Err != nil && error(re) == err {
+		if re.StatusCode == http.StatusTooManyRequests {
+			return rateLimitedError{err: re.Err}
+		}
+		return re.Err
+	}
+	return err
+}
+
+// rateLimitedError is a 429 that is no longer retryable.
+type rateLimitedError struct {
+	err error
+}
+
+func (e rateLimitedError) Error() string {
+	return e.err.Error()
+}
+
+func (e rateLimitedError) Unwrap() error {
+	return e.err
+}
+
+func (e rateLimitedError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
 func logRetry(delay time.Duration, attempt, maxRetries int, err error) {
-	retryLogger.Info("retrying request", "delay", delay.String(), "attempt", attempt, "maxRetries", maxRetries, "error", err)
+	Logger().Info("retrying request", "delay", delay.String(), "attempt", attempt, "maxRetries", maxRetries, "error", err)
+}
+
+var timeoutOverride = struct {
+	mu    sync.RWMutex
+	value time.Duration
+}{}
+
+// SetTimeoutOverride sets the timeout given with the global --timeout flag.
+// When positive it takes precedence over ASC_TIMEOUT, ASC_UPLOAD_TIMEOUT, the
+// config file, and the built-in defaults; zero clears it.
+func SetTimeoutOverride(timeout time.Duration) {
+	timeoutOverride.mu.Lock()
+	defer timeoutOverride.mu.Unlock()
+	timeoutOverride.value = timeout
+}
+
+// TimeoutOverride returns the --timeout value, or zero when unset.
+func TimeoutOverride() time.Duration {
+	timeoutOverride.mu.RLock()
+	defer timeoutOverride.mu.RUnlock()
+	return timeoutOverride.value
 }
 
 // ResolveTimeout returns the request timeout, optionally overridden by config/env.
@@ -268,6 +397,9 @@ func ResolveTimeout() time.Duration {
 
 // ResolveUploadTimeout returns the upload timeout, optionally overridden by config/env.
 func ResolveUploadTimeout() time.Duration {
+	if override := TimeoutOverride(); override > 0 {
+		return override
+	}
 	cfg := loadConfig()
 	var uploadTimeout config.DurationValue
 	var uploadTimeoutSeconds config.DurationValue
@@ -279,8 +411,12 @@ func ResolveUploadTimeout() time.Duration {
 }
 
 // ResolveTimeoutWithDefault returns the request timeout using a custom default.
-// ASC_TIMEOUT and ASC_TIMEOUT_SECONDS override the default when set.
+// The --timeout flag wins, then ASC_TIMEOUT and ASC_TIMEOUT_SECONDS, then the
+// config file, then defaultTimeout.
 func ResolveTimeoutWithDefault(defaultTimeout time.Duration) time.Duration {
+	if override := TimeoutOverride(); override > 0 {
+		return override
+	}
 	cfg := loadConfig()
 	var timeout config.DurationValue
 	var timeoutSeconds config.DurationValue
@@ -323,6 +459,12 @@ type Client struct {
 	keyID      string
 	issuerID   string
 	privateKey *ecdsa.PrivateKey
+
+	// tokenMu guards the cached JWT, which is reused across requests until
+	// it nears expiry.
+	tokenMu     sync.Mutex
+	token       string
+	tokenExpiry time.Time
 }
 
 // NewClient creates a new ASC client
@@ -11,18 +11,28 @@ import (
 
 // GetGameCenterDetailID retrieves the Game Center detail ID for an app.
 func (c *Client) GetGameCenterDetailID(ctx context.Context, appID string) (string, error) {
+	response, err := c.GetAppGameCenterDetail(ctx, appID)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Data.ID, nil
+}
+
+// GetAppGameCenterDetail retrieves the Game Center detail for an app.
+func (c *Client) GetAppGameCenterDetail(ctx context.Context, appID string) (*GameCenterDetailResponse, error) {
 	path := fmt.Sprintf("/v1/apps/%s/gameCenterDetail", strings.TrimSpace(appID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	var response GameCenterDetailResponse
 	if err := json.Unmarshal(data, &response); err != nil {
-		return "", fmt.Errorf("failed to parse response: %w", err)
+		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	return response.Data.ID, nil
+	return &response, nil
 }
 
 // GetGameCenterAchievements retrieves the list of Game Center achievements for a Game Center detail.
@@ -56,8 +66,16 @@ func (c *Client) GetGameCenterAchievements(ctx context.Context, gcDetailID strin
 }
 
 // GetGameCenterAchievement retrieves a Game Center achievement by ID.
-func (c *Client) GetGameCenterAchievement(ctx context.Context, achievementID string) (*GameCenterAchievementResponse, error) {
+func (c *Client) GetGameCenterAchievement(ctx context.Context, achievementID string, opts ...GCAchievementOption) (*GameCenterAchievementResponse, error) {
+	query := &gcAchievementQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	path := fmt.Sprintf("/v1/gameCenterAchievements/%s", strings.TrimSpace(achievementID))
+	if queryString := buildGCAchievementQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1330,6 +1348,19 @@ func (c *Client) UploadGameCenterAchievementImage(ctx context.Context, localizat
 	}, nil
 }
 
+// UploadGameCenterAchievementImageFromURL downloads an image from an https URL
+// to a temporary file and uploads it for an achievement localization. The file
+// name sent to App Store Connect is the last segment of the URL path.
+func (c *Client) UploadGameCenterAchievementImageFromURL(ctx context.Context, localizationID string, imageURL string) (*GameCenterAchievementImageUploadResult, error) {
+	filePath, cleanup, err := c.downloadImageToTemp(ctx, imageURL)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	return c.UploadGameCenterAchievementImage(ctx, localizationID, filePath)
+}
+
 // GetGameCenterLeaderboardSetImage retrieves a Game Center leaderboard set image by ID.
 func (c *Client) GetGameCenterLeaderboardSetImage(ctx context.Context, imageID string) (*GameCenterLeaderboardSetImageResponse, error) {
 	path := fmt.Sprintf("/v1/gameCenterLeaderboardSetImages/%s", strings.TrimSpace(imageID))
@@ -0,0 +1,94 @@
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUploadGameCenterAchievementImageFromURL(t *testing.T) {
+	imageBody := "png-bytes"
+
+	var uploaded string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		uploaded = string(data)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newUploadTestClient(t, func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.URL.Host == "assets.example.com":
+			if req.Header.Get("Authorization") != "" {
+				t.Fatalf("expected image download without App Store Connect auth")
+			}
+			if req.URL.Path != "/achievements/first-win.png" {
+				t.Fatalf("unexpected download path %s", req.URL.Path)
+			}
+			resp := jsonResponse(http.StatusOK, imageBody)
+			resp.Header.Set("Content-Type", "image/png")
+			return resp, nil
+		case req.URL.Path == "/v1/gameCenterAchievementImages":
+			data, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(data), `"fileName":"first-win.png"`) {
+				t.Fatalf("expected file name from URL in reservation, got %s", data)
+			}
+			body := fmt.Sprintf(`{"data":{"type":"gameCenterAchievementImages","id":"img-1","attributes":{"uploadOperations":[{"method":"PUT","url":"%s","length":%d,"offset":0}]}}}`, server.URL, len(imageBody))
+			return jsonResponse(http.StatusCreated, body), nil
+		case req.URL.Path == "/v1/gameCenterAchievementImages/img-1":
+			body := `{"data":{"type":"gameCenterAchievementImages","id":"img-1","attributes":{"assetDeliveryState":{"state":"COMPLETE"}}}}`
+			return jsonResponse(http.StatusOK, body), nil
+		default:
+			return jsonResponse(http.StatusNotFound, `{"errors":[{"title":"not found"}]}`), nil
+		}
+	})
+
+	result, err := client.UploadGameCenterAchievementImageFromURL(context.Background(), "loc-1", "https://assets.example.com/achievements/first-win.png?X-Signature=abc")
+	if err != nil {
+		t.Fatalf("UploadGameCenterAchievementImageFromURL() error: %v", err)
+	}
+	if uploaded != imageBody {
+		t.Fatalf("expected uploaded body %q, got %q", imageBody, uploaded)
+	}
+	if result.ID != "img-1" || result.FileName != "first-win.png" || result.FileSize != int64(len(imageBody)) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestUploadGameCenterAchievementImageFromURL_Rejects(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		contentType string
+		body        string
+		wantErr     string
+	}{
+		{name: "http scheme", url: "http://assets.example.com/a.png", wantErr: "expected https"},
+		{name: "no file name", url: "https://assets.example.com/", wantErr: "does not end in a file name"},
+		{name: "html content", url: "https://assets.example.com/a.png", contentType: "text/html", body: "<html>", wantErr: "unexpected content type"},
+		{name: "empty body", url: "https://assets.example.com/a.png", contentType: "application/octet-stream", wantErr: "file is empty"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newUploadTestClient(t, func(req *http.Request) (*http.Response, error) {
+				if req.URL.Host != "assets.example.com" {
+					t.Fatalf("unexpected request to %s", req.URL)
+				}
+				resp := jsonResponse(http.StatusOK, test.body)
+				resp.Header.Set("Content-Type", test.contentType)
+				return resp, nil
+			})
+
+			_, err := client.UploadGameCenterAchievementImageFromURL(context.Background(), "loc-1", test.url)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
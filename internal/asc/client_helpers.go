@@ -1,6 +1,8 @@
 package asc
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
@@ -71,3 +73,58 @@ func addLimit(values url.Values, limit int) {
 		values.Set("limit", strconv.Itoa(limit))
 	}
 }
+
+// MergeIncluded appends JSON:API included resources, skipping
+// duplicates by type and ID.
+func MergeIncluded(existing json.RawMessage, additional json.RawMessage) (json.RawMessage, error) {
+	if len(additional) == 0 {
+		return existing, nil
+	}
+
+	var merged []json.RawMessage
+	if len(existing) > 0 {
+		if err := json.Unmarshal(existing, &merged); err != nil {
+			return nil, fmt.Errorf("parse included: %w", err)
+		}
+	}
+	var extra []json.RawMessage
+	if err := json.Unmarshal(additional, &extra); err != nil {
+		return nil, fmt.Errorf("parse included: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(merged))
+	key := func(item json.RawMessage) (string, error) {
+		var header struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		}
+		if err := json.Unmarshal(item, &header); err != nil {
+			return "", fmt.Errorf("parse included: %w", err)
+		}
+		return header.Type + "/" + header.ID, nil
+	}
+	for _, item := range merged {
+		k, err := key(item)
+		if err != nil {
+			return nil, err
+		}
+		seen[k] = struct{}{}
+	}
+	for _, item := range extra {
+		k, err := key(item)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		merged = append(merged, item)
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -41,14 +41,35 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 	return req, nil
 }
 
-// generateJWT generates a JWT for ASC API authentication
+// jwtNow returns the current time for JWT issuance (overridden in tests).
+var jwtNow = time.Now
+
+// generateJWT returns a JWT for ASC API authentication, reusing the cached
+// token until it is within tokenRefreshMargin of expiring.
 func (c *Client) generateJWT() (string, error) {
-	return GenerateJWT(c.keyID, c.issuerID, c.privateKey)
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	now := jwtNow()
+	if c.token != "" && now.Add(tokenRefreshMargin).Before(c.tokenExpiry) {
+		return c.token, nil
+	}
+
+	token, err := generateJWTAt(c.keyID, c.issuerID, c.privateKey, now)
+	if err != nil {
+		return "", err
+	}
+	c.token = token
+	c.tokenExpiry = now.Add(tokenLifetime)
+	return token, nil
 }
 
 // GenerateJWT generates a JWT for ASC API authentication.
 func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string, error) {
-	now := time.Now()
+	return generateJWTAt(keyID, issuerID, privateKey, jwtNow())
+}
+
+func generateJWTAt(keyID, issuerID string, privateKey *ecdsa.PrivateKey, now time.Time) (string, error) {
 	claims := jwt.RegisteredClaims{
 		Issuer:    issuerID,
 		Audience:  jwt.ClaimStrings{"appstoreconnect-v1"},
@@ -68,8 +89,21 @@ func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string,
 	return signedToken, nil
 }
 
+// TokenIdentity returns the key ID (kid header) and issuer ID (iss claim) of an
+// App Store Connect JWT. The signature is not verified.
+func TokenIdentity(token string) (keyID, issuerID string, err error) {
+	claims := &jwt.RegisteredClaims{}
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, claims)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode token: %w", err)
+	}
+	keyID, _ = parsed.Header["kid"].(string)
+	return keyID, claims.Issuer, nil
+}
+
 // do performs an HTTP request and returns the response.
-// GET/HEAD requests use retry logic for rate limiting by default.
+// GET/HEAD requests are retried on retryable responses; other methods only
+// when rate limited.
 func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
 	var bodyBytes []byte
 	if body != nil {
@@ -80,6 +114,13 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 		}
 	}
 
+	if shouldDryRun(method) {
+		return dryRunRequest(method, path, bodyBytes)
+	}
+	if dir := ReplayDir(); dir != "" {
+		return replayRequest(dir, method, path)
+	}
+
 	request := func() ([]byte, error) {
 		var reader io.Reader
 		if bodyBytes != nil {
@@ -88,12 +129,20 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 		return c.doOnce(ctx, method, path, reader)
 	}
 
+	retryOpts := ResolveRetryOptions()
 	if shouldRetryMethod(method) {
-		retryOpts := ResolveRetryOptions()
 		return WithRetry(ctx, request, retryOpts)
 	}
 
-	return request()
+	// Other methods are only retried when rate limited, since the request
+	// was rejected before it had any effect.
+	return WithRetry(ctx, func() ([]byte, error) {
+		data, err := request()
+		if err != nil && !IsRateLimited(err) {
+			return nil, exhaustedRetryError(err)
+		}
+		return data, err
+	}, retryOpts)
 }
 
 func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
@@ -102,31 +151,50 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 		return nil, err
 	}
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
+	if VerboseEnabled() {
+		logVerboseRequest(req, resp, time.Since(start), err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		respBody, _ := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil, err
+	}
+	if dir := RecordDir(); dir != "" {
+		if err := recordExchange(dir, req, resp, respBody); err != nil {
+			return nil, err
+		}
+	}
 
+	return handleResponse(resp.StatusCode, resp.Header, respBody)
+}
+
+// handleResponse turns a response status and body into the result of a
+// request: the body on success, or the matching API error.
+func handleResponse(statusCode int, header http.Header, respBody []byte) ([]byte, error) {
+	if statusCode < 200 || statusCode >= 300 {
 		// Check for rate limiting (429) or service unavailable (503)
-		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
-			retryAfter := parseRetryAfterHeader(resp.Header.Get("Retry-After"))
+		if statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable {
+			retryAfter := parseRetryAfterHeader(header.Get("Retry-After"))
 			return nil, &RetryableError{
-				Err:        buildRetryableError(resp.StatusCode, retryAfter, respBody),
+				Err:        buildRetryableError(statusCode, retryAfter, respBody),
 				RetryAfter: retryAfter,
+				StatusCode: statusCode,
 			}
 		}
 
 		if err := ParseError(respBody); err != nil {
 			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+		return nil, fmt.Errorf("API request failed with status %d", statusCode)
 	}
 
-	return io.ReadAll(resp.Body)
+	return respBody, nil
 }
 
 func shouldRetryMethod(method string) bool {
@@ -384,6 +452,7 @@ func BuildRequestBody(data interface{}) (io.Reader, error) {
 func ParseError(body []byte) error {
 	var errResp struct {
 		Errors []struct {
+			ID     string `json:"id"`
 			Code   string `json:"code"`
 			Title  string `json:"title"`
 			Detail string `json:"detail"`
@@ -395,6 +464,7 @@ func ParseError(body []byte) error {
 			Code:   errResp.Errors[0].Code,
 			Title:  errResp.Errors[0].Title,
 			Detail: errResp.Errors[0].Detail,
+			ID:     errResp.Errors[0].ID,
 		}
 	}
 
@@ -28,6 +28,24 @@ func TestGetGameCenterDetailID(t *testing.T) {
 	}
 }
 
+func TestGetAppGameCenterDetail(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"gameCenterDetails","id":"gc-detail-1","attributes":{"arcadeEnabled":true}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/apps/app-1/gameCenterDetail" {
+			t.Fatalf("expected path /v1/apps/app-1/gameCenterDetail, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	detail, err := client.GetAppGameCenterDetail(context.Background(), "app-1")
+	if err != nil {
+		t.Fatalf("GetAppGameCenterDetail() error: %v", err)
+	}
+	if detail.Data.ID != "gc-detail-1" || !detail.Data.Attributes.ArcadeEnabled {
+		t.Fatalf("unexpected detail: %+v", detail.Data)
+	}
+}
+
 func TestGetGameCenterAchievements_WithLimit(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"gameCenterAchievements","id":"ach-1","attributes":{"referenceName":"First Win","vendorIdentifier":"com.example.firstwin","points":10,"showBeforeEarned":true,"repeatable":false}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -83,6 +101,48 @@ func TestGetGameCenterAchievement(t *testing.T) {
 	}
 }
 
+func TestGetGameCenterAchievement_WithInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"gameCenterAchievements","id":"ach-1","attributes":{"referenceName":"First Win"}},"included":[{"type":"gameCenterAchievementLocalizations","id":"loc-1","attributes":{"locale":"en-US"}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/gameCenterAchievements/ach-1" {
+			t.Fatalf("expected path /v1/gameCenterAchievements/ach-1, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("include") != "localizations" {
+			t.Fatalf("expected include=localizations, got %q", values.Get("include"))
+		}
+		if values.Get("limit[localizations]") != "25" {
+			t.Fatalf("expected limit[localizations]=25, got %q", values.Get("limit[localizations]"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.GetGameCenterAchievement(context.Background(), "ach-1",
+		WithGCAchievementInclude([]string{"localizations"}),
+		WithGCAchievementIncludedLocalizationsLimit(25),
+	)
+	if err != nil {
+		t.Fatalf("GetGameCenterAchievement() error: %v", err)
+	}
+	if len(resp.Included) == 0 {
+		t.Fatal("expected included resources")
+	}
+}
+
+func TestGetGameCenterAchievementLocalizations_WithInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Query().Get("include") != "gameCenterAchievementImage" {
+			t.Fatalf("expected include=gameCenterAchievementImage, got %q", req.URL.Query().Get("include"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetGameCenterAchievementLocalizations(context.Background(), "ach-1", WithGCAchievementLocalizationsInclude([]string{"gameCenterAchievementImage"})); err != nil {
+		t.Fatalf("GetGameCenterAchievementLocalizations() error: %v", err)
+	}
+}
+
 func TestCreateGameCenterAchievement(t *testing.T) {
 	response := jsonResponse(http.StatusCreated, `{"data":{"type":"gameCenterAchievements","id":"ach-1","attributes":{"referenceName":"First Win","vendorIdentifier":"com.example.firstwin","points":10,"showBeforeEarned":true,"repeatable":false}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -189,6 +249,23 @@ func TestGetGameCenterLeaderboards_WithLimit(t *testing.T) {
 	}
 }
 
+func TestGetGameCenterLeaderboards_WithFields(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"gameCenterLeaderboards","id":"lb-1","attributes":{"referenceName":"High Score"}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if got := req.URL.Query().Get("fields[gameCenterLeaderboards]"); got != "referenceName,vendorIdentifier" {
+			t.Fatalf("expected fields[gameCenterLeaderboards]=referenceName,vendorIdentifier, got %q", got)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetGameCenterLeaderboards(context.Background(), "gc-detail-1", WithGCLeaderboardsFields([]string{"referenceName", " vendorIdentifier "})); err != nil {
+		t.Fatalf("GetGameCenterLeaderboards() error: %v", err)
+	}
+}
+
 func TestGetGameCenterLeaderboards_UsesNextURL(t *testing.T) {
 	next := "https://api.appstoreconnect.apple.com/v1/gameCenterDetails/gc-detail-1/gameCenterLeaderboards?cursor=abc"
 	response := jsonResponse(http.StatusOK, `{"data":[]}`)
@@ -730,6 +730,38 @@ func TestGetBuilds_WithPreReleaseVersion(t *testing.T) {
 	}
 }
 
+func TestGetBuilds_WithProcessingStatesAndVersion(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/builds" {
+			t.Fatalf("expected path /v1/builds, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("filter[app]") != "123" {
+			t.Fatalf("expected filter[app]=123, got %q", values.Get("filter[app]"))
+		}
+		if values.Get("filter[processingState]") != "PROCESSING,FAILED" {
+			t.Fatalf("expected filter[processingState]=PROCESSING,FAILED, got %q", values.Get("filter[processingState]"))
+		}
+		if values.Get("filter[preReleaseVersion.version]") != "1.2.3" {
+			t.Fatalf("expected filter[preReleaseVersion.version]=1.2.3, got %q", values.Get("filter[preReleaseVersion.version]"))
+		}
+		if values.Get("include") != "preReleaseVersion" {
+			t.Fatalf("expected include=preReleaseVersion, got %q", values.Get("include"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	_, err := client.GetBuilds(context.Background(), "123",
+		WithBuildsProcessingStates([]string{"processing", "FAILED"}),
+		WithBuildsVersion("1.2.3"),
+		WithBuildsInclude([]string{"preReleaseVersion"}),
+	)
+	if err != nil {
+		t.Fatalf("GetBuilds() error: %v", err)
+	}
+}
+
 func TestGetAppStoreVersions_WithFilters(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersions","id":"1","attributes":{"versionString":"1.0.0","platform":"IOS"}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -1303,6 +1335,27 @@ func TestGetApp_ByID(t *testing.T) {
 	}
 }
 
+func TestGetApp_WithInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"name":"Demo"}},"included":[{"type":"builds","id":"build-1"}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/apps/app-1" {
+			t.Fatalf("expected path /v1/apps/app-1, got %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("include"); got != "appInfos,builds" {
+			t.Fatalf("expected include=appInfos,builds, got %q", got)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.GetApp(context.Background(), "app-1", WithAppInclude([]string{"appInfos", "builds"}))
+	if err != nil {
+		t.Fatalf("GetApp() error: %v", err)
+	}
+	if len(resp.Included) == 0 {
+		t.Fatal("expected included resources in response")
+	}
+}
+
 func TestGetBuildAppStoreVersion_ByBuildID(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.0"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -3371,6 +3424,37 @@ func TestUpdateCiWorkflow(t *testing.T) {
 	}
 }
 
+func TestSetCiWorkflowEnabled(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		response := jsonResponse(http.StatusOK, `{"data":{"type":"ciWorkflows","id":"wf-1","attributes":{"name":"CI"}}}`)
+		client := newTestClient(t, func(req *http.Request) {
+			if req.Method != http.MethodPatch {
+				t.Fatalf("expected PATCH, got %s", req.Method)
+			}
+			if req.URL.Path != "/v1/ciWorkflows/wf-1" {
+				t.Fatalf("expected path /v1/ciWorkflows/wf-1, got %s", req.URL.Path)
+			}
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("failed to read request: %v", err)
+			}
+			want := fmt.Sprintf(`{"data":{"type":"ciWorkflows","id":"wf-1","attributes":{"isEnabled":%t}}}`, enabled)
+			if strings.TrimSpace(string(body)) != want {
+				t.Fatalf("expected body %s, got %s", want, body)
+			}
+			assertAuthorized(t, req)
+		}, response)
+
+		resp, err := client.SetCiWorkflowEnabled(context.Background(), "wf-1", enabled)
+		if err != nil {
+			t.Fatalf("SetCiWorkflowEnabled() error: %v", err)
+		}
+		if resp.Data.ID != "wf-1" {
+			t.Fatalf("expected workflow wf-1, got %q", resp.Data.ID)
+		}
+	}
+}
+
 func TestDeleteCiWorkflow(t *testing.T) {
 	response := jsonResponse(http.StatusNoContent, ``)
 	client := newTestClient(t, func(req *http.Request) {
@@ -3447,6 +3531,30 @@ func TestGetCiBuildRun(t *testing.T) {
 	}
 }
 
+func TestGetCiBuildRun_WithInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"ciBuildRuns","id":"run-1"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/ciBuildRuns/run-1" {
+			t.Fatalf("expected path /v1/ciBuildRuns/run-1, got %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("include"); got != "workflow,sourceBranchOrTag" {
+			t.Fatalf("expected include=workflow,sourceBranchOrTag, got %q", got)
+		}
+		if req.URL.Query().Has("limit") {
+			t.Fatalf("expected list-only options to be ignored, got %q", req.URL.RawQuery)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	_, err := client.GetCiBuildRun(context.Background(), "run-1",
+		WithCiBuildRunsInclude([]string{"workflow", "sourceBranchOrTag"}),
+		WithCiBuildRunsLimit(10),
+	)
+	if err != nil {
+		t.Fatalf("GetCiBuildRun() error: %v", err)
+	}
+}
+
 func TestGetCiBuildRunBuilds_WithLimit(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"build-1"}]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -3899,7 +4007,7 @@ func TestResolveGitReferenceByName_CanonicalMatch(t *testing.T) {
 		assertAuthorized(t, req)
 	}, response)
 
-	ref, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "main")
+	ref, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "main", "")
 	if err != nil {
 		t.Fatalf("ResolveGitReferenceByName() error: %v", err)
 	}
@@ -3917,7 +4025,7 @@ func TestResolveGitReferenceByName_SuffixMatchNotAllowed(t *testing.T) {
 		assertAuthorized(t, req)
 	}, response)
 
-	if _, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "main"); err == nil {
+	if _, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "main", ""); err == nil {
 		t.Fatal("expected error")
 	}
 }
@@ -3926,11 +4034,40 @@ func TestResolveGitReferenceByName_NoMatch(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"scmGitReferences","id":"ref-1","attributes":{"name":"develop","canonicalName":"refs/heads/develop","isDeleted":false}}]}`)
 	client := newTestClient(t, nil, response)
 
-	if _, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "main"); err == nil {
+	if _, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "main", ""); err == nil {
 		t.Fatal("expected error")
 	}
 }
 
+func TestResolveGitReferenceByName_AmbiguousBranchAndTag(t *testing.T) {
+	body := `{"data":[{"type":"scmGitReferences","id":"ref-1","attributes":{"name":"v1","canonicalName":"refs/heads/v1","kind":"BRANCH"}},{"type":"scmGitReferences","id":"ref-2","attributes":{"name":"v1","canonicalName":"refs/tags/v1","kind":"TAG"}}]}`
+
+	client := newTestClient(t, nil, jsonResponse(http.StatusOK, body))
+	_, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "v1", "")
+	if err == nil || !strings.Contains(err.Error(), "matches both a branch and a tag") || !strings.Contains(err.Error(), "--git-reference-kind") {
+		t.Fatalf("expected ambiguous branch/tag error, got %v", err)
+	}
+
+	client = newTestClient(t, nil, jsonResponse(http.StatusOK, body))
+	ref, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "v1", ScmGitReferenceKindTag)
+	if err != nil {
+		t.Fatalf("ResolveGitReferenceByName() error: %v", err)
+	}
+	if ref.ID != "ref-2" {
+		t.Fatalf("expected tag ref-2, got %q", ref.ID)
+	}
+}
+
+func TestResolveGitReferenceByName_KindFromCanonicalName(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"scmGitReferences","id":"ref-1","attributes":{"name":"main","canonicalName":"refs/heads/main"}}]}`)
+	client := newTestClient(t, nil, response)
+
+	_, err := client.ResolveGitReferenceByName(context.Background(), "repo-1", "main", ScmGitReferenceKindTag)
+	if err == nil || !strings.Contains(err.Error(), `no tag named "main"`) {
+		t.Fatalf("expected no tag error, got %v", err)
+	}
+}
+
 func TestGetBundleIDs_WithIdentifierFilter(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"bundleIds","id":"bid-1","attributes":{"identifier":"com.example.app"}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -5022,6 +5159,56 @@ func TestGetSubscriptions_WithLimit(t *testing.T) {
 	}
 }
 
+func TestGetSubscriptions_WithInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptions","id":"sub-1","attributes":{"name":"Monthly"}}],"included":[{"type":"subscriptionPrices","id":"price-1"}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		values := req.URL.Query()
+		if values.Get("include") != "prices,subscriptionLocalizations" {
+			t.Fatalf("expected include=prices,subscriptionLocalizations, got %q", values.Get("include"))
+		}
+		if values.Get("limit[prices]") != "10" {
+			t.Fatalf("expected limit[prices]=10, got %q", values.Get("limit[prices]"))
+		}
+		if values.Get("limit[subscriptionLocalizations]") != "5" {
+			t.Fatalf("expected limit[subscriptionLocalizations]=5, got %q", values.Get("limit[subscriptionLocalizations]"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.GetSubscriptions(context.Background(), "group-1",
+		WithSubscriptionsInclude([]string{"prices", "subscriptionLocalizations"}),
+		WithSubscriptionsPricesLimit(10),
+		WithSubscriptionsLocalizationsLimit(5),
+	)
+	if err != nil {
+		t.Fatalf("GetSubscriptions() error: %v", err)
+	}
+	if len(resp.Included) == 0 {
+		t.Fatal("expected included resources to be preserved")
+	}
+}
+
+func TestGetSubscriptionGroups_WithIncludeSubscriptions(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionGroups","id":"group-1","attributes":{"referenceName":"Premium"}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		values := req.URL.Query()
+		if values.Get("include") != "subscriptions" {
+			t.Fatalf("expected include=subscriptions, got %q", values.Get("include"))
+		}
+		if values.Get("limit[subscriptions]") != "50" {
+			t.Fatalf("expected limit[subscriptions]=50, got %q", values.Get("limit[subscriptions]"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetSubscriptionGroups(context.Background(), "app-1",
+		WithSubscriptionGroupsInclude([]string{"subscriptions"}),
+		WithSubscriptionGroupsSubscriptionsLimit(50),
+	); err != nil {
+		t.Fatalf("GetSubscriptionGroups() error: %v", err)
+	}
+}
+
 func TestCreateSubscription(t *testing.T) {
 	response := jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptions","id":"sub-1","attributes":{"name":"Monthly","productId":"com.example.sub.monthly"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -7289,3 +7476,375 @@ func TestDeletePromotedPurchase_SendsRequest(t *testing.T) {
 		t.Fatalf("DeletePromotedPurchase() error: %v", err)
 	}
 }
+
+func TestClientReusesJWTUntilNearExpiry(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	originalNow := jwtNow
+	jwtNow = func() time.Time { return now }
+	t.Cleanup(func() { jwtNow = originalNow })
+
+	var tokens []string
+	client := newTestClient(t, nil, nil)
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		tokens = append(tokens, strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+		return jsonResponse(http.StatusOK, `{"data":[]}`), nil
+	})
+
+	request := func() {
+		t.Helper()
+		if _, err := client.GetApps(context.Background()); err != nil {
+			t.Fatalf("GetApps() error: %v", err)
+		}
+	}
+
+	request()
+	now = now.Add(tokenLifetime - tokenRefreshMargin - time.Second)
+	request()
+	if tokens[0] == "" || tokens[1] != tokens[0] {
+		t.Fatalf("expected the token to be reused before nearing expiry")
+	}
+
+	now = now.Add(time.Second)
+	request()
+	if tokens[2] == tokens[0] {
+		t.Fatalf("expected a new token once within the refresh margin of expiry")
+	}
+}
+
+func TestCreateSubscriptionLocalization(t *testing.T) {
+	response := jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Monthly Pro"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionLocalizations" {
+			t.Fatalf("expected path /v1/subscriptionLocalizations, got %s", req.URL.Path)
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		want := `{"data":{"type":"subscriptionLocalizations","attributes":{"name":"Monthly Pro","locale":"en-US"},"relationships":{"subscription":{"data":{"type":"subscriptions","id":"sub-1"}}}}}`
+		if strings.TrimSpace(string(body)) != want {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.CreateSubscriptionLocalization(context.Background(), "sub-1", SubscriptionLocalizationCreateAttributes{Name: "Monthly Pro", Locale: "en-US"})
+	if err != nil {
+		t.Fatalf("CreateSubscriptionLocalization() error: %v", err)
+	}
+	if resp.Data.ID != "loc-1" {
+		t.Fatalf("expected loc-1, got %q", resp.Data.ID)
+	}
+}
+
+func TestGetSubscriptionPromotionalOffers_WithLimit(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionPromotionalOffers","id":"offer-1"}],"links":{"self":"https://example.com"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptions/sub-1/promotionalOffers" {
+			t.Fatalf("expected path /v1/subscriptions/sub-1/promotionalOffers, got %s", req.URL.Path)
+		}
+		if req.URL.Query().Get("limit") != "5" {
+			t.Fatalf("expected limit=5, got %q", req.URL.Query().Get("limit"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetSubscriptionPromotionalOffers(context.Background(), "sub-1", WithSubscriptionPromotionalOffersLimit(5)); err != nil {
+		t.Fatalf("GetSubscriptionPromotionalOffers() error: %v", err)
+	}
+}
+
+func TestCreateSubscriptionPromotionalOffer(t *testing.T) {
+	response := jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionPromotionalOffers","id":"offer-1","attributes":{"offerCode":"WINBACK"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionPromotionalOffers" {
+			t.Fatalf("expected path /v1/subscriptionPromotionalOffers, got %s", req.URL.Path)
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		want := `{"data":{"type":"subscriptionPromotionalOffers","attributes":{"name":"Win back","offerCode":"WINBACK","duration":"ONE_MONTH","offerMode":"PAY_AS_YOU_GO","numberOfPeriods":3},` +
+			`"relationships":{"subscription":{"data":{"type":"subscriptions","id":"sub-1"}},"prices":{"data":[{"type":"subscriptionPromotionalOfferPrices","id":"${local-price-1}"}]}}},` +
+			`"included":[{"type":"subscriptionPromotionalOfferPrices","id":"${local-price-1}","relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"pp-1"}}}}]}`
+		if strings.TrimSpace(string(body)) != want {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	attrs := SubscriptionPromotionalOfferCreateAttributes{
+		Name:            "Win back",
+		OfferCode:       "WINBACK",
+		Duration:        SubscriptionOfferDurationOneMonth,
+		OfferMode:       SubscriptionOfferModePayAsYouGo,
+		NumberOfPeriods: 3,
+	}
+	resp, err := client.CreateSubscriptionPromotionalOffer(context.Background(), "sub-1", attrs, []string{"pp-1"})
+	if err != nil {
+		t.Fatalf("CreateSubscriptionPromotionalOffer() error: %v", err)
+	}
+	if resp.Data.ID != "offer-1" {
+		t.Fatalf("expected offer-1, got %q", resp.Data.ID)
+	}
+}
+
+func TestDeleteSubscriptionPromotionalOffer(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Fatalf("expected DELETE, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionPromotionalOffers/offer-1" {
+			t.Fatalf("expected path /v1/subscriptionPromotionalOffers/offer-1, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.DeleteSubscriptionPromotionalOffer(context.Background(), "offer-1"); err != nil {
+		t.Fatalf("DeleteSubscriptionPromotionalOffer() error: %v", err)
+	}
+}
+
+func TestGetSubscriptionIntroductoryOffers_WithTerritoryAndLimit(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionIntroductoryOffers","id":"intro-1","attributes":{"duration":"ONE_WEEK","offerMode":"FREE_TRIAL","numberOfPeriods":1}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptions/sub-1/introductoryOffers" {
+			t.Fatalf("expected path /v1/subscriptions/sub-1/introductoryOffers, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("filter[territory]") != "USA" {
+			t.Fatalf("expected filter[territory]=USA, got %q", values.Get("filter[territory]"))
+		}
+		if values.Get("limit") != "10" {
+			t.Fatalf("expected limit=10, got %q", values.Get("limit"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetSubscriptionIntroductoryOffers(context.Background(), "sub-1", WithSubscriptionIntroductoryOffersTerritories([]string{"usa"}), WithSubscriptionIntroductoryOffersLimit(10)); err != nil {
+		t.Fatalf("GetSubscriptionIntroductoryOffers() error: %v", err)
+	}
+}
+
+func TestCreateSubscriptionIntroductoryOffer(t *testing.T) {
+	response := jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionIntroductoryOffers","id":"intro-1","attributes":{"offerMode":"PAY_UP_FRONT"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionIntroductoryOffers" {
+			t.Fatalf("expected path /v1/subscriptionIntroductoryOffers, got %s", req.URL.Path)
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		want := `{"data":{"type":"subscriptionIntroductoryOffers","attributes":{"startDate":"2026-03-01","duration":"ONE_MONTH","offerMode":"PAY_UP_FRONT","numberOfPeriods":1},` +
+			`"relationships":{"subscription":{"data":{"type":"subscriptions","id":"sub-1"}},"territory":{"data":{"type":"territories","id":"USA"}},"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"pp-1"}}}}}`
+		if strings.TrimSpace(string(body)) != want {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	attrs := SubscriptionIntroductoryOfferCreateAttributes{
+		StartDate:       "2026-03-01",
+		Duration:        SubscriptionOfferDurationOneMonth,
+		OfferMode:       SubscriptionOfferModePayUpFront,
+		NumberOfPeriods: 1,
+	}
+	resp, err := client.CreateSubscriptionIntroductoryOffer(context.Background(), "sub-1", attrs, "usa", "pp-1")
+	if err != nil {
+		t.Fatalf("CreateSubscriptionIntroductoryOffer() error: %v", err)
+	}
+	if resp.Data.ID != "intro-1" {
+		t.Fatalf("expected intro-1, got %q", resp.Data.ID)
+	}
+}
+
+func TestDeleteSubscriptionIntroductoryOffer(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Fatalf("expected DELETE, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionIntroductoryOffers/intro-1" {
+			t.Fatalf("expected path /v1/subscriptionIntroductoryOffers/intro-1, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.DeleteSubscriptionIntroductoryOffer(context.Background(), "intro-1"); err != nil {
+		t.Fatalf("DeleteSubscriptionIntroductoryOffer() error: %v", err)
+	}
+}
+
+func TestGetSubscriptionLocalizations_WithLimit(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Monthly"}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptions/sub-1/subscriptionLocalizations" {
+			t.Fatalf("expected path /v1/subscriptions/sub-1/subscriptionLocalizations, got %s", req.URL.Path)
+		}
+		if req.URL.Query().Get("limit") != "5" {
+			t.Fatalf("expected limit=5, got %q", req.URL.Query().Get("limit"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetSubscriptionLocalizations(context.Background(), "sub-1", WithSubscriptionLocalizationsLimit(5)); err != nil {
+		t.Fatalf("GetSubscriptionLocalizations() error: %v", err)
+	}
+}
+
+func TestUpdateSubscriptionLocalization(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"description":"Full access"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionLocalizations/loc-1" {
+			t.Fatalf("expected path /v1/subscriptionLocalizations/loc-1, got %s", req.URL.Path)
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		want := `{"data":{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"description":"Full access"}}}`
+		if strings.TrimSpace(string(body)) != want {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	description := "Full access"
+	if _, err := client.UpdateSubscriptionLocalization(context.Background(), "loc-1", SubscriptionLocalizationUpdateAttributes{Description: &description}); err != nil {
+		t.Fatalf("UpdateSubscriptionLocalization() error: %v", err)
+	}
+}
+
+func TestDeleteSubscriptionLocalization(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Fatalf("expected DELETE, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionLocalizations/loc-1" {
+			t.Fatalf("expected path /v1/subscriptionLocalizations/loc-1, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.DeleteSubscriptionLocalization(context.Background(), "loc-1"); err != nil {
+		t.Fatalf("DeleteSubscriptionLocalization() error: %v", err)
+	}
+}
+
+func TestGetSubscriptionPrices_WithTerritory(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionPrices","id":"price-1","attributes":{"startDate":"2026-11-01","preserved":false}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptions/sub-1/prices" {
+			t.Fatalf("expected path /v1/subscriptions/sub-1/prices, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("filter[territory]") != "USA" {
+			t.Fatalf("expected filter[territory]=USA, got %q", values.Get("filter[territory]"))
+		}
+		if values.Get("limit") != "10" {
+			t.Fatalf("expected limit=10, got %q", values.Get("limit"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.GetSubscriptionPrices(context.Background(), "sub-1", WithSubscriptionPricesTerritory("usa"), WithSubscriptionPricesLimit(10))
+	if err != nil {
+		t.Fatalf("GetSubscriptionPrices() error: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].Attributes.StartDate != "2026-11-01" {
+		t.Fatalf("unexpected response: %+v", resp.Data)
+	}
+}
+
+func TestDeleteSubscriptionPrice(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Fatalf("expected DELETE, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionPrices/price-1" {
+			t.Fatalf("expected path /v1/subscriptionPrices/price-1, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.DeleteSubscriptionPrice(context.Background(), "price-1"); err != nil {
+		t.Fatalf("DeleteSubscriptionPrice() error: %v", err)
+	}
+}
+
+func TestUpdateSubscription_GroupLevel(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"subscriptions","id":"sub-1","attributes":{"groupLevel":2}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptions/sub-1" {
+			t.Fatalf("expected path /v1/subscriptions/sub-1, got %s", req.URL.Path)
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		want := `{"data":{"type":"subscriptions","id":"sub-1","attributes":{"groupLevel":2}}}`
+		if strings.TrimSpace(string(body)) != want {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	level := 2
+	if _, err := client.UpdateSubscription(context.Background(), "sub-1", SubscriptionUpdateAttributes{GroupLevel: &level}); err != nil {
+		t.Fatalf("UpdateSubscription() error: %v", err)
+	}
+}
+
+func TestTokenIdentity(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	token, err := GenerateJWT("KEY123", "ISS456", key)
+	if err != nil {
+		t.Fatalf("GenerateJWT() error: %v", err)
+	}
+
+	keyID, issuerID, err := TokenIdentity(token)
+	if err != nil {
+		t.Fatalf("TokenIdentity() error: %v", err)
+	}
+	if keyID != "KEY123" || issuerID != "ISS456" {
+		t.Fatalf("TokenIdentity() = %q, %q; want KEY123, ISS456", keyID, issuerID)
+	}
+
+	if _, _, err := TokenIdentity("not-a-token"); err == nil {
+		t.Fatal("expected error for malformed token")
+	}
+}
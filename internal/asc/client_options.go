@@ -14,6 +14,9 @@ type ReviewOption func(*reviewQuery)
 // AppsOption is a functional option for GetApps.
 type AppsOption func(*appsQuery)
 
+// AppOption is a functional option for GetApp.
+type AppOption func(*appQuery)
+
 // AppClipsOption is a functional option for GetAppClips.
 type AppClipsOption func(*appClipsQuery)
 
@@ -1016,6 +1019,13 @@ func WithAppsSKUs(skus []string) AppsOption {
 	}
 }
 
+// WithAppInclude sets include for app detail responses.
+func WithAppInclude(include []string) AppOption {
+	return func(q *appQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithAppClipsLimit sets the max number of App Clips to return.
 func WithAppClipsLimit(limit int) AppClipsOption {
 	return func(q *appClipsQuery) {
@@ -1335,6 +1345,29 @@ func WithBuildsPreReleaseVersion(preReleaseVersionID string) BuildsOption {
 	}
 }
 
+// WithBuildsVersion filters builds by pre-release version string (e.g. 1.2.3).
+func WithBuildsVersion(version string) BuildsOption {
+	return func(q *buildsQuery) {
+		if strings.TrimSpace(version) != "" {
+			q.version = strings.TrimSpace(version)
+		}
+	}
+}
+
+// WithBuildsProcessingStates filters builds by processing state.
+func WithBuildsProcessingStates(states []string) BuildsOption {
+	return func(q *buildsQuery) {
+		q.processingStates = normalizeUpperList(states)
+	}
+}
+
+// WithBuildsInclude includes related resources in the builds response.
+func WithBuildsInclude(include []string) BuildsOption {
+	return func(q *buildsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithBuildBundlesLimit sets the max number of included build bundles to return.
 func WithBuildBundlesLimit(limit int) BuildBundlesOption {
 	return func(q *buildBundlesQuery) {
@@ -2,6 +2,7 @@ package asc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 )
@@ -162,6 +163,14 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		result = &SubscriptionGroupsResponse{Links: Links{}}
 	case *SubscriptionsResponse:
 		result = &SubscriptionsResponse{Links: Links{}}
+	case *SubscriptionLocalizationsResponse:
+		result = &SubscriptionLocalizationsResponse{Links: Links{}}
+	case *SubscriptionPricesResponse:
+		result = &SubscriptionPricesResponse{Links: Links{}}
+	case *SubscriptionPromotionalOffersResponse:
+		result = &SubscriptionPromotionalOffersResponse{Links: Links{}}
+	case *SubscriptionIntroductoryOffersResponse:
+		result = &SubscriptionIntroductoryOffersResponse{Links: Links{}}
 	case *PromotedPurchasesResponse:
 		result = &PromotedPurchasesResponse{Links: Links{}}
 	case *BetaGroupsResponse:
@@ -218,46 +227,182 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		result = &CiMacOsVersionsResponse{Links: Links{}}
 	case *CiXcodeVersionsResponse:
 		result = &CiXcodeVersionsResponse{Links: Links{}}
+	case *GameCenterAchievementsResponse:
+		result = &GameCenterAchievementsResponse{Links: Links{}}
+	case *GameCenterAchievementLocalizationsResponse:
+		result = &GameCenterAchievementLocalizationsResponse{Links: Links{}}
+	case *GameCenterAchievementReleasesResponse:
+		result = &GameCenterAchievementReleasesResponse{Links: Links{}}
+	case *GameCenterAchievementImagesResponse:
+		result = &GameCenterAchievementImagesResponse{Links: Links{}}
+	case *GameCenterLeaderboardsResponse:
+		result = &GameCenterLeaderboardsResponse{Links: Links{}}
+	case *GameCenterLeaderboardLocalizationsResponse:
+		result = &GameCenterLeaderboardLocalizationsResponse{Links: Links{}}
+	case *GameCenterLeaderboardReleasesResponse:
+		result = &GameCenterLeaderboardReleasesResponse{Links: Links{}}
+	case *GameCenterLeaderboardImagesResponse:
+		result = &GameCenterLeaderboardImagesResponse{Links: Links{}}
+	case *GameCenterLeaderboardSetsResponse:
+		result = &GameCenterLeaderboardSetsResponse{Links: Links{}}
+	case *GameCenterLeaderboardSetLocalizationsResponse:
+		result = &GameCenterLeaderboardSetLocalizationsResponse{Links: Links{}}
+	case *GameCenterLeaderboardSetReleasesResponse:
+		result = &GameCenterLeaderboardSetReleasesResponse{Links: Links{}}
+	case *SubscriptionPricePointsResponse:
+		result = &SubscriptionPricePointsResponse{Links: Links{}}
+	case *RawListResponse:
+		result = &RawListResponse{Links: Links{}}
 	default:
 		return nil, fmt.Errorf("unsupported response type for pagination")
 	}
 
+	var aggregateErr error
+	pages := 0
+	err := PaginateEach(ctx, firstPage, fetchNext, func(page int, resp PaginatedResponse) error {
+		// Aggregate data from current page using reflection over the Data field.
+		// This keeps aggregation generic while still validating type compatibility.
+		if err := aggregatePageData(result, resp); err != nil {
+			aggregateErr = fmt.Errorf("page %d: %w", page, err)
+			return aggregateErr
+		}
+		pages = page
+		return nil
+	})
+	if aggregateErr != nil {
+		return nil, aggregateErr
+	}
+	if metaErr := setPaginationMeta(result, pages); metaErr != nil {
+		return nil, metaErr
+	}
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// PaginationMeta reports how much an aggregated response fetched, so a
+// truncated walk is visible. PaginateAll stores it under "pagination" in the
+// response's meta.
+type PaginationMeta struct {
+	Items int `json:"items"`
+	Pages int `json:"pages"`
+}
+
+// PaginationMetaFor returns the pagination summary PaginateAll recorded on
+// resp, if any. Responses that were not aggregated report false.
+func PaginationMetaFor(resp interface{}) (PaginationMeta, bool) {
+	field, ok := metaField(resp)
+	if !ok {
+		return PaginationMeta{}, false
+	}
+	meta := field.Interface().(json.RawMessage)
+	if len(meta) == 0 {
+		return PaginationMeta{}, false
+	}
+	var parsed struct {
+		Pagination *PaginationMeta `json:"pagination"`
+	}
+	if err := json.Unmarshal(meta, &parsed); err != nil || parsed.Pagination == nil {
+		return PaginationMeta{}, false
+	}
+	return *parsed.Pagination, true
+}
+
+// setPaginationMeta replaces result's meta with the item and page counts of
+// the aggregation. The API's own meta describes a single page, so it is not
+// carried over.
+func setPaginationMeta(result PaginatedResponse, pages int) error {
+	meta, ok := metaField(result)
+	if !ok {
+		return nil
+	}
+	items := 0
+	if data := reflect.ValueOf(result.GetData()); data.Kind() == reflect.Slice {
+		items = data.Len()
+	}
+	encoded, err := json.Marshal(struct {
+		Pagination PaginationMeta `json:"pagination"`
+	}{PaginationMeta{Items: items, Pages: pages}})
+	if err != nil {
+		return err
+	}
+	meta.Set(reflect.ValueOf(json.RawMessage(encoded)))
+	return nil
+}
+
+// metaField returns the settable json.RawMessage Meta field of a response
+// struct pointer.
+func metaField(resp interface{}) (reflect.Value, bool) {
+	value := reflect.ValueOf(resp)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	field := value.Elem().FieldByName("Meta")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(json.RawMessage(nil)) {
+		return reflect.Value{}, false
+	}
+	return field, true
+}
+
+// PageVisitor receives each page fetched by PaginateEach, numbered from 1.
+type PageVisitor func(page int, resp PaginatedResponse) error
+
+// PaginateEach walks all pages starting at firstPage and hands each one to
+// visit as soon as it arrives, without aggregating. A visit error stops the
+// walk and is returned as is.
+func PaginateEach(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc, visit PageVisitor) error {
+	if firstPage == nil {
+		return nil
+	}
+
+	current := firstPage
 	page := 1
 	seenNext := make(map[string]struct{})
 	for {
-		// Aggregate data from current page using reflection over the Data field.
-		// This keeps aggregation generic while still validating type compatibility.
-		if err := aggregatePageData(result, firstPage); err != nil {
-			return nil, fmt.Errorf("page %d: %w", page, err)
+		if err := visit(page, current); err != nil {
+			return err
 		}
 
 		// Check for next page
-		links := firstPage.GetLinks()
+		links := current.GetLinks()
 		if links == nil || links.Next == "" {
-			break
+			return nil
 		}
 
 		if _, ok := seenNext[links.Next]; ok {
-			return result, fmt.Errorf("page %d: %w", page+1, ErrRepeatedPaginationURL)
+			return fmt.Errorf("page %d: %w", page+1, ErrRepeatedPaginationURL)
 		}
 		seenNext[links.Next] = struct{}{}
 		page++
 
 		// Fetch next page
-		nextPage, err := fetchNext(ctx, links.Next)
+		nextPage, err := fetchPageWithRetry(ctx, fetchNext, links.Next)
 		if err != nil {
-			return result, fmt.Errorf("page %d: %w", page, err)
+			return fmt.Errorf("page %d: %w", page, err)
 		}
 
 		// Validate that the response type matches
 		if typeOf(nextPage) != typeOf(firstPage) {
-			return result, fmt.Errorf("page %d: unexpected response type (expected %T, got %T)", page, firstPage, nextPage)
+			return fmt.Errorf("page %d: unexpected response type (expected %T, got %T)", page, firstPage, nextPage)
 		}
 
-		firstPage = nextPage
+		current = nextPage
 	}
+}
 
-	return result, nil
+// fetchPageWithRetry fetches one page, retrying transient network failures
+// with the resolved retry options so a blip midway through aggregation doesn't
+// discard the pages already fetched. Rate limits are retried by the client.
+func fetchPageWithRetry(ctx context.Context, fetchNext PaginateFunc, nextURL string) (PaginatedResponse, error) {
+	return WithRetry(ctx, func() (PaginatedResponse, error) {
+		page, err := fetchNext(ctx, nextURL)
+		if err != nil && IsTransientNetworkError(err) {
+			return nil, &RetryableError{Err: err}
+		}
+		return page, err
+	}, ResolveRetryOptions())
 }
 
 // aggregatePageData appends page data to result by reflecting on the shared Data field.
@@ -292,6 +437,17 @@ func aggregatePageData(result, page PaginatedResponse) error {
 	}
 
 	resultData.Set(reflect.AppendSlice(resultData, pageData))
+
+	// Carry included resources across pages so include-based output survives pagination.
+	resultIncluded := resultElem.FieldByName("Included")
+	pageIncluded := pageElem.FieldByName("Included")
+	if resultIncluded.IsValid() && pageIncluded.IsValid() && resultIncluded.Type() == reflect.TypeOf(json.RawMessage(nil)) {
+		merged, err := MergeIncluded(resultIncluded.Interface().(json.RawMessage), pageIncluded.Interface().(json.RawMessage))
+		if err != nil {
+			return err
+		}
+		resultIncluded.Set(reflect.ValueOf(merged))
+	}
 	return nil
 }
 
@@ -406,6 +562,14 @@ func typeOf(p PaginatedResponse) string {
 		return "SubscriptionGroupsResponse"
 	case *SubscriptionsResponse:
 		return "SubscriptionsResponse"
+	case *SubscriptionLocalizationsResponse:
+		return "SubscriptionLocalizationsResponse"
+	case *SubscriptionPricesResponse:
+		return "SubscriptionPricesResponse"
+	case *SubscriptionPromotionalOffersResponse:
+		return "SubscriptionPromotionalOffersResponse"
+	case *SubscriptionIntroductoryOffersResponse:
+		return "SubscriptionIntroductoryOffersResponse"
 	case *PromotedPurchasesResponse:
 		return "PromotedPurchasesResponse"
 	case *BetaGroupsResponse:
@@ -462,6 +626,32 @@ func typeOf(p PaginatedResponse) string {
 		return "CiMacOsVersionsResponse"
 	case *CiXcodeVersionsResponse:
 		return "CiXcodeVersionsResponse"
+	case *GameCenterAchievementsResponse:
+		return "GameCenterAchievementsResponse"
+	case *GameCenterAchievementLocalizationsResponse:
+		return "GameCenterAchievementLocalizationsResponse"
+	case *GameCenterAchievementReleasesResponse:
+		return "GameCenterAchievementReleasesResponse"
+	case *GameCenterAchievementImagesResponse:
+		return "GameCenterAchievementImagesResponse"
+	case *GameCenterLeaderboardsResponse:
+		return "GameCenterLeaderboardsResponse"
+	case *GameCenterLeaderboardLocalizationsResponse:
+		return "GameCenterLeaderboardLocalizationsResponse"
+	case *GameCenterLeaderboardReleasesResponse:
+		return "GameCenterLeaderboardReleasesResponse"
+	case *GameCenterLeaderboardImagesResponse:
+		return "GameCenterLeaderboardImagesResponse"
+	case *GameCenterLeaderboardSetsResponse:
+		return "GameCenterLeaderboardSetsResponse"
+	case *GameCenterLeaderboardSetLocalizationsResponse:
+		return "GameCenterLeaderboardSetLocalizationsResponse"
+	case *GameCenterLeaderboardSetReleasesResponse:
+		return "GameCenterLeaderboardSetReleasesResponse"
+	case *SubscriptionPricePointsResponse:
+		return "SubscriptionPricePointsResponse"
+	case *RawListResponse:
+		return "RawListResponse"
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,187 @@
+package asc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// PaginateAllConcurrent is PaginateAll with up to workers pages fetched in
+// parallel. It only applies when the first page's next link carries an
+// offset-style cursor (an "offset" query parameter, or a "cursor" that is
+// base64-encoded JSON with an "offset" field), since that is what lets later
+// page URLs be computed up front. Otherwise, or with workers <= 1, it behaves
+// exactly like PaginateAll.
+//
+// Pages are fetched in batches of workers until a page without a next link is
+// seen; the first error is logged at debug level and cancels the requests
+// still in flight. The result is then assembled by following links.next in
+// order, so data ordering matches PaginateAll; any page the batches did not
+// produce is fetched serially.
+func PaginateAllConcurrent(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc, workers int) (PaginatedResponse, error) {
+	if firstPage == nil || workers <= 1 {
+		return PaginateAll(ctx, firstPage, fetchNext)
+	}
+	links := firstPage.GetLinks()
+	if links == nil || links.Next == "" {
+		return PaginateAll(ctx, firstPage, fetchNext)
+	}
+	cursor, ok := parseOffsetCursor(links.Next)
+	if !ok || cursor.offset <= 0 {
+		return PaginateAll(ctx, firstPage, fetchNext)
+	}
+
+	pages := prefetchOffsetPages(ctx, cursor, fetchNext, workers)
+	return PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		if next, ok := parseOffsetCursor(nextURL); ok {
+			if page, ok := pages[next.offset]; ok {
+				return page, nil
+			}
+		}
+		return fetchNext(ctx, nextURL)
+	})
+}
+
+// prefetchOffsetPages fetches the pages from first onwards in batches of
+// workers and returns the pages fetched successfully by offset. The page size
+// is the next link's limit parameter, or first's offset when it has none.
+func prefetchOffsetPages(ctx context.Context, first offsetCursor, fetchNext PaginateFunc, workers int) map[int]PaginatedResponse {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pageSize := first.offset
+	if limit, err := strconv.Atoi(first.url.Query().Get("limit")); err == nil && limit > 0 {
+		pageSize = limit
+	}
+	pages := make(map[int]PaginatedResponse)
+	var (
+		mu      sync.Mutex
+		failed  bool
+		lastHit bool
+	)
+	for batch := 0; !failed && !lastHit; batch++ {
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			offset := first.offset + pageSize*(batch*workers+i)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				page, err := fetchNext(ctx, first.withOffset(offset))
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					if !failed {
+						failed = true
+						cancel()
+						Logger().Debug("page prefetch failed; fetching remaining pages serially", "offset", offset, "error", err)
+					}
+					return
+				}
+				pages[offset] = page
+				if links := page.GetLinks(); links == nil || links.Next == "" {
+					lastHit = true
+				}
+			}()
+		}
+		wg.Wait()
+	}
+	return pages
+}
+
+// offsetCursor is a next-page URL whose position is an explicit offset.
+type offsetCursor struct {
+	url    *url.URL
+	offset int
+	// encoding is nil for a plain "offset" parameter; otherwise the cursor is
+	// JSON in fields encoded with encoding.
+	encoding *base64.Encoding
+	fields   map[string]json.RawMessage
+	quoted   bool
+}
+
+var cursorEncodings = []*base64.Encoding{
+	base64.StdEncoding,
+	base64.URLEncoding,
+	base64.RawStdEncoding,
+	base64.RawURLEncoding,
+}
+
+// parseOffsetCursor extracts the offset from a next-page URL.
+func parseOffsetCursor(rawURL string) (offsetCursor, bool) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return offsetCursor{}, false
+	}
+	values := parsed.Query()
+	if value := values.Get("offset"); value != "" {
+		offset, err := strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			return offsetCursor{}, false
+		}
+		return offsetCursor{url: parsed, offset: offset}, true
+	}
+
+	value := values.Get("cursor")
+	if value == "" {
+		return offsetCursor{}, false
+	}
+	for _, encoding := range cursorEncodings {
+		decoded, err := encoding.DecodeString(value)
+		if err != nil {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(decoded, &fields); err != nil {
+			continue
+		}
+		raw, ok := fields["offset"]
+		if !ok {
+			continue
+		}
+		cursor := offsetCursor{url: parsed, encoding: encoding, fields: fields}
+		var text string
+		if err := json.Unmarshal(raw, &text); err == nil {
+			cursor.quoted = true
+		} else {
+			text = string(raw)
+		}
+		offset, err := strconv.Atoi(text)
+		if err != nil || offset < 0 {
+			continue
+		}
+		cursor.offset = offset
+		return cursor, true
+	}
+	return offsetCursor{}, false
+}
+
+// withOffset returns the cursor's URL pointing at offset instead.
+func (c offsetCursor) withOffset(offset int) string {
+	next := *c.url
+	values := next.Query()
+	if c.encoding == nil {
+		values.Set("offset", strconv.Itoa(offset))
+		next.RawQuery = values.Encode()
+		return next.String()
+	}
+
+	fields := make(map[string]json.RawMessage, len(c.fields))
+	for key, value := range c.fields {
+		fields[key] = value
+	}
+	if c.quoted {
+		fields["offset"] = json.RawMessage(strconv.Quote(strconv.Itoa(offset)))
+	} else {
+		fields["offset"] = json.RawMessage(strconv.Itoa(offset))
+	}
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return c.url.String()
+	}
+	values.Set("cursor", c.encoding.EncodeToString(encoded))
+	next.RawQuery = values.Encode()
+	return next.String()
+}
@@ -0,0 +1,200 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const testPagesBaseURL = "https://api.appstoreconnect.apple.com/v1/ciWorkflows/wf-1/buildRuns"
+
+func offsetTestPage(offset, limit, total int, cursor func(int) string) *CiBuildRunsResponse {
+	data := make([]CiBuildRunResource, 0, limit)
+	for i := offset; i < offset+limit && i < total; i++ {
+		data = append(data, CiBuildRunResource{Type: ResourceTypeCiBuildRuns, ID: fmt.Sprintf("run-%d", i)})
+	}
+	links := Links{}
+	if offset+limit < total {
+		links.Next = cursor(offset + limit)
+	}
+	return &CiBuildRunsResponse{Data: data, Links: links}
+}
+
+func TestPaginateAllConcurrent_OffsetCursorKeepsOrder(t *testing.T) {
+	const limit, total = 10, 95
+	cursor := func(offset int) string {
+		return fmt.Sprintf("%s?limit=%d&offset=%d", testPagesBaseURL, limit, offset)
+	}
+
+	var mu sync.Mutex
+	fetched := map[string]int{}
+	response, err := PaginateAllConcurrent(context.Background(), offsetTestPage(0, limit, total, cursor), func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		next, ok := parseOffsetCursor(nextURL)
+		if !ok {
+			return nil, fmt.Errorf("unexpected next URL %q", nextURL)
+		}
+		mu.Lock()
+		fetched[nextURL]++
+		mu.Unlock()
+		return offsetTestPage(next.offset, limit, total, cursor), nil
+	}, 4)
+	if err != nil {
+		t.Fatalf("PaginateAllConcurrent() error: %v", err)
+	}
+
+	runs := response.(*CiBuildRunsResponse)
+	if len(runs.Data) != total {
+		t.Fatalf("expected %d runs, got %d", total, len(runs.Data))
+	}
+	for i, run := range runs.Data {
+		if run.ID != fmt.Sprintf("run-%d", i) {
+			t.Fatalf("run %d has ID %q; order not preserved", i, run.ID)
+		}
+	}
+	for url, count := range fetched {
+		if count != 1 {
+			t.Fatalf("expected %s to be fetched once, got %d", url, count)
+		}
+	}
+}
+
+func TestPaginateAllConcurrent_Base64JSONCursor(t *testing.T) {
+	const limit, total = 5, 23
+	cursor := func(offset int) string {
+		encoded := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(`{"offset":"%d"}`, offset)))
+		return fmt.Sprintf("%s?cursor=%s&limit=%d", testPagesBaseURL, encoded, limit)
+	}
+
+	response, err := PaginateAllConcurrent(context.Background(), offsetTestPage(0, limit, total, cursor), func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		next, ok := parseOffsetCursor(nextURL)
+		if !ok {
+			return nil, fmt.Errorf("unexpected next URL %q", nextURL)
+		}
+		return offsetTestPage(next.offset, limit, total, cursor), nil
+	}, 3)
+	if err != nil {
+		t.Fatalf("PaginateAllConcurrent() error: %v", err)
+	}
+	runs := response.(*CiBuildRunsResponse)
+	if len(runs.Data) != total || runs.Data[total-1].ID != fmt.Sprintf("run-%d", total-1) {
+		t.Fatalf("unexpected runs: %d", len(runs.Data))
+	}
+}
+
+func TestPaginateAllConcurrent_OpaqueCursorFallsBackToSerial(t *testing.T) {
+	firstPage := &CiBuildRunsResponse{
+		Data:  []CiBuildRunResource{{ID: "run-0"}},
+		Links: Links{Next: testPagesBaseURL + "?cursor=opaque-1"},
+	}
+
+	var requested []string
+	response, err := PaginateAllConcurrent(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		requested = append(requested, nextURL)
+		if strings.HasSuffix(nextURL, "opaque-1") {
+			return &CiBuildRunsResponse{
+				Data:  []CiBuildRunResource{{ID: "run-1"}},
+				Links: Links{Next: testPagesBaseURL + "?cursor=opaque-2"},
+			}, nil
+		}
+		return &CiBuildRunsResponse{Data: []CiBuildRunResource{{ID: "run-2"}}}, nil
+	}, 4)
+	if err != nil {
+		t.Fatalf("PaginateAllConcurrent() error: %v", err)
+	}
+	if len(requested) != 2 || len(response.(*CiBuildRunsResponse).Data) != 3 {
+		t.Fatalf("expected serial pagination, requested %v", requested)
+	}
+}
+
+func TestPaginateAllConcurrent_ReturnsError(t *testing.T) {
+	const limit, total = 10, 100
+	cursor := func(offset int) string {
+		return fmt.Sprintf("%s?limit=%d&offset=%d", testPagesBaseURL, limit, offset)
+	}
+	boom := errors.New("boom")
+
+	_, err := PaginateAllConcurrent(context.Background(), offsetTestPage(0, limit, total, cursor), func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		next, _ := parseOffsetCursor(nextURL)
+		if next.offset == 30 {
+			return nil, boom
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return offsetTestPage(next.offset, limit, total, cursor), nil
+	}, 4)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "page 4") {
+		t.Fatalf("expected error to name the failing page, got %v", err)
+	}
+}
+
+func TestPaginateAllConcurrent_LogsFailedPrefetch(t *testing.T) {
+	var logs bytes.Buffer
+	logState.mu.Lock()
+	previous := logState.logger
+	logState.logger = slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	logState.mu.Unlock()
+	t.Cleanup(func() {
+		logState.mu.Lock()
+		logState.logger = previous
+		logState.mu.Unlock()
+	})
+
+	const limit, total = 10, 50
+	cursor := func(offset int) string {
+		return fmt.Sprintf("%s?limit=%d&offset=%d", testPagesBaseURL, limit, offset)
+	}
+	var (
+		mu     sync.Mutex
+		failed bool
+	)
+	response, err := PaginateAllConcurrent(context.Background(), offsetTestPage(0, limit, total, cursor), func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		next, _ := parseOffsetCursor(nextURL)
+		mu.Lock()
+		if next.offset == 20 && !failed {
+			failed = true
+			mu.Unlock()
+			return nil, errors.New("transient")
+		}
+		mu.Unlock()
+		return offsetTestPage(next.offset, limit, total, cursor), nil
+	}, 4)
+	if err != nil {
+		t.Fatalf("PaginateAllConcurrent() error: %v", err)
+	}
+	if runs := response.(*CiBuildRunsResponse); len(runs.Data) != total {
+		t.Fatalf("expected %d runs, got %d", total, len(runs.Data))
+	}
+	output := logs.String()
+	if !strings.Contains(output, "level=DEBUG") || !strings.Contains(output, "page prefetch failed") || !strings.Contains(output, "error=transient") {
+		t.Fatalf("expected debug log for failed prefetch, got %q", output)
+	}
+}
+
+func TestOffsetCursorWithOffsetRoundTrip(t *testing.T) {
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(`{"offset":200,"sort":"-number"}`))
+	cursor, ok := parseOffsetCursor(testPagesBaseURL + "?cursor=" + encoded + "&limit=200")
+	if !ok || cursor.offset != 200 {
+		t.Fatalf("parseOffsetCursor() = %+v, %t", cursor, ok)
+	}
+
+	next, ok := parseOffsetCursor(cursor.withOffset(600))
+	if !ok || next.offset != 600 {
+		t.Fatalf("withOffset(600) parsed as %+v, %t", next, ok)
+	}
+	if string(next.fields["sort"]) != `"-number"` || next.quoted {
+		t.Fatalf("expected other cursor fields and number form to be kept, got %+v", next)
+	}
+	if got := next.url.Query().Get("limit"); got != "200" {
+		t.Fatalf("expected limit to be kept, got %q", got)
+	}
+}
@@ -52,6 +52,10 @@ type appsQuery struct {
 	skus      []string
 }
 
+type appQuery struct {
+	include []string
+}
+
 type appClipsQuery struct {
 	listQuery
 	bundleIDs []string
@@ -101,6 +105,9 @@ type buildsQuery struct {
 	listQuery
 	sort                string
 	preReleaseVersionID string
+	version             string
+	processingStates    []string
+	include             []string
 }
 
 type buildBundlesQuery struct {
@@ -507,6 +514,12 @@ func buildAppsQuery(query *appsQuery) string {
 	return values.Encode()
 }
 
+func buildAppQuery(query *appQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	return values.Encode()
+}
+
 func buildAppClipsQuery(query *appClipsQuery) string {
 	values := url.Values{}
 	addCSV(values, "filter[bundleId]", query.bundleIDs)
@@ -0,0 +1,48 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// Raw sends an authenticated request to an App Store Connect API path (for
+// example /v1/apps?limit=1) and returns the response body. It goes through the
+// same authentication, retry, dry-run, and timeout handling as every other
+// client call. Absolute URLs are only accepted on the API base URL, so the
+// token is never sent to another host.
+func (c *Client) Raw(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	requestPath, err := normalizeRawPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader
+	if len(body) > 0 {
+		reader = bytes.NewReader(body)
+	}
+	return c.do(ctx, strings.ToUpper(method), requestPath, reader)
+}
+
+func normalizeRawPath(path string) (string, error) {
+	trimmed := strings.TrimSpace(path)
+	if trimmed == "" {
+		return "", fmt.Errorf("path is required")
+	}
+	if strings.HasPrefix(trimmed, "http://") || strings.HasPrefix(trimmed, "https://") {
+		if !strings.HasPrefix(trimmed, BaseURL+"/") {
+			return "", fmt.Errorf("path must be relative to %s (e.g. /v1/apps)", BaseURL)
+		}
+		trimmed = strings.TrimPrefix(trimmed, BaseURL)
+	}
+	if !strings.HasPrefix(trimmed, "/") || strings.HasPrefix(trimmed, "//") {
+		return "", fmt.Errorf("path must start with a single / (e.g. /v1/apps)")
+	}
+	if _, err := url.ParseRequestURI(trimmed); err != nil {
+		return "", fmt.Errorf("invalid path: %w", err)
+	}
+	return trimmed, nil
+}
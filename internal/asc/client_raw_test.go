@@ -0,0 +1,57 @@
+package asc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRaw_SendsRequest(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/apps/app-1" || req.URL.RawQuery != "include=appInfos" {
+			t.Fatalf("unexpected URL %s", req.URL.String())
+		}
+		body, _ := io.ReadAll(req.Body)
+		if string(body) != `{"data":{"type":"apps","id":"app-1"}}` {
+			t.Fatalf("unexpected body %s", body)
+		}
+		assertAuthorized(t, req)
+	}, jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1"}}`))
+
+	resp, err := client.Raw(context.Background(), "patch", "/v1/apps/app-1?include=appInfos", []byte(`{"data":{"type":"apps","id":"app-1"}}`))
+	if err != nil {
+		t.Fatalf("Raw() error: %v", err)
+	}
+	if !strings.Contains(string(resp), `"id":"app-1"`) {
+		t.Fatalf("unexpected response %s", resp)
+	}
+}
+
+func TestRaw_AcceptsBaseURL(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Host != "api.appstoreconnect.apple.com" || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected URL %s", req.URL.String())
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[]}`))
+
+	if _, err := client.Raw(context.Background(), http.MethodGet, BaseURL+"/v1/apps", nil); err != nil {
+		t.Fatalf("Raw() error: %v", err)
+	}
+}
+
+func TestRaw_RejectsPathsOffTheAPI(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected request to %s", req.URL.String())
+	}, jsonResponse(http.StatusOK, `{}`))
+
+	for _, path := range []string{"", "v1/apps", "//evil.example.com/v1/apps", "https://evil.example.com/v1/apps", "https://api.appstoreconnect.apple.com.evil.example.com/v1/apps"} {
+		if _, err := client.Raw(context.Background(), http.MethodGet, path, nil); err == nil {
+			t.Fatalf("expected error for path %q", path)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryTestServer serves responses[i] for the i-th request and the last
+// response for any request after that.
+func newRetryTestServer(t *testing.T, responses ...func(http.ResponseWriter)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		index := int(calls.Add(1)) - 1
+		if index >= len(responses) {
+			index = len(responses) - 1
+		}
+		responses[index](w)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func rateLimited(retryAfter string) func(http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", retryAfter)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+}
+
+func okResponse(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"data":{"type":"apps","id":"app-1"}}`))
+}
+
+// setSlowBackoff makes exponential backoff much slower than the Retry-After
+// values used below, so a fast test proves the header was honored.
+func setSlowBackoff(t *testing.T) {
+	t.Helper()
+	t.Setenv("ASC_MAX_RETRIES", "3")
+	t.Setenv("ASC_BASE_DELAY", "5s")
+	t.Setenv("ASC_MAX_DELAY", "5s")
+	t.Setenv("ASC_RETRY_LOG", "")
+}
+
+func TestDo_RateLimitedHonorsRetryAfter(t *testing.T) {
+	setSlowBackoff(t)
+	server, calls := newRetryTestServer(t, rateLimited("1"), rateLimited("1"), okResponse)
+	client := newTestClient(t, nil, nil)
+	client.httpClient = server.Client()
+
+	start := time.Now()
+	data, err := client.do(context.Background(), http.MethodGet, server.URL+"/v1/apps/app-1", nil)
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if !strings.Contains(string(data), "app-1") {
+		t.Fatalf("unexpected response: %s", data)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("expected 3 requests, got %d", got)
+	}
+	if elapsed < 2*time.Second || elapsed >= 4*time.Second {
+		t.Fatalf("expected ~2s of Retry-After waits, took %s", elapsed)
+	}
+}
+
+func TestDo_RateLimitedRetriesNonIdempotentMethods(t *testing.T) {
+	setSlowBackoff(t)
+	server, calls := newRetryTestServer(t, rateLimited("1"), okResponse)
+	client := newTestClient(t, nil, nil)
+	client.httpClient = server.Client()
+
+	if _, err := client.do(context.Background(), http.MethodPost, server.URL+"/v1/apps", strings.NewReader(`{}`)); err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected rate-limited POST to be retried once, got %d requests", got)
+	}
+}
+
+func TestDo_ServiceUnavailableDoesNotRetryNonIdempotentMethods(t *testing.T) {
+	setSlowBackoff(t)
+	server, calls := newRetryTestServer(t, func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, okResponse)
+	client := newTestClient(t, nil, nil)
+	client.httpClient = server.Client()
+
+	_, err := client.do(context.Background(), http.MethodPost, server.URL+"/v1/apps", strings.NewReader(`{}`))
+	if err == nil || IsRetryable(err) {
+		t.Fatalf("expected non-retryable 503 error, got %v", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected POST not to be retried on 503, got %d requests", got)
+	}
+}
+
+func TestDo_RetryAfterBeyondDeadlineFailsFast(t *testing.T) {
+	setSlowBackoff(t)
+	server, calls := newRetryTestServer(t, rateLimited("30"), okResponse)
+	client := newTestClient(t, nil, nil)
+	client.httpClient = server.Client()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.do(ctx, http.MethodGet, server.URL+"/v1/apps/app-1", nil)
+	if err == nil || !strings.Contains(err.Error(), "would exceed the request deadline") {
+		t.Fatalf("expected deadline error, got %v", err)
+	}
+	if IsRetryable(err) {
+		t.Fatalf("expected exhausted retry error to be non-retryable, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Fatalf("expected to fail without waiting, took %s", elapsed)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 request, got %d", got)
+	}
+}
@@ -242,6 +242,137 @@ func (c *Client) DeleteSubscription(ctx context.Context, subID string) error {
 	return err
 }
 
+// CreateSubscriptionLocalization creates a localization for a subscription.
+func (c *Client) CreateSubscriptionLocalization(ctx context.Context, subID string, attrs SubscriptionLocalizationCreateAttributes) (*SubscriptionLocalizationResponse, error) {
+	payload := SubscriptionLocalizationCreateRequest{
+		Data: SubscriptionLocalizationCreateData{
+			Type:       ResourceTypeSubscriptionLocalizations,
+			Attributes: attrs,
+			Relationships: &SubscriptionLocalizationRelationships{
+				Subscription: &Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   strings.TrimSpace(subID),
+					},
+				},
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionLocalizations", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionLocalizations retrieves the localizations of a subscription.
+func (c *Client) GetSubscriptionLocalizations(ctx context.Context, subID string, opts ...SubscriptionLocalizationsOption) (*SubscriptionLocalizationsResponse, error) {
+	query := &subscriptionLocalizationsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	path := fmt.Sprintf("/v1/subscriptions/%s/subscriptionLocalizations", strings.TrimSpace(subID))
+	if query.nextURL != "" {
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionLocalizations: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionLocalizationsQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionLocalizationsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// UpdateSubscriptionLocalization updates a subscription localization.
+func (c *Client) UpdateSubscriptionLocalization(ctx context.Context, localizationID string, attrs SubscriptionLocalizationUpdateAttributes) (*SubscriptionLocalizationResponse, error) {
+	payload := SubscriptionLocalizationUpdateRequest{
+		Data: SubscriptionLocalizationUpdateData{
+			Type:       ResourceTypeSubscriptionLocalizations,
+			ID:         strings.TrimSpace(localizationID),
+			Attributes: attrs,
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/subscriptionLocalizations/%s", strings.TrimSpace(localizationID))
+	data, err := c.do(ctx, http.MethodPatch, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionLocalization deletes a subscription localization.
+func (c *Client) DeleteSubscriptionLocalization(ctx context.Context, localizationID string) error {
+	path := fmt.Sprintf("/v1/subscriptionLocalizations/%s", strings.TrimSpace(localizationID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+// GetSubscriptionPricePoints retrieves the price points available to a subscription.
+func (c *Client) GetSubscriptionPricePoints(ctx context.Context, subID string, opts ...SubscriptionPricePointsOption) (*SubscriptionPricePointsResponse, error) {
+	query := &subscriptionPricePointsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	path := fmt.Sprintf("/v1/subscriptions/%s/pricePoints", strings.TrimSpace(subID))
+	if query.nextURL != "" {
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionPricePoints: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionPricePointsQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPricePointsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // CreateSubscriptionPrice adds a price to a subscription.
 func (c *Client) CreateSubscriptionPrice(ctx context.Context, subID, pricePointID string, attrs SubscriptionPriceCreateAttributes) (*SubscriptionPriceResponse, error) {
 	subID = strings.TrimSpace(subID)
@@ -294,6 +425,44 @@ func (c *Client) CreateSubscriptionPrice(ctx context.Context, subID, pricePointI
 	return &response, nil
 }
 
+// GetSubscriptionPrices retrieves the prices of a subscription, including
+// ones scheduled to start in the future.
+func (c *Client) GetSubscriptionPrices(ctx context.Context, subID string, opts ...SubscriptionPricesOption) (*SubscriptionPricesResponse, error) {
+	query := &subscriptionPricesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	path := fmt.Sprintf("/v1/subscriptions/%s/prices", strings.TrimSpace(subID))
+	if query.nextURL != "" {
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionPrices: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionPricesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPricesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionPrice deletes a subscription price.
+func (c *Client) DeleteSubscriptionPrice(ctx context.Context, priceID string) error {
+	path := fmt.Sprintf("/v1/subscriptionPrices/%s", strings.TrimSpace(priceID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
 // CreateSubscriptionAvailability sets subscription availability in territories.
 func (c *Client) CreateSubscriptionAvailability(ctx context.Context, subID string, territoryIDs []string, attrs SubscriptionAvailabilityAttributes) (*SubscriptionAvailabilityResponse, error) {
 	subID = strings.TrimSpace(subID)
@@ -346,3 +515,219 @@ func (c *Client) CreateSubscriptionAvailability(ctx context.Context, subID strin
 
 	return &response, nil
 }
+
+// GetSubscriptionPromotionalOffers retrieves the promotional offers of a subscription.
+func (c *Client) GetSubscriptionPromotionalOffers(ctx context.Context, subID string, opts ...SubscriptionPromotionalOffersOption) (*SubscriptionPromotionalOffersResponse, error) {
+	query := &subscriptionPromotionalOffersQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	path := fmt.Sprintf("/v1/subscriptions/%s/promotionalOffers", strings.TrimSpace(subID))
+	if query.nextURL != "" {
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionPromotionalOffers: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionPromotionalOffersQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOffersResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSubscriptionPromotionalOffer retrieves a promotional offer by ID.
+func (c *Client) GetSubscriptionPromotionalOffer(ctx context.Context, offerID string) (*SubscriptionPromotionalOfferResponse, error) {
+	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s", strings.TrimSpace(offerID))
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionPromotionalOffer creates a promotional offer for a
+// subscription. Each price point ID becomes an offer price created inline
+// with the offer.
+func (c *Client) CreateSubscriptionPromotionalOffer(ctx context.Context, subID string, attrs SubscriptionPromotionalOfferCreateAttributes, pricePointIDs []string) (*SubscriptionPromotionalOfferResponse, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+	pricePointIDs = normalizeList(pricePointIDs)
+
+	priceData := make([]ResourceData, 0, len(pricePointIDs))
+	included := make([]SubscriptionPromotionalOfferPriceInlineCreate, 0, len(pricePointIDs))
+	for i, pricePointID := range pricePointIDs {
+		localID := fmt.Sprintf("${local-price-%d}", i+1)
+		priceData = append(priceData, ResourceData{
+			Type: ResourceTypeSubscriptionPromotionalOfferPrices,
+			ID:   localID,
+		})
+		included = append(included, SubscriptionPromotionalOfferPriceInlineCreate{
+			Type: ResourceTypeSubscriptionPromotionalOfferPrices,
+			ID:   localID,
+			Relationships: &SubscriptionPromotionalOfferPriceRelationships{
+				SubscriptionPricePoint: &Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptionPricePoints,
+						ID:   pricePointID,
+					},
+				},
+			},
+		})
+	}
+
+	payload := SubscriptionPromotionalOfferCreateRequest{
+		Data: SubscriptionPromotionalOfferCreateData{
+			Type:       ResourceTypeSubscriptionPromotionalOffers,
+			Attributes: attrs,
+			Relationships: SubscriptionPromotionalOfferCreateRelationships{
+				Subscription: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   subID,
+					},
+				},
+				Prices: RelationshipList{Data: priceData},
+			},
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionPromotionalOffers", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionPromotionalOffer deletes a promotional offer.
+func (c *Client) DeleteSubscriptionPromotionalOffer(ctx context.Context, offerID string) error {
+	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s", strings.TrimSpace(offerID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+// GetSubscriptionIntroductoryOffers retrieves the introductory offers of a subscription.
+func (c *Client) GetSubscriptionIntroductoryOffers(ctx context.Context, subID string, opts ...SubscriptionIntroductoryOffersOption) (*SubscriptionIntroductoryOffersResponse, error) {
+	query := &subscriptionIntroductoryOffersQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	path := fmt.Sprintf("/v1/subscriptions/%s/introductoryOffers", strings.TrimSpace(subID))
+	if query.nextURL != "" {
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("subscriptionIntroductoryOffers: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildSubscriptionIntroductoryOffersQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionIntroductoryOffersResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateSubscriptionIntroductoryOffer creates an introductory offer for a subscription.
+// territoryID and pricePointID are optional; free trials have no price point.
+func (c *Client) CreateSubscriptionIntroductoryOffer(ctx context.Context, subID string, attrs SubscriptionIntroductoryOfferCreateAttributes, territoryID, pricePointID string) (*SubscriptionIntroductoryOfferResponse, error) {
+	subID = strings.TrimSpace(subID)
+	if subID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+
+	relationships := SubscriptionIntroductoryOfferRelationships{
+		Subscription: Relationship{
+			Data: ResourceData{
+				Type: ResourceTypeSubscriptions,
+				ID:   subID,
+			},
+		},
+	}
+	if territoryID = strings.TrimSpace(territoryID); territoryID != "" {
+		relationships.Territory = &Relationship{
+			Data: ResourceData{
+				Type: ResourceTypeTerritories,
+				ID:   strings.ToUpper(territoryID),
+			},
+		}
+	}
+	if pricePointID = strings.TrimSpace(pricePointID); pricePointID != "" {
+		relationships.SubscriptionPricePoint = &Relationship{
+			Data: ResourceData{
+				Type: ResourceTypeSubscriptionPricePoints,
+				ID:   pricePointID,
+			},
+		}
+	}
+
+	payload := SubscriptionIntroductoryOfferCreateRequest{
+		Data: SubscriptionIntroductoryOfferCreateData{
+			Type:          ResourceTypeSubscriptionIntroductoryOffers,
+			Attributes:    attrs,
+			Relationships: relationships,
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionIntroductoryOffers", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionIntroductoryOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSubscriptionIntroductoryOffer deletes an introductory offer.
+func (c *Client) DeleteSubscriptionIntroductoryOffer(ctx context.Context, offerID string) error {
+	path := fmt.Sprintf("/v1/subscriptionIntroductoryOffers/%s", strings.TrimSpace(offerID))
+	_, err := c.do(ctx, http.MethodDelete, path, nil)
+	return err
+}
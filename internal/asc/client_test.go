@@ -6,9 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -2207,6 +2211,88 @@ func TestWithRetry_NonRetryableErrorFailsFast(t *testing.T) {
 	}
 }
 
+func TestRetryBackoff_JitterStaysWithinFraction(t *testing.T) {
+	for _, jitter := range []float64{0.1, 0.25, 0.5} {
+		opts := RetryOptions{BaseDelay: time.Second, MaxDelay: 30 * time.Second, Jitter: jitter}
+		for retryCount, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second} {
+			spread := time.Duration(float64(want) * jitter)
+			for i := 0; i < 200; i++ {
+				delay := retryBackoff(opts, retryCount)
+				if delay < want-spread || delay > want+spread {
+					t.Fatalf("jitter=%v retry=%d: delay %s outside %s ± %s", jitter, retryCount, delay, want, spread)
+				}
+			}
+		}
+	}
+}
+
+func TestRetryBackoff_NegativeJitterIsExact(t *testing.T) {
+	opts := RetryOptions{BaseDelay: time.Second, MaxDelay: 30 * time.Second, Jitter: -1}
+	for i := 0; i < 20; i++ {
+		if delay := retryBackoff(opts, 2); delay != 4*time.Second {
+			t.Fatalf("expected exact 4s delay without jitter, got %s", delay)
+		}
+	}
+}
+
+func TestResolveTimeout_OverrideBeatsEnv(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("ASC_TIMEOUT", "90s")
+	t.Setenv("ASC_UPLOAD_TIMEOUT", "3m")
+	t.Cleanup(func() { SetTimeoutOverride(0) })
+
+	if got := ResolveTimeout(); got != 90*time.Second {
+		t.Fatalf("expected ASC_TIMEOUT without an override, got %v", got)
+	}
+
+	SetTimeoutOverride(5 * time.Second)
+	if got := ResolveTimeout(); got != 5*time.Second {
+		t.Fatalf("expected override to beat ASC_TIMEOUT, got %v", got)
+	}
+	if got := ResolveTimeoutWithDefault(time.Hour); got != 5*time.Second {
+		t.Fatalf("expected override to beat the default, got %v", got)
+	}
+	if got := ResolveUploadTimeout(); got != 5*time.Second {
+		t.Fatalf("expected override to beat ASC_UPLOAD_TIMEOUT, got %v", got)
+	}
+
+	SetTimeoutOverride(0)
+	if got := ResolveUploadTimeout(); got != 3*time.Minute {
+		t.Fatalf("expected ASC_UPLOAD_TIMEOUT once cleared, got %v", got)
+	}
+}
+
+func TestResolveRetryOptions_RetryEnv(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	for _, name := range []string{"ASC_MAX_RETRIES", "ASC_BASE_DELAY", "ASC_MAX_DELAY", "ASC_RETRY_MAX", "ASC_RETRY_BASE", "ASC_RETRY_JITTER"} {
+		t.Setenv(name, "") // restores the variable after the test
+		os.Unsetenv(name)
+	}
+
+	defaults := ResolveRetryOptions()
+	if defaults.MaxRetries != DefaultMaxRetries || defaults.BaseDelay != DefaultBaseDelay || defaults.Jitter != DefaultRetryJitter {
+		t.Fatalf("expected defaults when unset, got %+v", defaults)
+	}
+
+	t.Setenv("ASC_RETRY_MAX", "5")
+	t.Setenv("ASC_RETRY_BASE", "250ms")
+	t.Setenv("ASC_RETRY_JITTER", "0.1")
+	opts := ResolveRetryOptions()
+	if opts.MaxRetries != 5 || opts.BaseDelay != 250*time.Millisecond || opts.Jitter != 0.1 {
+		t.Fatalf("expected env overrides, got %+v", opts)
+	}
+
+	t.Setenv("ASC_RETRY_JITTER", "0")
+	if opts := ResolveRetryOptions(); opts.Jitter >= 0 {
+		t.Fatalf("expected ASC_RETRY_JITTER=0 to disable jitter, got %v", opts.Jitter)
+	}
+
+	t.Setenv("ASC_RETRY_JITTER", "1.5")
+	if opts := ResolveRetryOptions(); opts.Jitter != DefaultRetryJitter {
+		t.Fatalf("expected out-of-range jitter to be ignored, got %v", opts.Jitter)
+	}
+}
+
 func TestWithRetry_SuccessOnFirstTry(t *testing.T) {
 	callCount := 0
 
@@ -2273,6 +2359,38 @@ func TestPaginateAll_CiBuildRuns_ManyPages(t *testing.T) {
 	}
 }
 
+func TestPaginateAll_RecordsPaginationMeta(t *testing.T) {
+	firstPage := &AppsResponse{
+		Data:  []Resource[AppAttributes]{{Type: ResourceTypeApps, ID: "app-1"}, {Type: ResourceTypeApps, ID: "app-2"}},
+		Links: Links{Self: "page=1", Next: "page=2"},
+		Meta:  json.RawMessage(`{"paging":{"total":3,"limit":2}}`),
+	}
+
+	response, err := PaginateAll(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		return &AppsResponse{
+			Data: []Resource[AppAttributes]{{Type: ResourceTypeApps, ID: "app-3"}},
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	meta, ok := PaginationMetaFor(response)
+	if !ok {
+		t.Fatalf("expected pagination meta on %T", response)
+	}
+	if meta.Items != 3 || meta.Pages != 2 {
+		t.Fatalf("expected 3 items across 2 pages, got %+v", meta)
+	}
+	apps := response.(*AppsResponse)
+	if string(apps.Meta) != `{"pagination":{"items":3,"pages":2}}` {
+		t.Fatalf("unexpected meta %s", apps.Meta)
+	}
+	if _, ok := PaginationMetaFor(firstPage); ok {
+		t.Fatal("expected no pagination meta on a single API page")
+	}
+}
+
 func TestPaginateAll_DetectsRepeatedNextURL(t *testing.T) {
 	firstPage := &AppsResponse{
 		Data: []Resource[AppAttributes]{
@@ -2303,6 +2421,233 @@ func TestPaginateAll_DetectsRepeatedNextURL(t *testing.T) {
 	}
 }
 
+func TestPaginateEach_VisitsPagesInOrder(t *testing.T) {
+	makePage := func(page int) *CiBuildRunsResponse {
+		links := Links{}
+		if page < 3 {
+			links.Next = fmt.Sprintf("page=%d", page+1)
+		}
+		return &CiBuildRunsResponse{
+			Data:  []CiBuildRunResource{{Type: ResourceTypeCiBuildRuns, ID: fmt.Sprintf("run-%d", page)}},
+			Links: links,
+		}
+	}
+
+	var visited []string
+	err := PaginateEach(context.Background(), makePage(1), func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		page, err := strconv.Atoi(strings.TrimPrefix(nextURL, "page="))
+		if err != nil {
+			return nil, fmt.Errorf("invalid next URL %q", nextURL)
+		}
+		return makePage(page), nil
+	}, func(page int, resp PaginatedResponse) error {
+		runs := resp.(*CiBuildRunsResponse)
+		visited = append(visited, fmt.Sprintf("%d:%s", page, runs.Data[0].ID))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("PaginateEach() error: %v", err)
+	}
+	if got := strings.Join(visited, ","); got != "1:run-1,2:run-2,3:run-3" {
+		t.Fatalf("unexpected visit order %q", got)
+	}
+}
+
+func TestPaginateEach_StopsOnVisitError(t *testing.T) {
+	firstPage := &CiBuildRunsResponse{Links: Links{Next: "page=2"}}
+	stop := errors.New("stop")
+	fetched := false
+	err := PaginateEach(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		fetched = true
+		return &CiBuildRunsResponse{}, nil
+	}, func(page int, resp PaginatedResponse) error {
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("expected visit error, got %v", err)
+	}
+	if fetched {
+		t.Fatal("expected no further pages to be fetched")
+	}
+}
+
+func TestPaginateAll_RetriesTransientPageFailure(t *testing.T) {
+	t.Setenv("ASC_RETRY_MAX", "2")
+	t.Setenv("ASC_RETRY_BASE", "1ms")
+
+	firstPage := &AppsResponse{
+		Data:  []Resource[AppAttributes]{{Type: ResourceTypeApps, ID: "app-1"}},
+		Links: Links{Next: "page=2"},
+	}
+
+	calls := map[string]int{}
+	result, err := PaginateAll(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		calls[nextURL]++
+		switch nextURL {
+		case "page=2":
+			if calls[nextURL] == 1 {
+				return nil, fmt.Errorf("request failed: %w", &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})
+			}
+			return &AppsResponse{
+				Data:  []Resource[AppAttributes]{{Type: ResourceTypeApps, ID: "app-2"}},
+				Links: Links{Next: "page=3"},
+			}, nil
+		case "page=3":
+			return &AppsResponse{
+				Data: []Resource[AppAttributes]{{Type: ResourceTypeApps, ID: "app-3"}},
+			}, nil
+		default:
+			t.Fatalf("unexpected next URL %q", nextURL)
+			return nil, nil
+		}
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	apps, ok := result.(*AppsResponse)
+	if !ok {
+		t.Fatalf("expected *AppsResponse, got %T", result)
+	}
+	if len(apps.Data) != 3 {
+		t.Fatalf("expected 3 apps, got %d", len(apps.Data))
+	}
+	if calls["page=2"] != 2 || calls["page=3"] != 1 {
+		t.Fatalf("expected page 2 to be retried once, got calls %v", calls)
+	}
+}
+
+func TestPaginateAll_DoesNotRetryPermanentPageFailure(t *testing.T) {
+	t.Setenv("ASC_RETRY_MAX", "2")
+	t.Setenv("ASC_RETRY_BASE", "1ms")
+
+	firstPage := &AppsResponse{
+		Data:  []Resource[AppAttributes]{{Type: ResourceTypeApps, ID: "app-1"}},
+		Links: Links{Next: "page=2"},
+	}
+
+	calls := 0
+	_, err := PaginateAll(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		calls++
+		return nil, ErrForbidden
+	})
+	if !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 fetch, got %d", calls)
+	}
+}
+
+func TestPaginateAll_MergesIncluded(t *testing.T) {
+	firstPage := &SubscriptionsResponse{
+		Data:     []Resource[SubscriptionAttributes]{{Type: ResourceTypeSubscriptions, ID: "sub-1"}},
+		Links:    Links{Next: "page=2"},
+		Included: json.RawMessage(`[{"type":"subscriptionPrices","id":"price-1"}]`),
+	}
+
+	resp, err := PaginateAll(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		return &SubscriptionsResponse{
+			Data:     []Resource[SubscriptionAttributes]{{Type: ResourceTypeSubscriptions, ID: "sub-2"}},
+			Included: json.RawMessage(`[{"type":"subscriptionPrices","id":"price-1"},{"type":"subscriptionPrices","id":"price-2"}]`),
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	subs, ok := resp.(*SubscriptionsResponse)
+	if !ok {
+		t.Fatalf("expected *SubscriptionsResponse, got %T", resp)
+	}
+	if len(subs.Data) != 2 {
+		t.Fatalf("expected 2 subscriptions, got %d", len(subs.Data))
+	}
+	var included []ResourceData
+	if err := json.Unmarshal(subs.Included, &included); err != nil {
+		t.Fatalf("unmarshal included: %v", err)
+	}
+	if len(included) != 2 || included[1].ID != "price-2" {
+		t.Fatalf("unexpected included resources: %+v", included)
+	}
+}
+
+func TestPaginateAll_RawListResponse(t *testing.T) {
+	firstPage, err := ParseRawListResponse([]byte(`{"data":[{"type":"apps","id":"app-1"}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps?cursor=2"}}`))
+	if err != nil {
+		t.Fatalf("ParseRawListResponse() error: %v", err)
+	}
+
+	resp, err := PaginateAll(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		return ParseRawListResponse([]byte(`{"data":[{"type":"apps","id":"app-2"}],"links":{}}`))
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	raw, ok := resp.(*RawListResponse)
+	if !ok {
+		t.Fatalf("expected *RawListResponse, got %T", resp)
+	}
+	if len(raw.Data) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(raw.Data))
+	}
+	if string(raw.Data[1]) != `{"type":"apps","id":"app-2"}` {
+		t.Fatalf("unexpected second resource: %s", raw.Data[1])
+	}
+}
+
+func TestPaginateAll_GameCenterResponses(t *testing.T) {
+	firstPage := &GameCenterAchievementLocalizationsResponse{
+		Data:  []Resource[GameCenterAchievementLocalizationAttributes]{{Type: ResourceTypeGameCenterAchievementLocalizations, ID: "loc-1"}},
+		Links: Links{Next: "page=2"},
+	}
+
+	resp, err := PaginateAll(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		return &GameCenterAchievementLocalizationsResponse{
+			Data: []Resource[GameCenterAchievementLocalizationAttributes]{{Type: ResourceTypeGameCenterAchievementLocalizations, ID: "loc-2"}},
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	localizations, ok := resp.(*GameCenterAchievementLocalizationsResponse)
+	if !ok {
+		t.Fatalf("expected *GameCenterAchievementLocalizationsResponse, got %T", resp)
+	}
+	if len(localizations.Data) != 2 || localizations.Data[1].ID != "loc-2" {
+		t.Fatalf("unexpected localizations: %+v", localizations.Data)
+	}
+
+	if _, err := PaginateAll(context.Background(), &GameCenterLeaderboardSetsResponse{}, nil); err != nil {
+		t.Fatalf("expected leaderboard sets to be paginatable, got %v", err)
+	}
+}
+
+func TestParseRawListResponse_RejectsNonCollections(t *testing.T) {
+	for _, body := range []string{
+		`{"data":{"type":"apps","id":"app-1"}}`,
+		`{"errors":[{"status":"404"}]}`,
+		`[1,2,3]`,
+		`not json`,
+	} {
+		if _, err := ParseRawListResponse([]byte(body)); !errors.Is(err, ErrNotJSONAPICollection) {
+			t.Fatalf("expected ErrNotJSONAPICollection for %s, got %v", body, err)
+		}
+	}
+}
+
+func TestMergeIncluded_EmptyExisting(t *testing.T) {
+	merged, err := MergeIncluded(nil, json.RawMessage(`[{"type":"gameCenterAchievementImages","id":"img-1"}]`))
+	if err != nil {
+		t.Fatalf("MergeIncluded() error: %v", err)
+	}
+	if string(merged) != `[{"type":"gameCenterAchievementImages","id":"img-1"}]` {
+		t.Fatalf("unexpected merged output: %s", merged)
+	}
+}
+
 func TestPaginateAll_CiArtifacts_ManyPages(t *testing.T) {
 	const totalPages = 4
 	const perPage = 3
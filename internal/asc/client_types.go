@@ -88,8 +88,12 @@ const (
 	ResourceTypeSubscriptions                                   ResourceType = "subscriptions"
 	ResourceTypePromotedPurchases                               ResourceType = "promotedPurchases"
 	ResourceTypeSubscriptionPrices                              ResourceType = "subscriptionPrices"
+	ResourceTypeSubscriptionLocalizations                       ResourceType = "subscriptionLocalizations"
 	ResourceTypeSubscriptionAvailabilities                      ResourceType = "subscriptionAvailabilities"
 	ResourceTypeSubscriptionPricePoints                         ResourceType = "subscriptionPricePoints"
+	ResourceTypeSubscriptionPromotionalOffers                   ResourceType = "subscriptionPromotionalOffers"
+	ResourceTypeSubscriptionPromotionalOfferPrices              ResourceType = "subscriptionPromotionalOfferPrices"
+	ResourceTypeSubscriptionIntroductoryOffers                  ResourceType = "subscriptionIntroductoryOffers"
 	ResourceTypeDevices                                         ResourceType = "devices"
 	ResourceTypeProfiles                                        ResourceType = "profiles"
 	ResourceTypeTerritories                                     ResourceType = "territories"
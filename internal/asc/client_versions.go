@@ -84,6 +84,7 @@ type PreReleaseVersion struct {
 type PreReleaseVersionsResponse struct {
 	Data  []PreReleaseVersion `json:"data"`
 	Links Links               `json:"links,omitempty"`
+	Meta  json.RawMessage     `json:"meta,omitempty"`
 }
 
 // PreReleaseVersionResponse is the response from pre-release version detail.
@@ -0,0 +1,174 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// IndicativeUSDRatesDate is when the bundled exchange rates were captured.
+const IndicativeUSDRatesDate = "2025-01"
+
+// IndicativeUSDRatesNote labels converted amounts in CLI output.
+const IndicativeUSDRatesNote = "approxCustomerPriceUsd uses bundled indicative exchange rates from " + IndicativeUSDRatesDate + "; it is approximate and not authoritative"
+
+// indicativeUnitsPerUSD is roughly how many units of each App Store currency
+// one US dollar bought. The rates are rounded and are not updated at runtime.
+var indicativeUnitsPerUSD = map[string]float64{
+	"AED": 3.67,
+	"AUD": 1.60,
+	"BGN": 1.88,
+	"BRL": 6.00,
+	"CAD": 1.44,
+	"CHF": 0.90,
+	"CLP": 990,
+	"CNY": 7.30,
+	"COP": 4350,
+	"CZK": 24.2,
+	"DKK": 7.15,
+	"EGP": 50.5,
+	"EUR": 0.96,
+	"GBP": 0.80,
+	"HKD": 7.78,
+	"HUF": 395,
+	"IDR": 16300,
+	"ILS": 3.60,
+	"INR": 86.0,
+	"JPY": 155,
+	"KRW": 1450,
+	"KZT": 520,
+	"MXN": 20.5,
+	"MYR": 4.48,
+	"NGN": 1550,
+	"NOK": 11.3,
+	"NZD": 1.77,
+	"PEN": 3.75,
+	"PHP": 58.5,
+	"PKR": 279,
+	"PLN": 4.08,
+	"QAR": 3.64,
+	"RON": 4.78,
+	"RUB": 100,
+	"SAR": 3.75,
+	"SEK": 11.0,
+	"SGD": 1.36,
+	"THB": 34.5,
+	"TRY": 35.5,
+	"TWD": 32.8,
+	"TZS": 2500,
+	"USD": 1,
+	"VND": 25400,
+	"ZAR": 18.6,
+}
+
+// ApproximateUSD converts a price in the given ISO 4217 currency to US
+// dollars using the bundled indicative rates, rounded to cents. It reports
+// false when the price is not a number or the currency is unknown.
+func ApproximateUSD(price, currency string) (float64, bool) {
+	rate, ok := indicativeUnitsPerUSD[strings.ToUpper(strings.TrimSpace(currency))]
+	if !ok || rate <= 0 {
+		return 0, false
+	}
+	amount, err := strconv.ParseFloat(strings.TrimSpace(price), 64)
+	if err != nil || amount < 0 {
+		return 0, false
+	}
+	usd, err := strconv.ParseFloat(strconv.FormatFloat(amount/rate, 'f', 2, 64), 64)
+	if err != nil {
+		return 0, false
+	}
+	return usd, true
+}
+
+// SubscriptionPricePointUSD is a subscription price point with its territory
+// currency and an approximate US dollar price.
+type SubscriptionPricePointUSD struct {
+	ID                     string   `json:"id"`
+	Territory              string   `json:"territory,omitempty"`
+	Currency               string   `json:"currency,omitempty"`
+	CustomerPrice          string   `json:"customerPrice,omitempty"`
+	Proceeds               string   `json:"proceeds,omitempty"`
+	ProceedsYear2          string   `json:"proceedsYear2,omitempty"`
+	ApproxCustomerPriceUSD *float64 `json:"approxCustomerPriceUsd,omitempty"`
+}
+
+// SubscriptionPricePointsUSDResult represents CLI output for price points
+// listed with --show-usd.
+type SubscriptionPricePointsUSDResult struct {
+	Data      []SubscriptionPricePointUSD `json:"data"`
+	Links     Links                       `json:"links,omitempty"`
+	RatesNote string                      `json:"ratesNote"`
+}
+
+// NewSubscriptionPricePointsUSDResult adds territory currencies and
+// approximate USD prices to price points fetched with include=territory.
+func NewSubscriptionPricePointsUSDResult(resp *SubscriptionPricePointsResponse) (*SubscriptionPricePointsUSDResult, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("price points response is nil")
+	}
+	currencies, err := parseIncludedTerritoryCurrencies(resp.Included)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SubscriptionPricePointsUSDResult{
+		Data:      make([]SubscriptionPricePointUSD, 0, len(resp.Data)),
+		Links:     resp.Links,
+		RatesNote: IndicativeUSDRatesNote,
+	}
+	for _, item := range resp.Data {
+		territory, err := relationshipID(item.Relationships, "territory")
+		if err != nil {
+			return nil, fmt.Errorf("price point %s: %w", item.ID, err)
+		}
+		point := SubscriptionPricePointUSD{
+			ID:            item.ID,
+			Territory:     territory,
+			Currency:      currencies[territory],
+			CustomerPrice: item.Attributes.CustomerPrice,
+			Proceeds:      item.Attributes.Proceeds,
+			ProceedsYear2: item.Attributes.ProceedsYear2,
+		}
+		if usd, ok := ApproximateUSD(point.CustomerPrice, point.Currency); ok {
+			point.ApproxCustomerPriceUSD = &usd
+		}
+		result.Data = append(result.Data, point)
+	}
+	return result, nil
+}
+
+func parseIncludedTerritoryCurrencies(raw json.RawMessage) (map[string]string, error) {
+	currencies := make(map[string]string)
+	if len(raw) == 0 {
+		return currencies, nil
+	}
+	var items []Resource[TerritoryAttributes]
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("parse included: %w", err)
+	}
+	for _, item := range items {
+		if item.Type == ResourceTypeTerritories {
+			currencies[item.ID] = item.Attributes.Currency
+		}
+	}
+	return currencies, nil
+}
+
+// relationshipID returns the ID of a to-one relationship, or "" when absent.
+func relationshipID(relationships json.RawMessage, name string) (string, error) {
+	if len(relationships) == 0 {
+		return "", nil
+	}
+	var parsed map[string]struct {
+		Data *ResourceData `json:"data"`
+	}
+	if err := json.Unmarshal(relationships, &parsed); err != nil {
+		return "", fmt.Errorf("parse relationships: %w", err)
+	}
+	rel, ok := parsed[name]
+	if !ok || rel.Data == nil {
+		return "", nil
+	}
+	return rel.Data.ID, nil
+}
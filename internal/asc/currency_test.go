@@ -0,0 +1,111 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestApproximateUSD(t *testing.T) {
+	tests := []struct {
+		price    string
+		currency string
+		want     float64
+		ok       bool
+	}{
+		{price: "9.99", currency: "USD", want: 9.99, ok: true},
+		{price: "1550", currency: "jpy", want: 10, ok: true},
+		{price: "0.96", currency: "EUR", want: 1, ok: true},
+		{price: "9.99", currency: "XXX", ok: false},
+		{price: "", currency: "USD", ok: false},
+		{price: "abc", currency: "USD", ok: false},
+		{price: "-1", currency: "USD", ok: false},
+	}
+	for _, test := range tests {
+		got, ok := ApproximateUSD(test.price, test.currency)
+		if ok != test.ok || got != test.want {
+			t.Fatalf("ApproximateUSD(%q, %q) = %v, %v; want %v, %v", test.price, test.currency, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestNewSubscriptionPricePointsUSDResult(t *testing.T) {
+	body := `{
+		"data": [
+			{"type":"subscriptionPricePoints","id":"pp-usa","attributes":{"customerPrice":"9.99","proceeds":"6.99"},"relationships":{"territory":{"data":{"type":"territories","id":"USA"}},"equalizations":{"links":{"related":"https://example.com"}}}},
+			{"type":"subscriptionPricePoints","id":"pp-xxx","attributes":{"customerPrice":"5"},"relationships":{"territory":{"data":{"type":"territories","id":"XXX"}}}}
+		],
+		"included": [
+			{"type":"territories","id":"USA","attributes":{"currency":"USD"}},
+			{"type":"territories","id":"XXX","attributes":{"currency":"XXX"}}
+		]
+	}`
+	var resp SubscriptionPricePointsResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	result, err := NewSubscriptionPricePointsUSDResult(&resp)
+	if err != nil {
+		t.Fatalf("NewSubscriptionPricePointsUSDResult() error: %v", err)
+	}
+	if len(result.Data) != 2 || result.RatesNote == "" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	first := result.Data[0]
+	if first.Territory != "USA" || first.Currency != "USD" || first.ApproxCustomerPriceUSD == nil || *first.ApproxCustomerPriceUSD != 9.99 {
+		t.Fatalf("unexpected first price point: %+v", first)
+	}
+	if result.Data[1].ApproxCustomerPriceUSD != nil {
+		t.Fatalf("expected no USD price for unknown currency, got %v", *result.Data[1].ApproxCustomerPriceUSD)
+	}
+}
+
+func TestGetSubscriptionPricePoints_WithTerritoryAndInclude(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		assertAuthorized(t, req)
+		if req.URL.Path != "/v1/subscriptions/sub-1/pricePoints" {
+			t.Fatalf("expected path /v1/subscriptions/sub-1/pricePoints, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("filter[territory]") != "USA" {
+			t.Fatalf("expected territory filter USA, got %q", values.Get("filter[territory]"))
+		}
+		if values.Get("include") != "territory" {
+			t.Fatalf("expected include=territory, got %q", values.Get("include"))
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionPricePoints","id":"pp-1","attributes":{"customerPrice":"0.99"}}]}`))
+
+	resp, err := client.GetSubscriptionPricePoints(context.Background(), "sub-1",
+		WithSubscriptionPricePointsTerritory("usa"),
+		WithSubscriptionPricePointsInclude([]string{"territory"}),
+	)
+	if err != nil {
+		t.Fatalf("GetSubscriptionPricePoints() error: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].ID != "pp-1" {
+		t.Fatalf("unexpected response: %+v", resp.Data)
+	}
+}
+
+func TestPrintTable_SubscriptionPricePointsUSDResult(t *testing.T) {
+	usd := 9.99
+	result := &SubscriptionPricePointsUSDResult{
+		Data: []SubscriptionPricePointUSD{
+			{ID: "pp-usa", Territory: "USA", Currency: "USD", CustomerPrice: "9.99", ApproxCustomerPriceUSD: &usd},
+		},
+		RatesNote: IndicativeUSDRatesNote,
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	for _, want := range []string{"Approx USD", "pp-usa", "~9.99", "not authoritative"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
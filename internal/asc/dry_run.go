@@ -0,0 +1,99 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// DryRunID is the resource ID reported for resources that a dry run would
+// have created.
+const DryRunID = "dry-run"
+
+var dryRunState = struct {
+	mu      sync.RWMutex
+	enabled bool
+	out     io.Writer
+}{}
+
+// SetDryRun enables or disables dry-run mode. While enabled, mutating requests
+// (anything other than GET and HEAD) are printed instead of sent, and a
+// synthesized response is returned. Reads still reach the API so commands can
+// resolve the IDs they need.
+func SetDryRun(enabled bool) {
+	dryRunState.mu.Lock()
+	defer dryRunState.mu.Unlock()
+	dryRunState.enabled = enabled
+}
+
+// DryRunEnabled reports whether dry-run mode is enabled.
+func DryRunEnabled() bool {
+	dryRunState.mu.RLock()
+	defer dryRunState.mu.RUnlock()
+	return dryRunState.enabled
+}
+
+// setDryRunOutput redirects dry-run request output (tests only). A nil writer
+// restores the default, the process's current stderr.
+func setDryRunOutput(w io.Writer) {
+	dryRunState.mu.Lock()
+	defer dryRunState.mu.Unlock()
+	dryRunState.out = w
+}
+
+// shouldDryRun reports whether a request with method must be intercepted.
+func shouldDryRun(method string) bool {
+	if !DryRunEnabled() {
+		return false
+	}
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead:
+		return false
+	default:
+		return true
+	}
+}
+
+// dryRunRequest prints the request that would be sent and returns the
+// response body to hand back to the caller in its place.
+func dryRunRequest(method, path string, body []byte) ([]byte, error) {
+	dryRunState.mu.RLock()
+	out := dryRunState.out
+	dryRunState.mu.RUnlock()
+	if out == nil {
+		out = os.Stderr
+	}
+
+	fmt.Fprintf(out, "dry-run: %s %s\n", strings.ToUpper(method), path)
+	if len(body) > 0 {
+		fmt.Fprint(out, string(body))
+		if body[len(body)-1] != '\n' {
+			fmt.Fprintln(out)
+		}
+	}
+	return dryRunResponse(body), nil
+}
+
+// dryRunResponse synthesizes the "would execute" response for a request body:
+// the request's primary data, with DryRunID standing in for the ID the API
+// would assign. Requests without a JSON:API body get an empty response.
+func dryRunResponse(body []byte) []byte {
+	var request struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if len(body) == 0 || json.Unmarshal(body, &request) != nil || request.Data == nil {
+		return nil
+	}
+	if _, ok := request.Data["id"]; !ok {
+		request.Data["id"] = json.RawMessage(`"` + DryRunID + `"`)
+	}
+	response, err := json.Marshal(map[string]any{"data": request.Data})
+	if err != nil {
+		return nil
+	}
+	return response
+}
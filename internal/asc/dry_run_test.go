@@ -0,0 +1,95 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func enableDryRun(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var out bytes.Buffer
+	SetDryRun(true)
+	setDryRunOutput(&out)
+	t.Cleanup(func() {
+		SetDryRun(false)
+		setDryRunOutput(nil)
+	})
+	return &out
+}
+
+func TestDryRunPrintsCreateRequestWithoutSending(t *testing.T) {
+	out := enableDryRun(t)
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected %s %s request in dry-run mode", req.Method, req.URL.Path)
+	}, jsonResponse(http.StatusCreated, `{}`))
+
+	attrs := SubscriptionLocalizationCreateAttributes{Name: "Monthly", Locale: "en-US"}
+	resp, err := client.CreateSubscriptionLocalization(context.Background(), "sub-1", attrs)
+	if err != nil {
+		t.Fatalf("CreateSubscriptionLocalization() error: %v", err)
+	}
+	if resp.Data.ID != DryRunID || resp.Data.Type != ResourceTypeSubscriptionLocalizations {
+		t.Fatalf("unexpected synthesized data: %+v", resp.Data)
+	}
+	if resp.Data.Attributes.Name != "Monthly" {
+		t.Fatalf("expected attributes from request body, got %+v", resp.Data.Attributes)
+	}
+
+	body, err := BuildRequestBody(SubscriptionLocalizationCreateRequest{
+		Data: SubscriptionLocalizationCreateData{
+			Type:       ResourceTypeSubscriptionLocalizations,
+			Attributes: attrs,
+			Relationships: &SubscriptionLocalizationRelationships{
+				Subscription: &Relationship{Data: ResourceData{Type: ResourceTypeSubscriptions, ID: "sub-1"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BuildRequestBody() error: %v", err)
+	}
+	wantBody, _ := io.ReadAll(body)
+	want := "dry-run: POST /v1/subscriptionLocalizations\n" + string(wantBody)
+	if out.String() != want {
+		t.Fatalf("unexpected dry-run output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestDryRunDeleteReportsPath(t *testing.T) {
+	out := enableDryRun(t)
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected %s %s request in dry-run mode", req.Method, req.URL.Path)
+	}, jsonResponse(http.StatusNoContent, ""))
+
+	if err := client.DeleteSubscription(context.Background(), "sub-1"); err != nil {
+		t.Fatalf("DeleteSubscription() error: %v", err)
+	}
+	if got := out.String(); got != "dry-run: DELETE /v1/subscriptions/sub-1\n" {
+		t.Fatalf("unexpected dry-run output: %q", got)
+	}
+}
+
+func TestDryRunStillSendsReads(t *testing.T) {
+	out := enableDryRun(t)
+	called := false
+	client := newTestClient(t, func(req *http.Request) {
+		called = true
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":{"type":"subscriptions","id":"sub-1","attributes":{"name":"Monthly"}}}`))
+
+	resp, err := client.GetSubscription(context.Background(), "sub-1")
+	if err != nil {
+		t.Fatalf("GetSubscription() error: %v", err)
+	}
+	if !called || resp.Data.ID != "sub-1" {
+		t.Fatalf("expected GET to reach the API, got %+v", resp.Data)
+	}
+	if strings.TrimSpace(out.String()) != "" {
+		t.Fatalf("expected no dry-run output for reads, got %q", out.String())
+	}
+}
@@ -12,6 +12,7 @@ var (
 	ErrForbidden             = errors.New("forbidden")
 	ErrBadRequest            = errors.New("bad request")
 	ErrRepeatedPaginationURL = errors.New("detected repeated pagination URL")
+	ErrRateLimited           = errors.New("rate limited")
 )
 
 // APIError represents a parsed App Store Connect error response.
@@ -19,6 +20,9 @@ type APIError struct {
 	Code   string
 	Title  string
 	Detail string
+	// ID is the unique identifier App Store Connect assigns to the error,
+	// useful when reporting a failed request to Apple.
+	ID string
 }
 
 func (e *APIError) Error() string {
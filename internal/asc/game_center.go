@@ -14,6 +14,27 @@ type GameCenterDetailAttributes struct {
 // GameCenterDetailResponse is the response from Game Center detail endpoints.
 type GameCenterDetailResponse = SingleResponse[GameCenterDetailAttributes]
 
+// GameCenterAppDetailStatus reports Game Center enablement for one app.
+type GameCenterAppDetailStatus struct {
+	AppID         string `json:"appId"`
+	AppName       string `json:"appName,omitempty"`
+	Configured    bool   `json:"configured"`
+	DetailID      string `json:"detailId,omitempty"`
+	ArcadeEnabled bool   `json:"arcadeEnabled"`
+}
+
+// GameCenterDetailsSummary reports Game Center enablement across apps.
+type GameCenterDetailsSummary struct {
+	Apps []GameCenterAppDetailStatus `json:"apps"`
+}
+
+// GameCenterCascadeStep records one dependent change made by a cascading delete.
+type GameCenterCascadeStep struct {
+	Action       string `json:"action"`
+	ResourceType string `json:"resourceType"`
+	ID           string `json:"id"`
+}
+
 // Valid leaderboard formatters.
 var ValidLeaderboardFormatters = []string{
 	"INTEGER",
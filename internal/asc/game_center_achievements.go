@@ -2,6 +2,7 @@ package asc
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -71,10 +72,18 @@ type GameCenterAchievementsResponse = Response[GameCenterAchievementAttributes]
 // GameCenterAchievementResponse is the response from achievement detail endpoints.
 type GameCenterAchievementResponse = SingleResponse[GameCenterAchievementAttributes]
 
+// GameCenterAchievementCreateResult represents CLI output for an achievement
+// created together with its first localization.
+type GameCenterAchievementCreateResult struct {
+	Achievement  *GameCenterAchievementResponse             `json:"achievement"`
+	Localization *GameCenterAchievementLocalizationResponse `json:"localization,omitempty"`
+}
+
 // GameCenterAchievementDeleteResult represents CLI output for achievement deletions.
 type GameCenterAchievementDeleteResult struct {
-	ID      string `json:"id"`
-	Deleted bool   `json:"deleted"`
+	ID      string                  `json:"id"`
+	Deleted bool                    `json:"deleted"`
+	Cascade []GameCenterCascadeStep `json:"cascade,omitempty"`
 }
 
 // GCAchievementsOption is a functional option for GetGameCenterAchievements.
@@ -108,6 +117,39 @@ func buildGCAchievementsQuery(query *gcAchievementsQuery) string {
 	return values.Encode()
 }
 
+// GCAchievementOption is a functional option for GetGameCenterAchievement.
+type GCAchievementOption func(*gcAchievementQuery)
+
+type gcAchievementQuery struct {
+	include            []string
+	localizationsLimit int
+}
+
+// WithGCAchievementInclude sets include for achievement detail responses.
+func WithGCAchievementInclude(include []string) GCAchievementOption {
+	return func(q *gcAchievementQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+// WithGCAchievementIncludedLocalizationsLimit sets limit[localizations] for included localizations.
+func WithGCAchievementIncludedLocalizationsLimit(limit int) GCAchievementOption {
+	return func(q *gcAchievementQuery) {
+		if limit > 0 {
+			q.localizationsLimit = limit
+		}
+	}
+}
+
+func buildGCAchievementQuery(query *gcAchievementQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	if query.localizationsLimit > 0 {
+		values.Set("limit[localizations]", strconv.Itoa(query.localizationsLimit))
+	}
+	return values.Encode()
+}
+
 // GameCenterAchievementLocalizationAttributes represents a Game Center achievement localization.
 type GameCenterAchievementLocalizationAttributes struct {
 	Locale                  string `json:"locale"`
@@ -177,6 +219,7 @@ type GCAchievementLocalizationsOption func(*gcAchievementLocalizationsQuery)
 
 type gcAchievementLocalizationsQuery struct {
 	listQuery
+	include []string
 }
 
 // WithGCAchievementLocalizationsLimit sets the max number of localizations to return.
@@ -197,8 +240,16 @@ func WithGCAchievementLocalizationsNextURL(next string) GCAchievementLocalizatio
 	}
 }
 
+// WithGCAchievementLocalizationsInclude sets include for achievement localization responses.
+func WithGCAchievementLocalizationsInclude(include []string) GCAchievementLocalizationsOption {
+	return func(q *gcAchievementLocalizationsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 func buildGCAchievementLocalizationsQuery(query *gcAchievementLocalizationsQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
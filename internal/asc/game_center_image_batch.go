@@ -0,0 +1,136 @@
+package asc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// GameCenterImageKind selects which Game Center localization an image
+// belongs to.
+type GameCenterImageKind string
+
+const (
+	GameCenterImageKindAchievement    GameCenterImageKind = "achievement"
+	GameCenterImageKindLeaderboard    GameCenterImageKind = "leaderboard"
+	GameCenterImageKindLeaderboardSet GameCenterImageKind = "leaderboardSet"
+)
+
+// GameCenterImageBatchResult represents CLI output for a batch image upload.
+type GameCenterImageBatchResult struct {
+	Kind     GameCenterImageKind        `json:"kind"`
+	Total    int                        `json:"total"`
+	Uploaded int                        `json:"uploaded"`
+	Failed   int                        `json:"failed"`
+	Results  []GameCenterImageBatchItem `json:"results"`
+}
+
+// GameCenterImageBatchItem is the outcome of one image in a batch upload.
+type GameCenterImageBatchItem struct {
+	LocalizationID     string `json:"localizationId"`
+	FilePath           string `json:"filePath"`
+	ImageID            string `json:"imageId,omitempty"`
+	AssetDeliveryState string `json:"assetDeliveryState,omitempty"`
+	Uploaded           bool   `json:"uploaded"`
+	Error              string `json:"error,omitempty"`
+}
+
+// UploadGameCenterImagesBatch uploads an image for each localization ID in
+// images (localization ID to file path), with at most concurrency uploads in
+// flight. Every image is attempted; failures are reported per item rather
+// than stopping the batch, so a rerun can skip what already succeeded.
+func (c *Client) UploadGameCenterImagesBatch(ctx context.Context, kind GameCenterImageKind, images map[string]string, concurrency int) (*GameCenterImageBatchResult, error) {
+	var upload func(context.Context, string, string) (string, string, error)
+	switch kind {
+	case GameCenterImageKindAchievement:
+		upload = func(ctx context.Context, localizationID, filePath string) (string, string, error) {
+			result, err := c.UploadGameCenterAchievementImage(ctx, localizationID, filePath)
+			if err != nil {
+				return "", "", err
+			}
+			return result.ID, result.AssetDeliveryState, nil
+		}
+	case GameCenterImageKindLeaderboard:
+		upload = func(ctx context.Context, localizationID, filePath string) (string, string, error) {
+			result, err := c.UploadGameCenterLeaderboardImage(ctx, localizationID, filePath)
+			if err != nil {
+				return "", "", err
+			}
+			return result.ID, result.AssetDeliveryState, nil
+		}
+	case GameCenterImageKindLeaderboardSet:
+		upload = func(ctx context.Context, localizationID, filePath string) (string, string, error) {
+			result, err := c.UploadGameCenterLeaderboardSetImage(ctx, localizationID, filePath)
+			if err != nil {
+				return "", "", err
+			}
+			return result.ID, result.AssetDeliveryState, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported Game Center image kind %q", kind)
+	}
+
+	result := uploadGameCenterImagesConcurrently(ctx, images, concurrency, upload)
+	result.Kind = kind
+	return result, nil
+}
+
+// uploadGameCenterImagesConcurrently runs upload for every entry of images
+// with at most concurrency calls in flight. Results are ordered by
+// localization ID; entries not started because ctx was done are failures.
+func uploadGameCenterImagesConcurrently(
+	ctx context.Context,
+	images map[string]string,
+	concurrency int,
+	upload func(ctx context.Context, localizationID, filePath string) (imageID, state string, err error),
+) *GameCenterImageBatchResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ids := make([]string, 0, len(images))
+	for id := range images {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := make([]GameCenterImageBatchItem, len(ids))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, id := range ids {
+		items[i] = GameCenterImageBatchItem{LocalizationID: id, FilePath: images[id]}
+		wg.Add(1)
+		go func(item *GameCenterImageBatchItem) {
+			defer wg.Done()
+
+			select {
+			case <-ctx.Done():
+				item.Error = ctx.Err().Error()
+				return
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			}
+
+			imageID, state, err := upload(ctx, item.LocalizationID, item.FilePath)
+			if err != nil {
+				item.Error = err.Error()
+				return
+			}
+			item.ImageID = imageID
+			item.AssetDeliveryState = state
+			item.Uploaded = true
+		}(&items[i])
+	}
+	wg.Wait()
+
+	result := &GameCenterImageBatchResult{Total: len(items), Results: items}
+	for _, item := range items {
+		if item.Uploaded {
+			result.Uploaded++
+		} else {
+			result.Failed++
+		}
+	}
+	return result
+}
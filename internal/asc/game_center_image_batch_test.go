@@ -0,0 +1,76 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUploadGameCenterImagesConcurrentlyReportsPartialFailure(t *testing.T) {
+	images := map[string]string{
+		"loc-c": "c.png",
+		"loc-a": "a.png",
+		"loc-b": "b.png",
+	}
+	var inFlight, maxInFlight int32
+	result := uploadGameCenterImagesConcurrently(context.Background(), images, 2, func(ctx context.Context, localizationID, filePath string) (string, string, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if localizationID == "loc-b" {
+			return "", "", errors.New("upload failed")
+		}
+		return "img-" + localizationID, "COMPLETE", nil
+	})
+
+	if result.Total != 3 || result.Uploaded != 2 || result.Failed != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Fatalf("expected at most 2 concurrent uploads, got %d", got)
+	}
+	wantOrder := []string{"loc-a", "loc-b", "loc-c"}
+	for i, item := range result.Results {
+		if item.LocalizationID != wantOrder[i] {
+			t.Fatalf("result %d: expected %s, got %s", i, wantOrder[i], item.LocalizationID)
+		}
+		if item.FilePath != images[item.LocalizationID] {
+			t.Fatalf("result %d: unexpected file path %q", i, item.FilePath)
+		}
+	}
+	if item := result.Results[0]; !item.Uploaded || item.ImageID != "img-loc-a" || item.AssetDeliveryState != "COMPLETE" {
+		t.Fatalf("unexpected success item: %+v", item)
+	}
+	if item := result.Results[1]; item.Uploaded || item.ImageID != "" || item.Error != "upload failed" {
+		t.Fatalf("unexpected failure item: %+v", item)
+	}
+}
+
+func TestUploadGameCenterImagesConcurrentlyCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := uploadGameCenterImagesConcurrently(ctx, map[string]string{"loc-1": "a.png"}, 1, func(ctx context.Context, localizationID, filePath string) (string, string, error) {
+		return "", "", ctx.Err()
+	})
+	if result.Failed != 1 || result.Results[0].Uploaded {
+		t.Fatalf("expected canceled upload to fail, got %+v", result)
+	}
+}
+
+func TestUploadGameCenterImagesBatchRejectsUnknownKind(t *testing.T) {
+	client := &Client{}
+	_, err := client.UploadGameCenterImagesBatch(context.Background(), GameCenterImageKind("trophy"), map[string]string{"loc-1": "a.png"}, 1)
+	if err == nil || !strings.Contains(err.Error(), "unsupported Game Center image kind") {
+		t.Fatalf("expected unsupported kind error, got %v", err)
+	}
+}
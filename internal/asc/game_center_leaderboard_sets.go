@@ -106,6 +106,13 @@ type GameCenterLeaderboardSetLocalizationCreateAttributes struct {
 	Name   string `json:"name"`
 }
 
+// GameCenterLeaderboardSetCreateResult represents CLI output for a leaderboard
+// set created together with its first localization.
+type GameCenterLeaderboardSetCreateResult struct {
+	LeaderboardSet *GameCenterLeaderboardSetResponse             `json:"leaderboardSet"`
+	Localization   *GameCenterLeaderboardSetLocalizationResponse `json:"localization,omitempty"`
+}
+
 // GameCenterLeaderboardSetLocalizationUpdateAttributes describes attributes for updating a leaderboard set localization.
 type GameCenterLeaderboardSetLocalizationUpdateAttributes struct {
 	Name *string `json:"name,omitempty"`
@@ -91,8 +91,9 @@ type GameCenterLeaderboardResponse = SingleResponse[GameCenterLeaderboardAttribu
 
 // GameCenterLeaderboardDeleteResult represents CLI output for leaderboard deletions.
 type GameCenterLeaderboardDeleteResult struct {
-	ID      string `json:"id"`
-	Deleted bool   `json:"deleted"`
+	ID      string                  `json:"id"`
+	Deleted bool                    `json:"deleted"`
+	Cascade []GameCenterCascadeStep `json:"cascade,omitempty"`
 }
 
 // GCLeaderboardsOption is a functional option for GetGameCenterLeaderboards.
@@ -100,6 +101,7 @@ type GCLeaderboardsOption func(*gcLeaderboardsQuery)
 
 type gcLeaderboardsQuery struct {
 	listQuery
+	fields []string
 }
 
 // WithGCLeaderboardsLimit sets the max number of leaderboards to return.
@@ -120,8 +122,16 @@ func WithGCLeaderboardsNextURL(next string) GCLeaderboardsOption {
 	}
 }
 
+// WithGCLeaderboardsFields limits the leaderboard attributes returned.
+func WithGCLeaderboardsFields(fields []string) GCLeaderboardsOption {
+	return func(q *gcLeaderboardsQuery) {
+		q.fields = normalizeList(fields)
+	}
+}
+
 func buildGCLeaderboardsQuery(query *gcLeaderboardsQuery) string {
 	values := url.Values{}
+	addCSV(values, "fields[gameCenterLeaderboards]", query.fields)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -353,6 +363,17 @@ type GameCenterLeaderboardImageDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// GameCenterLeaderboardImageDownloadResult represents CLI output for image downloads.
+type GameCenterLeaderboardImageDownloadResult struct {
+	ID           string `json:"id"`
+	FileName     string `json:"fileName,omitempty"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	URL          string `json:"url"`
+	OutputPath   string `json:"outputPath"`
+	BytesWritten int64  `json:"bytesWritten"`
+}
+
 // GameCenterLeaderboardImageUploadResult represents CLI output for image uploads.
 type GameCenterLeaderboardImageUploadResult struct {
 	ID                 string `json:"id"`
@@ -0,0 +1,82 @@
+package asc
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Supported diagnostic log formats.
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
+var logState = struct {
+	mu     sync.RWMutex
+	format string
+	logger *slog.Logger
+}{
+	format: LogFormatText,
+	logger: NewLogger(os.Stderr, LogFormatText),
+}
+
+// NewLogger returns a diagnostic logger writing to w. Text output omits
+// timestamps to stay readable in a terminal; JSON output emits one object per
+// line with time, level, and msg keys for log aggregators.
+func NewLogger(w io.Writer, format string) *slog.Logger {
+	if format == LogFormatJSON {
+		return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	}
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}))
+}
+
+// NormalizeLogFormat validates a log format name.
+func NormalizeLogFormat(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", LogFormatText:
+		return LogFormatText, nil
+	case LogFormatJSON:
+		return LogFormatJSON, nil
+	default:
+		return "", fmt.Errorf("log format must be %s or %s", LogFormatText, LogFormatJSON)
+	}
+}
+
+// SetLogFormat switches diagnostic logging on stderr to the given format.
+func SetLogFormat(format string) error {
+	normalized, err := NormalizeLogFormat(format)
+	if err != nil {
+		return err
+	}
+	logState.mu.Lock()
+	defer logState.mu.Unlock()
+	logState.format = normalized
+	logState.logger = NewLogger(os.Stderr, normalized)
+	return nil
+}
+
+// LogFormat returns the active diagnostic log format.
+func LogFormat() string {
+	logState.mu.RLock()
+	defer logState.mu.RUnlock()
+	return logState.format
+}
+
+// Logger returns the diagnostic logger used for stderr output.
+func Logger() *slog.Logger {
+	logState.mu.RLock()
+	defer logState.mu.RUnlock()
+	return logState.logger
+}
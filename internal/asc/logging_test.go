@@ -0,0 +1,49 @@
+package asc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeLogFormat(t *testing.T) {
+	for input, want := range map[string]string{"": LogFormatText, "TEXT": LogFormatText, " json ": LogFormatJSON} {
+		got, err := NormalizeLogFormat(input)
+		if err != nil || got != want {
+			t.Fatalf("NormalizeLogFormat(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+	if _, err := NormalizeLogFormat("xml"); err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+}
+
+func TestNewLogger_TextOmitsTime(t *testing.T) {
+	var buf bytes.Buffer
+	NewLogger(&buf, LogFormatText).Info("retrying request", "attempt", 1)
+
+	output := buf.String()
+	if strings.Contains(output, "time=") {
+		t.Fatalf("expected text output without time, got %q", output)
+	}
+	if !strings.Contains(output, `msg="retrying request"`) || !strings.Contains(output, "attempt=1") {
+		t.Fatalf("unexpected text output: %q", output)
+	}
+}
+
+func TestSetLogFormat(t *testing.T) {
+	t.Cleanup(func() { _ = SetLogFormat(LogFormatText) })
+
+	if err := SetLogFormat("json"); err != nil {
+		t.Fatalf("SetLogFormat() error: %v", err)
+	}
+	if LogFormat() != LogFormatJSON {
+		t.Fatalf("expected json format, got %q", LogFormat())
+	}
+	if err := SetLogFormat("yaml"); err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+	if LogFormat() != LogFormatJSON {
+		t.Fatalf("expected failed SetLogFormat to keep json, got %q", LogFormat())
+	}
+}
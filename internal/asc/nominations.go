@@ -118,6 +118,11 @@ type NominationUpdateRequest struct {
 	Data NominationUpdateData `json:"data"`
 }
 
+// NominationsReport renders nominations as a Markdown summary grouped by state.
+type NominationsReport struct {
+	Nominations *NominationsResponse
+}
+
 // NominationDeleteResult represents CLI output for deletions.
 type NominationDeleteResult struct {
 	ID      string `json:"id"`
@@ -1,17 +1,26 @@
 package asc
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"text/tabwriter"
 )
 
 func printNominationsTable(resp *NominationsResponse) error {
+	createdBy, showCreatedBy := nominationCreatedByNames(resp)
+
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tName\tType\tState\tPublish Start\tPublish End")
+	header := "ID\tName\tType\tState\tPublish Start\tPublish End"
+	if showCreatedBy {
+		header += "\tCreated By"
+	}
+	fmt.Fprintln(w, header)
 	for _, item := range resp.Data {
 		attrs := item.Attributes
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s",
 			sanitizeTerminal(item.ID),
 			compactWhitespace(fallbackValue(attrs.Name)),
 			sanitizeTerminal(fallbackValue(string(attrs.Type))),
@@ -19,10 +28,76 @@ func printNominationsTable(resp *NominationsResponse) error {
 			sanitizeTerminal(fallbackValue(attrs.PublishStartDate)),
 			sanitizeTerminal(fallbackValue(attrs.PublishEndDate)),
 		)
+		if showCreatedBy {
+			fmt.Fprintf(w, "\t%s", compactWhitespace(fallbackValue(createdBy[item.ID])))
+		}
+		fmt.Fprintln(w)
 	}
 	return w.Flush()
 }
 
+// nominationCreatedByNames maps nomination IDs to the display name of their
+// createdByActor, resolved from actors in the response's included section
+// (nominations list --include createdByActor). It reports false when the
+// response includes no actors, so the column is only shown when requested.
+func nominationCreatedByNames(resp *NominationsResponse) (map[string]string, bool) {
+	if len(resp.Included) == 0 {
+		return nil, false
+	}
+	var included []json.RawMessage
+	if err := json.Unmarshal(resp.Included, &included); err != nil {
+		return nil, false
+	}
+	actors := make(map[string]ActorAttributes)
+	for _, raw := range included {
+		var item Resource[ActorAttributes]
+		if err := json.Unmarshal(raw, &item); err != nil || item.Type != ResourceTypeActors {
+			continue
+		}
+		actors[item.ID] = item.Attributes
+	}
+	if len(actors) == 0 {
+		return nil, false
+	}
+
+	names := make(map[string]string, len(resp.Data))
+	for _, item := range resp.Data {
+		if len(item.Relationships) == 0 {
+			continue
+		}
+		var relationships struct {
+			CreatedByActor struct {
+				Data *ResourceData `json:"data"`
+			} `json:"createdByActor"`
+		}
+		if err := json.Unmarshal(item.Relationships, &relationships); err != nil || relationships.CreatedByActor.Data == nil {
+			continue
+		}
+		actorID := relationships.CreatedByActor.Data.ID
+		actor, ok := actors[actorID]
+		if !ok {
+			continue
+		}
+		names[item.ID] = actorDisplayName(actorID, actor)
+	}
+	return names, true
+}
+
+// actorDisplayName returns the most readable identifier available for an
+// actor: the user's name, then email, then API key ID, then the actor ID.
+func actorDisplayName(id string, attrs ActorAttributes) string {
+	if name := formatPersonName(attrs.UserFirstName, attrs.UserLastName); name != "" {
+		return name
+	}
+	if email := strings.TrimSpace(attrs.UserEmail); email != "" {
+		return email
+	}
+	if keyID := strings.TrimSpace(attrs.APIKeyID); keyID != "" {
+		return keyID
+	}
+	return id
+}
+
 func printNominationsMarkdown(resp *NominationsResponse) error {
 	fmt.Fprintln(os.Stdout, "| ID | Name | Type | State | Publish Start | Publish End |")
 	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
@@ -40,6 +115,80 @@ func printNominationsMarkdown(resp *NominationsResponse) error {
 	return nil
 }
 
+// nominationReportStateOrder is the order states appear in the report;
+// states not listed here follow alphabetically.
+var nominationReportStateOrder = []NominationState{
+	NominationStateDraft,
+	NominationStateSubmitted,
+	NominationStateArchived,
+}
+
+func printNominationsReportMarkdown(report *NominationsReport) error {
+	var items []Resource[NominationAttributes]
+	if report.Nominations != nil {
+		items = report.Nominations.Data
+	}
+
+	groups := make(map[NominationState][]Resource[NominationAttributes])
+	for _, item := range items {
+		groups[item.Attributes.State] = append(groups[item.Attributes.State], item)
+	}
+	states := make([]NominationState, 0, len(groups))
+	for _, state := range nominationReportStateOrder {
+		if len(groups[state]) > 0 {
+			states = append(states, state)
+		}
+	}
+	var others []NominationState
+	for state := range groups {
+		known := false
+		for _, ordered := range nominationReportStateOrder {
+			if state == ordered {
+				known = true
+				break
+			}
+		}
+		if !known {
+			others = append(others, state)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+	states = append(states, others...)
+
+	fmt.Fprintln(os.Stdout, "# Featuring Nominations")
+	fmt.Fprintln(os.Stdout)
+	fmt.Fprintf(os.Stdout, "Total: %d\n", len(items))
+	if len(items) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout)
+	fmt.Fprintln(os.Stdout, "| State | Count |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	for _, state := range states {
+		fmt.Fprintf(os.Stdout, "| %s | %d |\n", escapeMarkdown(fallbackValue(string(state))), len(groups[state]))
+	}
+
+	for _, state := range states {
+		fmt.Fprintln(os.Stdout)
+		fmt.Fprintf(os.Stdout, "## %s (%d)\n", escapeMarkdown(fallbackValue(string(state))), len(groups[state]))
+		fmt.Fprintln(os.Stdout)
+		fmt.Fprintln(os.Stdout, "| Name | Type | Publish Start | Publish End | ID |")
+		fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+		for _, item := range groups[state] {
+			attrs := item.Attributes
+			fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s |\n",
+				escapeMarkdown(fallbackValue(attrs.Name)),
+				escapeMarkdown(fallbackValue(string(attrs.Type))),
+				escapeMarkdown(fallbackValue(attrs.PublishStartDate)),
+				escapeMarkdown(fallbackValue(attrs.PublishEndDate)),
+				escapeMarkdown(item.ID),
+			)
+		}
+	}
+	return nil
+}
+
 func printNominationDeleteResultTable(result *NominationDeleteResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tDeleted")
@@ -118,3 +118,31 @@ func printAccessibilityDeclarationDeleteResultMarkdown(result *AccessibilityDecl
 	)
 	return nil
 }
+
+func printAccessibilityDeclarationBatchCreateResultTable(result *AccessibilityDeclarationBatchCreateResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Device Family\tID\tStatus\tError")
+	for _, item := range result.Results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			item.DeviceFamily,
+			sanitizeTerminal(item.ID),
+			item.Status,
+			sanitizeTerminal(compactWhitespace(item.Error)),
+		)
+	}
+	return w.Flush()
+}
+
+func printAccessibilityDeclarationBatchCreateResultMarkdown(result *AccessibilityDeclarationBatchCreateResult) error {
+	fmt.Fprintln(os.Stdout, "| Device Family | ID | Status | Error |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, item := range result.Results {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s |\n",
+			escapeMarkdown(string(item.DeviceFamily)),
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Status),
+			escapeMarkdown(compactWhitespace(item.Error)),
+		)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package asc
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"text/tabwriter"
@@ -33,3 +34,75 @@ func printAppsMarkdown(resp *AppsResponse) error {
 	}
 	return nil
 }
+
+// includedTypeCount is the number of included resources of one type.
+type includedTypeCount struct {
+	Type  string
+	Count int
+}
+
+// countIncludedByType tallies included resources by type, in first-seen order.
+func countIncludedByType(raw json.RawMessage) ([]includedTypeCount, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var items []struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("parse included: %w", err)
+	}
+
+	var counts []includedTypeCount
+	index := make(map[string]int)
+	for _, item := range items {
+		position, ok := index[item.Type]
+		if !ok {
+			index[item.Type] = len(counts)
+			counts = append(counts, includedTypeCount{Type: item.Type})
+			position = len(counts) - 1
+		}
+		counts[position].Count++
+	}
+	return counts, nil
+}
+
+func printAppTable(resp *AppResponse) error {
+	if err := printAppsTable(&AppsResponse{Data: []Resource[AppAttributes]{resp.Data}}); err != nil {
+		return err
+	}
+	counts, err := countIncludedByType(resp.Included)
+	if err != nil {
+		return err
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nIncluded")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Type\tCount")
+	for _, count := range counts {
+		fmt.Fprintf(w, "%s\t%d\n", count.Type, count.Count)
+	}
+	return w.Flush()
+}
+
+func printAppMarkdown(resp *AppResponse) error {
+	if err := printAppsMarkdown(&AppsResponse{Data: []Resource[AppAttributes]{resp.Data}}); err != nil {
+		return err
+	}
+	counts, err := countIncludedByType(resp.Included)
+	if err != nil {
+		return err
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\n| Included Type | Count |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	for _, count := range counts {
+		fmt.Fprintf(os.Stdout, "| %s | %d |\n", escapeMarkdown(count.Type), count.Count)
+	}
+	return nil
+}
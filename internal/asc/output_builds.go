@@ -1,6 +1,7 @@
 package asc
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -55,14 +56,49 @@ type BuildExpireAllResult struct {
 	Failures            []BuildExpireAllFailure `json:"failures,omitempty"`
 }
 
+// buildVersions maps each build ID to the version string of its included
+// pre-release version. Builds without an included pre-release version are
+// absent from the map.
+func buildVersions(resp *BuildsResponse) (map[string]string, error) {
+	versions := make(map[string]string)
+	if len(resp.Included) == 0 {
+		return versions, nil
+	}
+	var included []Resource[PreReleaseVersionAttributes]
+	if err := json.Unmarshal(resp.Included, &included); err != nil {
+		return nil, fmt.Errorf("parse included: %w", err)
+	}
+	byID := make(map[string]string)
+	for _, item := range included {
+		if item.Type == ResourceTypePreReleaseVersions {
+			byID[item.ID] = item.Attributes.Version
+		}
+	}
+	for _, item := range resp.Data {
+		id, err := relationshipID(item.Relationships, "preReleaseVersion")
+		if err != nil {
+			return nil, err
+		}
+		if version, ok := byID[id]; ok {
+			versions[item.ID] = version
+		}
+	}
+	return versions, nil
+}
+
 func printBuildsTable(resp *BuildsResponse) error {
+	versions, err := buildVersions(resp)
+	if err != nil {
+		return err
+	}
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "Version\tUploaded\tProcessing\tExpired")
+	fmt.Fprintln(w, "Build\tVersion\tProcessing\tUploaded\tExpired")
 	for _, item := range resp.Data {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%t\n",
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n",
 			item.Attributes.Version,
-			item.Attributes.UploadedDate,
+			versions[item.ID],
 			item.Attributes.ProcessingState,
+			item.Attributes.UploadedDate,
 			item.Attributes.Expired,
 		)
 	}
@@ -70,13 +106,18 @@ func printBuildsTable(resp *BuildsResponse) error {
 }
 
 func printBuildsMarkdown(resp *BuildsResponse) error {
-	fmt.Fprintln(os.Stdout, "| Version | Uploaded | Processing | Expired |")
-	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	versions, err := buildVersions(resp)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, "| Build | Version | Processing | Uploaded | Expired |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
 	for _, item := range resp.Data {
-		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %t |\n",
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %t |\n",
 			escapeMarkdown(item.Attributes.Version),
-			escapeMarkdown(item.Attributes.UploadedDate),
+			escapeMarkdown(versions[item.ID]),
 			escapeMarkdown(item.Attributes.ProcessingState),
+			escapeMarkdown(item.Attributes.UploadedDate),
 			item.Attributes.Expired,
 		)
 	}
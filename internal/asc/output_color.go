@@ -0,0 +1,73 @@
+package asc
+
+import (
+	"strings"
+	"sync"
+)
+
+// ANSI codes used to color status columns in table output. Each color code has
+// the same length, so every cell of a colored column (header included) carries
+// the same escape overhead and tabwriter keeps the column aligned.
+const (
+	ANSIRed     = "\033[31m"
+	ANSIGreen   = "\033[32m"
+	ANSIDefault = "\033[39m"
+	ANSIReset   = "\033[0m"
+)
+
+// ANSI codes used by live terminal views, such as xcode-cloud build-runs watch.
+const (
+	ANSIBold   = "\033[1m"
+	ANSIYellow = "\033[33m"
+	ANSICyan   = "\033[36m"
+)
+
+var tableColor = struct {
+	mu      sync.RWMutex
+	enabled bool
+}{}
+
+// SetTableColor enables or disables ANSI colors in table output. Callers
+// enable it only when stdout is a terminal and colors were not turned off.
+func SetTableColor(enabled bool) {
+	tableColor.mu.Lock()
+	defer tableColor.mu.Unlock()
+	tableColor.enabled = enabled
+}
+
+// TableColorEnabled reports whether table output is colored.
+func TableColorEnabled() bool {
+	tableColor.mu.RLock()
+	defer tableColor.mu.RUnlock()
+	return tableColor.enabled
+}
+
+// colorHeader formats the header of a status column.
+func colorHeader(name string) string {
+	return colorCell(name, ANSIDefault)
+}
+
+// colorStatus formats a status cell in its StatusColor.
+func colorStatus(status string) string {
+	return colorCell(status, StatusColor(status))
+}
+
+func colorCell(value, color string) string {
+	if !TableColorEnabled() {
+		return value
+	}
+	return color + value + ANSIReset
+}
+
+// StatusColor returns the ANSI color for a status value: green for success,
+// red for failures, and the default color otherwise.
+func StatusColor(status string) string {
+	switch strings.ToUpper(strings.TrimSpace(status)) {
+	case "SUCCEEDED", "SUCCESS", "APPROVED", "CREATED":
+		return ANSIGreen
+	case "FAILED", "FAILURE", "ERRORED", "REJECTED", "DEVELOPER_ACTION_NEEDED":
+		return ANSIRed
+	default:
+		return ANSIDefault
+	}
+}
@@ -0,0 +1,79 @@
+package asc
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var ansiPattern = regexp.MustCompile("\033\\[[0-9;]*m")
+
+func TestPrintTable_CiBuildRunsColoredStatus(t *testing.T) {
+	SetTableColor(true)
+	t.Cleanup(func() { SetTableColor(false) })
+
+	resp := &CiBuildRunsResponse{
+		Data: []CiBuildRunResource{
+			{ID: "run-1", Attributes: CiBuildRunAttributes{Number: 1, CompletionStatus: CiBuildRunCompletionStatusSucceeded, StartReason: "MANUAL"}},
+			{ID: "run-2", Attributes: CiBuildRunAttributes{Number: 2, CompletionStatus: CiBuildRunCompletionStatusFailed, StartReason: "MANUAL"}},
+			{ID: "run-3", Attributes: CiBuildRunAttributes{Number: 3, CompletionStatus: CiBuildRunCompletionStatusCanceled, StartReason: "MANUAL"}},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	for _, want := range []string{ANSIGreen + "SUCCEEDED" + ANSIReset, ANSIRed + "FAILED" + ANSIReset, ANSIDefault + "CANCELED" + ANSIReset} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+
+	// Columns after the colored one must stay aligned once escapes are removed.
+	lines := strings.Split(strings.TrimSpace(ansiPattern.ReplaceAllString(output, "")), "\n")
+	column := strings.Index(lines[0], "Start Reason")
+	for _, line := range lines[1:] {
+		if strings.Index(line, "MANUAL") != column {
+			t.Fatalf("expected Start Reason column at %d, got %q", column, line)
+		}
+	}
+}
+
+func TestPrintTable_SubscriptionsPlainWhenColorDisabled(t *testing.T) {
+	resp := &SubscriptionsResponse{
+		Data: []Resource[SubscriptionAttributes]{
+			{ID: "sub-1", Attributes: SubscriptionAttributes{Name: "Monthly", State: "REJECTED"}},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	if ansiPattern.MatchString(output) {
+		t.Fatalf("expected no ANSI codes, got %q", output)
+	}
+	if !strings.Contains(output, "REJECTED") {
+		t.Fatalf("expected state in output, got %q", output)
+	}
+}
+
+func TestStatusColor(t *testing.T) {
+	tests := map[string]string{
+		"SUCCEEDED":               ANSIGreen,
+		"APPROVED":                ANSIGreen,
+		"created":                 ANSIGreen,
+		"FAILED":                  ANSIRed,
+		"ERRORED":                 ANSIRed,
+		"FAILURE":                 ANSIRed,
+		"DEVELOPER_ACTION_NEEDED": ANSIRed,
+		"SKIPPED":                 ANSIDefault,
+		"":                        ANSIDefault,
+	}
+	for status, want := range tests {
+		if got := StatusColor(status); got != want {
+			t.Fatalf("StatusColor(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
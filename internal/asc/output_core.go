@@ -58,7 +58,7 @@ func PrintMarkdown(data interface{}) error {
 	case *AppCategoriesResponse:
 		return printAppCategoriesMarkdown(v)
 	case *AppResponse:
-		return printAppsMarkdown(&AppsResponse{Data: []Resource[AppAttributes]{v.Data}})
+		return printAppMarkdown(v)
 	case *AppClipResponse:
 		return printAppClipsMarkdown(&AppClipsResponse{Data: []Resource[AppClipAttributes]{v.Data}})
 	case *AppClipDefaultExperiencesResponse:
@@ -185,14 +185,36 @@ func PrintMarkdown(data interface{}) error {
 		return printSubscriptionsMarkdown(v)
 	case *SubscriptionResponse:
 		return printSubscriptionsMarkdown(&SubscriptionsResponse{Data: []Resource[SubscriptionAttributes]{v.Data}})
+	case *SubscriptionCreateResult:
+		return printSubscriptionCreateResultMarkdown(v)
+	case *SubscriptionLocalizationsResponse:
+		return printSubscriptionLocalizationsMarkdown(v)
+	case *SubscriptionLocalizationResponse:
+		return printSubscriptionLocalizationsMarkdown(&SubscriptionLocalizationsResponse{Data: []Resource[SubscriptionLocalizationAttributes]{v.Data}})
 	case *PromotedPurchasesResponse:
 		return printPromotedPurchasesMarkdown(v)
 	case *PromotedPurchaseResponse:
 		return printPromotedPurchasesMarkdown(&PromotedPurchasesResponse{Data: []Resource[PromotedPurchaseAttributes]{v.Data}})
 	case *SubscriptionPriceResponse:
 		return printSubscriptionPriceMarkdown(v)
+	case *SubscriptionPricesResponse:
+		return printSubscriptionPricesMarkdown(v)
+	case *SubscriptionPricePointsResponse:
+		return printSubscriptionPricePointsMarkdown(v)
+	case *SubscriptionPricePointsUSDResult:
+		return printSubscriptionPricePointsUSDMarkdown(v)
 	case *SubscriptionAvailabilityResponse:
 		return printSubscriptionAvailabilityMarkdown(v)
+	case *SubscriptionAvailabilityNamedResult:
+		return printSubscriptionAvailabilityNamedMarkdown(v)
+	case *SubscriptionPromotionalOffersResponse:
+		return printSubscriptionPromotionalOffersMarkdown(v)
+	case *SubscriptionPromotionalOfferResponse:
+		return printSubscriptionPromotionalOffersMarkdown(&SubscriptionPromotionalOffersResponse{Data: []Resource[SubscriptionPromotionalOfferAttributes]{v.Data}})
+	case *SubscriptionIntroductoryOffersResponse:
+		return printSubscriptionIntroductoryOffersMarkdown(v)
+	case *SubscriptionIntroductoryOfferResponse:
+		return printSubscriptionIntroductoryOffersMarkdown(&SubscriptionIntroductoryOffersResponse{Data: []Resource[SubscriptionIntroductoryOfferAttributes]{v.Data}})
 	case *TerritoriesResponse:
 		return printTerritoriesMarkdown(v)
 	case *AppPricePointsV3Response:
@@ -239,6 +261,8 @@ func PrintMarkdown(data interface{}) error {
 		return printAppAvailabilityMarkdown(v)
 	case *TerritoryAvailabilitiesResponse:
 		return printTerritoryAvailabilitiesMarkdown(v)
+	case *TerritoryAvailabilitiesNamedResult:
+		return printTerritoryAvailabilitiesNamedMarkdown(v)
 	case *EndAppAvailabilityPreOrderResponse:
 		return printEndAppAvailabilityPreOrderMarkdown(v)
 	case *PreReleaseVersionResponse:
@@ -446,7 +470,11 @@ func PrintMarkdown(data interface{}) error {
 	case *GameCenterAchievementsResponse:
 		return printGameCenterAchievementsMarkdown(v)
 	case *GameCenterAchievementResponse:
-		return printGameCenterAchievementsMarkdown(&GameCenterAchievementsResponse{Data: []Resource[GameCenterAchievementAttributes]{v.Data}})
+		return printGameCenterAchievementMarkdown(v)
+	case *GameCenterAchievementCreateResult:
+		return printGameCenterAchievementCreateResultMarkdown(v)
+	case *GameCenterLeaderboardSetCreateResult:
+		return printGameCenterLeaderboardSetCreateResultMarkdown(v)
 	case *GameCenterAchievementDeleteResult:
 		return printGameCenterAchievementDeleteResultMarkdown(v)
 	case *GameCenterLeaderboardsResponse:
@@ -499,10 +527,14 @@ func PrintMarkdown(data interface{}) error {
 		return printGameCenterAchievementLocalizationDeleteResultMarkdown(v)
 	case *GameCenterLeaderboardImageUploadResult:
 		return printGameCenterLeaderboardImageUploadResultMarkdown(v)
+	case *GameCenterLeaderboardImageDownloadResult:
+		return printGameCenterLeaderboardImageDownloadResultMarkdown(v)
 	case *GameCenterLeaderboardImageDeleteResult:
 		return printGameCenterLeaderboardImageDeleteResultMarkdown(v)
 	case *GameCenterAchievementImageUploadResult:
 		return printGameCenterAchievementImageUploadResultMarkdown(v)
+	case *GameCenterImageBatchResult:
+		return printGameCenterImageBatchResultMarkdown(v)
 	case *GameCenterAchievementImageDeleteResult:
 		return printGameCenterAchievementImageDeleteResultMarkdown(v)
 	case *GameCenterLeaderboardSetImageUploadResult:
@@ -513,6 +545,16 @@ func PrintMarkdown(data interface{}) error {
 		return printSubscriptionGroupDeleteResultMarkdown(v)
 	case *SubscriptionDeleteResult:
 		return printSubscriptionDeleteResultMarkdown(v)
+	case *SubscriptionPriceBulkResult:
+		return printSubscriptionPriceBulkResultMarkdown(v)
+	case *SubscriptionLocalizationDeleteResult:
+		return printSubscriptionLocalizationDeleteResultMarkdown(v)
+	case *SubscriptionPriceDeleteResult:
+		return printSubscriptionPriceDeleteResultMarkdown(v)
+	case *SubscriptionPromotionalOfferDeleteResult:
+		return printSubscriptionPromotionalOfferDeleteResultMarkdown(v)
+	case *SubscriptionIntroductoryOfferDeleteResult:
+		return printSubscriptionIntroductoryOfferDeleteResultMarkdown(v)
 	case *BetaTesterDeleteResult:
 		return printBetaTesterDeleteResultMarkdown(v)
 	case *BetaTesterGroupsUpdateResult:
@@ -557,6 +599,16 @@ func PrintMarkdown(data interface{}) error {
 		return printXcodeCloudRunResultMarkdown(v)
 	case *XcodeCloudStatusResult:
 		return printXcodeCloudStatusResultMarkdown(v)
+	case *XcodeCloudRunArtifactsResult:
+		return printXcodeCloudRunArtifactsResultMarkdown(v)
+	case *CiBuildArtifactsResult:
+		return printCiBuildArtifactsResultMarkdown(v)
+	case *CiActionLogsResult:
+		return printCiActionLogsResultMarkdown(v)
+	case *CiArtifactsDownloadAllResult:
+		return printCiArtifactsDownloadAllResultMarkdown(v)
+	case *CiWorkflowsLatestStatusResult:
+		return printCiWorkflowsLatestStatusMarkdown(v)
 	case *CiProductsResponse:
 		return printCiProductsMarkdown(v)
 	case *CiProductResponse:
@@ -575,6 +627,8 @@ func PrintMarkdown(data interface{}) error {
 		return printCiBuildActionsMarkdown(v)
 	case *CiBuildActionResponse:
 		return printCiBuildActionsMarkdown(&CiBuildActionsResponse{Data: []CiBuildActionResource{v.Data}})
+	case *CiBuildActionDetailsResult:
+		return printCiBuildActionDetailsMarkdown(v)
 	case *CiMacOsVersionsResponse:
 		return printCiMacOsVersionsMarkdown(v)
 	case *CiMacOsVersionResponse:
@@ -599,6 +653,18 @@ func PrintMarkdown(data interface{}) error {
 		return printCiArtifactDownloadResultMarkdown(v)
 	case *CiWorkflowDeleteResult:
 		return printCiWorkflowDeleteResultMarkdown(v)
+	case *CiWorkflowDeletePreview:
+		return printCiWorkflowDeletePreviewMarkdown(v)
+	case *CiIssuesSummaryResult:
+		return printCiIssuesSummaryMarkdown(v)
+	case *CiBuildRunStatsResult:
+		return printCiBuildRunStatsMarkdown(v)
+	case *CiBuildRunsByWorkflowResult:
+		return printCiBuildRunsByWorkflowMarkdown(v)
+	case *CiTestResultsSummaryResult:
+		return printCiTestResultsSummaryMarkdown(v)
+	case *GameCenterDetailsSummary:
+		return printGameCenterDetailsSummaryMarkdown(v)
 	case *CiProductDeleteResult:
 		return printCiProductDeleteResultMarkdown(v)
 	case *EndUserLicenseAgreementResponse:
@@ -611,10 +677,14 @@ func PrintMarkdown(data interface{}) error {
 		return printCustomerReviewResponseDeleteResultMarkdown(v)
 	case *AccessibilityDeclarationDeleteResult:
 		return printAccessibilityDeclarationDeleteResultMarkdown(v)
+	case *AccessibilityDeclarationBatchCreateResult:
+		return printAccessibilityDeclarationBatchCreateResultMarkdown(v)
 	case *AppStoreReviewAttachmentDeleteResult:
 		return printAppStoreReviewAttachmentDeleteResultMarkdown(v)
 	case *RoutingAppCoverageDeleteResult:
 		return printRoutingAppCoverageDeleteResultMarkdown(v)
+	case *NominationsReport:
+		return printNominationsReportMarkdown(v)
 	case *NominationDeleteResult:
 		return printNominationDeleteResultMarkdown(v)
 	case *AppEncryptionDeclarationBuildsUpdateResult:
@@ -696,7 +766,7 @@ func PrintTable(data interface{}) error {
 	case *AppCategoriesResponse:
 		return printAppCategoriesTable(v)
 	case *AppResponse:
-		return printAppsTable(&AppsResponse{Data: []Resource[AppAttributes]{v.Data}})
+		return printAppTable(v)
 	case *AppClipResponse:
 		return printAppClipsTable(&AppClipsResponse{Data: []Resource[AppClipAttributes]{v.Data}})
 	case *AppClipDefaultExperiencesResponse:
@@ -823,14 +893,36 @@ func PrintTable(data interface{}) error {
 		return printSubscriptionsTable(v)
 	case *SubscriptionResponse:
 		return printSubscriptionsTable(&SubscriptionsResponse{Data: []Resource[SubscriptionAttributes]{v.Data}})
+	case *SubscriptionCreateResult:
+		return printSubscriptionCreateResultTable(v)
+	case *SubscriptionLocalizationsResponse:
+		return printSubscriptionLocalizationsTable(v)
+	case *SubscriptionLocalizationResponse:
+		return printSubscriptionLocalizationsTable(&SubscriptionLocalizationsResponse{Data: []Resource[SubscriptionLocalizationAttributes]{v.Data}})
 	case *PromotedPurchasesResponse:
 		return printPromotedPurchasesTable(v)
 	case *PromotedPurchaseResponse:
 		return printPromotedPurchasesTable(&PromotedPurchasesResponse{Data: []Resource[PromotedPurchaseAttributes]{v.Data}})
 	case *SubscriptionPriceResponse:
 		return printSubscriptionPriceTable(v)
+	case *SubscriptionPricesResponse:
+		return printSubscriptionPricesTable(v)
+	case *SubscriptionPricePointsResponse:
+		return printSubscriptionPricePointsTable(v)
+	case *SubscriptionPricePointsUSDResult:
+		return printSubscriptionPricePointsUSDTable(v)
 	case *SubscriptionAvailabilityResponse:
 		return printSubscriptionAvailabilityTable(v)
+	case *SubscriptionAvailabilityNamedResult:
+		return printSubscriptionAvailabilityNamedTable(v)
+	case *SubscriptionPromotionalOffersResponse:
+		return printSubscriptionPromotionalOffersTable(v)
+	case *SubscriptionPromotionalOfferResponse:
+		return printSubscriptionPromotionalOffersTable(&SubscriptionPromotionalOffersResponse{Data: []Resource[SubscriptionPromotionalOfferAttributes]{v.Data}})
+	case *SubscriptionIntroductoryOffersResponse:
+		return printSubscriptionIntroductoryOffersTable(v)
+	case *SubscriptionIntroductoryOfferResponse:
+		return printSubscriptionIntroductoryOffersTable(&SubscriptionIntroductoryOffersResponse{Data: []Resource[SubscriptionIntroductoryOfferAttributes]{v.Data}})
 	case *TerritoriesResponse:
 		return printTerritoriesTable(v)
 	case *AppPricePointsV3Response:
@@ -877,6 +969,8 @@ func PrintTable(data interface{}) error {
 		return printAppAvailabilityTable(v)
 	case *TerritoryAvailabilitiesResponse:
 		return printTerritoryAvailabilitiesTable(v)
+	case *TerritoryAvailabilitiesNamedResult:
+		return printTerritoryAvailabilitiesNamedTable(v)
 	case *EndAppAvailabilityPreOrderResponse:
 		return printEndAppAvailabilityPreOrderTable(v)
 	case *PreReleaseVersionResponse:
@@ -1084,7 +1178,11 @@ func PrintTable(data interface{}) error {
 	case *GameCenterAchievementsResponse:
 		return printGameCenterAchievementsTable(v)
 	case *GameCenterAchievementResponse:
-		return printGameCenterAchievementsTable(&GameCenterAchievementsResponse{Data: []Resource[GameCenterAchievementAttributes]{v.Data}})
+		return printGameCenterAchievementTable(v)
+	case *GameCenterAchievementCreateResult:
+		return printGameCenterAchievementCreateResultTable(v)
+	case *GameCenterLeaderboardSetCreateResult:
+		return printGameCenterLeaderboardSetCreateResultTable(v)
 	case *GameCenterAchievementDeleteResult:
 		return printGameCenterAchievementDeleteResultTable(v)
 	case *GameCenterLeaderboardsResponse:
@@ -1137,10 +1235,14 @@ func PrintTable(data interface{}) error {
 		return printGameCenterAchievementLocalizationDeleteResultTable(v)
 	case *GameCenterLeaderboardImageUploadResult:
 		return printGameCenterLeaderboardImageUploadResultTable(v)
+	case *GameCenterLeaderboardImageDownloadResult:
+		return printGameCenterLeaderboardImageDownloadResultTable(v)
 	case *GameCenterLeaderboardImageDeleteResult:
 		return printGameCenterLeaderboardImageDeleteResultTable(v)
 	case *GameCenterAchievementImageUploadResult:
 		return printGameCenterAchievementImageUploadResultTable(v)
+	case *GameCenterImageBatchResult:
+		return printGameCenterImageBatchResultTable(v)
 	case *GameCenterAchievementImageDeleteResult:
 		return printGameCenterAchievementImageDeleteResultTable(v)
 	case *GameCenterLeaderboardSetImageUploadResult:
@@ -1151,6 +1253,16 @@ func PrintTable(data interface{}) error {
 		return printSubscriptionGroupDeleteResultTable(v)
 	case *SubscriptionDeleteResult:
 		return printSubscriptionDeleteResultTable(v)
+	case *SubscriptionPriceBulkResult:
+		return printSubscriptionPriceBulkResultTable(v)
+	case *SubscriptionLocalizationDeleteResult:
+		return printSubscriptionLocalizationDeleteResultTable(v)
+	case *SubscriptionPriceDeleteResult:
+		return printSubscriptionPriceDeleteResultTable(v)
+	case *SubscriptionPromotionalOfferDeleteResult:
+		return printSubscriptionPromotionalOfferDeleteResultTable(v)
+	case *SubscriptionIntroductoryOfferDeleteResult:
+		return printSubscriptionIntroductoryOfferDeleteResultTable(v)
 	case *BetaTesterDeleteResult:
 		return printBetaTesterDeleteResultTable(v)
 	case *BetaTesterGroupsUpdateResult:
@@ -1199,6 +1311,16 @@ func PrintTable(data interface{}) error {
 		return printXcodeCloudRunResultTable(v)
 	case *XcodeCloudStatusResult:
 		return printXcodeCloudStatusResultTable(v)
+	case *XcodeCloudRunArtifactsResult:
+		return printXcodeCloudRunArtifactsResultTable(v)
+	case *CiBuildArtifactsResult:
+		return printCiBuildArtifactsResultTable(v)
+	case *CiActionLogsResult:
+		return printCiActionLogsResultTable(v)
+	case *CiArtifactsDownloadAllResult:
+		return printCiArtifactsDownloadAllResultTable(v)
+	case *CiWorkflowsLatestStatusResult:
+		return printCiWorkflowsLatestStatusTable(v)
 	case *CiProductsResponse:
 		return printCiProductsTable(v)
 	case *CiProductResponse:
@@ -1217,6 +1339,8 @@ func PrintTable(data interface{}) error {
 		return printCiBuildActionsTable(v)
 	case *CiBuildActionResponse:
 		return printCiBuildActionsTable(&CiBuildActionsResponse{Data: []CiBuildActionResource{v.Data}})
+	case *CiBuildActionDetailsResult:
+		return printCiBuildActionDetailsTable(v)
 	case *CiMacOsVersionsResponse:
 		return printCiMacOsVersionsTable(v)
 	case *CiMacOsVersionResponse:
@@ -1241,6 +1365,18 @@ func PrintTable(data interface{}) error {
 		return printCiArtifactDownloadResultTable(v)
 	case *CiWorkflowDeleteResult:
 		return printCiWorkflowDeleteResultTable(v)
+	case *CiWorkflowDeletePreview:
+		return printCiWorkflowDeletePreviewTable(v)
+	case *CiIssuesSummaryResult:
+		return printCiIssuesSummaryTable(v)
+	case *CiBuildRunStatsResult:
+		return printCiBuildRunStatsTable(v)
+	case *CiBuildRunsByWorkflowResult:
+		return printCiBuildRunsByWorkflowTable(v)
+	case *CiTestResultsSummaryResult:
+		return printCiTestResultsSummaryTable(v)
+	case *GameCenterDetailsSummary:
+		return printGameCenterDetailsSummaryTable(v)
 	case *CiProductDeleteResult:
 		return printCiProductDeleteResultTable(v)
 	case *CustomerReviewResponseResponse:
@@ -1249,6 +1385,8 @@ func PrintTable(data interface{}) error {
 		return printCustomerReviewResponseDeleteResultTable(v)
 	case *AccessibilityDeclarationDeleteResult:
 		return printAccessibilityDeclarationDeleteResultTable(v)
+	case *AccessibilityDeclarationBatchCreateResult:
+		return printAccessibilityDeclarationBatchCreateResultTable(v)
 	case *AppStoreReviewAttachmentDeleteResult:
 		return printAppStoreReviewAttachmentDeleteResultTable(v)
 	case *RoutingAppCoverageDeleteResult:
@@ -1,6 +1,7 @@
 package asc
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"text/tabwriter"
@@ -44,7 +45,10 @@ func printGameCenterAchievementDeleteResultTable(result *GameCenterAchievementDe
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tDeleted")
 	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
-	return w.Flush()
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return printGameCenterCascadeStepsTable(result.Cascade)
 }
 
 func printGameCenterAchievementDeleteResultMarkdown(result *GameCenterAchievementDeleteResult) error {
@@ -54,7 +58,7 @@ func printGameCenterAchievementDeleteResultMarkdown(result *GameCenterAchievemen
 		escapeMarkdown(result.ID),
 		result.Deleted,
 	)
-	return nil
+	return printGameCenterCascadeStepsMarkdown(result.Cascade)
 }
 
 func printGameCenterLeaderboardsTable(resp *GameCenterLeaderboardsResponse) error {
@@ -95,7 +99,10 @@ func printGameCenterLeaderboardDeleteResultTable(result *GameCenterLeaderboardDe
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tDeleted")
 	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
-	return w.Flush()
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return printGameCenterCascadeStepsTable(result.Cascade)
 }
 
 func printGameCenterLeaderboardDeleteResultMarkdown(result *GameCenterLeaderboardDeleteResult) error {
@@ -105,6 +112,36 @@ func printGameCenterLeaderboardDeleteResultMarkdown(result *GameCenterLeaderboar
 		escapeMarkdown(result.ID),
 		result.Deleted,
 	)
+	return printGameCenterCascadeStepsMarkdown(result.Cascade)
+}
+
+func printGameCenterCascadeStepsTable(steps []GameCenterCascadeStep) error {
+	if len(steps) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nCascade")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Action\tResource Type\tID")
+	for _, step := range steps {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", step.Action, step.ResourceType, step.ID)
+	}
+	return w.Flush()
+}
+
+func printGameCenterCascadeStepsMarkdown(steps []GameCenterCascadeStep) error {
+	if len(steps) == 0 {
+		return nil
+	}
+	fmt.Fprintf(os.Stdout, "\n### Cascade\n\n")
+	fmt.Fprintln(os.Stdout, "| Action | Resource Type | ID |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
+	for _, step := range steps {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s |\n",
+			escapeMarkdown(step.Action),
+			escapeMarkdown(step.ResourceType),
+			escapeMarkdown(step.ID),
+		)
+	}
 	return nil
 }
 
@@ -153,33 +190,31 @@ func printGameCenterLeaderboardSetDeleteResultMarkdown(result *GameCenterLeaderb
 
 func printGameCenterLeaderboardLocalizationsTable(resp *GameCenterLeaderboardLocalizationsResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tLocale\tName\tFormatter Override\tFormatter Suffix\tFormatter Suffix Singular\tDescription")
+	fmt.Fprintln(w, "ID\tLocale\tName\tSuffix (Singular)\tSuffix (Plural)\tScore Format")
 	for _, item := range resp.Data {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
 			item.ID,
 			item.Attributes.Locale,
 			compactWhitespace(item.Attributes.Name),
-			formatOptionalString(item.Attributes.FormatterOverride),
-			formatOptionalString(item.Attributes.FormatterSuffix),
 			formatOptionalString(item.Attributes.FormatterSuffixSingular),
-			formatOptionalString(item.Attributes.Description),
+			formatOptionalString(item.Attributes.FormatterSuffix),
+			formatOptionalString(item.Attributes.FormatterOverride),
 		)
 	}
 	return w.Flush()
 }
 
 func printGameCenterLeaderboardLocalizationsMarkdown(resp *GameCenterLeaderboardLocalizationsResponse) error {
-	fmt.Fprintln(os.Stdout, "| ID | Locale | Name | Formatter Override | Formatter Suffix | Formatter Suffix Singular | Description |")
-	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintln(os.Stdout, "| ID | Locale | Name | Suffix (Singular) | Suffix (Plural) | Score Format |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
 	for _, item := range resp.Data {
-		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %s | %s |\n",
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %s |\n",
 			escapeMarkdown(item.ID),
 			escapeMarkdown(item.Attributes.Locale),
 			escapeMarkdown(item.Attributes.Name),
-			escapeMarkdown(formatOptionalString(item.Attributes.FormatterOverride)),
-			escapeMarkdown(formatOptionalString(item.Attributes.FormatterSuffix)),
 			escapeMarkdown(formatOptionalString(item.Attributes.FormatterSuffixSingular)),
-			escapeMarkdown(formatOptionalString(item.Attributes.Description)),
+			escapeMarkdown(formatOptionalString(item.Attributes.FormatterSuffix)),
+			escapeMarkdown(formatOptionalString(item.Attributes.FormatterOverride)),
 		)
 	}
 	return nil
@@ -461,6 +496,34 @@ func printGameCenterLeaderboardImageUploadResultMarkdown(result *GameCenterLeade
 	return nil
 }
 
+func printGameCenterLeaderboardImageDownloadResultTable(result *GameCenterLeaderboardImageDownloadResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tFile Name\tSize\tOutput Path\tBytes Written")
+	fmt.Fprintf(w, "%s\t%s\t%dx%d\t%s\t%d\n",
+		result.ID,
+		result.FileName,
+		result.Width,
+		result.Height,
+		result.OutputPath,
+		result.BytesWritten,
+	)
+	return w.Flush()
+}
+
+func printGameCenterLeaderboardImageDownloadResultMarkdown(result *GameCenterLeaderboardImageDownloadResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | File Name | Size | Output Path | Bytes Written |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %dx%d | %s | %d |\n",
+		escapeMarkdown(result.ID),
+		escapeMarkdown(result.FileName),
+		result.Width,
+		result.Height,
+		escapeMarkdown(result.OutputPath),
+		result.BytesWritten,
+	)
+	return nil
+}
+
 func printGameCenterLeaderboardImageDeleteResultTable(result *GameCenterLeaderboardImageDeleteResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tDeleted")
@@ -567,3 +630,232 @@ func printGameCenterLeaderboardSetImageDeleteResultMarkdown(result *GameCenterLe
 	)
 	return nil
 }
+
+type gameCenterAchievementIncluded struct {
+	Localizations []Resource[GameCenterAchievementLocalizationAttributes]
+	Images        []Resource[GameCenterAchievementImageAttributes]
+}
+
+func parseGameCenterAchievementIncluded(raw json.RawMessage) (gameCenterAchievementIncluded, error) {
+	var included gameCenterAchievementIncluded
+	if len(raw) == 0 {
+		return included, nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return included, fmt.Errorf("parse included: %w", err)
+	}
+	for _, item := range items {
+		var header struct {
+			Type ResourceType `json:"type"`
+		}
+		if err := json.Unmarshal(item, &header); err != nil {
+			return included, fmt.Errorf("parse included: %w", err)
+		}
+		switch header.Type {
+		case ResourceTypeGameCenterAchievementLocalizations:
+			var localization Resource[GameCenterAchievementLocalizationAttributes]
+			if err := json.Unmarshal(item, &localization); err != nil {
+				return included, fmt.Errorf("parse included localization: %w", err)
+			}
+			included.Localizations = append(included.Localizations, localization)
+		case ResourceTypeGameCenterAchievementImages:
+			var image Resource[GameCenterAchievementImageAttributes]
+			if err := json.Unmarshal(item, &image); err != nil {
+				return included, fmt.Errorf("parse included image: %w", err)
+			}
+			included.Images = append(included.Images, image)
+		}
+	}
+	return included, nil
+}
+
+func gameCenterAchievementImageState(attrs GameCenterAchievementImageAttributes) string {
+	if attrs.AssetDeliveryState == nil {
+		return ""
+	}
+	return attrs.AssetDeliveryState.State
+}
+
+func printGameCenterAchievementTable(resp *GameCenterAchievementResponse) error {
+	if err := printGameCenterAchievementsTable(&GameCenterAchievementsResponse{Data: []Resource[GameCenterAchievementAttributes]{resp.Data}}); err != nil {
+		return err
+	}
+	included, err := parseGameCenterAchievementIncluded(resp.Included)
+	if err != nil {
+		return err
+	}
+	if len(included.Localizations) > 0 {
+		fmt.Fprintln(os.Stdout, "\nLocalizations")
+		if err := printGameCenterAchievementLocalizationsTable(&GameCenterAchievementLocalizationsResponse{Data: included.Localizations}); err != nil {
+			return err
+		}
+	}
+	if len(included.Images) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nImages")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tFile Name\tFile Size\tDelivery State")
+	for _, item := range included.Images {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n",
+			item.ID,
+			item.Attributes.FileName,
+			item.Attributes.FileSize,
+			gameCenterAchievementImageState(item.Attributes),
+		)
+	}
+	return w.Flush()
+}
+
+func printGameCenterAchievementMarkdown(resp *GameCenterAchievementResponse) error {
+	if err := printGameCenterAchievementsMarkdown(&GameCenterAchievementsResponse{Data: []Resource[GameCenterAchievementAttributes]{resp.Data}}); err != nil {
+		return err
+	}
+	included, err := parseGameCenterAchievementIncluded(resp.Included)
+	if err != nil {
+		return err
+	}
+	if len(included.Localizations) > 0 {
+		fmt.Fprintln(os.Stdout)
+		if err := printGameCenterAchievementLocalizationsMarkdown(&GameCenterAchievementLocalizationsResponse{Data: included.Localizations}); err != nil {
+			return err
+		}
+	}
+	if len(included.Images) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\n| ID | File Name | File Size | Delivery State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, item := range included.Images {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %d | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.FileName),
+			item.Attributes.FileSize,
+			escapeMarkdown(gameCenterAchievementImageState(item.Attributes)),
+		)
+	}
+	return nil
+}
+
+func printGameCenterAchievementCreateResultTable(result *GameCenterAchievementCreateResult) error {
+	if result.Achievement != nil {
+		if err := printGameCenterAchievementsTable(&GameCenterAchievementsResponse{Data: []Resource[GameCenterAchievementAttributes]{result.Achievement.Data}}); err != nil {
+			return err
+		}
+	}
+	if result.Localization == nil {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nLocalizations")
+	return printGameCenterAchievementLocalizationsTable(&GameCenterAchievementLocalizationsResponse{Data: []Resource[GameCenterAchievementLocalizationAttributes]{result.Localization.Data}})
+}
+
+func printGameCenterAchievementCreateResultMarkdown(result *GameCenterAchievementCreateResult) error {
+	if result.Achievement != nil {
+		if err := printGameCenterAchievementsMarkdown(&GameCenterAchievementsResponse{Data: []Resource[GameCenterAchievementAttributes]{result.Achievement.Data}}); err != nil {
+			return err
+		}
+	}
+	if result.Localization == nil {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout)
+	return printGameCenterAchievementLocalizationsMarkdown(&GameCenterAchievementLocalizationsResponse{Data: []Resource[GameCenterAchievementLocalizationAttributes]{result.Localization.Data}})
+}
+
+func printGameCenterLeaderboardSetCreateResultTable(result *GameCenterLeaderboardSetCreateResult) error {
+	if result.LeaderboardSet != nil {
+		if err := printGameCenterLeaderboardSetsTable(&GameCenterLeaderboardSetsResponse{Data: []Resource[GameCenterLeaderboardSetAttributes]{result.LeaderboardSet.Data}}); err != nil {
+			return err
+		}
+	}
+	if result.Localization == nil {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nLocalizations")
+	return printGameCenterLeaderboardSetLocalizationsTable(&GameCenterLeaderboardSetLocalizationsResponse{Data: []Resource[GameCenterLeaderboardSetLocalizationAttributes]{result.Localization.Data}})
+}
+
+func printGameCenterLeaderboardSetCreateResultMarkdown(result *GameCenterLeaderboardSetCreateResult) error {
+	if result.LeaderboardSet != nil {
+		if err := printGameCenterLeaderboardSetsMarkdown(&GameCenterLeaderboardSetsResponse{Data: []Resource[GameCenterLeaderboardSetAttributes]{result.LeaderboardSet.Data}}); err != nil {
+			return err
+		}
+	}
+	if result.Localization == nil {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout)
+	return printGameCenterLeaderboardSetLocalizationsMarkdown(&GameCenterLeaderboardSetLocalizationsResponse{Data: []Resource[GameCenterLeaderboardSetLocalizationAttributes]{result.Localization.Data}})
+}
+
+func printGameCenterDetailsSummaryTable(summary *GameCenterDetailsSummary) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "App ID\tApp Name\tGame Center\tDetail ID\tArcade")
+	for _, item := range summary.Apps {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n",
+			item.AppID,
+			compactWhitespace(item.AppName),
+			gameCenterConfiguredState(item.Configured),
+			item.DetailID,
+			item.ArcadeEnabled,
+		)
+	}
+	return w.Flush()
+}
+
+func printGameCenterDetailsSummaryMarkdown(summary *GameCenterDetailsSummary) error {
+	fmt.Fprintln(os.Stdout, "| App ID | App Name | Game Center | Detail ID | Arcade |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	for _, item := range summary.Apps {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %t |\n",
+			escapeMarkdown(item.AppID),
+			escapeMarkdown(item.AppName),
+			gameCenterConfiguredState(item.Configured),
+			escapeMarkdown(item.DetailID),
+			item.ArcadeEnabled,
+		)
+	}
+	return nil
+}
+
+func gameCenterConfiguredState(configured bool) string {
+	if configured {
+		return "enabled"
+	}
+	return "not-configured"
+}
+
+func printGameCenterImageBatchResultTable(result *GameCenterImageBatchResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Localization ID\tFile\tImage ID\tDelivery State\tUploaded\tError")
+	for _, item := range result.Results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\n",
+			item.LocalizationID,
+			item.FilePath,
+			item.ImageID,
+			item.AssetDeliveryState,
+			item.Uploaded,
+			compactWhitespace(item.Error),
+		)
+	}
+	return w.Flush()
+}
+
+func printGameCenterImageBatchResultMarkdown(result *GameCenterImageBatchResult) error {
+	fmt.Fprintln(os.Stdout, "| Localization ID | File | Image ID | Delivery State | Uploaded | Error |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Results {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %t | %s |\n",
+			escapeMarkdown(item.LocalizationID),
+			escapeMarkdown(item.FilePath),
+			escapeMarkdown(item.ImageID),
+			escapeMarkdown(item.AssetDeliveryState),
+			item.Uploaded,
+			escapeMarkdown(compactWhitespace(item.Error)),
+		)
+	}
+	return nil
+}
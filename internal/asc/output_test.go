@@ -1039,6 +1039,52 @@ func TestPrintTable_Nominations(t *testing.T) {
 	}
 }
 
+func TestPrintTable_NominationsWithCreatedByActor(t *testing.T) {
+	resp := &NominationsResponse{
+		Data: []Resource[NominationAttributes]{
+			{
+				ID:            "nom-1",
+				Attributes:    NominationAttributes{Name: "Spring Launch"},
+				Relationships: json.RawMessage(`{"createdByActor":{"data":{"type":"actors","id":"actor-1"}}}`),
+			},
+			{
+				ID:            "nom-2",
+				Attributes:    NominationAttributes{Name: "Summer Update"},
+				Relationships: json.RawMessage(`{"createdByActor":{"data":{"type":"actors","id":"actor-2"}}}`),
+			},
+			{
+				ID:         "nom-3",
+				Attributes: NominationAttributes{Name: "Fall Event"},
+			},
+		},
+		Included: json.RawMessage(`[
+			{"type":"apps","id":"app-1","attributes":{"name":"Example"}},
+			{"type":"actors","id":"actor-1","attributes":{"actorType":"USER","userFirstName":"Jane","userLastName":"Doe"}},
+			{"type":"actors","id":"actor-2","attributes":{"actorType":"API_KEY","apiKeyId":"KEY123"}}
+		]`),
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	if !strings.Contains(output, "Created By") {
+		t.Fatalf("expected created by header, got: %s", output)
+	}
+	for _, want := range []string{"Jane Doe", "KEY123"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+
+	plain := captureStdout(t, func() error {
+		return PrintTable(&NominationsResponse{Data: resp.Data})
+	})
+	if strings.Contains(plain, "Created By") {
+		t.Fatalf("expected no created by column without included actors, got: %s", plain)
+	}
+}
+
 func TestPrintMarkdown_Nominations(t *testing.T) {
 	resp := &NominationsResponse{
 		Data: []Resource[NominationAttributes]{
@@ -1066,6 +1112,37 @@ func TestPrintMarkdown_Nominations(t *testing.T) {
 	}
 }
 
+func TestPrintMarkdown_NominationsReport(t *testing.T) {
+	report := &NominationsReport{
+		Nominations: &NominationsResponse{
+			Data: []Resource[NominationAttributes]{
+				{ID: "nom-1", Attributes: NominationAttributes{Name: "Summer Event", State: NominationStateSubmitted}},
+				{ID: "nom-2", Attributes: NominationAttributes{Name: "Spring Launch", State: NominationStateDraft}},
+				{ID: "nom-3", Attributes: NominationAttributes{Name: "Winter Update", State: NominationStateSubmitted}},
+			},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(report)
+	})
+
+	if !strings.Contains(output, "Total: 3") {
+		t.Fatalf("expected total count, got: %s", output)
+	}
+	if !strings.Contains(output, "| DRAFT | 1 |") || !strings.Contains(output, "| SUBMITTED | 2 |") {
+		t.Fatalf("expected per-state counts, got: %s", output)
+	}
+	draft := strings.Index(output, "## DRAFT (1)")
+	submitted := strings.Index(output, "## SUBMITTED (2)")
+	if draft == -1 || submitted == -1 || draft > submitted {
+		t.Fatalf("expected DRAFT section before SUBMITTED section, got: %s", output)
+	}
+	if !strings.Contains(output[submitted:], "Summer Event") || !strings.Contains(output[submitted:], "Winter Update") {
+		t.Fatalf("expected submitted nominations grouped together, got: %s", output)
+	}
+}
+
 func TestPrintTable_NominationDeleteResult(t *testing.T) {
 	result := &NominationDeleteResult{
 		ID:      "nom-1",
@@ -1272,6 +1349,39 @@ func TestPrintTable_Builds(t *testing.T) {
 	}
 }
 
+func TestPrintTable_BuildsWithIncludedVersion(t *testing.T) {
+	resp := &BuildsResponse{
+		Data: []Resource[BuildAttributes]{
+			{
+				Type: ResourceTypeBuilds,
+				ID:   "build-1",
+				Attributes: BuildAttributes{
+					Version:         "42",
+					UploadedDate:    "2026-01-20T00:00:00Z",
+					ProcessingState: "VALID",
+				},
+				Relationships: json.RawMessage(`{"preReleaseVersion":{"data":{"type":"preReleaseVersions","id":"prv-1"}}}`),
+			},
+		},
+		Included: json.RawMessage(`[{"type":"preReleaseVersions","id":"prv-1","attributes":{"version":"1.2.3","platform":"IOS"}}]`),
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got: %s", output)
+	}
+	if got := strings.Fields(lines[0]); strings.Join(got, " ") != "Build Version Processing Uploaded Expired" {
+		t.Fatalf("unexpected header: %q", lines[0])
+	}
+	if got := strings.Fields(lines[1]); strings.Join(got, " ") != "42 1.2.3 VALID 2026-01-20T00:00:00Z false" {
+		t.Fatalf("unexpected row: %q", lines[1])
+	}
+}
+
 func TestPrintMarkdown_Builds(t *testing.T) {
 	resp := &BuildsResponse{
 		Data: []Resource[BuildAttributes]{
@@ -1291,7 +1401,7 @@ func TestPrintMarkdown_Builds(t *testing.T) {
 		return PrintMarkdown(resp)
 	})
 
-	if !strings.Contains(output, "| Version | Uploaded | Processing | Expired |") {
+	if !strings.Contains(output, "| Build | Version | Processing | Uploaded | Expired |") {
 		t.Fatalf("expected markdown header, got: %s", output)
 	}
 	if !strings.Contains(output, "1.2.3") {
@@ -1699,7 +1809,7 @@ func TestPrintMarkdown_BuildInfo(t *testing.T) {
 		return PrintMarkdown(resp)
 	})
 
-	if !strings.Contains(output, "| Version | Uploaded | Processing | Expired |") {
+	if !strings.Contains(output, "| Build | Version | Processing | Uploaded | Expired |") {
 		t.Fatalf("expected markdown header, got: %s", output)
 	}
 	if !strings.Contains(output, "2.0.0") {
@@ -3795,3 +3905,302 @@ func TestPrintMarkdown_AppEventLocalizationDeleteResult(t *testing.T) {
 		t.Fatalf("expected localization id in output, got: %s", output)
 	}
 }
+
+func TestPrintTable_GameCenterAchievementWithIncluded(t *testing.T) {
+	resp := &GameCenterAchievementResponse{
+		Data: Resource[GameCenterAchievementAttributes]{
+			ID:         "ach-1",
+			Attributes: GameCenterAchievementAttributes{ReferenceName: "First Win"},
+		},
+		Included: json.RawMessage(`[
+			{"type":"gameCenterAchievementLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Winner"}},
+			{"type":"gameCenterAchievementImages","id":"img-1","attributes":{"fileName":"badge.png","fileSize":1024,"assetDeliveryState":{"state":"COMPLETE"}}}
+		]`),
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	for _, want := range []string{"First Win", "Localizations", "en-US", "Winner", "Images", "badge.png", "COMPLETE"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
+
+func TestPrintTable_AppWithIncludedCounts(t *testing.T) {
+	resp := &AppResponse{
+		Data: Resource[AppAttributes]{
+			ID:         "app-1",
+			Attributes: AppAttributes{Name: "Demo", BundleID: "com.example.demo"},
+		},
+		Included: json.RawMessage(`[
+			{"type":"builds","id":"build-1"},
+			{"type":"appInfos","id":"info-1"},
+			{"type":"builds","id":"build-2"}
+		]`),
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("expected app table and included summary, got %q", output)
+	}
+	if strings.TrimSpace(lines[3]) != "Included" {
+		t.Fatalf("expected Included heading, got %q", lines[3])
+	}
+	if fields := strings.Fields(lines[5]); strings.Join(fields, " ") != "builds 2" {
+		t.Fatalf("unexpected builds row: %q", lines[5])
+	}
+	if fields := strings.Fields(lines[6]); strings.Join(fields, " ") != "appInfos 1" {
+		t.Fatalf("unexpected appInfos row: %q", lines[6])
+	}
+}
+
+func TestPrintMarkdown_AppWithoutIncluded(t *testing.T) {
+	resp := &AppResponse{
+		Data: Resource[AppAttributes]{ID: "app-1", Attributes: AppAttributes{Name: "Demo"}},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(resp)
+	})
+
+	if strings.Contains(output, "Included Type") {
+		t.Fatalf("expected no included summary, got %q", output)
+	}
+}
+
+func TestPrintTable_GameCenterDetailsSummary(t *testing.T) {
+	summary := &GameCenterDetailsSummary{
+		Apps: []GameCenterAppDetailStatus{
+			{AppID: "app-1", AppName: "Puzzle", Configured: true, DetailID: "gc-1", ArcadeEnabled: true},
+			{AppID: "app-2", AppName: "Notes"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(summary)
+	})
+
+	for _, want := range []string{"App ID", "Arcade", "app-1", "enabled", "gc-1", "app-2", "not-configured"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
+
+func TestPrintTable_SubscriptionGroupsColumns(t *testing.T) {
+	resp := &SubscriptionGroupsResponse{
+		Data: []Resource[SubscriptionGroupAttributes]{
+			{
+				ID:            "group-1",
+				Attributes:    SubscriptionGroupAttributes{ReferenceName: "Premium"},
+				Relationships: json.RawMessage(`{"subscriptions":{"data":[{"type":"subscriptions","id":"sub-1"}],"meta":{"paging":{"total":3,"limit":1}}}}`),
+			},
+			{
+				ID:         "group-2",
+				Attributes: SubscriptionGroupAttributes{ReferenceName: "Basic"},
+			},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %q", output)
+	}
+	if fields := strings.Fields(lines[0]); strings.Join(fields, " ") != "Reference Name ID Subscriptions" {
+		t.Fatalf("unexpected header: %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); strings.Join(fields, " ") != "Premium group-1 3" {
+		t.Fatalf("unexpected first row: %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); strings.Join(fields, " ") != "Basic group-2" {
+		t.Fatalf("expected blank count without included subscriptions, got %q", lines[2])
+	}
+}
+
+func TestPrintMarkdown_SubscriptionsWithIncluded(t *testing.T) {
+	resp := &SubscriptionsResponse{
+		Data: []Resource[SubscriptionAttributes]{
+			{
+				ID:            "sub-1",
+				Attributes:    SubscriptionAttributes{Name: "Monthly"},
+				Relationships: json.RawMessage(`{"prices":{"data":[{"type":"subscriptionPrices","id":"price-1"}]},"subscriptionLocalizations":{"data":[{"type":"subscriptionLocalizations","id":"loc-1"}]}}`),
+			},
+		},
+		Included: json.RawMessage(`[
+			{"type":"subscriptionPrices","id":"price-1","attributes":{"startDate":"2026-01-01","preserved":true}},
+			{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Monthly Plan","state":"APPROVED"}}
+		]`),
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(resp)
+	})
+
+	for _, want := range []string{"| sub-1 | price-1 | 2026-01-01 | true |", "| sub-1 | loc-1 | en-US | Monthly Plan | APPROVED |"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
+
+func TestPrintTable_GameCenterAchievementCreateResult(t *testing.T) {
+	result := &GameCenterAchievementCreateResult{
+		Achievement: &GameCenterAchievementResponse{
+			Data: Resource[GameCenterAchievementAttributes]{
+				ID:         "ach-1",
+				Attributes: GameCenterAchievementAttributes{ReferenceName: "First Win", VendorIdentifier: "com.example.firstwin", Points: 10},
+			},
+		},
+		Localization: &GameCenterAchievementLocalizationResponse{
+			Data: Resource[GameCenterAchievementLocalizationAttributes]{
+				ID:         "loc-1",
+				Attributes: GameCenterAchievementLocalizationAttributes{Locale: "en-US", Name: "First Win", BeforeEarnedDescription: "Win a game"},
+			},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	for _, want := range []string{"ach-1", "com.example.firstwin", "Localizations", "loc-1", "en-US", "Win a game"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
+
+func TestPrintTable_GameCenterLeaderboardSetCreateResult(t *testing.T) {
+	result := &GameCenterLeaderboardSetCreateResult{
+		LeaderboardSet: &GameCenterLeaderboardSetResponse{
+			Data: Resource[GameCenterLeaderboardSetAttributes]{
+				ID:         "set-1",
+				Attributes: GameCenterLeaderboardSetAttributes{ReferenceName: "Season 1", VendorIdentifier: "com.example.season1"},
+			},
+		},
+		Localization: &GameCenterLeaderboardSetLocalizationResponse{
+			Data: Resource[GameCenterLeaderboardSetLocalizationAttributes]{
+				ID:         "loc-1",
+				Attributes: GameCenterLeaderboardSetLocalizationAttributes{Locale: "en-US", Name: "Season One"},
+			},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	for _, want := range []string{"set-1", "com.example.season1", "Localizations", "loc-1", "en-US", "Season One"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
+
+func TestPrintMarkdown_GameCenterAchievementDeleteResultCascade(t *testing.T) {
+	result := &GameCenterAchievementDeleteResult{
+		ID:      "ach-1",
+		Deleted: true,
+		Cascade: []GameCenterCascadeStep{
+			{Action: "deleted", ResourceType: "gameCenterAchievementImages", ID: "img-1"},
+			{Action: "deleted", ResourceType: "gameCenterAchievementLocalizations", ID: "loc-1"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(result)
+	})
+
+	for _, want := range []string{"ach-1", "Cascade", "gameCenterAchievementImages", "img-1", "loc-1"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
+
+func TestPrintTable_GameCenterLeaderboardDeleteResultCascade(t *testing.T) {
+	result := &GameCenterLeaderboardDeleteResult{
+		ID:      "lb-1",
+		Deleted: true,
+		Cascade: []GameCenterCascadeStep{
+			{Action: "removed-from-set", ResourceType: "gameCenterLeaderboardSets", ID: "set-1"},
+			{Action: "deleted", ResourceType: "gameCenterLeaderboardReleases", ID: "rel-1"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	for _, want := range []string{"lb-1", "Cascade", "removed-from-set", "set-1", "gameCenterLeaderboardReleases", "rel-1"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
+
+func TestPrintTable_GameCenterLeaderboardLocalizations(t *testing.T) {
+	singular := "point"
+	plural := "points"
+	format := "INTEGER"
+	resp := &GameCenterLeaderboardLocalizationsResponse{
+		Data: []Resource[GameCenterLeaderboardLocalizationAttributes]{
+			{
+				ID: "loc-1",
+				Attributes: GameCenterLeaderboardLocalizationAttributes{
+					Locale:                  "en-US",
+					Name:                    "High Score",
+					FormatterSuffixSingular: &singular,
+					FormatterSuffix:         &plural,
+					FormatterOverride:       &format,
+				},
+			},
+			{
+				ID:         "loc-2",
+				Attributes: GameCenterLeaderboardLocalizationAttributes{Locale: "de-DE", Name: "Bestwert"},
+			},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	for _, want := range []string{"Locale", "Suffix (Singular)", "Suffix (Plural)", "Score Format", "en-US", "High Score", "point", "points", "INTEGER", "de-DE", "Bestwert"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got %q", want, output)
+		}
+	}
+}
+
+func TestPrintMarkdown_GameCenterLeaderboardLocalizations(t *testing.T) {
+	singular := "lap"
+	resp := &GameCenterLeaderboardLocalizationResponse{
+		Data: Resource[GameCenterLeaderboardLocalizationAttributes]{
+			ID:         "loc-1",
+			Attributes: GameCenterLeaderboardLocalizationAttributes{Locale: "en-US", Name: "Fastest Lap", FormatterSuffixSingular: &singular},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(resp)
+	})
+
+	if !strings.Contains(output, "| ID | Locale | Name | Suffix (Singular) | Suffix (Plural) | Score Format |") {
+		t.Fatalf("expected markdown header, got %q", output)
+	}
+	if !strings.Contains(output, "| loc-1 | en-US | Fastest Lap | lap | - | - |") {
+		t.Fatalf("expected markdown row, got %q", output)
+	}
+}
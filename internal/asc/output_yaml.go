@@ -0,0 +1,56 @@
+package asc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PrintYAML prints data as YAML. Field names and omission follow the JSON
+// encoding, so YAML output mirrors --output json key for key.
+func PrintYAML(data interface{}) error {
+	out, err := MarshalYAML(data)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// MarshalYAML converts data to YAML via its JSON encoding, preserving the
+// JSON key order.
+func MarshalYAML(data interface{}) ([]byte, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("convert to yaml: %w", err)
+	}
+	resetYAMLStyle(&doc)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("encode yaml: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("encode yaml: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// resetYAMLStyle drops the flow and quoting styles inherited from the JSON
+// source so the encoder emits block YAML and quotes scalars only when needed.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
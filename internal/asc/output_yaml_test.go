@@ -0,0 +1,106 @@
+package asc
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// assertYAMLRoundTrip checks that YAML output decodes to the same document
+// as the JSON output.
+func assertYAMLRoundTrip(t *testing.T, data interface{}) {
+	t.Helper()
+
+	out, err := MarshalYAML(data)
+	if err != nil {
+		t.Fatalf("MarshalYAML() error: %v", err)
+	}
+	var fromYAML interface{}
+	if err := yaml.Unmarshal(out, &fromYAML); err != nil {
+		t.Fatalf("failed to parse yaml %q: %v", out, err)
+	}
+	converted, err := json.Marshal(fromYAML)
+	if err != nil {
+		t.Fatalf("failed to convert yaml to json: %v", err)
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+	var want, got interface{}
+	if err := json.Unmarshal(raw, &want); err != nil {
+		t.Fatalf("decode json: %v", err)
+	}
+	if err := json.Unmarshal(converted, &got); err != nil {
+		t.Fatalf("decode converted json: %v", err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("yaml round trip mismatch:\nyaml:\n%s\nwant: %s\n got: %s", out, raw, converted)
+	}
+}
+
+func TestMarshalYAMLRoundTripsWorkflow(t *testing.T) {
+	resp := &CiWorkflowResponse{
+		Data: CiWorkflowResource{
+			Type: ResourceTypeCiWorkflows,
+			ID:   "wf-1",
+			Attributes: CiWorkflowAttributes{
+				Name:        "CI",
+				Description: "Runs on: main\nand release/*",
+				BranchStartCondition: &CiBranchStartCondition{
+					Source: &CiBranchPatterns{Patterns: []CiStartConditionPattern{{Pattern: "release/", IsPrefix: true}}},
+				},
+				IsEnabled:         true,
+				ContainerFilePath: "App.xcodeproj",
+			},
+			Relationships: &CiWorkflowRelationships{
+				Product: &Relationship{Data: ResourceData{Type: ResourceTypeCiProducts, ID: "123"}},
+			},
+		},
+	}
+	assertYAMLRoundTrip(t, resp)
+}
+
+func TestMarshalYAMLRoundTripsSubscription(t *testing.T) {
+	resp := &SubscriptionResponse{
+		Data: Resource[SubscriptionAttributes]{
+			Type: ResourceTypeSubscriptions,
+			ID:   "sub-1",
+			Attributes: SubscriptionAttributes{
+				Name:               "Monthly",
+				ProductID:          "true",
+				SubscriptionPeriod: "ONE_MONTH",
+				ReviewNote:         "",
+				GroupLevel:         1,
+			},
+		},
+	}
+	assertYAMLRoundTrip(t, resp)
+}
+
+func TestMarshalYAMLUsesJSONFieldNamesInOrder(t *testing.T) {
+	out, err := MarshalYAML(&SubscriptionResponse{
+		Data: Resource[SubscriptionAttributes]{
+			Type:       ResourceTypeSubscriptions,
+			ID:         "sub-1",
+			Attributes: SubscriptionAttributes{Name: "Monthly", ProductID: "123"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("MarshalYAML() error: %v", err)
+	}
+	want := `data:
+  type: subscriptions
+  id: sub-1
+  attributes:
+    name: Monthly
+    productId: "123"
+`
+	if !strings.HasPrefix(string(out), want) {
+		t.Fatalf("unexpected yaml:\n%s", out)
+	}
+}
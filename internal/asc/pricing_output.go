@@ -126,6 +126,38 @@ func printTerritoryAvailabilitiesTable(resp *TerritoryAvailabilitiesResponse) er
 	return w.Flush()
 }
 
+func printTerritoryAvailabilitiesNamedTable(result *TerritoryAvailabilitiesNamedResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTerritory\tTerritory Name\tAvailable\tRelease Date\tPreorder Enabled")
+	for _, item := range result.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%t\n",
+			item.ID,
+			item.Territory,
+			item.TerritoryName,
+			item.Available,
+			compactWhitespace(item.ReleaseDate),
+			item.PreOrderEnabled,
+		)
+	}
+	return w.Flush()
+}
+
+func printTerritoryAvailabilitiesNamedMarkdown(result *TerritoryAvailabilitiesNamedResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Territory | Territory Name | Available | Release Date | Preorder Enabled |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %t | %s | %t |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Territory),
+			escapeMarkdown(item.TerritoryName),
+			item.Available,
+			escapeMarkdown(item.ReleaseDate),
+			item.PreOrderEnabled,
+		)
+	}
+	return nil
+}
+
 func printTerritoryAvailabilitiesMarkdown(resp *TerritoryAvailabilitiesResponse) error {
 	fmt.Fprintln(os.Stdout, "| ID | Available | Release Date | Preorder Enabled |")
 	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
@@ -21,9 +21,10 @@ type AppStorePublishResult struct {
 	Submitted    bool   `json:"submitted"`
 }
 
-// Build processing states to poll for.
+// Build processing states.
 const (
 	BuildProcessingStateProcessing = "PROCESSING"
+	BuildProcessingStateFailed     = "FAILED"
 	BuildProcessingStateValid      = "VALID"
 	BuildProcessingStateInvalid    = "INVALID"
 )
@@ -0,0 +1,75 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotJSONAPICollection is returned when a raw response is not a JSON:API
+// collection document and therefore cannot be paginated.
+var ErrNotJSONAPICollection = errors.New("response is not a JSON:API collection (expected a top-level data array)")
+
+// RawListResponse adapts an untyped JSON:API collection document to
+// PaginatedResponse so PaginateAll can follow links.next for endpoints
+// without a typed wrapper.
+type RawListResponse struct {
+	Data     []json.RawMessage `json:"data"`
+	Included json.RawMessage   `json:"included,omitempty"`
+	Links    Links             `json:"links,omitempty"`
+	Meta     json.RawMessage   `json:"meta,omitempty"`
+}
+
+// GetLinks returns the links field for pagination.
+func (r *RawListResponse) GetLinks() *Links {
+	return &r.Links
+}
+
+// GetData returns the data field for aggregation.
+func (r *RawListResponse) GetData() interface{} {
+	return r.Data
+}
+
+// ParseRawListResponse decodes body as a JSON:API collection. Single-resource
+// documents, error documents, and non-JSON bodies are rejected with
+// ErrNotJSONAPICollection.
+func ParseRawListResponse(body []byte) (*RawListResponse, error) {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, ErrNotJSONAPICollection
+	}
+	data, ok := document["data"]
+	if !ok {
+		return nil, ErrNotJSONAPICollection
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return nil, ErrNotJSONAPICollection
+	}
+
+	var resp RawListResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &resp, nil
+}
+
+// GetRawList fetches a JSON:API collection from path, which may be an API
+// path (e.g. /v1/apps) or a full links.next URL.
+func (c *Client) GetRawList(ctx context.Context, path string) (*RawListResponse, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+	if err := validateNextURL(path); err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRawListResponse(data)
+}
@@ -0,0 +1,255 @@
+package asc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RecordedExchange is one HTTP request and its response, as written by
+// --record and served by --replay.
+type RecordedExchange struct {
+	RecordedAt string           `json:"recordedAt"`
+	Method     string           `json:"method"`
+	Path       string           `json:"path"`
+	Request    RecordedRequest  `json:"request"`
+	Response   RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the request half of a RecordedExchange. Credential
+// headers are never written.
+type RecordedRequest struct {
+	Headers http.Header     `json:"headers,omitempty"`
+	Body    json.RawMessage `json:"body,omitempty"`
+}
+
+// RecordedResponse is the response half of a RecordedExchange.
+type RecordedResponse struct {
+	Status  int             `json:"status"`
+	Headers http.Header     `json:"headers,omitempty"`
+	Body    json.RawMessage `json:"body,omitempty"`
+}
+
+// recordedSecretHeaders are dropped from recordings.
+var recordedSecretHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+var recordingState = struct {
+	mu        sync.Mutex
+	recordDir string
+	replayDir string
+	seq       int
+	replay    map[string][]RecordedExchange
+	served    map[string]int
+}{}
+
+// SetRecordDir enables recording every API request and response to JSON
+// files in dir. An empty dir disables recording.
+func SetRecordDir(dir string) {
+	recordingState.mu.Lock()
+	defer recordingState.mu.Unlock()
+	recordingState.recordDir = dir
+}
+
+// RecordDir returns the active recording directory, or "" when disabled.
+func RecordDir() string {
+	recordingState.mu.Lock()
+	defer recordingState.mu.Unlock()
+	return recordingState.recordDir
+}
+
+// SetReplayDir serves API responses from the recordings in dir instead of
+// sending requests. An empty dir disables replay.
+func SetReplayDir(dir string) {
+	recordingState.mu.Lock()
+	defer recordingState.mu.Unlock()
+	if dir != recordingState.replayDir {
+		recordingState.replay = nil
+		recordingState.served = nil
+	}
+	recordingState.replayDir = dir
+}
+
+// ReplayDir returns the active replay directory, or "" when disabled.
+func ReplayDir() string {
+	recordingState.mu.Lock()
+	defer recordingState.mu.Unlock()
+	return recordingState.replayDir
+}
+
+// NewReplayClient returns a client without credentials for use with
+// SetReplayDir. Requests it cannot serve from recordings fail.
+func NewReplayClient() *Client {
+	return &Client{httpClient: &http.Client{Timeout: ResolveTimeout()}}
+}
+
+// recordingKey identifies a request for replay: the method and the path with
+// its query string, whether path is relative or an absolute API URL.
+func recordingKey(method, path string) string {
+	target := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		target = BaseURL + path
+	}
+	if parsed, err := url.Parse(target); err == nil {
+		path = parsed.RequestURI()
+	}
+	return strings.ToUpper(method) + " " + path
+}
+
+var recordingFileUnsafe = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// recordExchange writes req and its response to a new file in dir. File names
+// start with a UTC timestamp and sequence number so they sort in the order
+// the requests were made.
+func recordExchange(dir string, req *http.Request, resp *http.Response, respBody []byte) error {
+	var reqBody []byte
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			reqBody, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	now := time.Now().UTC()
+	exchange := RecordedExchange{
+		RecordedAt: now.Format(time.RFC3339Nano),
+		Method:     req.Method,
+		Path:       req.URL.RequestURI(),
+		Request: RecordedRequest{
+			Headers: recordedHeaders(req.Header),
+			Body:    recordedBody(reqBody),
+		},
+		Response: RecordedResponse{
+			Status:  resp.StatusCode,
+			Headers: recordedHeaders(resp.Header),
+			Body:    recordedBody(respBody),
+		},
+	}
+	data, err := json.MarshalIndent(exchange, "", "  ")
+	if err != nil {
+		return fmt.Errorf("record: %w", err)
+	}
+
+	recordingState.mu.Lock()
+	recordingState.seq++
+	seq := recordingState.seq
+	recordingState.mu.Unlock()
+
+	slug := strings.Trim(recordingFileUnsafe.ReplaceAllString(req.URL.Path, "-"), "-")
+	if len(slug) > 80 {
+		slug = slug[:80]
+	}
+	name := fmt.Sprintf("%s-%04d-%s-%s.json", now.Format("20060102T150405.000000000Z"), seq, req.Method, slug)
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("record: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("record: %w", err)
+	}
+	return nil
+}
+
+func recordedHeaders(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	cloned := header.Clone()
+	for _, name := range recordedSecretHeaders {
+		cloned.Del(name)
+	}
+	if len(cloned) == 0 {
+		return nil
+	}
+	return cloned
+}
+
+// recordedBody keeps JSON bodies as JSON so recordings stay readable and
+// stores anything else as a JSON string.
+func recordedBody(body []byte) json.RawMessage {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	if json.Valid(trimmed) {
+		return json.RawMessage(trimmed)
+	}
+	encoded, _ := json.Marshal(string(body))
+	return encoded
+}
+
+func replayedBody(body json.RawMessage) []byte {
+	if len(body) > 0 && body[0] == '"' {
+		var text string
+		if err := json.Unmarshal(body, &text); err == nil {
+			return []byte(text)
+		}
+	}
+	return body
+}
+
+// replayRequest answers a request from the recordings in dir. Repeated
+// requests get successive recordings for the same method and path, and the
+// last one once they run out, so polling loops replay as recorded.
+func replayRequest(dir, method, path string) ([]byte, error) {
+	recordingState.mu.Lock()
+	if recordingState.replay == nil {
+		exchanges, err := loadRecordings(dir)
+		if err != nil {
+			recordingState.mu.Unlock()
+			return nil, err
+		}
+		recordingState.replay = exchanges
+		recordingState.served = make(map[string]int)
+	}
+	key := recordingKey(method, path)
+	matches := recordingState.replay[key]
+	index := recordingState.served[key]
+	if index < len(matches) {
+		recordingState.served[key]++
+	}
+	recordingState.mu.Unlock()
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("replay: no recorded response for %s in %s", key, dir)
+	}
+	exchange := matches[min(index, len(matches)-1)]
+	return handleResponse(exchange.Response.Status, exchange.Response.Headers, replayedBody(exchange.Response.Body))
+}
+
+// loadRecordings reads every recording in dir, grouped by request and in
+// file name order.
+func loadRecordings(dir string) (map[string][]RecordedExchange, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("replay: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("replay: no recordings found in %s", dir)
+	}
+	sort.Strings(paths)
+
+	exchanges := make(map[string][]RecordedExchange)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("replay: %w", err)
+		}
+		var exchange RecordedExchange
+		if err := json.Unmarshal(data, &exchange); err != nil {
+			return nil, fmt.Errorf("replay: invalid recording %s: %w", path, err)
+		}
+		key := recordingKey(exchange.Method, exchange.Path)
+		exchanges[key] = append(exchanges[key], exchange)
+	}
+	return exchanges, nil
+}
@@ -0,0 +1,102 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	dir := t.TempDir()
+	SetRecordDir(dir)
+	t.Cleanup(func() { SetRecordDir("") })
+
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo"}}]}`)
+	response.Header.Set("Set-Cookie", "session=secret")
+	client := newTestClient(t, nil, response)
+	if _, err := client.GetApps(context.Background(), WithAppsLimit(1)); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*-GET-v1-apps.json"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected one recording, got %v (%v)", files, err)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("read recording: %v", err)
+	}
+	if strings.Contains(string(data), "Bearer") || strings.Contains(string(data), "session=secret") {
+		t.Fatalf("expected secrets to be stripped, got %s", data)
+	}
+	var exchange RecordedExchange
+	if err := json.Unmarshal(data, &exchange); err != nil {
+		t.Fatalf("decode recording: %v", err)
+	}
+	if exchange.Method != http.MethodGet || exchange.Path != "/v1/apps?limit=1" || exchange.Response.Status != http.StatusOK {
+		t.Fatalf("unexpected recording: %+v", exchange)
+	}
+
+	SetRecordDir("")
+	SetReplayDir(dir)
+	t.Cleanup(func() { SetReplayDir("") })
+
+	offline := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected network request to %s", req.URL)
+	}, nil)
+	resp, err := offline.GetApps(context.Background(), WithAppsLimit(1))
+	if err != nil {
+		t.Fatalf("replayed GetApps() error: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].Attributes.Name != "Demo" {
+		t.Fatalf("unexpected replayed response: %+v", resp)
+	}
+
+	if _, err := offline.GetApps(context.Background(), WithAppsLimit(2)); err == nil || !strings.Contains(err.Error(), "no recorded response for GET /v1/apps?limit=2") {
+		t.Fatalf("expected missing recording error, got %v", err)
+	}
+}
+
+func TestReplayServesRecordedErrorsAndRequestBodies(t *testing.T) {
+	dir := t.TempDir()
+	SetRecordDir(dir)
+	t.Cleanup(func() { SetRecordDir("") })
+
+	client := newTestClient(t, nil, jsonResponse(http.StatusNotFound, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found","detail":"missing"}]}`))
+	if err := client.DeleteBetaGroup(context.Background(), "group-1"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	created := newTestClient(t, nil, jsonResponse(http.StatusCreated, `{"data":{"type":"betaGroups","id":"group-2"}}`))
+	if _, err := created.CreateBetaGroup(context.Background(), "app-1", "Games"); err != nil {
+		t.Fatalf("CreateBetaGroup() error: %v", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*-POST-v1-betaGroups.json"))
+	if len(files) != 1 {
+		t.Fatalf("expected one POST recording, got %v", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	if !strings.Contains(string(data), `"name": "Games"`) {
+		t.Fatalf("expected request body in recording, got %s", data)
+	}
+
+	SetRecordDir("")
+	SetReplayDir(dir)
+	t.Cleanup(func() { SetReplayDir("") })
+
+	offline := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected network request to %s", req.URL)
+	}, nil)
+	if err := offline.DeleteBetaGroup(context.Background(), "group-1"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected replayed not found, got %v", err)
+	}
+	resp, err := offline.CreateBetaGroup(context.Background(), "app-1", "Games")
+	if err != nil || resp.Data.ID != "group-2" {
+		t.Fatalf("unexpected replayed create: %+v, %v", resp, err)
+	}
+}
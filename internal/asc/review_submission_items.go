@@ -45,6 +45,7 @@ type ReviewSubmissionItemResource struct {
 type ReviewSubmissionItemsResponse struct {
 	Data  []ReviewSubmissionItemResource `json:"data"`
 	Links Links                          `json:"links,omitempty"`
+	Meta  json.RawMessage                `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -47,6 +47,7 @@ type ReviewSubmissionsResponse struct {
 	Data     []ReviewSubmissionResource `json:"data"`
 	Links    Links                      `json:"links,omitempty"`
 	Included json.RawMessage            `json:"included,omitempty"`
+	Meta     json.RawMessage            `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
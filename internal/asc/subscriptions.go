@@ -2,6 +2,7 @@ package asc
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -117,6 +118,56 @@ type SubscriptionPriceAttributes struct {
 	Preserved bool   `json:"preserved,omitempty"`
 }
 
+// SubscriptionLocalizationAttributes describes a subscription localization resource.
+type SubscriptionLocalizationAttributes struct {
+	Name        string `json:"name,omitempty"`
+	Locale      string `json:"locale,omitempty"`
+	Description string `json:"description,omitempty"`
+	State       string `json:"state,omitempty"`
+}
+
+// SubscriptionLocalizationCreateAttributes describes attributes for creating a subscription localization.
+type SubscriptionLocalizationCreateAttributes struct {
+	Name        string `json:"name"`
+	Locale      string `json:"locale"`
+	Description string `json:"description,omitempty"`
+}
+
+// SubscriptionLocalizationUpdateAttributes describes attributes for updating a subscription localization.
+type SubscriptionLocalizationUpdateAttributes struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// SubscriptionLocalizationRelationships describes relationships for subscription localizations.
+type SubscriptionLocalizationRelationships struct {
+	Subscription *Relationship `json:"subscription"`
+}
+
+// SubscriptionLocalizationCreateData is the data portion of a subscription localization create request.
+type SubscriptionLocalizationCreateData struct {
+	Type          ResourceType                             `json:"type"`
+	Attributes    SubscriptionLocalizationCreateAttributes `json:"attributes"`
+	Relationships *SubscriptionLocalizationRelationships   `json:"relationships"`
+}
+
+// SubscriptionLocalizationCreateRequest is a request to create a subscription localization.
+type SubscriptionLocalizationCreateRequest struct {
+	Data SubscriptionLocalizationCreateData `json:"data"`
+}
+
+// SubscriptionLocalizationUpdateData is the data portion of a subscription localization update request.
+type SubscriptionLocalizationUpdateData struct {
+	Type       ResourceType                             `json:"type"`
+	ID         string                                   `json:"id"`
+	Attributes SubscriptionLocalizationUpdateAttributes `json:"attributes"`
+}
+
+// SubscriptionLocalizationUpdateRequest is a request to update a subscription localization.
+type SubscriptionLocalizationUpdateRequest struct {
+	Data SubscriptionLocalizationUpdateData `json:"data"`
+}
+
 // SubscriptionPriceCreateAttributes describes attributes for creating a price.
 type SubscriptionPriceCreateAttributes struct {
 	StartDate string `json:"startDate,omitempty"`
@@ -164,6 +215,105 @@ type SubscriptionAvailabilityCreateRequest struct {
 	Data SubscriptionAvailabilityCreateData `json:"data"`
 }
 
+// SubscriptionPromotionalOfferAttributes describes a subscription promotional offer.
+type SubscriptionPromotionalOfferAttributes struct {
+	Name            string                    `json:"name,omitempty"`
+	OfferCode       string                    `json:"offerCode,omitempty"`
+	Duration        SubscriptionOfferDuration `json:"duration,omitempty"`
+	OfferMode       SubscriptionOfferMode     `json:"offerMode,omitempty"`
+	NumberOfPeriods int                       `json:"numberOfPeriods,omitempty"`
+}
+
+// SubscriptionPromotionalOfferCreateAttributes describes attributes for creating a promotional offer.
+type SubscriptionPromotionalOfferCreateAttributes struct {
+	Name            string                    `json:"name"`
+	OfferCode       string                    `json:"offerCode"`
+	Duration        SubscriptionOfferDuration `json:"duration"`
+	OfferMode       SubscriptionOfferMode     `json:"offerMode"`
+	NumberOfPeriods int                       `json:"numberOfPeriods"`
+}
+
+// SubscriptionPromotionalOfferCreateRelationships describes relationships for promotional offer create requests.
+type SubscriptionPromotionalOfferCreateRelationships struct {
+	Subscription Relationship     `json:"subscription"`
+	Prices       RelationshipList `json:"prices"`
+}
+
+// SubscriptionPromotionalOfferCreateData is the data portion of a promotional offer create request.
+type SubscriptionPromotionalOfferCreateData struct {
+	Type          ResourceType                                    `json:"type"`
+	Attributes    SubscriptionPromotionalOfferCreateAttributes    `json:"attributes"`
+	Relationships SubscriptionPromotionalOfferCreateRelationships `json:"relationships"`
+}
+
+// SubscriptionPromotionalOfferPriceRelationships describes relationships for promotional offer prices.
+type SubscriptionPromotionalOfferPriceRelationships struct {
+	Territory              *Relationship `json:"territory,omitempty"`
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+}
+
+// SubscriptionPromotionalOfferPriceInlineCreate describes a promotional offer price created with the offer.
+type SubscriptionPromotionalOfferPriceInlineCreate struct {
+	Type          ResourceType                                    `json:"type"`
+	ID            string                                          `json:"id,omitempty"`
+	Relationships *SubscriptionPromotionalOfferPriceRelationships `json:"relationships,omitempty"`
+}
+
+// SubscriptionPromotionalOfferCreateRequest is a request to create a promotional offer.
+type SubscriptionPromotionalOfferCreateRequest struct {
+	Data     SubscriptionPromotionalOfferCreateData          `json:"data"`
+	Included []SubscriptionPromotionalOfferPriceInlineCreate `json:"included,omitempty"`
+}
+
+// SubscriptionPromotionalOffersResponse is the response from promotional offer list endpoints.
+type SubscriptionPromotionalOffersResponse = Response[SubscriptionPromotionalOfferAttributes]
+
+// SubscriptionPromotionalOfferResponse is the response from promotional offer detail endpoints.
+type SubscriptionPromotionalOfferResponse = SingleResponse[SubscriptionPromotionalOfferAttributes]
+
+// SubscriptionIntroductoryOfferAttributes describes a subscription introductory offer.
+type SubscriptionIntroductoryOfferAttributes struct {
+	StartDate       string                    `json:"startDate,omitempty"`
+	EndDate         string                    `json:"endDate,omitempty"`
+	Duration        SubscriptionOfferDuration `json:"duration,omitempty"`
+	OfferMode       SubscriptionOfferMode     `json:"offerMode,omitempty"`
+	NumberOfPeriods int                       `json:"numberOfPeriods,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferCreateAttributes describes attributes for creating an introductory offer.
+type SubscriptionIntroductoryOfferCreateAttributes struct {
+	StartDate       string                    `json:"startDate,omitempty"`
+	EndDate         string                    `json:"endDate,omitempty"`
+	Duration        SubscriptionOfferDuration `json:"duration"`
+	OfferMode       SubscriptionOfferMode     `json:"offerMode"`
+	NumberOfPeriods int                       `json:"numberOfPeriods"`
+}
+
+// SubscriptionIntroductoryOfferRelationships describes relationships for introductory offer create requests.
+type SubscriptionIntroductoryOfferRelationships struct {
+	Subscription           Relationship  `json:"subscription"`
+	Territory              *Relationship `json:"territory,omitempty"`
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferCreateData is the data portion of an introductory offer create request.
+type SubscriptionIntroductoryOfferCreateData struct {
+	Type          ResourceType                                  `json:"type"`
+	Attributes    SubscriptionIntroductoryOfferCreateAttributes `json:"attributes"`
+	Relationships SubscriptionIntroductoryOfferRelationships    `json:"relationships"`
+}
+
+// SubscriptionIntroductoryOfferCreateRequest is a request to create an introductory offer.
+type SubscriptionIntroductoryOfferCreateRequest struct {
+	Data SubscriptionIntroductoryOfferCreateData `json:"data"`
+}
+
+// SubscriptionIntroductoryOffersResponse is the response from introductory offer list endpoints.
+type SubscriptionIntroductoryOffersResponse = Response[SubscriptionIntroductoryOfferAttributes]
+
+// SubscriptionIntroductoryOfferResponse is the response from introductory offer detail endpoints.
+type SubscriptionIntroductoryOfferResponse = SingleResponse[SubscriptionIntroductoryOfferAttributes]
+
 // SubscriptionGroupsResponse is the response from subscription groups endpoints.
 type SubscriptionGroupsResponse = Response[SubscriptionGroupAttributes]
 
@@ -176,6 +326,19 @@ type SubscriptionsResponse = Response[SubscriptionAttributes]
 // SubscriptionResponse is the response from subscription detail endpoints.
 type SubscriptionResponse = SingleResponse[SubscriptionAttributes]
 
+// SubscriptionLocalizationsResponse is the response from subscription localizations list endpoints.
+type SubscriptionLocalizationsResponse = Response[SubscriptionLocalizationAttributes]
+
+// SubscriptionLocalizationResponse is the response from subscription localization detail endpoints.
+type SubscriptionLocalizationResponse = SingleResponse[SubscriptionLocalizationAttributes]
+
+// SubscriptionCreateResult represents CLI output for a subscription created
+// together with its first localization.
+type SubscriptionCreateResult struct {
+	Subscription *SubscriptionResponse             `json:"subscription"`
+	Localization *SubscriptionLocalizationResponse `json:"localization,omitempty"`
+}
+
 // SubscriptionPricesResponse is the response from subscription prices list endpoints.
 type SubscriptionPricesResponse = Response[SubscriptionPriceAttributes]
 
@@ -185,18 +348,72 @@ type SubscriptionPriceResponse = SingleResponse[SubscriptionPriceAttributes]
 // SubscriptionAvailabilityResponse is the response from availability endpoints.
 type SubscriptionAvailabilityResponse = SingleResponse[SubscriptionAvailabilityAttributes]
 
+// SubscriptionPricePointAttributes describes a subscription price point.
+type SubscriptionPricePointAttributes struct {
+	CustomerPrice string `json:"customerPrice,omitempty"`
+	Proceeds      string `json:"proceeds,omitempty"`
+	ProceedsYear2 string `json:"proceedsYear2,omitempty"`
+}
+
+// SubscriptionPricePointsResponse is the response from subscription price point list endpoints.
+type SubscriptionPricePointsResponse = Response[SubscriptionPricePointAttributes]
+
 // SubscriptionGroupsOption is a functional option for GetSubscriptionGroups.
 type SubscriptionGroupsOption func(*subscriptionGroupsQuery)
 
 // SubscriptionsOption is a functional option for GetSubscriptions.
 type SubscriptionsOption func(*subscriptionsQuery)
 
+// SubscriptionPricePointsOption is a functional option for GetSubscriptionPricePoints.
+type SubscriptionPricePointsOption func(*subscriptionPricePointsQuery)
+
+// SubscriptionPricesOption is a functional option for GetSubscriptionPrices.
+type SubscriptionPricesOption func(*subscriptionPricesQuery)
+
+// SubscriptionLocalizationsOption is a functional option for GetSubscriptionLocalizations.
+type SubscriptionLocalizationsOption func(*subscriptionLocalizationsQuery)
+
+// SubscriptionPromotionalOffersOption is a functional option for GetSubscriptionPromotionalOffers.
+type SubscriptionPromotionalOffersOption func(*subscriptionPromotionalOffersQuery)
+
+// SubscriptionIntroductoryOffersOption is a functional option for GetSubscriptionIntroductoryOffers.
+type SubscriptionIntroductoryOffersOption func(*subscriptionIntroductoryOffersQuery)
+
 type subscriptionGroupsQuery struct {
 	listQuery
+	include            []string
+	subscriptionsLimit int
 }
 
 type subscriptionsQuery struct {
 	listQuery
+	include            []string
+	pricesLimit        int
+	localizationsLimit int
+}
+
+type subscriptionPricePointsQuery struct {
+	listQuery
+	territory string
+	include   []string
+}
+
+type subscriptionPricesQuery struct {
+	listQuery
+	territory string
+}
+
+type subscriptionLocalizationsQuery struct {
+	listQuery
+}
+
+type subscriptionPromotionalOffersQuery struct {
+	listQuery
+}
+
+type subscriptionIntroductoryOffersQuery struct {
+	listQuery
+	territories []string
 }
 
 // WithSubscriptionGroupsLimit sets the max number of groups to return.
@@ -217,6 +434,22 @@ func WithSubscriptionGroupsNextURL(next string) SubscriptionGroupsOption {
 	}
 }
 
+// WithSubscriptionGroupsInclude sets include for subscription group list responses.
+func WithSubscriptionGroupsInclude(include []string) SubscriptionGroupsOption {
+	return func(q *subscriptionGroupsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+// WithSubscriptionGroupsSubscriptionsLimit sets limit[subscriptions] for included subscriptions.
+func WithSubscriptionGroupsSubscriptionsLimit(limit int) SubscriptionGroupsOption {
+	return func(q *subscriptionGroupsQuery) {
+		if limit > 0 {
+			q.subscriptionsLimit = limit
+		}
+	}
+}
+
 // WithSubscriptionsLimit sets the max number of subscriptions to return.
 func WithSubscriptionsLimit(limit int) SubscriptionsOption {
 	return func(q *subscriptionsQuery) {
@@ -235,13 +468,209 @@ func WithSubscriptionsNextURL(next string) SubscriptionsOption {
 	}
 }
 
+// WithSubscriptionsInclude sets include for subscription list responses.
+func WithSubscriptionsInclude(include []string) SubscriptionsOption {
+	return func(q *subscriptionsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+// WithSubscriptionsPricesLimit sets limit[prices] for included prices.
+func WithSubscriptionsPricesLimit(limit int) SubscriptionsOption {
+	return func(q *subscriptionsQuery) {
+		if limit > 0 {
+			q.pricesLimit = limit
+		}
+	}
+}
+
+// WithSubscriptionsLocalizationsLimit sets limit[subscriptionLocalizations] for included localizations.
+func WithSubscriptionsLocalizationsLimit(limit int) SubscriptionsOption {
+	return func(q *subscriptionsQuery) {
+		if limit > 0 {
+			q.localizationsLimit = limit
+		}
+	}
+}
+
 func buildSubscriptionGroupsQuery(query *subscriptionGroupsQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
+	if query.subscriptionsLimit > 0 {
+		values.Set("limit[subscriptions]", strconv.Itoa(query.subscriptionsLimit))
+	}
 	return values.Encode()
 }
 
 func buildSubscriptionsQuery(query *subscriptionsQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	addLimit(values, query.limit)
+	if query.pricesLimit > 0 {
+		values.Set("limit[prices]", strconv.Itoa(query.pricesLimit))
+	}
+	if query.localizationsLimit > 0 {
+		values.Set("limit[subscriptionLocalizations]", strconv.Itoa(query.localizationsLimit))
+	}
+	return values.Encode()
+}
+
+// WithSubscriptionPricePointsLimit sets the max number of price points to return.
+func WithSubscriptionPricePointsLimit(limit int) SubscriptionPricePointsOption {
+	return func(q *subscriptionPricePointsQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithSubscriptionPricePointsNextURL uses a next page URL directly.
+func WithSubscriptionPricePointsNextURL(next string) SubscriptionPricePointsOption {
+	return func(q *subscriptionPricePointsQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+// WithSubscriptionPricePointsTerritory filters price points by territory.
+func WithSubscriptionPricePointsTerritory(territory string) SubscriptionPricePointsOption {
+	return func(q *subscriptionPricePointsQuery) {
+		if strings.TrimSpace(territory) != "" {
+			q.territory = strings.ToUpper(strings.TrimSpace(territory))
+		}
+	}
+}
+
+// WithSubscriptionPricePointsInclude sets include for price point list responses.
+func WithSubscriptionPricePointsInclude(include []string) SubscriptionPricePointsOption {
+	return func(q *subscriptionPricePointsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+func buildSubscriptionPricePointsQuery(query *subscriptionPricePointsQuery) string {
+	values := url.Values{}
+	if query.territory != "" {
+		values.Set("filter[territory]", query.territory)
+	}
+	addCSV(values, "include", query.include)
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
+// WithSubscriptionPricesLimit sets the max number of prices to return.
+func WithSubscriptionPricesLimit(limit int) SubscriptionPricesOption {
+	return func(q *subscriptionPricesQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithSubscriptionPricesNextURL uses a next page URL directly.
+func WithSubscriptionPricesNextURL(next string) SubscriptionPricesOption {
+	return func(q *subscriptionPricesQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+// WithSubscriptionPricesTerritory filters prices by territory.
+func WithSubscriptionPricesTerritory(territory string) SubscriptionPricesOption {
+	return func(q *subscriptionPricesQuery) {
+		if strings.TrimSpace(territory) != "" {
+			q.territory = strings.ToUpper(strings.TrimSpace(territory))
+		}
+	}
+}
+
+func buildSubscriptionPricesQuery(query *subscriptionPricesQuery) string {
+	values := url.Values{}
+	if query.territory != "" {
+		values.Set("filter[territory]", query.territory)
+	}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
+// WithSubscriptionPromotionalOffersLimit sets the max number of promotional offers to return.
+func WithSubscriptionPromotionalOffersLimit(limit int) SubscriptionPromotionalOffersOption {
+	return func(q *subscriptionPromotionalOffersQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithSubscriptionPromotionalOffersNextURL uses a next page URL directly.
+func WithSubscriptionPromotionalOffersNextURL(next string) SubscriptionPromotionalOffersOption {
+	return func(q *subscriptionPromotionalOffersQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+func buildSubscriptionPromotionalOffersQuery(query *subscriptionPromotionalOffersQuery) string {
+	values := url.Values{}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
+// WithSubscriptionIntroductoryOffersLimit sets the max number of introductory offers to return.
+func WithSubscriptionIntroductoryOffersLimit(limit int) SubscriptionIntroductoryOffersOption {
+	return func(q *subscriptionIntroductoryOffersQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithSubscriptionIntroductoryOffersNextURL uses a next page URL directly.
+func WithSubscriptionIntroductoryOffersNextURL(next string) SubscriptionIntroductoryOffersOption {
+	return func(q *subscriptionIntroductoryOffersQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+// WithSubscriptionIntroductoryOffersTerritories filters introductory offers by territory ID(s).
+func WithSubscriptionIntroductoryOffersTerritories(territories []string) SubscriptionIntroductoryOffersOption {
+	return func(q *subscriptionIntroductoryOffersQuery) {
+		q.territories = normalizeUpperList(territories)
+	}
+}
+
+func buildSubscriptionIntroductoryOffersQuery(query *subscriptionIntroductoryOffersQuery) string {
+	values := url.Values{}
+	addCSV(values, "filter[territory]", query.territories)
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
+// WithSubscriptionLocalizationsLimit sets the max number of localizations to return.
+func WithSubscriptionLocalizationsLimit(limit int) SubscriptionLocalizationsOption {
+	return func(q *subscriptionLocalizationsQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithSubscriptionLocalizationsNextURL uses a next page URL directly.
+func WithSubscriptionLocalizationsNextURL(next string) SubscriptionLocalizationsOption {
+	return func(q *subscriptionLocalizationsQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+func buildSubscriptionLocalizationsQuery(query *subscriptionLocalizationsQuery) string {
 	values := url.Values{}
 	addLimit(values, query.limit)
 	return values.Encode()
@@ -1,6 +1,7 @@
 package asc
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"text/tabwriter"
@@ -18,43 +19,126 @@ type SubscriptionDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// SubscriptionPriceBulkResult represents CLI output for bulk subscription price creation.
+type SubscriptionPriceBulkResult struct {
+	SubscriptionID string                      `json:"subscriptionId"`
+	Total          int                         `json:"total"`
+	Created        int                         `json:"created"`
+	Failed         int                         `json:"failed"`
+	Skipped        int                         `json:"skipped,omitempty"`
+	Results        []SubscriptionPriceBulkItem `json:"results"`
+}
+
+// SubscriptionPriceBulkItem is the outcome of one entry in a bulk price file.
+type SubscriptionPriceBulkItem struct {
+	Index         int    `json:"index"`
+	PricePointID  string `json:"pricePointId,omitempty"`
+	Territory     string `json:"territory,omitempty"`
+	CustomerPrice string `json:"customerPrice,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	Preserved     bool   `json:"preserved,omitempty"`
+	PriceID       string `json:"priceId,omitempty"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+}
+
+// SubscriptionLocalizationDeleteResult represents CLI output for subscription localization deletions.
+type SubscriptionLocalizationDeleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// SubscriptionPriceDeleteResult represents CLI output for subscription price deletions.
+type SubscriptionPriceDeleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// SubscriptionPromotionalOfferDeleteResult represents CLI output for promotional offer deletions.
+type SubscriptionPromotionalOfferDeleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// SubscriptionIntroductoryOfferDeleteResult represents CLI output for introductory offer deletions.
+type SubscriptionIntroductoryOfferDeleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
 func printSubscriptionGroupsTable(resp *SubscriptionGroupsResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tReference Name")
+	fmt.Fprintln(w, "Reference Name\tID\tSubscriptions")
 	for _, item := range resp.Data {
-		fmt.Fprintf(w, "%s\t%s\n",
-			item.ID,
+		fmt.Fprintf(w, "%s\t%s\t%s\n",
 			compactWhitespace(item.Attributes.ReferenceName),
+			item.ID,
+			subscriptionGroupSubscriptionCount(item.Relationships),
 		)
 	}
 	return w.Flush()
 }
 
 func printSubscriptionGroupsMarkdown(resp *SubscriptionGroupsResponse) error {
-	fmt.Fprintln(os.Stdout, "| ID | Reference Name |")
-	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintln(os.Stdout, "| Reference Name | ID | Subscriptions |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
 	for _, item := range resp.Data {
-		fmt.Fprintf(os.Stdout, "| %s | %s |\n",
-			escapeMarkdown(item.ID),
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s |\n",
 			escapeMarkdown(item.Attributes.ReferenceName),
+			escapeMarkdown(item.ID),
+			subscriptionGroupSubscriptionCount(item.Relationships),
 		)
 	}
 	return nil
 }
 
+// subscriptionGroupSubscriptionCount reports how many subscriptions a group
+// has when the subscriptions relationship was included, preferring the
+// relationship's paging total over the (possibly truncated) linkage list.
+// It returns an empty string when the count is not available.
+func subscriptionGroupSubscriptionCount(relationships json.RawMessage) string {
+	if len(relationships) == 0 {
+		return ""
+	}
+	var parsed struct {
+		Subscriptions *struct {
+			Data []ResourceData `json:"data"`
+			Meta *struct {
+				Paging struct {
+					Total int `json:"total"`
+				} `json:"paging"`
+			} `json:"meta"`
+		} `json:"subscriptions"`
+	}
+	if err := json.Unmarshal(relationships, &parsed); err != nil || parsed.Subscriptions == nil {
+		return ""
+	}
+	if parsed.Subscriptions.Data == nil && parsed.Subscriptions.Meta == nil {
+		return ""
+	}
+	count := len(parsed.Subscriptions.Data)
+	if meta := parsed.Subscriptions.Meta; meta != nil && meta.Paging.Total > count {
+		count = meta.Paging.Total
+	}
+	return fmt.Sprintf("%d", count)
+}
+
 func printSubscriptionsTable(resp *SubscriptionsResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tName\tProduct ID\tPeriod\tState")
+	fmt.Fprintf(w, "ID\tName\tProduct ID\tPeriod\t%s\n", colorHeader("State"))
 	for _, item := range resp.Data {
 		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
 			item.ID,
 			compactWhitespace(item.Attributes.Name),
 			item.Attributes.ProductID,
 			item.Attributes.SubscriptionPeriod,
-			item.Attributes.State,
+			colorStatus(item.Attributes.State),
 		)
 	}
-	return w.Flush()
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return printSubscriptionsIncludedTable(resp)
 }
 
 func printSubscriptionsMarkdown(resp *SubscriptionsResponse) error {
@@ -69,6 +153,202 @@ func printSubscriptionsMarkdown(resp *SubscriptionsResponse) error {
 			escapeMarkdown(item.Attributes.State),
 		)
 	}
+	return printSubscriptionsIncludedMarkdown(resp)
+}
+
+func printSubscriptionLocalizationsTable(resp *SubscriptionLocalizationsResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\tLocale\tName\tDescription\t%s\n", colorHeader("State"))
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			item.ID,
+			item.Attributes.Locale,
+			compactWhitespace(item.Attributes.Name),
+			compactWhitespace(item.Attributes.Description),
+			colorStatus(item.Attributes.State),
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionLocalizationsMarkdown(resp *SubscriptionLocalizationsResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Locale | Name | Description | State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.Locale),
+			escapeMarkdown(item.Attributes.Name),
+			escapeMarkdown(item.Attributes.Description),
+			escapeMarkdown(item.Attributes.State),
+		)
+	}
+	return nil
+}
+
+func printSubscriptionCreateResultTable(result *SubscriptionCreateResult) error {
+	if result.Subscription != nil {
+		if err := printSubscriptionsTable(&SubscriptionsResponse{Data: []Resource[SubscriptionAttributes]{result.Subscription.Data}}); err != nil {
+			return err
+		}
+	}
+	if result.Localization == nil {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nLocalizations")
+	return printSubscriptionLocalizationsTable(&SubscriptionLocalizationsResponse{Data: []Resource[SubscriptionLocalizationAttributes]{result.Localization.Data}})
+}
+
+func printSubscriptionCreateResultMarkdown(result *SubscriptionCreateResult) error {
+	if result.Subscription != nil {
+		if err := printSubscriptionsMarkdown(&SubscriptionsResponse{Data: []Resource[SubscriptionAttributes]{result.Subscription.Data}}); err != nil {
+			return err
+		}
+	}
+	if result.Localization == nil {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout)
+	return printSubscriptionLocalizationsMarkdown(&SubscriptionLocalizationsResponse{Data: []Resource[SubscriptionLocalizationAttributes]{result.Localization.Data}})
+}
+
+type subscriptionsIncluded struct {
+	Prices        []Resource[SubscriptionPriceAttributes]
+	Localizations []Resource[SubscriptionLocalizationAttributes]
+	// owners maps "type/id" of an included resource to its subscription ID.
+	owners map[string]string
+}
+
+func (i subscriptionsIncluded) owner(resourceType ResourceType, id string) string {
+	return i.owners[string(resourceType)+"/"+id]
+}
+
+func parseSubscriptionsIncluded(resp *SubscriptionsResponse) (subscriptionsIncluded, error) {
+	included := subscriptionsIncluded{owners: make(map[string]string)}
+	if len(resp.Included) == 0 {
+		return included, nil
+	}
+
+	for _, item := range resp.Data {
+		if len(item.Relationships) == 0 {
+			continue
+		}
+		var relationships map[string]struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(item.Relationships, &relationships); err != nil {
+			continue
+		}
+		for _, name := range []string{"prices", "subscriptionLocalizations"} {
+			var linkages []ResourceData
+			if err := json.Unmarshal(relationships[name].Data, &linkages); err != nil {
+				continue
+			}
+			for _, linkage := range linkages {
+				included.owners[string(linkage.Type)+"/"+linkage.ID] = item.ID
+			}
+		}
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(resp.Included, &items); err != nil {
+		return included, fmt.Errorf("parse included: %w", err)
+	}
+	for _, item := range items {
+		var header struct {
+			Type ResourceType `json:"type"`
+		}
+		if err := json.Unmarshal(item, &header); err != nil {
+			return included, fmt.Errorf("parse included: %w", err)
+		}
+		switch header.Type {
+		case ResourceTypeSubscriptionPrices:
+			var price Resource[SubscriptionPriceAttributes]
+			if err := json.Unmarshal(item, &price); err != nil {
+				return included, fmt.Errorf("parse included price: %w", err)
+			}
+			included.Prices = append(included.Prices, price)
+		case ResourceTypeSubscriptionLocalizations:
+			var localization Resource[SubscriptionLocalizationAttributes]
+			if err := json.Unmarshal(item, &localization); err != nil {
+				return included, fmt.Errorf("parse included localization: %w", err)
+			}
+			included.Localizations = append(included.Localizations, localization)
+		}
+	}
+	return included, nil
+}
+
+func printSubscriptionsIncludedTable(resp *SubscriptionsResponse) error {
+	included, err := parseSubscriptionsIncluded(resp)
+	if err != nil {
+		return err
+	}
+	if len(included.Prices) > 0 {
+		fmt.Fprintln(os.Stdout, "\nPrices")
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Subscription ID\tPrice ID\tStart Date\tPreserved")
+		for _, item := range included.Prices {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\n",
+				included.owner(ResourceTypeSubscriptionPrices, item.ID),
+				item.ID,
+				item.Attributes.StartDate,
+				item.Attributes.Preserved,
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(included.Localizations) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nLocalizations")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Subscription ID\tLocalization ID\tLocale\tName\t%s\n", colorHeader("State"))
+	for _, item := range included.Localizations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			included.owner(ResourceTypeSubscriptionLocalizations, item.ID),
+			item.ID,
+			item.Attributes.Locale,
+			compactWhitespace(item.Attributes.Name),
+			colorStatus(item.Attributes.State),
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionsIncludedMarkdown(resp *SubscriptionsResponse) error {
+	included, err := parseSubscriptionsIncluded(resp)
+	if err != nil {
+		return err
+	}
+	if len(included.Prices) > 0 {
+		fmt.Fprintln(os.Stdout, "\n| Subscription ID | Price ID | Start Date | Preserved |")
+		fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+		for _, item := range included.Prices {
+			fmt.Fprintf(os.Stdout, "| %s | %s | %s | %t |\n",
+				escapeMarkdown(included.owner(ResourceTypeSubscriptionPrices, item.ID)),
+				escapeMarkdown(item.ID),
+				escapeMarkdown(item.Attributes.StartDate),
+				item.Attributes.Preserved,
+			)
+		}
+	}
+	if len(included.Localizations) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\n| Subscription ID | Localization ID | Locale | Name | State |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	for _, item := range included.Localizations {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s |\n",
+			escapeMarkdown(included.owner(ResourceTypeSubscriptionLocalizations, item.ID)),
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.Locale),
+			escapeMarkdown(item.Attributes.Name),
+			escapeMarkdown(item.Attributes.State),
+		)
+	}
 	return nil
 }
 
@@ -94,6 +374,104 @@ func printSubscriptionPriceMarkdown(resp *SubscriptionPriceResponse) error {
 	return nil
 }
 
+func printSubscriptionPricesTable(resp *SubscriptionPricesResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tStart Date\tPreserved")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%t\n",
+			item.ID,
+			item.Attributes.StartDate,
+			item.Attributes.Preserved,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionPricesMarkdown(resp *SubscriptionPricesResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Start Date | Preserved |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %t |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.StartDate),
+			item.Attributes.Preserved,
+		)
+	}
+	return nil
+}
+
+func printSubscriptionPricePointsTable(resp *SubscriptionPricePointsResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tCustomer Price\tProceeds\tProceeds Year 2")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			item.ID,
+			item.Attributes.CustomerPrice,
+			item.Attributes.Proceeds,
+			item.Attributes.ProceedsYear2,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionPricePointsMarkdown(resp *SubscriptionPricePointsResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Customer Price | Proceeds | Proceeds Year 2 |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.CustomerPrice),
+			escapeMarkdown(item.Attributes.Proceeds),
+			escapeMarkdown(item.Attributes.ProceedsYear2),
+		)
+	}
+	return nil
+}
+
+func formatApproxUSD(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("~%.2f", *value)
+}
+
+func printSubscriptionPricePointsUSDTable(result *SubscriptionPricePointsUSDResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTerritory\tCurrency\tCustomer Price\tApprox USD\tProceeds")
+	for _, item := range result.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			item.ID,
+			item.Territory,
+			item.Currency,
+			item.CustomerPrice,
+			formatApproxUSD(item.ApproxCustomerPriceUSD),
+			item.Proceeds,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "\nApprox USD uses indicative exchange rates from %s; it is not authoritative.\n", IndicativeUSDRatesDate)
+	return nil
+}
+
+func printSubscriptionPricePointsUSDMarkdown(result *SubscriptionPricePointsUSDResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Territory | Currency | Customer Price | Approx USD | Proceeds |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Territory),
+			escapeMarkdown(item.Currency),
+			escapeMarkdown(item.CustomerPrice),
+			escapeMarkdown(formatApproxUSD(item.ApproxCustomerPriceUSD)),
+			escapeMarkdown(item.Proceeds),
+		)
+	}
+	fmt.Fprintf(os.Stdout, "\n_Approx USD uses indicative exchange rates from %s; it is not authoritative._\n", IndicativeUSDRatesDate)
+	return nil
+}
+
 func printSubscriptionAvailabilityTable(resp *SubscriptionAvailabilityResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tAvailable In New Territories")
@@ -114,6 +492,34 @@ func printSubscriptionAvailabilityMarkdown(resp *SubscriptionAvailabilityRespons
 	return nil
 }
 
+func printSubscriptionAvailabilityNamedTable(result *SubscriptionAvailabilityNamedResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tAvailable In New Territories\tTerritory\tTerritory Name")
+	for _, territory := range result.Territories {
+		fmt.Fprintf(w, "%s\t%t\t%s\t%s\n",
+			result.ID,
+			result.AvailableInNewTerritories,
+			territory.ID,
+			territory.TerritoryName,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionAvailabilityNamedMarkdown(result *SubscriptionAvailabilityNamedResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Available In New Territories | Territory | Territory Name |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, territory := range result.Territories {
+		fmt.Fprintf(os.Stdout, "| %s | %t | %s | %s |\n",
+			escapeMarkdown(result.ID),
+			result.AvailableInNewTerritories,
+			escapeMarkdown(territory.ID),
+			escapeMarkdown(territory.TerritoryName),
+		)
+	}
+	return nil
+}
+
 func printSubscriptionGroupDeleteResultTable(result *SubscriptionGroupDeleteResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tDeleted")
@@ -147,3 +553,173 @@ func printSubscriptionDeleteResultMarkdown(result *SubscriptionDeleteResult) err
 	)
 	return nil
 }
+
+func printSubscriptionPromotionalOffersTable(resp *SubscriptionPromotionalOffersResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tOffer Code\tDuration\tMode\tPeriods")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n",
+			item.ID,
+			compactWhitespace(item.Attributes.Name),
+			item.Attributes.OfferCode,
+			item.Attributes.Duration,
+			item.Attributes.OfferMode,
+			item.Attributes.NumberOfPeriods,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionPromotionalOffersMarkdown(resp *SubscriptionPromotionalOffersResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | Offer Code | Duration | Mode | Periods |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s | %s | %d |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.Name),
+			escapeMarkdown(item.Attributes.OfferCode),
+			escapeMarkdown(string(item.Attributes.Duration)),
+			escapeMarkdown(string(item.Attributes.OfferMode)),
+			item.Attributes.NumberOfPeriods,
+		)
+	}
+	return nil
+}
+
+func printSubscriptionPromotionalOfferDeleteResultTable(result *SubscriptionPromotionalOfferDeleteResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDeleted")
+	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
+	return w.Flush()
+}
+
+func printSubscriptionPromotionalOfferDeleteResultMarkdown(result *SubscriptionPromotionalOfferDeleteResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Deleted |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t |\n",
+		escapeMarkdown(result.ID),
+		result.Deleted,
+	)
+	return nil
+}
+
+func printSubscriptionIntroductoryOffersTable(resp *SubscriptionIntroductoryOffersResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDuration\tMode\tPeriods\tStart Date\tEnd Date")
+	for _, item := range resp.Data {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n",
+			item.ID,
+			item.Attributes.Duration,
+			item.Attributes.OfferMode,
+			item.Attributes.NumberOfPeriods,
+			item.Attributes.StartDate,
+			item.Attributes.EndDate,
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionIntroductoryOffersMarkdown(resp *SubscriptionIntroductoryOffersResponse) error {
+	fmt.Fprintln(os.Stdout, "| ID | Duration | Mode | Periods | Start Date | End Date |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range resp.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(string(item.Attributes.Duration)),
+			escapeMarkdown(string(item.Attributes.OfferMode)),
+			item.Attributes.NumberOfPeriods,
+			escapeMarkdown(item.Attributes.StartDate),
+			escapeMarkdown(item.Attributes.EndDate),
+		)
+	}
+	return nil
+}
+
+func printSubscriptionIntroductoryOfferDeleteResultTable(result *SubscriptionIntroductoryOfferDeleteResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDeleted")
+	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
+	return w.Flush()
+}
+
+func printSubscriptionIntroductoryOfferDeleteResultMarkdown(result *SubscriptionIntroductoryOfferDeleteResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Deleted |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t |\n",
+		escapeMarkdown(result.ID),
+		result.Deleted,
+	)
+	return nil
+}
+
+func printSubscriptionLocalizationDeleteResultTable(result *SubscriptionLocalizationDeleteResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDeleted")
+	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
+	return w.Flush()
+}
+
+func printSubscriptionLocalizationDeleteResultMarkdown(result *SubscriptionLocalizationDeleteResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Deleted |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t |\n",
+		escapeMarkdown(result.ID),
+		result.Deleted,
+	)
+	return nil
+}
+
+func printSubscriptionPriceDeleteResultTable(result *SubscriptionPriceDeleteResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDeleted")
+	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
+	return w.Flush()
+}
+
+func printSubscriptionPriceDeleteResultMarkdown(result *SubscriptionPriceDeleteResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Deleted |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %t |\n",
+		escapeMarkdown(result.ID),
+		result.Deleted,
+	)
+	return nil
+}
+
+func printSubscriptionPriceBulkResultTable(result *SubscriptionPriceBulkResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Index\tPrice Point\tTerritory\tCustomer Price\tStart Date\tPreserved\tPrice ID\t%s\tError\n", colorHeader("Status"))
+	for _, item := range result.Results {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%t\t%s\t%s\t%s\n",
+			item.Index,
+			item.PricePointID,
+			item.Territory,
+			item.CustomerPrice,
+			item.StartDate,
+			item.Preserved,
+			item.PriceID,
+			colorStatus(item.Status),
+			compactWhitespace(item.Error),
+		)
+	}
+	return w.Flush()
+}
+
+func printSubscriptionPriceBulkResultMarkdown(result *SubscriptionPriceBulkResult) error {
+	fmt.Fprintln(os.Stdout, "| Index | Price Point | Territory | Customer Price | Start Date | Preserved | Price ID | Status | Error |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Results {
+		fmt.Fprintf(os.Stdout, "| %d | %s | %s | %s | %s | %t | %s | %s | %s |\n",
+			item.Index,
+			escapeMarkdown(item.PricePointID),
+			escapeMarkdown(item.Territory),
+			escapeMarkdown(item.CustomerPrice),
+			escapeMarkdown(item.StartDate),
+			item.Preserved,
+			escapeMarkdown(item.PriceID),
+			escapeMarkdown(item.Status),
+			escapeMarkdown(compactWhitespace(item.Error)),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package asc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// territoryNames maps App Store territory IDs (ISO 3166-1 alpha-3 codes) to
+// English country and region names. The API exposes no territory names, so
+// --resolve-names reads this bundled table instead of making extra requests.
+var territoryNames = map[string]string{
+	"AFG": "Afghanistan",
+	"AGO": "Angola",
+	"AIA": "Anguilla",
+	"ALB": "Albania",
+	"ARE": "United Arab Emirates",
+	"ARG": "Argentina",
+	"ARM": "Armenia",
+	"ATG": "Antigua and Barbuda",
+	"AUS": "Australia",
+	"AUT": "Austria",
+	"AZE": "Azerbaijan",
+	"BEL": "Belgium",
+	"BEN": "Benin",
+	"BFA": "Burkina Faso",
+	"BGR": "Bulgaria",
+	"BHR": "Bahrain",
+	"BHS": "Bahamas",
+	"BIH": "Bosnia and Herzegovina",
+	"BLR": "Belarus",
+	"BLZ": "Belize",
+	"BMU": "Bermuda",
+	"BOL": "Bolivia",
+	"BRA": "Brazil",
+	"BRB": "Barbados",
+	"BRN": "Brunei",
+	"BTN": "Bhutan",
+	"BWA": "Botswana",
+	"CAN": "Canada",
+	"CHE": "Switzerland",
+	"CHL": "Chile",
+	"CHN": "China mainland",
+	"CIV": "Côte d'Ivoire",
+	"CMR": "Cameroon",
+	"COD": "Congo, Democratic Republic of the",
+	"COG": "Congo, Republic of the",
+	"COL": "Colombia",
+	"CPV": "Cape Verde",
+	"CRI": "Costa Rica",
+	"CYM": "Cayman Islands",
+	"CYP": "Cyprus",
+	"CZE": "Czech Republic",
+	"DEU": "Germany",
+	"DMA": "Dominica",
+	"DNK": "Denmark",
+	"DOM": "Dominican Republic",
+	"DZA": "Algeria",
+	"ECU": "Ecuador",
+	"EGY": "Egypt",
+	"ESP": "Spain",
+	"EST": "Estonia",
+	"FIN": "Finland",
+	"FJI": "Fiji",
+	"FRA": "France",
+	"FSM": "Micronesia",
+	"GAB": "Gabon",
+	"GBR": "United Kingdom",
+	"GEO": "Georgia",
+	"GHA": "Ghana",
+	"GMB": "Gambia",
+	"GNB": "Guinea-Bissau",
+	"GRC": "Greece",
+	"GRD": "Grenada",
+	"GTM": "Guatemala",
+	"GUY": "Guyana",
+	"HKG": "Hong Kong",
+	"HND": "Honduras",
+	"HRV": "Croatia",
+	"HUN": "Hungary",
+	"IDN": "Indonesia",
+	"IND": "India",
+	"IRL": "Ireland",
+	"IRQ": "Iraq",
+	"ISL": "Iceland",
+	"ISR": "Israel",
+	"ITA": "Italy",
+	"JAM": "Jamaica",
+	"JOR": "Jordan",
+	"JPN": "Japan",
+	"KAZ": "Kazakhstan",
+	"KEN": "Kenya",
+	"KGZ": "Kyrgyzstan",
+	"KHM": "Cambodia",
+	"KNA": "St. Kitts and Nevis",
+	"KOR": "Korea, Republic of",
+	"KWT": "Kuwait",
+	"LAO": "Laos",
+	"LBN": "Lebanon",
+	"LBR": "Liberia",
+	"LBY": "Libya",
+	"LCA": "St. Lucia",
+	"LKA": "Sri Lanka",
+	"LTU": "Lithuania",
+	"LUX": "Luxembourg",
+	"LVA": "Latvia",
+	"MAC": "Macao",
+	"MAR": "Morocco",
+	"MDA": "Moldova",
+	"MDG": "Madagascar",
+	"MDV": "Maldives",
+	"MEX": "Mexico",
+	"MKD": "North Macedonia",
+	"MLI": "Mali",
+	"MLT": "Malta",
+	"MMR": "Myanmar",
+	"MNE": "Montenegro",
+	"MNG": "Mongolia",
+	"MOZ": "Mozambique",
+	"MRT": "Mauritania",
+	"MSR": "Montserrat",
+	"MUS": "Mauritius",
+	"MWI": "Malawi",
+	"MYS": "Malaysia",
+	"NAM": "Namibia",
+	"NER": "Niger",
+	"NGA": "Nigeria",
+	"NIC": "Nicaragua",
+	"NLD": "Netherlands",
+	"NOR": "Norway",
+	"NPL": "Nepal",
+	"NRU": "Nauru",
+	"NZL": "New Zealand",
+	"OMN": "Oman",
+	"PAK": "Pakistan",
+	"PAN": "Panama",
+	"PER": "Peru",
+	"PHL": "Philippines",
+	"PLW": "Palau",
+	"PNG": "Papua New Guinea",
+	"POL": "Poland",
+	"PRT": "Portugal",
+	"PRY": "Paraguay",
+	"QAT": "Qatar",
+	"ROU": "Romania",
+	"RUS": "Russia",
+	"RWA": "Rwanda",
+	"SAU": "Saudi Arabia",
+	"SEN": "Senegal",
+	"SGP": "Singapore",
+	"SLB": "Solomon Islands",
+	"SLE": "Sierra Leone",
+	"SLV": "El Salvador",
+	"SRB": "Serbia",
+	"STP": "São Tomé and Príncipe",
+	"SUR": "Suriname",
+	"SVK": "Slovakia",
+	"SVN": "Slovenia",
+	"SWE": "Sweden",
+	"SWZ": "Eswatini",
+	"SYC": "Seychelles",
+	"TCA": "Turks and Caicos Islands",
+	"TCD": "Chad",
+	"THA": "Thailand",
+	"TJK": "Tajikistan",
+	"TKM": "Turkmenistan",
+	"TON": "Tonga",
+	"TTO": "Trinidad and Tobago",
+	"TUN": "Tunisia",
+	"TUR": "Türkiye",
+	"TWN": "Taiwan",
+	"TZA": "Tanzania",
+	"UGA": "Uganda",
+	"UKR": "Ukraine",
+	"URY": "Uruguay",
+	"USA": "United States",
+	"UZB": "Uzbekistan",
+	"VCT": "St. Vincent and the Grenadines",
+	"VEN": "Venezuela",
+	"VGB": "British Virgin Islands",
+	"VNM": "Vietnam",
+	"VUT": "Vanuatu",
+	"XKS": "Kosovo",
+	"YEM": "Yemen",
+	"ZAF": "South Africa",
+	"ZMB": "Zambia",
+	"ZWE": "Zimbabwe",
+}
+
+// TerritoryName returns the English name of an App Store territory ID, or ""
+// when the ID is unknown.
+func TerritoryName(id string) string {
+	return territoryNames[strings.ToUpper(strings.TrimSpace(id))]
+}
+
+// NamedTerritory is a territory ID with its resolved name.
+type NamedTerritory struct {
+	ID            string `json:"id"`
+	TerritoryName string `json:"territoryName,omitempty"`
+}
+
+// TerritoryAvailabilityNamed is a territory availability with its territory
+// ID and name.
+type TerritoryAvailabilityNamed struct {
+	ID              string `json:"id"`
+	Territory       string `json:"territory,omitempty"`
+	TerritoryName   string `json:"territoryName,omitempty"`
+	Available       bool   `json:"available"`
+	ReleaseDate     string `json:"releaseDate,omitempty"`
+	PreOrderEnabled bool   `json:"preOrderEnabled,omitempty"`
+}
+
+// TerritoryAvailabilitiesNamedResult represents CLI output for territory
+// availabilities listed with --resolve-names.
+type TerritoryAvailabilitiesNamedResult struct {
+	Data  []TerritoryAvailabilityNamed `json:"data"`
+	Links Links                        `json:"links,omitempty"`
+}
+
+// NewTerritoryAvailabilitiesNamedResult adds territory IDs and names to
+// territory availabilities fetched with their territory relationship.
+func NewTerritoryAvailabilitiesNamedResult(resp *TerritoryAvailabilitiesResponse) (*TerritoryAvailabilitiesNamedResult, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("territory availabilities response is nil")
+	}
+	result := &TerritoryAvailabilitiesNamedResult{
+		Data:  make([]TerritoryAvailabilityNamed, 0, len(resp.Data)),
+		Links: resp.Links,
+	}
+	for _, item := range resp.Data {
+		territory, err := relationshipID(item.Relationships, "territory")
+		if err != nil {
+			return nil, fmt.Errorf("territory availability %s: %w", item.ID, err)
+		}
+		result.Data = append(result.Data, TerritoryAvailabilityNamed{
+			ID:              item.ID,
+			Territory:       territory,
+			TerritoryName:   TerritoryName(territory),
+			Available:       item.Attributes.Available,
+			ReleaseDate:     item.Attributes.ReleaseDate,
+			PreOrderEnabled: item.Attributes.PreOrderEnabled,
+		})
+	}
+	return result, nil
+}
+
+// SubscriptionAvailabilityNamedResult represents CLI output for subscription
+// availability set with --resolve-names.
+type SubscriptionAvailabilityNamedResult struct {
+	ID                        string           `json:"id"`
+	AvailableInNewTerritories bool             `json:"availableInNewTerritories"`
+	Territories               []NamedTerritory `json:"territories"`
+}
+
+// NewSubscriptionAvailabilityNamedResult pairs a subscription availability
+// with the names of the territories it was set for.
+func NewSubscriptionAvailabilityNamedResult(resp *SubscriptionAvailabilityResponse, territoryIDs []string) (*SubscriptionAvailabilityNamedResult, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("subscription availability response is nil")
+	}
+	result := &SubscriptionAvailabilityNamedResult{
+		ID:                        resp.Data.ID,
+		AvailableInNewTerritories: resp.Data.Attributes.AvailableInNewTerritories,
+		Territories:               make([]NamedTerritory, 0, len(territoryIDs)),
+	}
+	for _, id := range territoryIDs {
+		result.Territories = append(result.Territories, NamedTerritory{ID: id, TerritoryName: TerritoryName(id)})
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package asc
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTerritoryName(t *testing.T) {
+	tests := map[string]string{
+		"USA":  "United States",
+		" gbr": "United Kingdom",
+		"XYZ":  "",
+	}
+	for id, want := range tests {
+		if got := TerritoryName(id); got != want {
+			t.Fatalf("TerritoryName(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestNewTerritoryAvailabilitiesNamedResult(t *testing.T) {
+	resp := &TerritoryAvailabilitiesResponse{
+		Data: []Resource[TerritoryAvailabilityAttributes]{
+			{
+				ID:            "ta-1",
+				Attributes:    TerritoryAvailabilityAttributes{Available: true, ReleaseDate: "2026-01-01"},
+				Relationships: json.RawMessage(`{"territory":{"data":{"type":"territories","id":"DEU"}}}`),
+			},
+			{ID: "ta-2", Attributes: TerritoryAvailabilityAttributes{Available: false}},
+		},
+		Links: Links{Next: "https://api.appstoreconnect.apple.com/v2/next"},
+	}
+
+	result, err := NewTerritoryAvailabilitiesNamedResult(resp)
+	if err != nil {
+		t.Fatalf("NewTerritoryAvailabilitiesNamedResult() error: %v", err)
+	}
+	if len(result.Data) != 2 || result.Links.Next != resp.Links.Next {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	first := result.Data[0]
+	if first.Territory != "DEU" || first.TerritoryName != "Germany" || !first.Available || first.ReleaseDate != "2026-01-01" {
+		t.Fatalf("unexpected first item: %+v", first)
+	}
+	if second := result.Data[1]; second.Territory != "" || second.TerritoryName != "" {
+		t.Fatalf("expected no territory without a relationship, got %+v", second)
+	}
+}
+
+func TestNewSubscriptionAvailabilityNamedResult(t *testing.T) {
+	resp := &SubscriptionAvailabilityResponse{
+		Data: Resource[SubscriptionAvailabilityAttributes]{
+			ID:         "avail-1",
+			Attributes: SubscriptionAvailabilityAttributes{AvailableInNewTerritories: true},
+		},
+	}
+
+	result, err := NewSubscriptionAvailabilityNamedResult(resp, []string{"USA", "CAN"})
+	if err != nil {
+		t.Fatalf("NewSubscriptionAvailabilityNamedResult() error: %v", err)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	want := `{"id":"avail-1","availableInNewTerritories":true,"territories":[{"id":"USA","territoryName":"United States"},{"id":"CAN","territoryName":"Canada"}]}`
+	if string(data) != want {
+		t.Fatalf("unexpected JSON:\n got %s\nwant %s", data, want)
+	}
+}
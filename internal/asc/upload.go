@@ -194,6 +194,7 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 			return struct{}{}, &RetryableError{
 				Err:        buildRetryableError(resp.StatusCode, retryAfter, nil),
 				RetryAfter: retryAfter,
+				StatusCode: resp.StatusCode,
 			}
 		}
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
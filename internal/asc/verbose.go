@@ -0,0 +1,72 @@
+package asc
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// rateLimitHeader is the header App Store Connect uses to report the hourly
+// request quota, e.g. "user-hour-lim:3500;user-hour-rem:3499;".
+const rateLimitHeader = "X-Rate-Limit"
+
+var verboseState = struct {
+	mu      sync.RWMutex
+	enabled bool
+	out     io.Writer
+}{
+	out: os.Stderr,
+}
+
+// SetVerbose enables or disables request logging. While enabled, every API
+// request is logged to stderr with its status, duration, and rate-limit header.
+func SetVerbose(enabled bool) {
+	verboseState.mu.Lock()
+	defer verboseState.mu.Unlock()
+	verboseState.enabled = enabled
+}
+
+// VerboseEnabled reports whether request logging is enabled.
+func VerboseEnabled() bool {
+	verboseState.mu.RLock()
+	defer verboseState.mu.RUnlock()
+	return verboseState.enabled
+}
+
+// setVerboseOutput redirects request logging (tests only).
+func setVerboseOutput(w io.Writer) {
+	verboseState.mu.Lock()
+	defer verboseState.mu.Unlock()
+	verboseState.out = w
+}
+
+// logVerboseRequest logs a completed request attempt. resp is nil when the
+// request failed before a response arrived. The token is never logged.
+func logVerboseRequest(req *http.Request, resp *http.Response, elapsed time.Duration, err error) {
+	verboseState.mu.RLock()
+	out := verboseState.out
+	verboseState.mu.RUnlock()
+
+	attrs := []any{
+		"method", req.Method,
+		"path", req.URL.RequestURI(),
+	}
+	if req.Header.Get("Authorization") != "" {
+		attrs = append(attrs, "authorization", "Bearer [REDACTED]")
+	}
+	if resp != nil {
+		attrs = append(attrs, "status", resp.StatusCode)
+	}
+	attrs = append(attrs, "duration", elapsed.Round(time.Millisecond).String())
+	if resp != nil {
+		if rateLimit := resp.Header.Get(rateLimitHeader); rateLimit != "" {
+			attrs = append(attrs, "rate_limit", rateLimit)
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, "error", err)
+	}
+	NewLogger(out, LogFormat()).Info("api request", attrs...)
+}
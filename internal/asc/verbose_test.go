@@ -0,0 +1,80 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func enableVerbose(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var out bytes.Buffer
+	SetVerbose(true)
+	setVerboseOutput(&out)
+	t.Cleanup(func() {
+		SetVerbose(false)
+		setVerboseOutput(os.Stderr)
+	})
+	return &out
+}
+
+func TestVerboseLogsRequestWithRateLimit(t *testing.T) {
+	out := enableVerbose(t)
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	response.Header.Set("X-Rate-Limit", "user-hour-lim:3500;user-hour-rem:3499;")
+	client := newTestClient(t, nil, response)
+
+	if _, err := client.GetApps(context.Background(), WithAppsLimit(1)); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+
+	logged := out.String()
+	for _, want := range []string{
+		"msg=\"api request\"",
+		"method=GET",
+		"path=\"/v1/apps?limit=1\"",
+		"status=200",
+		"duration=",
+		"rate_limit=user-hour-lim:3500;user-hour-rem:3499;",
+		"authorization=\"Bearer [REDACTED]\"",
+	} {
+		if !strings.Contains(logged, want) {
+			t.Fatalf("expected %q in verbose log, got %q", want, logged)
+		}
+	}
+	if strings.Contains(logged, "eyJ") {
+		t.Fatalf("expected token to be redacted, got %q", logged)
+	}
+}
+
+func TestVerboseLogsFailedStatus(t *testing.T) {
+	out := enableVerbose(t)
+	client := newTestClient(t, nil, jsonResponse(http.StatusNotFound, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`))
+
+	if _, err := client.GetApp(context.Background(), "missing"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(out.String(), "status=404") {
+		t.Fatalf("expected status in verbose log, got %q", out.String())
+	}
+	if strings.Contains(out.String(), "rate_limit=") {
+		t.Fatalf("expected no rate_limit without header, got %q", out.String())
+	}
+}
+
+func TestVerboseDisabledLogsNothing(t *testing.T) {
+	var out bytes.Buffer
+	setVerboseOutput(&out)
+	t.Cleanup(func() { setVerboseOutput(os.Stderr) })
+	client := newTestClient(t, nil, jsonResponse(http.StatusOK, `{"data":[]}`))
+
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Fatalf("expected no verbose output, got %q", out.String())
+	}
+}
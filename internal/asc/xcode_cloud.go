@@ -70,6 +70,7 @@ type CiProductResource struct {
 type CiProductsResponse struct {
 	Data  []CiProductResource `json:"data"`
 	Links Links               `json:"links,omitempty"`
+	Meta  json.RawMessage     `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -99,6 +100,7 @@ type CiWorkflowAttributes struct {
 	ManualBranchStartCondition      *CiManualStartCondition      `json:"manualBranchStartCondition,omitempty"`
 	ManualTagStartCondition         *CiManualStartCondition      `json:"manualTagStartCondition,omitempty"`
 	ManualPullRequestStartCondition *CiManualStartCondition      `json:"manualPullRequestStartCondition,omitempty"`
+	Actions                         json.RawMessage              `json:"actions,omitempty"`
 	IsEnabled                       bool                         `json:"isEnabled,omitempty"`
 	IsLockedForEditing              bool                         `json:"isLockedForEditing,omitempty"`
 	Clean                           bool                         `json:"clean,omitempty"`
@@ -180,6 +182,40 @@ type CiWorkflowRelationships struct {
 	MacOsVersion *Relationship `json:"macOsVersion,omitempty"`
 }
 
+// CiWorkflowPayload describes the JSON:API document accepted by workflow
+// create and update requests. Nested start conditions and actions are kept
+// raw, so strict validation only checks the document, data, attributes, and
+// relationships keys.
+type CiWorkflowPayload struct {
+	Data CiWorkflowPayloadData `json:"data"`
+}
+
+// CiWorkflowPayloadData is the data object of a CiWorkflowPayload.
+type CiWorkflowPayloadData struct {
+	Type          ResourceType                 `json:"type"`
+	ID            string                       `json:"id,omitempty"`
+	Attributes    *CiWorkflowPayloadAttributes `json:"attributes,omitempty"`
+	Relationships *CiWorkflowRelationships     `json:"relationships,omitempty"`
+}
+
+// CiWorkflowPayloadAttributes lists the writable workflow attributes.
+type CiWorkflowPayloadAttributes struct {
+	Name                            string          `json:"name,omitempty"`
+	Description                     string          `json:"description,omitempty"`
+	BranchStartCondition            json.RawMessage `json:"branchStartCondition,omitempty"`
+	TagStartCondition               json.RawMessage `json:"tagStartCondition,omitempty"`
+	PullRequestStartCondition       json.RawMessage `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         json.RawMessage `json:"scheduledStartCondition,omitempty"`
+	ManualBranchStartCondition      json.RawMessage `json:"manualBranchStartCondition,omitempty"`
+	ManualTagStartCondition         json.RawMessage `json:"manualTagStartCondition,omitempty"`
+	ManualPullRequestStartCondition json.RawMessage `json:"manualPullRequestStartCondition,omitempty"`
+	Actions                         json.RawMessage `json:"actions,omitempty"`
+	IsEnabled                       *bool           `json:"isEnabled,omitempty"`
+	IsLockedForEditing              *bool           `json:"isLockedForEditing,omitempty"`
+	Clean                           *bool           `json:"clean,omitempty"`
+	ContainerFilePath               string          `json:"containerFilePath,omitempty"`
+}
+
 // CiWorkflowResource represents a CI workflow resource.
 type CiWorkflowResource struct {
 	Type          ResourceType             `json:"type"`
@@ -192,6 +228,7 @@ type CiWorkflowResource struct {
 type CiWorkflowsResponse struct {
 	Data  []CiWorkflowResource `json:"data"`
 	Links Links                `json:"links,omitempty"`
+	Meta  json.RawMessage      `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -237,6 +274,7 @@ type ScmRepositoryResource struct {
 type ScmRepositoriesResponse struct {
 	Data  []ScmRepositoryResource `json:"data"`
 	Links Links                   `json:"links,omitempty"`
+	Meta  json.RawMessage         `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -257,6 +295,14 @@ type ScmGitReferenceAttributes struct {
 	Kind          string `json:"kind,omitempty"` // BRANCH or TAG
 }
 
+// ScmGitReferenceKind represents the kind of an SCM git reference.
+type ScmGitReferenceKind string
+
+const (
+	ScmGitReferenceKindBranch ScmGitReferenceKind = "BRANCH"
+	ScmGitReferenceKindTag    ScmGitReferenceKind = "TAG"
+)
+
 // ScmGitReferenceRelationships describes relationships for an SCM git reference.
 type ScmGitReferenceRelationships struct {
 	Repository *Relationship `json:"repository,omitempty"`
@@ -274,6 +320,7 @@ type ScmGitReferenceResource struct {
 type ScmGitReferencesResponse struct {
 	Data  []ScmGitReferenceResource `json:"data"`
 	Links Links                     `json:"links,omitempty"`
+	Meta  json.RawMessage           `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -372,6 +419,7 @@ type CiBuildRunResource struct {
 type CiBuildRunsResponse struct {
 	Data  []CiBuildRunResource `json:"data"`
 	Links Links                `json:"links,omitempty"`
+	Meta  json.RawMessage      `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -405,6 +453,7 @@ type CiBuildRunCreateData struct {
 type CiBuildRunCreateRelationships struct {
 	Workflow          *Relationship `json:"workflow"`
 	SourceBranchOrTag *Relationship `json:"sourceBranchOrTag"`
+	PullRequest       *Relationship `json:"pullRequest,omitempty"`
 }
 
 // Query types for Xcode Cloud endpoints
@@ -517,6 +566,8 @@ func buildScmGitReferencesQuery(query *scmGitReferencesQuery) string {
 
 type ciBuildRunsQuery struct {
 	listQuery
+	include []string
+	sort    string
 }
 
 // CiBuildRunsOption is a functional option for GetCiBuildRuns.
@@ -540,12 +591,38 @@ func WithCiBuildRunsNextURL(next string) CiBuildRunsOption {
 	}
 }
 
+// WithCiBuildRunsInclude sets include for build run responses (e.g. workflow).
+func WithCiBuildRunsInclude(include []string) CiBuildRunsOption {
+	return func(q *ciBuildRunsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+// WithCiBuildRunsSort sets the sort order for build runs (number or -number).
+func WithCiBuildRunsSort(sort string) CiBuildRunsOption {
+	return func(q *ciBuildRunsQuery) {
+		if strings.TrimSpace(sort) != "" {
+			q.sort = strings.TrimSpace(sort)
+		}
+	}
+}
+
 func buildCiBuildRunsQuery(query *ciBuildRunsQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
+	if query.sort != "" {
+		values.Set("sort", query.sort)
+	}
 	addLimit(values, query.limit)
 	return values.Encode()
 }
 
+func buildCiBuildRunDetailQuery(query *ciBuildRunsQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	return values.Encode()
+}
+
 // GetCiProducts retrieves CI products, optionally filtered by app ID.
 func (c *Client) GetCiProducts(ctx context.Context, opts ...CiProductsOption) (*CiProductsResponse, error) {
 	query := &ciProductsQuery{}
@@ -789,6 +866,36 @@ func (c *Client) UpdateCiWorkflow(ctx context.Context, workflowID string, payloa
 	return &response, nil
 }
 
+// SetCiWorkflowEnabled enables or disables a CI workflow. Only isEnabled is
+// sent, so the rest of the workflow definition is left untouched.
+func (c *Client) SetCiWorkflowEnabled(ctx context.Context, workflowID string, enabled bool) (*CiWorkflowResponse, error) {
+	workflowID = strings.TrimSpace(workflowID)
+	payload := CiWorkflowPayload{
+		Data: CiWorkflowPayloadData{
+			Type:       ResourceTypeCiWorkflows,
+			ID:         workflowID,
+			Attributes: &CiWorkflowPayloadAttributes{IsEnabled: &enabled},
+		},
+	}
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/ciWorkflows/%s", workflowID)
+	data, err := c.do(ctx, "PATCH", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CiWorkflowResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // DeleteCiWorkflow deletes a CI workflow by ID.
 func (c *Client) DeleteCiWorkflow(ctx context.Context, workflowID string) error {
 	workflowID = strings.TrimSpace(workflowID)
@@ -1045,9 +1152,18 @@ func (c *Client) GetCiBuildRuns(ctx context.Context, workflowID string, opts ...
 	return &response, nil
 }
 
-// GetCiBuildRun retrieves a CI build run by ID.
-func (c *Client) GetCiBuildRun(ctx context.Context, buildRunID string) (*CiBuildRunResponse, error) {
+// GetCiBuildRun retrieves a CI build run by ID. Only WithCiBuildRunsInclude
+// applies to a single build run.
+func (c *Client) GetCiBuildRun(ctx context.Context, buildRunID string, opts ...CiBuildRunsOption) (*CiBuildRunResponse, error) {
+	query := &ciBuildRunsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	path := fmt.Sprintf("/v1/ciBuildRuns/%s", buildRunID)
+	if queryString := buildCiBuildRunDetailQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
@@ -1191,8 +1307,9 @@ func (c *Client) ResolveCiWorkflowByName(ctx context.Context, productID, workflo
 }
 
 // ResolveGitReferenceByName finds a git reference (branch or tag) by name.
-// Returns an error if no reference or multiple references match the name.
-func (c *Client) ResolveGitReferenceByName(ctx context.Context, repositoryID, refName string) (*ScmGitReferenceResource, error) {
+// When kind is set, only references of that kind are considered. Returns an
+// error if no reference or multiple references match the name.
+func (c *Client) ResolveGitReferenceByName(ctx context.Context, repositoryID, refName string, kind ScmGitReferenceKind) (*ScmGitReferenceResource, error) {
 	var allRefs []ScmGitReferenceResource
 	var nextURL string
 
@@ -1233,20 +1350,28 @@ func (c *Client) ResolveGitReferenceByName(ctx context.Context, repositoryID, re
 			canonical == normalizedName ||
 			canonical == headsName ||
 			canonical == tagsName {
-			if !ref.Attributes.IsDeleted {
+			if !ref.Attributes.IsDeleted && (kind == "" || gitReferenceKind(ref) == kind) {
 				matches = append(matches, ref)
 			}
 		}
 	}
 
 	if len(matches) == 0 {
+		if kind != "" {
+			return nil, fmt.Errorf("no %s named %q found; use --git-reference-id to specify directly", strings.ToLower(string(kind)), refName)
+		}
 		return nil, fmt.Errorf("no git reference named %q found; use --git-reference-id to specify directly", refName)
 	}
 
 	if len(matches) > 1 {
 		var ids []string
+		kinds := make(map[ScmGitReferenceKind]bool)
 		for _, ref := range matches {
 			ids = append(ids, fmt.Sprintf("%s (%s)", ref.ID, ref.Attributes.CanonicalName))
+			kinds[gitReferenceKind(ref)] = true
+		}
+		if kind == "" && kinds[ScmGitReferenceKindBranch] && kinds[ScmGitReferenceKindTag] {
+			return nil, fmt.Errorf("%q matches both a branch and a tag; use --git-reference-kind branch|tag or --git-reference-id with one of: %s", refName, strings.Join(ids, ", "))
 		}
 		return nil, fmt.Errorf("multiple git references match %q; use --git-reference-id with one of: %s", refName, strings.Join(ids, ", "))
 	}
@@ -1254,6 +1379,22 @@ func (c *Client) ResolveGitReferenceByName(ctx context.Context, repositoryID, re
 	return &matches[0], nil
 }
 
+// gitReferenceKind returns the reference's kind, falling back to its
+// canonical name when the API omits the kind attribute.
+func gitReferenceKind(ref ScmGitReferenceResource) ScmGitReferenceKind {
+	if kind := strings.ToUpper(strings.TrimSpace(ref.Attributes.Kind)); kind != "" {
+		return ScmGitReferenceKind(kind)
+	}
+	switch {
+	case strings.HasPrefix(ref.Attributes.CanonicalName, "refs/heads/"):
+		return ScmGitReferenceKindBranch
+	case strings.HasPrefix(ref.Attributes.CanonicalName, "refs/tags/"):
+		return ScmGitReferenceKindTag
+	default:
+		return ""
+	}
+}
+
 // XcodeCloudRunResult represents the result of triggering a build run.
 type XcodeCloudRunResult struct {
 	BuildRunID        string `json:"buildRunId"`
@@ -1318,6 +1459,7 @@ type CiBuildActionResource struct {
 type CiBuildActionsResponse struct {
 	Data  []CiBuildActionResource `json:"data"`
 	Links Links                   `json:"links,omitempty"`
+	Meta  json.RawMessage         `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -1355,6 +1497,7 @@ type CiArtifactResource struct {
 type CiArtifactsResponse struct {
 	Data  []CiArtifactResource `json:"data"`
 	Links Links                `json:"links,omitempty"`
+	Meta  json.RawMessage      `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -1403,6 +1546,7 @@ type CiTestResultResource struct {
 type CiTestResultsResponse struct {
 	Data  []CiTestResultResource `json:"data"`
 	Links Links                  `json:"links,omitempty"`
+	Meta  json.RawMessage        `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -1440,6 +1584,7 @@ type CiIssueResource struct {
 type CiIssuesResponse struct {
 	Data  []CiIssueResource `json:"data"`
 	Links Links             `json:"links,omitempty"`
+	Meta  json.RawMessage   `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -1482,6 +1627,7 @@ type CiMacOsVersionsResponse struct {
 	Data     []CiMacOsVersionResource `json:"data"`
 	Included []CiXcodeVersionResource `json:"included,omitempty"`
 	Links    Links                    `json:"links,omitempty"`
+	Meta     json.RawMessage          `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
@@ -1540,6 +1686,7 @@ type CiXcodeVersionsResponse struct {
 	Data     []CiXcodeVersionResource `json:"data"`
 	Included []CiMacOsVersionResource `json:"included,omitempty"`
 	Links    Links                    `json:"links,omitempty"`
+	Meta     json.RawMessage          `json:"meta,omitempty"`
 }
 
 // GetLinks returns the links field for pagination.
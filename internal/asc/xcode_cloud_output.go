@@ -3,7 +3,10 @@ package asc
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"text/tabwriter"
+	"time"
 )
 
 // CiArtifactDownloadResult represents CLI output for artifact downloads.
@@ -14,23 +17,186 @@ type CiArtifactDownloadResult struct {
 	FileSize     int    `json:"fileSize,omitempty"`
 	OutputPath   string `json:"outputPath"`
 	BytesWritten int64  `json:"bytesWritten,omitempty"`
+	// Extracted is set when the artifact was unzipped into OutputPath.
+	Extracted      bool  `json:"extracted,omitempty"`
+	ExtractedFiles int   `json:"extractedFiles,omitempty"`
+	ExtractedBytes int64 `json:"extractedBytes,omitempty"`
+}
+
+// XcodeCloudRunArtifactsResult represents a completed build run together with
+// the artifacts downloaded from its actions.
+type XcodeCloudRunArtifactsResult struct {
+	XcodeCloudStatusResult
+	ArtifactsDir string                     `json:"artifactsDir"`
+	Artifacts    []CiArtifactDownloadResult `json:"artifacts"`
+}
+
+// CiWorkflowNeverRun is the latestStatus of a workflow without build runs.
+const CiWorkflowNeverRun = "never run"
+
+// CiWorkflowsLatestStatusResult represents workflows annotated with their
+// most recent build run (workflows list --with-latest-status).
+type CiWorkflowsLatestStatusResult struct {
+	Data  []CiWorkflowLatestStatus `json:"data"`
+	Links Links                    `json:"links,omitempty"`
+}
+
+// CiWorkflowLatestStatus is a workflow together with its most recent build run.
+type CiWorkflowLatestStatus struct {
+	CiWorkflowResource
+	LatestRunID       string `json:"latestRunId,omitempty"`
+	LatestBuildNumber int    `json:"latestBuildNumber,omitempty"`
+	LatestStatus      string `json:"latestStatus"`
+	LatestDate        string `json:"latestDate,omitempty"`
+}
+
+// CiBuildArtifactsResult represents the artifacts of the archive actions that
+// produced a build in an Xcode Cloud build run, and any downloaded copies.
+type CiBuildArtifactsResult struct {
+	BuildID    string                     `json:"buildId"`
+	BuildRunID string                     `json:"buildRunId"`
+	ActionIDs  []string                   `json:"actionIds"`
+	Artifacts  []CiArtifactResource       `json:"artifacts"`
+	Downloads  []CiArtifactDownloadResult `json:"downloads,omitempty"`
+}
+
+// CiArtifactsDownloadAllResult summarizes downloading every artifact of a
+// build action (artifacts download-all).
+type CiArtifactsDownloadAllResult struct {
+	ActionID   string                      `json:"actionId"`
+	OutputDir  string                      `json:"outputDir"`
+	Total      int                         `json:"total"`
+	Downloaded int                         `json:"downloaded"`
+	Failed     int                         `json:"failed"`
+	Downloads  []CiArtifactDownloadResult  `json:"downloads"`
+	Failures   []CiArtifactDownloadFailure `json:"failures,omitempty"`
+}
+
+// CiArtifactDownloadFailure records an artifact that could not be downloaded.
+type CiArtifactDownloadFailure struct {
+	ID         string `json:"id"`
+	FileName   string `json:"fileName,omitempty"`
+	OutputPath string `json:"outputPath"`
+	Error      string `json:"error"`
+}
+
+// CiActionLogsResult represents the log bundles downloaded for a build action.
+type CiActionLogsResult struct {
+	ActionID   string                     `json:"actionId"`
+	OutputPath string                     `json:"outputPath"`
+	Logs       []CiArtifactDownloadResult `json:"logs"`
+}
+
+// CiBuildActionDetailsResult represents a build action together with the
+// related resources requested with actions get --include. The ASC API only
+// supports including the build run on this endpoint, so each relationship is
+// fetched separately.
+type CiBuildActionDetailsResult struct {
+	Data        CiBuildActionResource  `json:"data"`
+	Artifacts   *CiArtifactsResponse   `json:"artifacts,omitempty"`
+	Issues      *CiIssuesResponse      `json:"issues,omitempty"`
+	TestResults *CiTestResultsResponse `json:"testResults,omitempty"`
 }
 
 // CiWorkflowDeleteResult represents CLI output for workflow deletions.
 type CiWorkflowDeleteResult struct {
 	ID      string `json:"id"`
+	Name    string `json:"name,omitempty"`
 	Deleted bool   `json:"deleted"`
 }
 
+// CiWorkflowDeletePreview represents CLI output for a workflow delete dry run.
+type CiWorkflowDeletePreview struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	InProgressRuns int    `json:"inProgressRuns"`
+	DryRun         bool   `json:"dryRun"`
+}
+
+// CiIssuesSummaryResult aggregates issues across the actions of a build run.
+type CiIssuesSummaryResult struct {
+	BuildRunID string                  `json:"buildRunId"`
+	Totals     CiIssueCounts           `json:"totals"`
+	Actions    []CiActionIssuesSummary `json:"actions"`
+}
+
+// CiActionIssuesSummary summarizes issues for a single build action.
+type CiActionIssuesSummary struct {
+	ActionID   string            `json:"actionId"`
+	Name       string            `json:"name,omitempty"`
+	ActionType string            `json:"actionType,omitempty"`
+	Counts     CiIssueCounts     `json:"counts"`
+	Issues     []CiIssueResource `json:"issues,omitempty"`
+}
+
+// CiTestResultsSummaryResult aggregates test results for a build action.
+type CiTestResultsSummaryResult struct {
+	ActionID  string              `json:"actionId"`
+	Total     int                 `json:"total"`
+	Passed    int                 `json:"passed"`
+	Failed    int                 `json:"failed"`
+	Skipped   int                 `json:"skipped"`
+	Flaky     int                 `json:"flaky"`
+	FlakyOnly bool                `json:"flakyOnly,omitempty"`
+	Tests     []CiTestSummaryItem `json:"tests"`
+}
+
+// CiTestSummaryItem summarizes every outcome recorded for one test.
+type CiTestSummaryItem struct {
+	Identifier string       `json:"identifier"`
+	ClassName  string       `json:"className,omitempty"`
+	Name       string       `json:"name,omitempty"`
+	Status     CiTestStatus `json:"status"`
+	Passes     int          `json:"passes"`
+	Failures   int          `json:"failures"`
+	Flaky      bool         `json:"flaky"`
+}
+
+// CiBuildRunStatsResult aggregates reliability metrics over a list of build
+// runs. SuccessRate is the fraction of completed runs that succeeded, and
+// durations only count runs with both a start and a finish date.
+type CiBuildRunStatsResult struct {
+	WorkflowID             string         `json:"workflowId,omitempty"`
+	TotalRuns              int            `json:"totalRuns"`
+	CompletedRuns          int            `json:"completedRuns"`
+	SucceededRuns          int            `json:"succeededRuns"`
+	SuccessRate            float64        `json:"successRate"`
+	TimedRuns              int            `json:"timedRuns"`
+	AverageDurationSeconds float64        `json:"averageDurationSeconds"`
+	MedianDurationSeconds  float64        `json:"medianDurationSeconds"`
+	ByCompletionStatus     map[string]int `json:"byCompletionStatus"`
+}
+
+// CiBuildRunsByWorkflowResult groups a product's build runs by workflow,
+// keyed by workflow ID.
+type CiBuildRunsByWorkflowResult struct {
+	ProductID string                              `json:"productId,omitempty"`
+	TotalRuns int                                 `json:"totalRuns"`
+	Workflows map[string]CiWorkflowBuildRunsGroup `json:"workflows"`
+}
+
+// CiWorkflowBuildRunsGroup summarizes the build runs of one workflow.
+type CiWorkflowBuildRunsGroup struct {
+	WorkflowID         string         `json:"workflowId"`
+	WorkflowName       string         `json:"workflowName,omitempty"`
+	TotalRuns          int            `json:"totalRuns"`
+	ByCompletionStatus map[string]int `json:"byCompletionStatus"`
+	LatestRunID        string         `json:"latestRunId,omitempty"`
+	LatestBuildNumber  int            `json:"latestBuildNumber,omitempty"`
+	LatestStatus       string         `json:"latestStatus,omitempty"`
+	LatestCreatedDate  string         `json:"latestCreatedDate,omitempty"`
+}
+
 // CiProductDeleteResult represents CLI output for product deletions.
 type CiProductDeleteResult struct {
-	ID      string `json:"id"`
-	Deleted bool   `json:"deleted"`
+	ID        string                   `json:"id"`
+	Deleted   bool                     `json:"deleted"`
+	Workflows []CiWorkflowDeleteResult `json:"workflows,omitempty"`
 }
 
 func printXcodeCloudRunResultTable(result *XcodeCloudRunResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "Build Run ID\tBuild #\tWorkflow ID\tWorkflow Name\tGit Ref ID\tGit Ref Name\tProgress\tStatus\tStart Reason\tCreated")
+	fmt.Fprintf(w, "Build Run ID\tBuild #\tWorkflow ID\tWorkflow Name\tGit Ref ID\tGit Ref Name\tProgress\t%s\tStart Reason\tCreated\n", colorHeader("Status"))
 	fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 		result.BuildRunID,
 		result.BuildNumber,
@@ -39,7 +205,7 @@ func printXcodeCloudRunResultTable(result *XcodeCloudRunResult) error {
 		result.GitReferenceID,
 		result.GitReferenceName,
 		result.ExecutionProgress,
-		result.CompletionStatus,
+		colorStatus(result.CompletionStatus),
 		result.StartReason,
 		result.CreatedDate,
 	)
@@ -66,13 +232,13 @@ func printXcodeCloudRunResultMarkdown(result *XcodeCloudRunResult) error {
 
 func printXcodeCloudStatusResultTable(result *XcodeCloudStatusResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "Build Run ID\tBuild #\tWorkflow ID\tProgress\tStatus\tStart Reason\tCancel Reason\tCreated\tStarted\tFinished")
+	fmt.Fprintf(w, "Build Run ID\tBuild #\tWorkflow ID\tProgress\t%s\tStart Reason\tCancel Reason\tCreated\tStarted\tFinished\n", colorHeader("Status"))
 	fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 		result.BuildRunID,
 		result.BuildNumber,
 		result.WorkflowID,
 		result.ExecutionProgress,
-		result.CompletionStatus,
+		colorStatus(result.CompletionStatus),
 		result.StartReason,
 		result.CancelReason,
 		result.CreatedDate,
@@ -158,6 +324,45 @@ func printCiWorkflowsMarkdown(resp *CiWorkflowsResponse) error {
 	return nil
 }
 
+func printCiWorkflowsLatestStatusTable(result *CiWorkflowsLatestStatusResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\tName\tEnabled\t%s\tLatest Build\tLatest Date\n", colorHeader("Latest Status"))
+	for _, item := range result.Data {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%s\n",
+			item.ID,
+			item.Attributes.Name,
+			item.Attributes.IsEnabled,
+			colorStatus(item.LatestStatus),
+			formatCiWorkflowLatestBuildNumber(item.LatestBuildNumber),
+			item.LatestDate,
+		)
+	}
+	return w.Flush()
+}
+
+func printCiWorkflowsLatestStatusMarkdown(result *CiWorkflowsLatestStatusResult) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | Enabled | Latest Status | Latest Build | Latest Date |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	for _, item := range result.Data {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %t | %s | %s | %s |\n",
+			escapeMarkdown(item.ID),
+			escapeMarkdown(item.Attributes.Name),
+			item.Attributes.IsEnabled,
+			escapeMarkdown(item.LatestStatus),
+			formatCiWorkflowLatestBuildNumber(item.LatestBuildNumber),
+			escapeMarkdown(item.LatestDate),
+		)
+	}
+	return nil
+}
+
+func formatCiWorkflowLatestBuildNumber(number int) string {
+	if number == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", number)
+}
+
 func printScmRepositoriesTable(resp *ScmRepositoriesResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tOwner\tRepository\tHTTP URL\tSSH URL\tLast Accessed")
@@ -244,13 +449,13 @@ func printCiXcodeVersionsMarkdown(resp *CiXcodeVersionsResponse) error {
 
 func printCiBuildRunsTable(resp *CiBuildRunsResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tBuild #\tProgress\tStatus\tStart Reason\tCreated\tStarted\tFinished")
+	fmt.Fprintf(w, "ID\tBuild #\tProgress\t%s\tStart Reason\tCreated\tStarted\tFinished\n", colorHeader("Status"))
 	for _, item := range resp.Data {
 		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
 			item.ID,
 			item.Attributes.Number,
 			string(item.Attributes.ExecutionProgress),
-			string(item.Attributes.CompletionStatus),
+			colorStatus(string(item.Attributes.CompletionStatus)),
 			item.Attributes.StartReason,
 			item.Attributes.CreatedDate,
 			item.Attributes.StartedDate,
@@ -280,7 +485,7 @@ func printCiBuildRunsMarkdown(resp *CiBuildRunsResponse) error {
 
 func printCiBuildActionsTable(resp *CiBuildActionsResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "Name\tType\tProgress\tStatus\tErrors\tWarnings\tStarted\tFinished")
+	fmt.Fprintf(w, "Name\tType\tProgress\t%s\tErrors\tWarnings\tStarted\tFinished\n", colorHeader("Status"))
 	for _, item := range resp.Data {
 		errors := 0
 		warnings := 0
@@ -292,7 +497,7 @@ func printCiBuildActionsTable(resp *CiBuildActionsResponse) error {
 			item.Attributes.Name,
 			item.Attributes.ActionType,
 			string(item.Attributes.ExecutionProgress),
-			string(item.Attributes.CompletionStatus),
+			colorStatus(string(item.Attributes.CompletionStatus)),
 			errors,
 			warnings,
 			item.Attributes.StartedDate,
@@ -326,6 +531,56 @@ func printCiBuildActionsMarkdown(resp *CiBuildActionsResponse) error {
 	return nil
 }
 
+func printCiBuildActionDetailsTable(result *CiBuildActionDetailsResult) error {
+	if err := printCiBuildActionsTable(&CiBuildActionsResponse{Data: []CiBuildActionResource{result.Data}}); err != nil {
+		return err
+	}
+	if result.Artifacts != nil {
+		fmt.Fprintln(os.Stdout, "\nArtifacts")
+		if err := printCiArtifactsTable(result.Artifacts); err != nil {
+			return err
+		}
+	}
+	if result.Issues != nil {
+		fmt.Fprintln(os.Stdout, "\nIssues")
+		if err := printCiIssuesTable(result.Issues); err != nil {
+			return err
+		}
+	}
+	if result.TestResults != nil {
+		fmt.Fprintln(os.Stdout, "\nTest Results")
+		if err := printCiTestResultsTable(result.TestResults); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printCiBuildActionDetailsMarkdown(result *CiBuildActionDetailsResult) error {
+	if err := printCiBuildActionsMarkdown(&CiBuildActionsResponse{Data: []CiBuildActionResource{result.Data}}); err != nil {
+		return err
+	}
+	if result.Artifacts != nil {
+		fmt.Fprintf(os.Stdout, "\n### Artifacts\n\n")
+		if err := printCiArtifactsMarkdown(result.Artifacts); err != nil {
+			return err
+		}
+	}
+	if result.Issues != nil {
+		fmt.Fprintf(os.Stdout, "\n### Issues\n\n")
+		if err := printCiIssuesMarkdown(result.Issues); err != nil {
+			return err
+		}
+	}
+	if result.TestResults != nil {
+		fmt.Fprintf(os.Stdout, "\n### Test Results\n\n")
+		if err := printCiTestResultsMarkdown(result.TestResults); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printCiArtifactsTable(resp *CiArtifactsResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tName\tType\tSize\tDownload URL")
@@ -366,13 +621,13 @@ func printCiArtifactMarkdown(resp *CiArtifactResponse) error {
 
 func printCiTestResultsTable(resp *CiTestResultsResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tClass\tName\tStatus\tDuration")
+	fmt.Fprintf(w, "ID\tClass\tName\t%s\tDuration\n", colorHeader("Status"))
 	for _, item := range resp.Data {
 		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
 			item.ID,
 			item.Attributes.ClassName,
 			item.Attributes.Name,
-			string(item.Attributes.Status),
+			colorStatus(string(item.Attributes.Status)),
 			formatTestDuration(item),
 		)
 	}
@@ -443,30 +698,227 @@ func printCiIssueMarkdown(resp *CiIssueResponse) error {
 }
 
 func printCiArtifactDownloadResultTable(result *CiArtifactDownloadResult) error {
+	return printCiArtifactDownloadResultsTable([]CiArtifactDownloadResult{*result})
+}
+
+func printCiArtifactDownloadResultMarkdown(result *CiArtifactDownloadResult) error {
+	return printCiArtifactDownloadResultsMarkdown([]CiArtifactDownloadResult{*result})
+}
+
+func printCiArtifactDownloadResultsTable(results []CiArtifactDownloadResult) error {
+	extracted := anyCiArtifactExtracted(results)
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tName\tType\tSize\tBytes Written\tOutput Path")
-	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n",
-		result.ID,
-		result.FileName,
-		result.FileType,
-		result.FileSize,
-		result.BytesWritten,
-		result.OutputPath,
-	)
+	if extracted {
+		fmt.Fprintln(w, "ID\tName\tType\tSize\tBytes Written\tExtracted Files\tExtracted Bytes\tOutput Path")
+	} else {
+		fmt.Fprintln(w, "ID\tName\tType\tSize\tBytes Written\tOutput Path")
+	}
+	for _, result := range results {
+		if extracted {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\n",
+				result.ID,
+				result.FileName,
+				result.FileType,
+				result.FileSize,
+				result.BytesWritten,
+				result.ExtractedFiles,
+				result.ExtractedBytes,
+				result.OutputPath,
+			)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n",
+			result.ID,
+			result.FileName,
+			result.FileType,
+			result.FileSize,
+			result.BytesWritten,
+			result.OutputPath,
+		)
+	}
 	return w.Flush()
 }
 
-func printCiArtifactDownloadResultMarkdown(result *CiArtifactDownloadResult) error {
-	fmt.Fprintln(os.Stdout, "| ID | Name | Type | Size | Bytes Written | Output Path |")
-	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
-	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %d | %s |\n",
-		escapeMarkdown(result.ID),
-		escapeMarkdown(result.FileName),
-		escapeMarkdown(result.FileType),
-		result.FileSize,
-		result.BytesWritten,
+func printCiArtifactDownloadResultsMarkdown(results []CiArtifactDownloadResult) error {
+	extracted := anyCiArtifactExtracted(results)
+	if extracted {
+		fmt.Fprintln(os.Stdout, "| ID | Name | Type | Size | Bytes Written | Extracted Files | Extracted Bytes | Output Path |")
+		fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- |")
+	} else {
+		fmt.Fprintln(os.Stdout, "| ID | Name | Type | Size | Bytes Written | Output Path |")
+		fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	}
+	for _, result := range results {
+		if extracted {
+			fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %d | %d | %d | %s |\n",
+				escapeMarkdown(result.ID),
+				escapeMarkdown(result.FileName),
+				escapeMarkdown(result.FileType),
+				result.FileSize,
+				result.BytesWritten,
+				result.ExtractedFiles,
+				result.ExtractedBytes,
+				escapeMarkdown(result.OutputPath),
+			)
+			continue
+		}
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %d | %s |\n",
+			escapeMarkdown(result.ID),
+			escapeMarkdown(result.FileName),
+			escapeMarkdown(result.FileType),
+			result.FileSize,
+			result.BytesWritten,
+			escapeMarkdown(result.OutputPath),
+		)
+	}
+	return nil
+}
+
+func anyCiArtifactExtracted(results []CiArtifactDownloadResult) bool {
+	for _, result := range results {
+		if result.Extracted {
+			return true
+		}
+	}
+	return false
+}
+
+func printXcodeCloudRunArtifactsResultTable(result *XcodeCloudRunArtifactsResult) error {
+	if err := printXcodeCloudStatusResultTable(&result.XcodeCloudStatusResult); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, "\nArtifacts")
+	return printCiArtifactDownloadResultsTable(result.Artifacts)
+}
+
+func printXcodeCloudRunArtifactsResultMarkdown(result *XcodeCloudRunArtifactsResult) error {
+	if err := printXcodeCloudStatusResultMarkdown(&result.XcodeCloudStatusResult); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout)
+	return printCiArtifactDownloadResultsMarkdown(result.Artifacts)
+}
+
+func printCiBuildArtifactsResultTable(result *CiBuildArtifactsResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Build ID\tBuild Run ID\tActions\tArtifacts")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", result.BuildID, result.BuildRunID, strings.Join(result.ActionIDs, ", "), len(result.Artifacts))
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(result.Artifacts) > 0 {
+		fmt.Fprintln(os.Stdout, "\nArtifacts")
+		if err := printCiArtifactsTable(&CiArtifactsResponse{Data: result.Artifacts}); err != nil {
+			return err
+		}
+	}
+	if len(result.Downloads) > 0 {
+		fmt.Fprintln(os.Stdout, "\nDownloads")
+		return printCiArtifactDownloadResultsTable(result.Downloads)
+	}
+	return nil
+}
+
+func printCiBuildArtifactsResultMarkdown(result *CiBuildArtifactsResult) error {
+	fmt.Fprintln(os.Stdout, "| Build ID | Build Run ID | Actions | Artifacts |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d |\n",
+		escapeMarkdown(result.BuildID),
+		escapeMarkdown(result.BuildRunID),
+		escapeMarkdown(strings.Join(result.ActionIDs, ", ")),
+		len(result.Artifacts),
+	)
+	if len(result.Artifacts) > 0 {
+		fmt.Fprintf(os.Stdout, "\n### Artifacts\n\n")
+		if err := printCiArtifactsMarkdown(&CiArtifactsResponse{Data: result.Artifacts}); err != nil {
+			return err
+		}
+	}
+	if len(result.Downloads) > 0 {
+		fmt.Fprintf(os.Stdout, "\n### Downloads\n\n")
+		return printCiArtifactDownloadResultsMarkdown(result.Downloads)
+	}
+	return nil
+}
+
+func printCiActionLogsResultTable(result *CiActionLogsResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Action ID\tOutput Path\tLogs")
+	fmt.Fprintf(w, "%s\t%s\t%d\n", result.ActionID, result.OutputPath, len(result.Logs))
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, "\nLogs")
+	return printCiArtifactDownloadResultsTable(result.Logs)
+}
+
+func printCiActionLogsResultMarkdown(result *CiActionLogsResult) error {
+	fmt.Fprintln(os.Stdout, "| Action ID | Output Path | Logs |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %d |\n",
+		escapeMarkdown(result.ActionID),
 		escapeMarkdown(result.OutputPath),
+		len(result.Logs),
 	)
+	fmt.Fprintf(os.Stdout, "\n### Logs\n\n")
+	return printCiArtifactDownloadResultsMarkdown(result.Logs)
+}
+
+func printCiArtifactsDownloadAllResultTable(result *CiArtifactsDownloadAllResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Action ID\tOutput Dir\tTotal\tDownloaded\tFailed")
+	fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\n", result.ActionID, result.OutputDir, result.Total, result.Downloaded, result.Failed)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(result.Downloads) > 0 {
+		fmt.Fprintln(os.Stdout, "\nDownloads")
+		if err := printCiArtifactDownloadResultsTable(result.Downloads); err != nil {
+			return err
+		}
+	}
+	if len(result.Failures) == 0 {
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, "\nFailures")
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tOutput Path\tError")
+	for _, failure := range result.Failures {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", failure.ID, failure.FileName, failure.OutputPath, failure.Error)
+	}
+	return w.Flush()
+}
+
+func printCiArtifactsDownloadAllResultMarkdown(result *CiArtifactsDownloadAllResult) error {
+	fmt.Fprintln(os.Stdout, "| Action ID | Output Dir | Total | Downloaded | Failed |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %d | %d | %d |\n",
+		escapeMarkdown(result.ActionID),
+		escapeMarkdown(result.OutputDir),
+		result.Total,
+		result.Downloaded,
+		result.Failed,
+	)
+	if len(result.Downloads) > 0 {
+		fmt.Fprintf(os.Stdout, "\n### Downloads\n\n")
+		if err := printCiArtifactDownloadResultsMarkdown(result.Downloads); err != nil {
+			return err
+		}
+	}
+	if len(result.Failures) == 0 {
+		return nil
+	}
+	fmt.Fprintf(os.Stdout, "\n### Failures\n\n")
+	fmt.Fprintln(os.Stdout, "| ID | Name | Output Path | Error |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	for _, failure := range result.Failures {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %s |\n",
+			escapeMarkdown(failure.ID),
+			escapeMarkdown(failure.FileName),
+			escapeMarkdown(failure.OutputPath),
+			escapeMarkdown(failure.Error),
+		)
+	}
 	return nil
 }
 
@@ -484,10 +936,42 @@ func printCiWorkflowDeleteResultMarkdown(result *CiWorkflowDeleteResult) error {
 	return nil
 }
 
+func printCiWorkflowDeletePreviewTable(result *CiWorkflowDeletePreview) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tIn Progress Runs\tDry Run")
+	fmt.Fprintf(w, "%s\t%s\t%d\t%t\n", result.ID, compactWhitespace(result.Name), result.InProgressRuns, result.DryRun)
+	return w.Flush()
+}
+
+func printCiWorkflowDeletePreviewMarkdown(result *CiWorkflowDeletePreview) error {
+	fmt.Fprintln(os.Stdout, "| ID | Name | In Progress Runs | Dry Run |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %s | %d | %t |\n",
+		escapeMarkdown(result.ID),
+		escapeMarkdown(result.Name),
+		result.InProgressRuns,
+		result.DryRun,
+	)
+	return nil
+}
+
 func printCiProductDeleteResultTable(result *CiProductDeleteResult) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tDeleted")
 	fmt.Fprintf(w, "%s\t%t\n", result.ID, result.Deleted)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(result.Workflows) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout, "\nWorkflows")
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tDeleted")
+	for _, workflow := range result.Workflows {
+		fmt.Fprintf(w, "%s\t%s\t%t\n", workflow.ID, compactWhitespace(workflow.Name), workflow.Deleted)
+	}
 	return w.Flush()
 }
 
@@ -495,6 +979,15 @@ func printCiProductDeleteResultMarkdown(result *CiProductDeleteResult) error {
 	fmt.Fprintln(os.Stdout, "| ID | Deleted |")
 	fmt.Fprintln(os.Stdout, "| --- | --- |")
 	fmt.Fprintf(os.Stdout, "| %s | %t |\n", escapeMarkdown(result.ID), result.Deleted)
+	if len(result.Workflows) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout, "\n| Workflow ID | Name | Deleted |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- |")
+	for _, workflow := range result.Workflows {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %t |\n", escapeMarkdown(workflow.ID), escapeMarkdown(workflow.Name), workflow.Deleted)
+	}
 	return nil
 }
 
@@ -509,6 +1002,240 @@ func formatTestDuration(result CiTestResultResource) string {
 	return fmt.Sprintf("%.2fs", duration)
 }
 
+func printCiIssuesSummaryTable(result *CiIssuesSummaryResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Action ID\tName\tType\tErrors\tWarnings\tAnalyzer Warnings\tTest Failures")
+	for _, action := range result.Actions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\n",
+			action.ActionID,
+			compactWhitespace(action.Name),
+			action.ActionType,
+			action.Counts.Errors,
+			action.Counts.Warnings,
+			action.Counts.AnalyzerWarnings,
+			action.Counts.TestFailures,
+		)
+	}
+	fmt.Fprintf(w, "Total\t\t\t%d\t%d\t%d\t%d\n",
+		result.Totals.Errors,
+		result.Totals.Warnings,
+		result.Totals.AnalyzerWarnings,
+		result.Totals.TestFailures,
+	)
+	return w.Flush()
+}
+
+func printCiIssuesSummaryMarkdown(result *CiIssuesSummaryResult) error {
+	fmt.Fprintln(os.Stdout, "| Action ID | Name | Type | Errors | Warnings | Analyzer Warnings | Test Failures |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- |")
+	for _, action := range result.Actions {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %s | %d | %d | %d | %d |\n",
+			escapeMarkdown(action.ActionID),
+			escapeMarkdown(action.Name),
+			escapeMarkdown(action.ActionType),
+			action.Counts.Errors,
+			action.Counts.Warnings,
+			action.Counts.AnalyzerWarnings,
+			action.Counts.TestFailures,
+		)
+	}
+	fmt.Fprintf(os.Stdout, "| Total |  |  | %d | %d | %d | %d |\n",
+		result.Totals.Errors,
+		result.Totals.Warnings,
+		result.Totals.AnalyzerWarnings,
+		result.Totals.TestFailures,
+	)
+	return nil
+}
+
+func printCiBuildRunStatsTable(result *CiBuildRunStatsResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Metric\tValue")
+	for _, row := range ciBuildRunStatsRows(result) {
+		fmt.Fprintf(w, "%s\t%s\n", row[0], row[1])
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, "\nBy Completion Status")
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tRuns\n", colorHeader("Status"))
+	for _, status := range ciBuildRunStatusKeys(result.ByCompletionStatus) {
+		fmt.Fprintf(w, "%s\t%d\n", colorStatus(status), result.ByCompletionStatus[status])
+	}
+	return w.Flush()
+}
+
+func printCiBuildRunStatsMarkdown(result *CiBuildRunStatsResult) error {
+	fmt.Fprintln(os.Stdout, "| Metric | Value |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	for _, row := range ciBuildRunStatsRows(result) {
+		fmt.Fprintf(os.Stdout, "| %s | %s |\n", escapeMarkdown(row[0]), escapeMarkdown(row[1]))
+	}
+
+	fmt.Fprintln(os.Stdout)
+	fmt.Fprintln(os.Stdout, "| Status | Runs |")
+	fmt.Fprintln(os.Stdout, "| --- | --- |")
+	for _, status := range ciBuildRunStatusKeys(result.ByCompletionStatus) {
+		fmt.Fprintf(os.Stdout, "| %s | %d |\n", escapeMarkdown(status), result.ByCompletionStatus[status])
+	}
+	return nil
+}
+
+func ciBuildRunStatsRows(result *CiBuildRunStatsResult) [][2]string {
+	rows := [][2]string{}
+	if result.WorkflowID != "" {
+		rows = append(rows, [2]string{"Workflow ID", result.WorkflowID})
+	}
+	return append(rows,
+		[2]string{"Total Runs", fmt.Sprintf("%d", result.TotalRuns)},
+		[2]string{"Completed Runs", fmt.Sprintf("%d", result.CompletedRuns)},
+		[2]string{"Succeeded Runs", fmt.Sprintf("%d", result.SucceededRuns)},
+		[2]string{"Success Rate", fmt.Sprintf("%.1f%%", result.SuccessRate*100)},
+		[2]string{"Average Duration", formatStatsDuration(result.AverageDurationSeconds, result.TimedRuns)},
+		[2]string{"Median Duration", formatStatsDuration(result.MedianDurationSeconds, result.TimedRuns)},
+	)
+}
+
+func printCiBuildRunsByWorkflowTable(result *CiBuildRunsByWorkflowResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Workflow ID\tWorkflow Name\tRuns\tSucceeded\tFailed\tLatest Build #\t%s\tLatest Created\n", colorHeader("Latest Status"))
+	for _, id := range ciBuildRunsByWorkflowKeys(result.Workflows) {
+		group := result.Workflows[id]
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n",
+			group.WorkflowID,
+			compactWhitespace(group.WorkflowName),
+			group.TotalRuns,
+			group.ByCompletionStatus[string(CiBuildRunCompletionStatusSucceeded)],
+			ciWorkflowGroupFailures(group),
+			formatLatestBuildNumber(group.LatestBuildNumber),
+			colorStatus(group.LatestStatus),
+			group.LatestCreatedDate,
+		)
+	}
+	return w.Flush()
+}
+
+func printCiBuildRunsByWorkflowMarkdown(result *CiBuildRunsByWorkflowResult) error {
+	fmt.Fprintln(os.Stdout, "| Workflow ID | Workflow Name | Runs | Succeeded | Failed | Latest Build # | Latest Status | Latest Created |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- | --- | --- |")
+	for _, id := range ciBuildRunsByWorkflowKeys(result.Workflows) {
+		group := result.Workflows[id]
+		fmt.Fprintf(os.Stdout, "| %s | %s | %d | %d | %d | %s | %s | %s |\n",
+			escapeMarkdown(group.WorkflowID),
+			escapeMarkdown(group.WorkflowName),
+			group.TotalRuns,
+			group.ByCompletionStatus[string(CiBuildRunCompletionStatusSucceeded)],
+			ciWorkflowGroupFailures(group),
+			formatLatestBuildNumber(group.LatestBuildNumber),
+			escapeMarkdown(group.LatestStatus),
+			escapeMarkdown(group.LatestCreatedDate),
+		)
+	}
+	return nil
+}
+
+func ciBuildRunsByWorkflowKeys(groups map[string]CiWorkflowBuildRunsGroup) []string {
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ciWorkflowGroupFailures counts failed and errored runs.
+func ciWorkflowGroupFailures(group CiWorkflowBuildRunsGroup) int {
+	return group.ByCompletionStatus[string(CiBuildRunCompletionStatusFailed)] +
+		group.ByCompletionStatus[string(CiBuildRunCompletionStatusErrored)]
+}
+
+func formatLatestBuildNumber(number int) string {
+	if number == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", number)
+}
+
+func ciBuildRunStatusKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatStatsDuration(seconds float64, samples int) string {
+	if samples == 0 {
+		return ""
+	}
+	return (time.Duration(seconds * float64(time.Second))).Round(time.Second).String()
+}
+
+func printCiTestResultsSummaryTable(result *CiTestResultsSummaryResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Action ID\tTotal\tPassed\tFailed\tSkipped\tFlaky")
+	fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\n",
+		result.ActionID,
+		result.Total,
+		result.Passed,
+		result.Failed,
+		result.Skipped,
+		result.Flaky,
+	)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(result.Tests) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout, "\nTests")
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Test\t%s\tPasses\tFailures\tFlaky\n", colorHeader("Status"))
+	for _, test := range result.Tests {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%t\n",
+			compactWhitespace(test.Identifier),
+			colorStatus(string(test.Status)),
+			test.Passes,
+			test.Failures,
+			test.Flaky,
+		)
+	}
+	return w.Flush()
+}
+
+func printCiTestResultsSummaryMarkdown(result *CiTestResultsSummaryResult) error {
+	fmt.Fprintln(os.Stdout, "| Action ID | Total | Passed | Failed | Skipped | Flaky |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- | --- |")
+	fmt.Fprintf(os.Stdout, "| %s | %d | %d | %d | %d | %d |\n",
+		escapeMarkdown(result.ActionID),
+		result.Total,
+		result.Passed,
+		result.Failed,
+		result.Skipped,
+		result.Flaky,
+	)
+	if len(result.Tests) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stdout, "\n| Test | Status | Passes | Failures | Flaky |")
+	fmt.Fprintln(os.Stdout, "| --- | --- | --- | --- | --- |")
+	for _, test := range result.Tests {
+		fmt.Fprintf(os.Stdout, "| %s | %s | %d | %d | %t |\n",
+			escapeMarkdown(test.Identifier),
+			escapeMarkdown(string(test.Status)),
+			test.Passes,
+			test.Failures,
+			test.Flaky,
+		)
+	}
+	return nil
+}
+
 func formatFileLocation(location *FileLocation) (string, string) {
 	if location == nil {
 		return "", ""
@@ -502,6 +502,28 @@ func TestPrintTable_CiArtifactDownloadResult(t *testing.T) {
 	}
 }
 
+func TestPrintTable_CiArtifactDownloadResultExtracted(t *testing.T) {
+	result := &CiArtifactDownloadResult{
+		ID:             "art-1",
+		FileName:       "Build.zip",
+		OutputPath:     "/tmp/Build",
+		BytesWritten:   2048,
+		Extracted:      true,
+		ExtractedFiles: 12,
+		ExtractedBytes: 8192,
+	}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	for _, want := range []string{"Extracted Files", "Extracted Bytes", "12", "8192", "/tmp/Build"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+}
+
 func TestIsBuildRunComplete(t *testing.T) {
 	tests := []struct {
 		progress CiBuildRunExecutionProgress
@@ -724,6 +746,18 @@ func TestPrintMarkdown_CiWorkflowDeleteResult(t *testing.T) {
 	}
 }
 
+func TestPrintMarkdown_CiWorkflowDeletePreview(t *testing.T) {
+	result := &CiWorkflowDeletePreview{ID: "wf-3", Name: "Nightly", InProgressRuns: 2, DryRun: true}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintMarkdown(result)
+	})
+
+	if !strings.Contains(output, "| wf-3 | Nightly | 2 | true |") {
+		t.Fatalf("expected preview row in output, got: %s", output)
+	}
+}
+
 func TestPrintTable_CiProductDeleteResult(t *testing.T) {
 	result := &CiProductDeleteResult{ID: "prod-1", Deleted: true}
 
@@ -739,6 +773,27 @@ func TestPrintTable_CiProductDeleteResult(t *testing.T) {
 	}
 }
 
+func TestPrintMarkdown_CiProductDeleteResultWithWorkflows(t *testing.T) {
+	result := &CiProductDeleteResult{
+		ID:      "prod-3",
+		Deleted: true,
+		Workflows: []CiWorkflowDeleteResult{
+			{ID: "wf-1", Name: "CI", Deleted: true},
+			{ID: "wf-2", Name: "Release", Deleted: true},
+		},
+	}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintMarkdown(result)
+	})
+
+	for _, want := range []string{"| prod-3 | true |", "| Workflow ID | Name | Deleted |", "| wf-1 | CI | true |", "| wf-2 | Release | true |"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+}
+
 func TestPrintMarkdown_CiProductDeleteResult(t *testing.T) {
 	result := &CiProductDeleteResult{ID: "prod-2", Deleted: true}
 
@@ -810,6 +865,40 @@ func TestBuildCiBuildRunsQuery(t *testing.T) {
 	}
 }
 
+func TestBuildCiBuildRunsQueryInclude(t *testing.T) {
+	query := &ciBuildRunsQuery{}
+	WithCiBuildRunsInclude([]string{"workflow"})(query)
+	WithCiBuildRunsLimit(200)(query)
+
+	values, err := url.ParseQuery(buildCiBuildRunsQuery(query))
+	if err != nil {
+		t.Fatalf("failed to parse query: %v", err)
+	}
+	if got := values.Get("include"); got != "workflow" {
+		t.Fatalf("expected include=workflow, got %q", got)
+	}
+	if got := values.Get("limit"); got != "200" {
+		t.Fatalf("expected limit=200, got %q", got)
+	}
+}
+
+func TestBuildCiBuildRunsQuerySort(t *testing.T) {
+	query := &ciBuildRunsQuery{}
+	WithCiBuildRunsSort("-number")(query)
+	WithCiBuildRunsLimit(1)(query)
+
+	values, err := url.ParseQuery(buildCiBuildRunsQuery(query))
+	if err != nil {
+		t.Fatalf("failed to parse query: %v", err)
+	}
+	if got := values.Get("sort"); got != "-number" {
+		t.Fatalf("expected sort=-number, got %q", got)
+	}
+	if got := values.Get("limit"); got != "1" {
+		t.Fatalf("expected limit=1, got %q", got)
+	}
+}
+
 func TestBuildCiArtifactsQuery(t *testing.T) {
 	query := &ciArtifactsQuery{}
 	WithCiArtifactsLimit(25)(query)
@@ -900,3 +989,114 @@ func TestBuildCiBuildRunBuildsQuery(t *testing.T) {
 		t.Fatalf("expected limit=8, got %q", got)
 	}
 }
+
+func TestPrintTable_CiBuildActionDetails(t *testing.T) {
+	result := &CiBuildActionDetailsResult{
+		Data: CiBuildActionResource{
+			ID: "action-1",
+			Attributes: CiBuildActionAttributes{
+				Name:       "Test - iOS",
+				ActionType: "TEST",
+			},
+		},
+		Artifacts: &CiArtifactsResponse{
+			Data: []CiArtifactResource{
+				{ID: "art-1", Attributes: CiArtifactAttributes{FileName: "Logs.zip", FileType: "LOG_BUNDLE"}},
+			},
+		},
+		TestResults: &CiTestResultsResponse{
+			Data: []CiTestResultResource{
+				{ID: "test-1", Attributes: CiTestResultAttributes{ClassName: "AppTests", Name: "testLaunch", Status: "SUCCESS"}},
+			},
+		},
+	}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	for _, want := range []string{"Test - iOS", "Artifacts", "Logs.zip", "Test Results", "testLaunch"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+	if strings.Contains(output, "\nIssues") {
+		t.Fatalf("expected no issues section when issues were not included, got: %s", output)
+	}
+}
+
+func TestPrintMarkdown_CiBuildActionDetails(t *testing.T) {
+	result := &CiBuildActionDetailsResult{
+		Data: CiBuildActionResource{
+			ID:         "action-1",
+			Attributes: CiBuildActionAttributes{Name: "Build - iOS", ActionType: "BUILD"},
+		},
+		Issues: &CiIssuesResponse{
+			Data: []CiIssueResource{
+				{ID: "issue-1", Attributes: CiIssueAttributes{IssueType: "WARNING", Message: "Deprecated API"}},
+			},
+		},
+	}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintMarkdown(result)
+	})
+
+	if !strings.Contains(output, "### Issues") {
+		t.Fatalf("expected issues heading in output, got: %s", output)
+	}
+	if !strings.Contains(output, "Deprecated API") {
+		t.Fatalf("expected issue message in output, got: %s", output)
+	}
+	if strings.Contains(output, "### Artifacts") || strings.Contains(output, "### Test Results") {
+		t.Fatalf("expected only included sections, got: %s", output)
+	}
+}
+
+func TestPrintTable_CiBuildRunStats(t *testing.T) {
+	result := &CiBuildRunStatsResult{
+		WorkflowID:             "wf-1",
+		TotalRuns:              4,
+		CompletedRuns:          4,
+		SucceededRuns:          3,
+		SuccessRate:            0.75,
+		TimedRuns:              4,
+		AverageDurationSeconds: 754.4,
+		MedianDurationSeconds:  600,
+		ByCompletionStatus:     map[string]int{"SUCCEEDED": 3, "FAILED": 1},
+	}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	for _, want := range []string{"Success Rate", "75.0%", "12m34s", "10m0s", "By Completion Status", "SUCCEEDED", "FAILED"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+}
+
+func TestPrintTable_CiBuildRunsByWorkflow(t *testing.T) {
+	result := &CiBuildRunsByWorkflowResult{
+		ProductID: "prod-1",
+		TotalRuns: 3,
+		Workflows: map[string]CiWorkflowBuildRunsGroup{
+			"wf-2": {WorkflowID: "wf-2", WorkflowName: "Release", TotalRuns: 1, ByCompletionStatus: map[string]int{"FAILED": 1}, LatestBuildNumber: 12, LatestStatus: "FAILED"},
+			"wf-1": {WorkflowID: "wf-1", WorkflowName: "CI", TotalRuns: 2, ByCompletionStatus: map[string]int{"SUCCEEDED": 2}, LatestBuildNumber: 14, LatestStatus: "SUCCEEDED"},
+		},
+	}
+
+	output := captureXcodeCloudStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	for _, want := range []string{"Latest Status", "CI", "Release", "SUCCEEDED", "FAILED"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+	if strings.Index(output, "wf-1") > strings.Index(output, "wf-2") {
+		t.Fatalf("expected workflows sorted by ID, got: %s", output)
+	}
+}
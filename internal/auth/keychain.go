@@ -171,7 +171,11 @@ func LoadPrivateKey(path string) (*ecdsa.PrivateKey, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to read key file: %w", err)
 	}
+	return ParsePrivateKey(data)
+}
 
+// ParsePrivateKey parses a PEM-encoded ECDSA private key.
+func ParsePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
 	block, _ := pem.Decode(data)
 	if block == nil {
 		return nil, fmt.Errorf("invalid PEM data")
@@ -249,22 +253,29 @@ func clearConfigCredentials() error {
 	if err != nil {
 		return err
 	}
+	writePath, err := config.WritePath()
+	if err != nil {
+		return err
+	}
 	globalPath, err := config.GlobalPath()
 	if err != nil {
 		return err
 	}
-	if err := clearConfigCredentialsAt(activePath); err != nil && !errors.Is(err, config.ErrNotFound) {
+	if err := clearConfigCredentialsAt(activePath, writePath); err != nil && !errors.Is(err, config.ErrNotFound) {
 		return err
 	}
-	if !sameConfigPath(activePath, globalPath) {
-		if err := clearConfigCredentialsAt(globalPath); err != nil && !errors.Is(err, config.ErrNotFound) {
+	if !sameConfigPath(writePath, globalPath) {
+		if err := clearConfigCredentialsAt(globalPath, globalPath); err != nil && !errors.Is(err, config.ErrNotFound) {
 			return err
 		}
 	}
 	return nil
 }
 
-func clearConfigCredentialsAt(path string) error {
+// clearConfigCredentialsAt clears the credentials in the config read from path
+// and saves the result to writePath, which differs from path only for a
+// read-only config.toml.
+func clearConfigCredentialsAt(path, writePath string) error {
 	cfg, err := config.LoadAt(path)
 	if err != nil {
 		return err
@@ -274,7 +285,7 @@ func clearConfigCredentialsAt(path string) error {
 	cfg.PrivateKeyPath = ""
 	cfg.DefaultKeyName = ""
 	cfg.Keys = nil
-	return config.SaveAt(path, cfg)
+	return config.SaveAt(writePath, cfg)
 }
 
 // ListCredentials lists all stored credentials from all sources.
@@ -744,10 +755,20 @@ func storeInConfig(name string, payload credentialPayload) error {
 	if err != nil {
 		return err
 	}
-	return storeInConfigAt(name, payload, path)
+	writePath, err := config.WritePath()
+	if err != nil {
+		return err
+	}
+	return storeInConfigFrom(name, payload, path, writePath)
 }
 
 func storeInConfigAt(name string, payload credentialPayload, configPath string) error {
+	return storeInConfigFrom(name, payload, configPath, configPath)
+}
+
+// storeInConfigFrom adds the credential to the config read from configPath
+// and saves the result to writePath.
+func storeInConfigFrom(name string, payload credentialPayload, configPath, writePath string) error {
 	cfg, err := config.LoadAt(configPath)
 	if err != nil && err != config.ErrNotFound {
 		return err
@@ -781,7 +802,7 @@ func storeInConfigAt(name string, payload credentialPayload, configPath string)
 	cfg.IssuerID = payload.IssuerID
 	cfg.PrivateKeyPath = payload.PrivateKeyPath
 	cfg.DefaultKeyName = name
-	return config.SaveAt(configPath, cfg)
+	return config.SaveAt(writePath, cfg)
 }
 
 func hasCompleteCredentials(cfg *config.Config) bool {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -26,7 +25,8 @@ Examples:
   asc accessibility list --app "APP_ID"
   asc accessibility get --id "DECLARATION_ID"
   asc accessibility create --app "APP_ID" --device-family IPHONE --supports-voiceover true
-  asc accessibility update --id "DECLARATION_ID" --publish true
+  asc accessibility update --id "DECLARATION_ID" --supports-voiceover true
+  asc accessibility publish --id "DECLARATION_ID"
   asc accessibility delete --id "DECLARATION_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
@@ -35,6 +35,8 @@ Examples:
 			AccessibilityGetCommand(),
 			AccessibilityCreateCommand(),
 			AccessibilityUpdateCommand(),
+			AccessibilityPublishCommand(),
+			AccessibilityUnpublishCommand(),
 			AccessibilityDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -54,7 +56,7 @@ func AccessibilityListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -72,7 +74,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("accessibility list: --limit must be between 1 and 200")
+				return usageErrorf("accessibility list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("accessibility list: %w", err)
@@ -95,8 +97,7 @@ Examples:
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -122,14 +123,13 @@ Examples:
 					return fmt.Errorf("accessibility list: failed to fetch: %w", err)
 				}
 
-				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAccessibilityDeclarations(ctx, resolvedAppID, asc.WithAccessibilityDeclarationsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("accessibility list: %w", err)
 				}
-
-				return printOutput(pages, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAccessibilityDeclarations(requestCtx, resolvedAppID, opts...)
@@ -148,7 +148,7 @@ func AccessibilityGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Accessibility declaration ID (required)")
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(accessibilityDeclarationFieldList(), ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -165,8 +165,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			fieldsValue, err := normalizeAccessibilityDeclarationFields(*fields)
@@ -197,7 +196,7 @@ func AccessibilityCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	deviceFamily := fs.String("device-family", "", "Device family: "+strings.Join(accessibilityDeviceFamilyList(), ", "))
+	deviceFamily := fs.String("device-family", "", "Device family(s), comma-separated: "+strings.Join(accessibilityDeviceFamilyList(), ", "))
 	supportsAudioDescriptions := fs.String("supports-audio-descriptions", "", "Supports audio descriptions (true/false)")
 	supportsCaptions := fs.String("supports-captions", "", "Supports captions (true/false)")
 	supportsDarkInterface := fs.String("supports-dark-interface", "", "Supports dark interface (true/false)")
@@ -207,7 +206,7 @@ func AccessibilityCreateCommand() *ffcli.Command {
 	supportsSufficientContrast := fs.String("supports-sufficient-contrast", "", "Supports sufficient contrast (true/false)")
 	supportsVoiceControl := fs.String("supports-voice-control", "", "Supports voice control (true/false)")
 	supportsVoiceover := fs.String("supports-voiceover", "", "Supports voiceover (true/false)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -216,30 +215,34 @@ func AccessibilityCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create an accessibility declaration.",
 		LongHelp: `Create an accessibility declaration.
 
+Pass several comma-separated device families to create one declaration per
+family with the same support flags. Every family is attempted, even after a
+failure; the result lists each family's outcome and the command exits
+non-zero if any creation failed.
+
 Examples:
   asc accessibility create --app "APP_ID" --device-family IPHONE --supports-voiceover true
-  asc accessibility create --app "APP_ID" --device-family IPAD --supports-captions true`,
+  asc accessibility create --app "APP_ID" --device-family IPAD --supports-captions true
+  asc accessibility create --app "APP_ID" --device-family IPHONE,IPAD,MAC --supports-voiceover true`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			deviceFamilyValue := strings.TrimSpace(*deviceFamily)
 			if deviceFamilyValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --device-family is required")
-				return flag.ErrHelp
+				return flagErrorf("--device-family is required")
 			}
 
-			normalizedDeviceFamily, err := normalizeAccessibilityDeviceFamily(deviceFamilyValue)
+			deviceFamilies, err := normalizeAccessibilityCreateDeviceFamilies(deviceFamilyValue)
 			if err != nil {
 				return fmt.Errorf("accessibility create: %w", err)
 			}
 
-			attrs, err := buildAccessibilityDeclarationCreateAttributes(normalizedDeviceFamily, map[string]string{
+			attrs, err := buildAccessibilityDeclarationCreateAttributes(deviceFamilies[0], map[string]string{
 				"supports-audio-descriptions":                *supportsAudioDescriptions,
 				"supports-captions":                          *supportsCaptions,
 				"supports-dark-interface":                    *supportsDarkInterface,
@@ -262,16 +265,73 @@ Examples:
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.CreateAccessibilityDeclaration(requestCtx, resolvedAppID, attrs)
-			if err != nil {
-				return fmt.Errorf("accessibility create: failed to create: %w", err)
+			if len(deviceFamilies) == 1 {
+				resp, err := client.CreateAccessibilityDeclaration(requestCtx, resolvedAppID, attrs)
+				if err != nil {
+					return fmt.Errorf("accessibility create: failed to create: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
 			}
 
-			return printOutput(resp, *output, *pretty)
+			result := createAccessibilityDeclarations(requestCtx, resolvedAppID, deviceFamilies, attrs,
+				func(ctx context.Context, attrs asc.AccessibilityDeclarationCreateAttributes) (string, error) {
+					resp, err := client.CreateAccessibilityDeclaration(ctx, resolvedAppID, attrs)
+					if err != nil {
+						return "", err
+					}
+					return resp.Data.ID, nil
+				},
+			)
+
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("accessibility create: %d of %d declarations failed", result.Failed, result.Total)
+			}
+
+			return nil
 		},
 	}
 }
 
+// createAccessibilityDeclarations creates one declaration per device family
+// with otherwise identical attributes. A failure is recorded and the
+// remaining families are still attempted.
+func createAccessibilityDeclarations(
+	ctx context.Context,
+	appID string,
+	deviceFamilies []string,
+	attrs asc.AccessibilityDeclarationCreateAttributes,
+	create func(context.Context, asc.AccessibilityDeclarationCreateAttributes) (string, error),
+) *asc.AccessibilityDeclarationBatchCreateResult {
+	result := &asc.AccessibilityDeclarationBatchCreateResult{
+		AppID:   appID,
+		Total:   len(deviceFamilies),
+		Results: make([]asc.AccessibilityDeclarationBatchCreateItem, 0, len(deviceFamilies)),
+	}
+	for _, family := range deviceFamilies {
+		familyAttrs := attrs
+		familyAttrs.DeviceFamily = asc.DeviceFamily(family)
+		item := asc.AccessibilityDeclarationBatchCreateItem{DeviceFamily: familyAttrs.DeviceFamily}
+
+		id, err := create(ctx, familyAttrs)
+		if err != nil {
+			item.Status = "failed"
+			item.Error = err.Error()
+			result.Failed++
+		} else {
+			item.Status = "created"
+			item.ID = id
+			result.Created++
+		}
+		result.Results = append(result.Results, item)
+	}
+	return result
+}
+
 // AccessibilityUpdateCommand returns the accessibility update subcommand.
 func AccessibilityUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("update", flag.ExitOnError)
@@ -287,7 +347,7 @@ func AccessibilityUpdateCommand() *ffcli.Command {
 	supportsSufficientContrast := fs.String("supports-sufficient-contrast", "", "Supports sufficient contrast (true/false)")
 	supportsVoiceControl := fs.String("supports-voice-control", "", "Supports voice control (true/false)")
 	supportsVoiceover := fs.String("supports-voiceover", "", "Supports voiceover (true/false)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -304,8 +364,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			attrs, err := buildAccessibilityDeclarationUpdateAttributes(map[string]string{
@@ -346,13 +405,74 @@ Examples:
 	}
 }
 
+// AccessibilityPublishCommand returns the accessibility publish subcommand.
+func AccessibilityPublishCommand() *ffcli.Command {
+	return accessibilityPublishStateCommand("publish", true)
+}
+
+// AccessibilityUnpublishCommand returns the accessibility unpublish subcommand.
+func AccessibilityUnpublishCommand() *ffcli.Command {
+	return accessibilityPublishStateCommand("unpublish", false)
+}
+
+// accessibilityPublishStateCommand builds publish/unpublish, which differ only
+// in the publish attribute they send.
+func accessibilityPublishStateCommand(name string, publish bool) *ffcli.Command {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+
+	id := fs.String("id", "", "Accessibility declaration ID (required)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	verb := "Publish"
+	if !publish {
+		verb = "Unpublish"
+	}
+
+	return &ffcli.Command{
+		Name:       name,
+		ShortUsage: fmt.Sprintf("asc accessibility %s --id DECLARATION_ID [flags]", name),
+		ShortHelp:  verb + " an accessibility declaration.",
+		LongHelp: fmt.Sprintf(`%s an accessibility declaration.
+
+Shorthand for "asc accessibility update --id DECLARATION_ID --publish %t".
+
+Examples:
+  asc accessibility %s --id "DECLARATION_ID"`, verb, publish, name),
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				return flagErrorf("--id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("accessibility %s: %w", name, err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			attrs := asc.AccessibilityDeclarationUpdateAttributes{Publish: &publish}
+			resp, err := client.UpdateAccessibilityDeclaration(requestCtx, idValue, attrs)
+			if err != nil {
+				return fmt.Errorf("accessibility %s: failed to update: %w", name, err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
 // AccessibilityDeleteCommand returns the accessibility delete subcommand.
 func AccessibilityDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 
 	id := fs.String("id", "", "Accessibility declaration ID (required)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -368,12 +488,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("accessibility declaration %q", idValue))
+				if err != nil {
+					return fmt.Errorf("accessibility delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -518,7 +642,31 @@ func normalizeAccessibilityDeviceFamily(value string) (string, error) {
 			return normalized, nil
 		}
 	}
-	return "", fmt.Errorf("--device-family must be one of: %s", strings.Join(accessibilityDeviceFamilyList(), ", "))
+	return "", usageErrorf("--device-family must be one of: %s", strings.Join(accessibilityDeviceFamilyList(), ", "))
+}
+
+// normalizeAccessibilityCreateDeviceFamilies validates a comma-separated
+// --device-family value for create, dropping duplicates. Every family is
+// checked before any declaration is created.
+func normalizeAccessibilityCreateDeviceFamilies(value string) ([]string, error) {
+	values := splitCSV(value)
+	if len(values) == 0 {
+		return nil, usageErrorf("--device-family must be one of: %s", strings.Join(accessibilityDeviceFamilyList(), ", "))
+	}
+	families := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, item := range values {
+		family, err := normalizeAccessibilityDeviceFamily(item)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[family]; ok {
+			continue
+		}
+		seen[family] = struct{}{}
+		families = append(families, family)
+	}
+	return families, nil
 }
 
 func normalizeAccessibilityDeviceFamilies(values []string) ([]string, error) {
@@ -527,7 +675,7 @@ func normalizeAccessibilityDeviceFamilies(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := accessibilityDeviceFamilies[value]; !ok {
-			return nil, fmt.Errorf("--device-family must be one of: %s", strings.Join(accessibilityDeviceFamilyList(), ", "))
+			return nil, usageErrorf("--device-family must be one of: %s", strings.Join(accessibilityDeviceFamilyList(), ", "))
 		}
 	}
 	return values, nil
@@ -539,7 +687,7 @@ func normalizeAccessibilityStates(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := accessibilityStates[value]; !ok {
-			return nil, fmt.Errorf("--state must be one of: %s", strings.Join(accessibilityStateList(), ", "))
+			return nil, usageErrorf("--state must be one of: %s", strings.Join(accessibilityStateList(), ", "))
 		}
 	}
 	return values, nil
@@ -557,7 +705,7 @@ func normalizeAccessibilityDeclarationFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(accessibilityDeclarationFieldList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(accessibilityDeclarationFieldList(), ", "))
 		}
 	}
 
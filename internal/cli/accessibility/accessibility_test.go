@@ -0,0 +1,56 @@
+package accessibility
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestNormalizeAccessibilityCreateDeviceFamilies(t *testing.T) {
+	families, err := normalizeAccessibilityCreateDeviceFamilies("iphone, IPAD,MAC,iPhone")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"IPHONE", "IPAD", "MAC"}; !reflect.DeepEqual(families, want) {
+		t.Fatalf("expected %v, got %v", want, families)
+	}
+
+	if _, err := normalizeAccessibilityCreateDeviceFamilies("IPHONE,TOASTER"); err == nil {
+		t.Fatal("expected error for unknown device family")
+	}
+}
+
+func TestCreateAccessibilityDeclarationsContinuesAfterFailure(t *testing.T) {
+	supported := true
+	attrs := asc.AccessibilityDeclarationCreateAttributes{SupportsVoiceover: &supported}
+
+	var created []asc.DeviceFamily
+	result := createAccessibilityDeclarations(context.Background(), "app-1", []string{"IPHONE", "IPAD", "MAC"}, attrs,
+		func(_ context.Context, attrs asc.AccessibilityDeclarationCreateAttributes) (string, error) {
+			if attrs.SupportsVoiceover == nil || !*attrs.SupportsVoiceover {
+				t.Fatalf("expected shared attributes, got %+v", attrs)
+			}
+			created = append(created, attrs.DeviceFamily)
+			if attrs.DeviceFamily == "IPAD" {
+				return "", errors.New("already exists")
+			}
+			return "decl-" + string(attrs.DeviceFamily), nil
+		},
+	)
+
+	if want := []asc.DeviceFamily{"IPHONE", "IPAD", "MAC"}; !reflect.DeepEqual(created, want) {
+		t.Fatalf("expected every family attempted in order, got %v", created)
+	}
+	if result.AppID != "app-1" || result.Total != 3 || result.Created != 2 || result.Failed != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	if got := result.Results[1]; got.Status != "failed" || got.Error != "already exists" || got.ID != "" {
+		t.Fatalf("unexpected failed item: %+v", got)
+	}
+	if got := result.Results[2]; got.Status != "created" || got.ID != "decl-MAC" {
+		t.Fatalf("unexpected created item: %+v", got)
+	}
+}
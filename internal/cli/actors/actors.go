@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -46,7 +45,7 @@ func ActorsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -63,14 +62,13 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("actors list: --limit must be between 1 and 200")
+				return usageErrorf("actors list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("actors list: %w", err)
 			}
 			if strings.TrimSpace(*ids) == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			fieldsValue, err := normalizeActorFields(*fields)
@@ -102,14 +100,13 @@ Examples:
 					return fmt.Errorf("actors list: failed to fetch: %w", err)
 				}
 
-				actors, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetActors(ctx, asc.WithActorsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("actors list: %w", err)
 				}
-
-				return printOutput(actors, *output, *pretty)
+				return nil
 			}
 
 			actors, err := client.GetActors(requestCtx, opts...)
@@ -128,7 +125,7 @@ func ActorsGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Actor ID")
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(actorFieldsList(), ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -145,8 +142,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			fieldsValue, err := normalizeActorFields(*fields)
@@ -184,7 +180,7 @@ func normalizeActorFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(actorFieldsList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(actorFieldsList(), ", "))
 		}
 	}
 
@@ -57,7 +57,7 @@ func AgeRatingGetCommand() *ffcli.Command {
 	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required unless --app-info-id or --version-id is provided)")
 	appInfoID := fs.String("app-info-id", "", "App info ID (optional)")
 	versionID := fs.String("version-id", "", "App Store version ID (optional)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -81,8 +81,7 @@ Examples:
 				return fmt.Errorf("age-rating get: only one of --app-info-id or --version-id is allowed")
 			}
 			if appInfoValue == "" && versionValue == "" && appValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -129,7 +128,7 @@ func AgeRatingSetCommand() *ffcli.Command {
 	unrestrictedWebAccess := fs.String("unrestricted-web-access", "", "Unrestricted web access (true/false)")
 	kidsAgeBand := fs.String("kids-age-band", "", "Kids age band: FIVE_AND_UNDER, SIX_TO_EIGHT, NINE_TO_ELEVEN")
 
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -154,8 +153,7 @@ Examples:
 					return fmt.Errorf("age-rating set: only one of --app-info-id or --version-id is allowed")
 				}
 				if appInfoValue == "" && versionValue == "" && appValue == "" {
-					fmt.Fprintln(os.Stderr, "Error: --id or --app is required (or set ASC_APP_ID)")
-					return flag.ErrHelp
+					return flagErrorf("--id or --app is required (or set ASC_APP_ID)")
 				}
 			}
 
@@ -252,7 +250,7 @@ func buildAgeRatingAttributes(values map[string]string) (asc.AgeRatingDeclaratio
 		return attrs, err
 	}
 	if strings.TrimSpace(values["seventeen-plus"]) != "" {
-		return attrs, fmt.Errorf("--seventeen-plus is not supported by the App Store Connect API")
+		return attrs, usageErrorf("--seventeen-plus is not supported by the App Store Connect API")
 	}
 	unrestrictedWebAccess, err := parseOptionalBoolFlag("--unrestricted-web-access", values["unrestricted-web-access"])
 	if err != nil {
@@ -32,3 +32,11 @@ func printOutput(data interface{}, format string, pretty bool) error {
 func parseOptionalBoolFlag(name, raw string) (*bool, error) {
 	return shared.ParseOptionalBoolFlag(name, raw)
 }
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -48,7 +47,7 @@ func AlternativeDistributionDomainsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -65,7 +64,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > alternativeDistributionMaxLimit) {
-				return fmt.Errorf("alternative-distribution domains list: --limit must be between 1 and %d", alternativeDistributionMaxLimit)
+				return usageErrorf("alternative-distribution domains list: --limit must be between 1 and %d", alternativeDistributionMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("alternative-distribution domains list: %w", err)
@@ -91,14 +90,13 @@ Examples:
 					return fmt.Errorf("alternative-distribution domains list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionDomains(ctx, asc.WithAlternativeDistributionDomainsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("alternative-distribution domains list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAlternativeDistributionDomains(requestCtx, opts...)
@@ -116,7 +114,7 @@ func AlternativeDistributionDomainsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	domainID := fs.String("domain-id", "", "Alternative distribution domain ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -132,8 +130,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*domainID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --domain-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--domain-id is required")
 			}
 
 			client, err := getASCClient()
@@ -160,7 +157,7 @@ func AlternativeDistributionDomainsCreateCommand() *ffcli.Command {
 
 	domain := fs.String("domain", "", "Domain name")
 	referenceName := fs.String("reference-name", "", "Reference name for the domain")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -176,14 +173,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			domainValue := strings.TrimSpace(*domain)
 			if domainValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --domain is required")
-				return flag.ErrHelp
+				return flagErrorf("--domain is required")
 			}
 
 			referenceValue := strings.TrimSpace(*referenceName)
 			if referenceValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --reference-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--reference-name is required")
 			}
 
 			client, err := getASCClient()
@@ -210,7 +205,7 @@ func AlternativeDistributionDomainsDeleteCommand() *ffcli.Command {
 
 	domainID := fs.String("domain-id", "", "Alternative distribution domain ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -226,12 +221,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*domainID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --domain-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--domain-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("alternative distribution domain %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("alternative-distribution domains delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -50,7 +49,7 @@ func AlternativeDistributionKeysListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -67,7 +66,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > alternativeDistributionMaxLimit) {
-				return fmt.Errorf("alternative-distribution keys list: --limit must be between 1 and %d", alternativeDistributionMaxLimit)
+				return usageErrorf("alternative-distribution keys list: --limit must be between 1 and %d", alternativeDistributionMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("alternative-distribution keys list: %w", err)
@@ -93,14 +92,13 @@ Examples:
 					return fmt.Errorf("alternative-distribution keys list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionKeys(ctx, asc.WithAlternativeDistributionKeysNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("alternative-distribution keys list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAlternativeDistributionKeys(requestCtx, opts...)
@@ -118,7 +116,7 @@ func AlternativeDistributionKeysGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	keyID := fs.String("key-id", "", "Alternative distribution key ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -134,8 +132,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*keyID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --key-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--key-id is required")
 			}
 
 			client, err := getASCClient()
@@ -163,7 +160,7 @@ func AlternativeDistributionKeysCreateCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	publicKey := fs.String("public-key", "", "Public key content")
 	publicKeyPath := fs.String("public-key-path", "", "Path to public key file")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -180,8 +177,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			keyValue := strings.TrimSpace(*publicKey)
@@ -190,8 +186,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution keys create: only one of --public-key or --public-key-path is allowed")
 			}
 			if keyValue == "" && keyPath == "" {
-				fmt.Fprintln(os.Stderr, "Error: --public-key or --public-key-path is required")
-				return flag.ErrHelp
+				return flagErrorf("--public-key or --public-key-path is required")
 			}
 			if keyValue == "" && keyPath != "" {
 				var err error
@@ -225,7 +220,7 @@ func AlternativeDistributionKeysDeleteCommand() *ffcli.Command {
 
 	keyID := fs.String("key-id", "", "Alternative distribution key ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -241,12 +236,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*keyID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --key-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--key-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("alternative distribution key %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("alternative-distribution keys delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -276,7 +275,7 @@ func AlternativeDistributionKeysAppCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -292,8 +291,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
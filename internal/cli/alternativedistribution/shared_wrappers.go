@@ -29,6 +29,22 @@ func printOutput(data interface{}, format string, pretty bool) error {
 	return shared.PrintOutput(data, format, pretty)
 }
 
+func paginateOutput(ctx context.Context, firstPage asc.PaginatedResponse, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	return shared.PaginateOutput(ctx, firstPage, fetchNext, format, pretty)
+}
+
 func validateNextURL(next string) error {
 	return shared.ValidateNextURL(next)
 }
+
+func confirmDestructive(resourceDescription string) (bool, error) {
+	return shared.ConfirmDestructive(resourceDescription)
+}
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
@@ -28,7 +28,7 @@ func normalizeSalesReportType(value string) (asc.SalesReportType, error) {
 	case string(asc.SalesReportTypeSubscriptionEvent):
 		return asc.SalesReportTypeSubscriptionEvent, nil
 	default:
-		return "", fmt.Errorf("--type must be SALES, PRE_ORDER, NEWSSTAND, SUBSCRIPTION, or SUBSCRIPTION_EVENT")
+		return "", usageErrorf("--type must be SALES, PRE_ORDER, NEWSSTAND, SUBSCRIPTION, or SUBSCRIPTION_EVENT")
 	}
 }
 
@@ -40,7 +40,7 @@ func normalizeSalesReportSubType(value string) (asc.SalesReportSubType, error) {
 	case string(asc.SalesReportSubTypeDetailed):
 		return asc.SalesReportSubTypeDetailed, nil
 	default:
-		return "", fmt.Errorf("--subtype must be SUMMARY or DETAILED")
+		return "", usageErrorf("--subtype must be SUMMARY or DETAILED")
 	}
 }
 
@@ -56,7 +56,7 @@ func normalizeSalesReportFrequency(value string) (asc.SalesReportFrequency, erro
 	case string(asc.SalesReportFrequencyYearly):
 		return asc.SalesReportFrequencyYearly, nil
 	default:
-		return "", fmt.Errorf("--frequency must be DAILY, WEEKLY, MONTHLY, or YEARLY")
+		return "", usageErrorf("--frequency must be DAILY, WEEKLY, MONTHLY, or YEARLY")
 	}
 }
 
@@ -71,7 +71,7 @@ func normalizeSalesReportVersion(value string) (asc.SalesReportVersion, error) {
 	case string(asc.SalesReportVersion1_1):
 		return asc.SalesReportVersion1_1, nil
 	default:
-		return "", fmt.Errorf("--version must be 1_0 or 1_1")
+		return "", usageErrorf("--version must be 1_0 or 1_1")
 	}
 }
 
@@ -83,7 +83,7 @@ func normalizeAnalyticsAccessType(value string) (asc.AnalyticsAccessType, error)
 	case string(asc.AnalyticsAccessTypeOneTimeSnapshot):
 		return asc.AnalyticsAccessTypeOneTimeSnapshot, nil
 	default:
-		return "", fmt.Errorf("--access-type must be ONGOING or ONE_TIME_SNAPSHOT")
+		return "", usageErrorf("--access-type must be ONGOING or ONE_TIME_SNAPSHOT")
 	}
 }
 
@@ -97,7 +97,7 @@ func normalizeAnalyticsRequestState(value string) (asc.AnalyticsReportRequestSta
 	case string(asc.AnalyticsReportRequestStateFailed):
 		return asc.AnalyticsReportRequestStateFailed, nil
 	default:
-		return "", fmt.Errorf("--state must be PROCESSING, COMPLETED, or FAILED")
+		return "", usageErrorf("--state must be PROCESSING, COMPLETED, or FAILED")
 	}
 }
 
@@ -114,25 +114,25 @@ func validateUUIDFlag(flagName, value string) error {
 func normalizeReportDate(value string, frequency asc.SalesReportFrequency) (string, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
-		return "", fmt.Errorf("--date is required")
+		return "", usageErrorf("--date is required")
 	}
 	switch frequency {
 	case asc.SalesReportFrequencyMonthly:
 		parsed, err := time.Parse("2006-01", trimmed)
 		if err != nil {
-			return "", fmt.Errorf("--date must be in YYYY-MM format for monthly reports")
+			return "", usageErrorf("--date must be in YYYY-MM format for monthly reports")
 		}
 		return parsed.Format("2006-01"), nil
 	case asc.SalesReportFrequencyYearly:
 		parsed, err := time.Parse("2006", trimmed)
 		if err != nil {
-			return "", fmt.Errorf("--date must be in YYYY format for yearly reports")
+			return "", usageErrorf("--date must be in YYYY format for yearly reports")
 		}
 		return parsed.Format("2006"), nil
 	default:
 		parsed, err := time.Parse("2006-01-02", trimmed)
 		if err != nil {
-			return "", fmt.Errorf("--date must be in YYYY-MM-DD format")
+			return "", usageErrorf("--date must be in YYYY-MM-DD format")
 		}
 		return parsed.Format("2006-01-02"), nil
 	}
@@ -145,7 +145,7 @@ func normalizeAnalyticsDateFilter(value string) (string, error) {
 	}
 	parsed, err := time.Parse("2006-01-02", trimmed)
 	if err != nil {
-		return "", fmt.Errorf("--date must be in YYYY-MM-DD format")
+		return "", usageErrorf("--date must be in YYYY-MM-DD format")
 	}
 	return parsed.Format("2006-01-02"), nil
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -19,7 +18,7 @@ func AnalyticsRequestCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	accessType := fs.String("access-type", "", "Access type: ONGOING or ONE_TIME_SNAPSHOT")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -36,12 +35,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if strings.TrimSpace(*accessType) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --access-type is required")
-				return flag.ErrHelp
+				return flagErrorf("--access-type is required")
 			}
 			normalizedAccessType, err := normalizeAnalyticsAccessType(*accessType)
 			if err != nil {
@@ -84,7 +81,7 @@ func AnalyticsRequestsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -103,7 +100,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > analyticsMaxLimit) {
-				return fmt.Errorf("analytics requests: --limit must be between 1 and 200")
+				return usageErrorf("analytics requests: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("analytics requests: %w", err)
@@ -125,8 +122,7 @@ Examples:
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" && strings.TrimSpace(*requestID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -165,14 +161,13 @@ Examples:
 					}
 
 					// Fetch all remaining pages
-					paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAnalyticsReportRequests(ctx, resolvedAppID, asc.WithAnalyticsReportRequestsNextURL(nextURL))
-					})
+					}, *output, *pretty)
 					if err != nil {
 						return fmt.Errorf("analytics requests: %w", err)
 					}
-
-					return printOutput(paginated, *output, *pretty)
+					return nil
 				}
 
 				response, err = client.GetAnalyticsReportRequests(requestCtx, resolvedAppID, opts...)
@@ -197,7 +192,7 @@ func AnalyticsGetCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Paginate all reports (recommended with --date)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -215,8 +210,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*requestID) == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --request-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--request-id is required")
 			}
 			if strings.TrimSpace(*requestID) != "" {
 				if err := validateUUIDFlag("--request-id", *requestID); err != nil {
@@ -229,7 +223,7 @@ Examples:
 				}
 			}
 			if *limit != 0 && (*limit < 1 || *limit > analyticsMaxLimit) {
-				return fmt.Errorf("analytics get: --limit must be between 1 and 200")
+				return usageErrorf("analytics get: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("analytics get: %w", err)
@@ -365,12 +359,10 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*requestID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --request-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--request-id is required")
 			}
 			if strings.TrimSpace(*instanceID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --instance-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--instance-id is required")
 			}
 			if err := validateUUIDFlag("--request-id", *requestID); err != nil {
 				return fmt.Errorf("analytics download: %w", err)
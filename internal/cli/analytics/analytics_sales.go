@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -44,24 +43,19 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			vendorNumber := shared.ResolveVendorNumber(*vendor)
 			if vendorNumber == "" {
-				fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER)")
-				return flag.ErrHelp
+				return flagErrorf("--vendor is required (or set ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER)")
 			}
 			if strings.TrimSpace(*reportType) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --type is required")
-				return flag.ErrHelp
+				return flagErrorf("--type is required")
 			}
 			if strings.TrimSpace(*reportSubType) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --subtype is required")
-				return flag.ErrHelp
+				return flagErrorf("--subtype is required")
 			}
 			if strings.TrimSpace(*frequency) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --frequency is required")
-				return flag.ErrHelp
+				return flagErrorf("--frequency is required")
 			}
 			if strings.TrimSpace(*date) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --date is required")
-				return flag.ErrHelp
+				return flagErrorf("--date is required")
 			}
 
 			salesType, err := normalizeSalesReportType(*reportType)
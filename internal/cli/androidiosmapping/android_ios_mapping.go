@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -52,7 +51,7 @@ func AndroidIosMappingListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -69,11 +68,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("android-ios-mapping list: --limit must be between 1 and 200")
+				return usageErrorf("android-ios-mapping list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("android-ios-mapping list: %w", err)
@@ -104,14 +102,13 @@ Examples:
 					return fmt.Errorf("android-ios-mapping list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAndroidToIosAppMappingDetails(ctx, resolvedAppID, asc.WithAndroidToIosAppMappingDetailsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("android-ios-mapping list: %w", err)
 				}
-
-				return printOutput(paginated, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAndroidToIosAppMappingDetails(requestCtx, resolvedAppID, opts...)
@@ -130,7 +127,7 @@ func AndroidIosMappingGetCommand() *ffcli.Command {
 
 	id := fs.String("mapping-id", "", "Mapping ID")
 	fields := fs.String("fields", "", "Fields to return (comma-separated: "+strings.Join(androidIosMappingFieldsList(), ", ")+")")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -145,8 +142,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*id) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --mapping-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--mapping-id is required")
 			}
 			fieldValues, err := normalizeAndroidIosMappingFields(*fields)
 			if err != nil {
@@ -180,7 +176,7 @@ func AndroidIosMappingCreateCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	packageName := fs.String("android-package-name", "", "Android package name (e.g., com.example.android)")
 	fingerprints := fs.String("fingerprints", "", "Signing key fingerprints (comma-separated)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -196,18 +192,15 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			packageValue := strings.TrimSpace(*packageName)
 			if packageValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --android-package-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--android-package-name is required")
 			}
 			fingerprintValues := splitCSV(*fingerprints)
 			if len(fingerprintValues) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --fingerprints is required")
-				return flag.ErrHelp
+				return flagErrorf("--fingerprints is required")
 			}
 
 			client, err := getASCClient()
@@ -240,7 +233,7 @@ func AndroidIosMappingUpdateCommand() *ffcli.Command {
 	fingerprints := fs.String("fingerprints", "", "Signing key fingerprints (comma-separated)")
 	clearPackageName := fs.Bool("clear-android-package-name", false, "Clear the Android package name")
 	clearFingerprints := fs.Bool("clear-fingerprints", false, "Clear signing key fingerprints")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -259,8 +252,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*id)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --mapping-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--mapping-id is required")
 			}
 
 			seen := map[string]bool{}
@@ -271,17 +263,17 @@ Examples:
 				return fmt.Errorf("android-ios-mapping update: at least one update flag is required")
 			}
 			if seen["android-package-name"] && *clearPackageName {
-				return fmt.Errorf("android-ios-mapping update: --android-package-name cannot be used with --clear-android-package-name")
+				return usageErrorf("android-ios-mapping update: --android-package-name cannot be used with --clear-android-package-name")
 			}
 			if seen["fingerprints"] && *clearFingerprints {
-				return fmt.Errorf("android-ios-mapping update: --fingerprints cannot be used with --clear-fingerprints")
+				return usageErrorf("android-ios-mapping update: --fingerprints cannot be used with --clear-fingerprints")
 			}
 
 			var attrs asc.AndroidToIosAppMappingDetailUpdateAttributes
 			if seen["android-package-name"] {
 				packageValue := strings.TrimSpace(*packageName)
 				if packageValue == "" {
-					return fmt.Errorf("android-ios-mapping update: --android-package-name cannot be empty")
+					return usageErrorf("android-ios-mapping update: --android-package-name cannot be empty")
 				}
 				attrs.PackageName = &asc.NullableString{Value: &packageValue}
 			}
@@ -291,7 +283,7 @@ Examples:
 			if seen["fingerprints"] {
 				fingerprintValues := splitCSV(*fingerprints)
 				if len(fingerprintValues) == 0 {
-					return fmt.Errorf("android-ios-mapping update: --fingerprints must include at least one value")
+					return usageErrorf("android-ios-mapping update: --fingerprints must include at least one value")
 				}
 				attrs.AppSigningKeyPublicCertificateSha256Fingerprints = &asc.NullableStringSlice{Value: fingerprintValues}
 			}
@@ -323,7 +315,7 @@ func AndroidIosMappingDeleteCommand() *ffcli.Command {
 
 	id := fs.String("mapping-id", "", "Mapping ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -339,12 +331,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*id)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --mapping-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--mapping-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("Android-to-iOS app mapping %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("android-ios-mapping delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -388,7 +384,7 @@ func normalizeAndroidIosMappingFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(androidIosMappingFieldsList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(androidIosMappingFieldsList(), ", "))
 		}
 	}
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -55,7 +54,7 @@ func AppEventsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -71,7 +70,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("app-events list: --limit must be between 1 and 200")
+				return usageErrorf("app-events list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("app-events list: %w", err)
@@ -79,8 +78,7 @@ Examples:
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -103,13 +101,13 @@ Examples:
 					return fmt.Errorf("app-events list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEvents(ctx, resolvedAppID, asc.WithAppEventsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("app-events list: %w", err)
 				}
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppEvents(requestCtx, resolvedAppID, opts...)
@@ -127,7 +125,7 @@ func AppEventsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	eventID := fs.String("event-id", "", "App event ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -143,8 +141,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*eventID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --event-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--event-id is required")
 			}
 
 			client, err := getASCClient()
@@ -181,7 +178,7 @@ func AppEventsCreateCommand() *ffcli.Command {
 	primaryLocale := fs.String("primary-locale", "", "Primary locale (e.g., en-US)")
 	priority := fs.String("priority", "", "Priority: "+strings.Join(asc.ValidAppEventPriorities, ", "))
 	purpose := fs.String("purpose", "", "Purpose: "+strings.Join(asc.ValidAppEventPurposes, ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -198,32 +195,27 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			nameValue := strings.TrimSpace(*name)
 			if nameValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return flagErrorf("--name is required")
 			}
 
 			normalizedBadge, err := normalizeAppEventBadge(*eventType, true)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err.Error())
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			normalizedPriority, err := normalizeAppEventPriority(*priority)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err.Error())
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			normalizedPurpose, err := normalizeAppEventPurpose(*purpose)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err.Error())
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			scheduleProvided := strings.TrimSpace(*start) != "" ||
@@ -235,18 +227,15 @@ Examples:
 			if scheduleProvided {
 				startValue, err := normalizeRFC3339(*start, "--start", true)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				endValue, err := normalizeRFC3339(*end, "--end", true)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				publishValue, err := normalizeRFC3339(*publishStart, "--publish-start", false)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				territoryValues := splitCSVUpper(*territories)
 				schedule := buildAppEventTerritorySchedule(territoryValues, publishValue, startValue, endValue)
@@ -298,7 +287,7 @@ func AppEventsUpdateCommand() *ffcli.Command {
 	primaryLocale := fs.String("primary-locale", "", "Primary locale (e.g., en-US)")
 	priority := fs.String("priority", "", "Priority: "+strings.Join(asc.ValidAppEventPriorities, ", "))
 	purpose := fs.String("purpose", "", "Purpose: "+strings.Join(asc.ValidAppEventPurposes, ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -315,8 +304,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*eventID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --event-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--event-id is required")
 			}
 
 			var (
@@ -333,8 +321,7 @@ Examples:
 			if strings.TrimSpace(*eventType) != "" {
 				normalized, err := normalizeAppEventBadge(*eventType, false)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				if normalized != "" {
 					attrs.Badge = &normalized
@@ -363,8 +350,7 @@ Examples:
 			if strings.TrimSpace(*priority) != "" {
 				normalized, err := normalizeAppEventPriority(*priority)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				if normalized != "" {
 					attrs.Priority = &normalized
@@ -375,8 +361,7 @@ Examples:
 			if strings.TrimSpace(*purpose) != "" {
 				normalized, err := normalizeAppEventPurpose(*purpose)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				if normalized != "" {
 					attrs.Purpose = &normalized
@@ -391,18 +376,15 @@ Examples:
 			if scheduleProvided {
 				startValue, err := normalizeRFC3339(*start, "--start", true)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				endValue, err := normalizeRFC3339(*end, "--end", true)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				publishValue, err := normalizeRFC3339(*publishStart, "--publish-start", false)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				territoryValues := splitCSVUpper(*territories)
 				schedule := buildAppEventTerritorySchedule(territoryValues, publishValue, startValue, endValue)
@@ -411,8 +393,7 @@ Examples:
 			}
 
 			if !hasUpdate {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			client, err := getASCClient()
@@ -439,7 +420,7 @@ func AppEventsDeleteCommand() *ffcli.Command {
 
 	eventID := fs.String("event-id", "", "App event ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -455,12 +436,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*eventID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --event-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--event-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("in-app event %q", id))
+				if err != nil {
+					return fmt.Errorf("app-events delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
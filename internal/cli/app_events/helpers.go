@@ -17,7 +17,7 @@ func normalizeAppEventBadge(value string, required bool) (string, error) {
 	normalized := strings.ToUpper(strings.TrimSpace(value))
 	if normalized == "" {
 		if required {
-			return "", fmt.Errorf("--event-type is required")
+			return "", usageErrorf("--event-type is required")
 		}
 		return "", nil
 	}
@@ -26,7 +26,7 @@ func normalizeAppEventBadge(value string, required bool) (string, error) {
 			return normalized, nil
 		}
 	}
-	return "", fmt.Errorf("--event-type must be one of: %s", strings.Join(asc.ValidAppEventBadges, ", "))
+	return "", usageErrorf("--event-type must be one of: %s", strings.Join(asc.ValidAppEventBadges, ", "))
 }
 
 func normalizeAppEventPriority(value string) (string, error) {
@@ -39,7 +39,7 @@ func normalizeAppEventPriority(value string) (string, error) {
 			return normalized, nil
 		}
 	}
-	return "", fmt.Errorf("--priority must be one of: %s", strings.Join(asc.ValidAppEventPriorities, ", "))
+	return "", usageErrorf("--priority must be one of: %s", strings.Join(asc.ValidAppEventPriorities, ", "))
 }
 
 func normalizeAppEventPurpose(value string) (string, error) {
@@ -52,20 +52,20 @@ func normalizeAppEventPurpose(value string) (string, error) {
 			return normalized, nil
 		}
 	}
-	return "", fmt.Errorf("--purpose must be one of: %s", strings.Join(asc.ValidAppEventPurposes, ", "))
+	return "", usageErrorf("--purpose must be one of: %s", strings.Join(asc.ValidAppEventPurposes, ", "))
 }
 
 func normalizeAppEventAssetType(value string) (string, error) {
 	normalized := strings.ToUpper(strings.TrimSpace(value))
 	if normalized == "" {
-		return "", fmt.Errorf("--asset-type is required")
+		return "", usageErrorf("--asset-type is required")
 	}
 	for _, option := range asc.ValidAppEventAssetTypes {
 		if normalized == option {
 			return normalized, nil
 		}
 	}
-	return "", fmt.Errorf("--asset-type must be one of: %s", strings.Join(asc.ValidAppEventAssetTypes, ", "))
+	return "", usageErrorf("--asset-type must be one of: %s", strings.Join(asc.ValidAppEventAssetTypes, ", "))
 }
 
 func normalizeRFC3339(value, flagName string, required bool) (string, error) {
@@ -104,7 +104,7 @@ func resolveAppEventLocalizationID(ctx context.Context, client *asc.Client, even
 	}
 	eventID = strings.TrimSpace(eventID)
 	if eventID == "" {
-		return "", fmt.Errorf("--event-id is required")
+		return "", usageErrorf("--event-id is required")
 	}
 	locale = strings.TrimSpace(locale)
 	if locale == "" {
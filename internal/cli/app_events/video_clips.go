@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -49,7 +48,7 @@ func AppEventVideoClipsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -66,14 +65,13 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("app-events video-clips list: --limit must be between 1 and 200")
+				return usageErrorf("app-events video-clips list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("app-events video-clips list: %w", err)
 			}
 			if strings.TrimSpace(*next) == "" && strings.TrimSpace(*localizationID) == "" && strings.TrimSpace(*eventID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --event-id or --localization-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--event-id or --localization-id is required")
 			}
 
 			client, err := getASCClient()
@@ -88,8 +86,7 @@ Examples:
 			if strings.TrimSpace(*next) == "" {
 				resolvedLocalizationID, err = resolveAppEventLocalizationID(requestCtx, client, *eventID, resolvedLocalizationID, *locale)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 			}
 
@@ -105,14 +102,13 @@ Examples:
 					return fmt.Errorf("app-events video-clips list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEventVideoClips(ctx, resolvedLocalizationID, asc.WithAppEventVideoClipsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("app-events video-clips list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppEventVideoClips(requestCtx, resolvedLocalizationID, opts...)
@@ -130,7 +126,7 @@ func AppEventVideoClipsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("video-clips get", flag.ExitOnError)
 
 	clipID := fs.String("clip-id", "", "App event video clip ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -146,8 +142,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*clipID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --clip-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--clip-id is required")
 			}
 
 			client, err := getASCClient()
@@ -178,7 +173,7 @@ func AppEventVideoClipsCreateCommand() *ffcli.Command {
 	path := fs.String("path", "", "Path to video clip file")
 	assetType := fs.String("asset-type", "", "Asset type: "+strings.Join(asc.ValidAppEventAssetTypes, ", "))
 	previewFrame := fs.String("preview-frame-time-code", "", "Preview frame time code (e.g., 00:00:05.000)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -195,18 +190,15 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			pathValue := strings.TrimSpace(*path)
 			if pathValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --path is required")
-				return flag.ErrHelp
+				return flagErrorf("--path is required")
 			}
 			if strings.TrimSpace(*localizationID) == "" && strings.TrimSpace(*eventID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --event-id or --localization-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--event-id or --localization-id is required")
 			}
 
 			normalizedAssetType, err := normalizeAppEventAssetType(*assetType)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err.Error())
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			client, err := getASCClient()
@@ -219,8 +211,7 @@ Examples:
 
 			resolvedLocalizationID, err := resolveAppEventLocalizationID(requestCtx, client, *eventID, *localizationID, *locale)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err.Error())
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			file, info, err := openAssetFile(pathValue)
@@ -269,7 +260,7 @@ func AppEventVideoClipsDeleteCommand() *ffcli.Command {
 
 	clipID := fs.String("clip-id", "", "App event video clip ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -285,12 +276,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*clipID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --clip-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--clip-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("in-app event video clip %q", id))
+				if err != nil {
+					return fmt.Errorf("app-events video-clips delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
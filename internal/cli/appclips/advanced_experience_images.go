@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -43,7 +42,7 @@ func AppClipAdvancedExperienceImagesCreateCommand() *ffcli.Command {
 
 	experienceID := fs.String("experience-id", "", "Advanced experience ID")
 	filePath := fs.String("file", "", "Path to image file (PNG)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -62,14 +61,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			experienceValue := strings.TrimSpace(*experienceID)
 			if experienceValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --experience-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--experience-id is required")
 			}
 
 			fileValue := strings.TrimSpace(*filePath)
 			if fileValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
 			client, err := getASCClient()
@@ -101,7 +98,7 @@ func AppClipAdvancedExperienceImagesDeleteCommand() *ffcli.Command {
 
 	imageID := fs.String("id", "", "Image ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -117,12 +114,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*imageID)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required to delete")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("advanced experience image %q", idValue))
+				if err != nil {
+					return fmt.Errorf("app-clips advanced-experiences images delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required to delete")
+				}
 			}
 
 			client, err := getASCClient()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -52,7 +51,7 @@ func AppClipAdvancedExperiencesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -68,7 +67,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("app-clips advanced-experiences list: --limit must be between 1 and 200")
+				return usageErrorf("app-clips advanced-experiences list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("app-clips advanced-experiences list: %w", err)
@@ -81,8 +80,7 @@ Examples:
 
 			appClipValue := strings.TrimSpace(*appClipID)
 			if appClipValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app-clip-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--app-clip-id is required")
 			}
 
 			client, err := getASCClient()
@@ -112,14 +110,13 @@ Examples:
 					return fmt.Errorf("app-clips advanced-experiences list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppClipAdvancedExperiences(ctx, appClipValue, asc.WithAppClipAdvancedExperiencesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("app-clips advanced-experiences list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppClipAdvancedExperiences(requestCtx, appClipValue, opts...)
@@ -141,7 +138,7 @@ func AppClipAdvancedExperiencesGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	experienceID := fs.String("experience-id", "", "Advanced experience ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -157,8 +154,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*experienceID)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --experience-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--experience-id is required")
 			}
 
 			client, err := getASCClient()
@@ -193,7 +189,7 @@ func AppClipAdvancedExperiencesCreateCommand() *ffcli.Command {
 	category := fs.String("category", "", "Business category")
 	headerImageID := fs.String("header-image-id", "", "Header image ID")
 	localizationIDs := fs.String("localization-id", "", "Localization ID(s), comma-separated")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -210,13 +206,11 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			linkValue := strings.TrimSpace(*link)
 			if linkValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --link is required")
-				return flag.ErrHelp
+				return flagErrorf("--link is required")
 			}
 
 			if strings.TrimSpace(*defaultLanguage) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --default-language is required")
-				return flag.ErrHelp
+				return flagErrorf("--default-language is required")
 			}
 
 			langValue, err := normalizeAppClipLanguage(*defaultLanguage)
@@ -229,8 +223,7 @@ Examples:
 				visited[f.Name] = true
 			})
 			if !visited["is-powered-by"] {
-				fmt.Fprintln(os.Stderr, "Error: --is-powered-by is required")
-				return flag.ErrHelp
+				return flagErrorf("--is-powered-by is required")
 			}
 
 			var actionValue *asc.AppClipAction
@@ -263,12 +256,10 @@ Examples:
 			bundleValue := strings.TrimSpace(*bundleID)
 			appValue := strings.TrimSpace(resolveAppID(*appID))
 			if appClipValue == "" && bundleValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app-clip-id or --bundle-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--app-clip-id or --bundle-id is required")
 			}
 			if appClipValue == "" && appValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required with --bundle-id")
-				return flag.ErrHelp
+				return flagErrorf("--app is required with --bundle-id")
 			}
 
 			appClipValue, err = resolveAppClipID(requestCtx, client, appValue, appClipValue, bundleValue)
@@ -307,7 +298,7 @@ func AppClipAdvancedExperiencesUpdateCommand() *ffcli.Command {
 	removed := fs.Bool("removed", false, "Mark the experience as removed")
 	headerImageID := fs.String("header-image-id", "", "Header image ID")
 	localizationIDs := fs.String("localization-id", "", "Localization ID(s), comma-separated")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -324,8 +315,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			experienceValue := strings.TrimSpace(*experienceID)
 			if experienceValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --experience-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--experience-id is required")
 			}
 
 			visited := map[string]bool{}
@@ -335,8 +325,7 @@ Examples:
 
 			hasUpdate := visited["action"] || visited["category"] || visited["default-language"] || visited["is-powered-by"] || visited["removed"] || visited["header-image-id"] || visited["localization-id"] || visited["app-clip-id"]
 			if !hasUpdate {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			var attrs *asc.AppClipAdvancedExperienceUpdateAttributes
@@ -398,7 +387,7 @@ func AppClipAdvancedExperiencesDeleteCommand() *ffcli.Command {
 
 	experienceID := fs.String("experience-id", "", "Advanced experience ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -414,12 +403,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			experienceValue := strings.TrimSpace(*experienceID)
 			if experienceValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --experience-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--experience-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required to delete")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("advanced experience %q", experienceValue))
+				if err != nil {
+					return fmt.Errorf("app-clips advanced-experiences delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required to delete")
+				}
 			}
 
 			client, err := getASCClient()
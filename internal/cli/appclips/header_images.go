@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -43,7 +42,7 @@ func AppClipHeaderImagesCreateCommand() *ffcli.Command {
 
 	localizationID := fs.String("localization-id", "", "Default experience localization ID")
 	filePath := fs.String("file", "", "Path to image file (PNG)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -61,14 +60,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			locValue := strings.TrimSpace(*localizationID)
 			if locValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --localization-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--localization-id is required")
 			}
 
 			fileValue := strings.TrimSpace(*filePath)
 			if fileValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
 			client, err := getASCClient()
@@ -95,7 +92,7 @@ func AppClipHeaderImagesDeleteCommand() *ffcli.Command {
 
 	imageID := fs.String("id", "", "Header image ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -111,12 +108,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*imageID)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required to delete")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("header image %q", idValue))
+				if err != nil {
+					return fmt.Errorf("app-clips header-images delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required to delete")
+				}
 			}
 
 			client, err := getASCClient()
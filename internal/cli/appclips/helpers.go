@@ -135,10 +135,10 @@ func resolveAppClipID(ctx context.Context, client *asc.Client, appID string, app
 
 	bundle := strings.TrimSpace(bundleID)
 	if bundle == "" {
-		return "", fmt.Errorf("--app-clip-id or --bundle-id is required")
+		return "", usageErrorf("--app-clip-id or --bundle-id is required")
 	}
 	if strings.TrimSpace(appID) == "" {
-		return "", fmt.Errorf("--app is required with --bundle-id")
+		return "", usageErrorf("--app is required with --bundle-id")
 	}
 
 	resp, err := client.GetAppClips(ctx, appID, asc.WithAppClipsBundleIDs([]string{bundle}), asc.WithAppClipsLimit(200))
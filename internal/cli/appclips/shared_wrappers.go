@@ -33,6 +33,10 @@ func printOutput(data interface{}, format string, pretty bool) error {
 	return shared.PrintOutput(data, format, pretty)
 }
 
+func paginateOutput(ctx context.Context, firstPage asc.PaginatedResponse, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	return shared.PaginateOutput(ctx, firstPage, fetchNext, format, pretty)
+}
+
 func validateNextURL(next string) error {
 	return shared.ValidateNextURL(next)
 }
@@ -44,3 +48,15 @@ func splitCSV(value string) []string {
 func splitCSVUpper(value string) []string {
 	return shared.SplitCSVUpper(value)
 }
+
+func confirmDestructive(resourceDescription string) (bool, error) {
+	return shared.ConfirmDestructive(resourceDescription)
+}
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
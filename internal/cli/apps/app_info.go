@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"sort"
 	"strings"
 	"time"
@@ -54,7 +53,7 @@ func AppInfoGetCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -75,19 +74,18 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("app-info get: --limit must be between 1 and 200")
+				return usageErrorf("app-info get: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("app-info get: %w", err)
 			}
 			if strings.TrimSpace(*version) != "" && strings.TrimSpace(*versionID) != "" {
-				return fmt.Errorf("app-info get: --version and --version-id are mutually exclusive")
+				return usageErrorf("app-info get: --version and --version-id are mutually exclusive")
 			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if strings.TrimSpace(*versionID) == "" && resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			platforms, err := shared.NormalizeAppStoreVersionPlatforms(splitCSVUpper(*platform))
@@ -99,8 +97,7 @@ Examples:
 				return fmt.Errorf("app-info get: %w", err)
 			}
 			if strings.TrimSpace(*version) != "" && len(platforms) != 1 {
-				fmt.Fprintln(os.Stderr, "Error: --platform is required with --version")
-				return flag.ErrHelp
+				return flagErrorf("--platform is required with --version")
 			}
 
 			client, err := getASCClient()
@@ -140,13 +137,13 @@ Examples:
 					return fmt.Errorf("app-info get: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionLocalizations(ctx, versionResource.ID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("app-info get: %w", err)
 				}
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppStoreVersionLocalizations(requestCtx, versionResource.ID, opts...)
@@ -175,7 +172,7 @@ func AppInfoSetCommand() *ffcli.Command {
 	marketingURL := fs.String("marketing-url", "", "Marketing URL")
 	promotionalText := fs.String("promotional-text", "", "Promotional text")
 	whatsNew := fs.String("whats-new", "", "What's New text")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -191,13 +188,12 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*version) != "" && strings.TrimSpace(*versionID) != "" {
-				return fmt.Errorf("app-info set: --version and --version-id are mutually exclusive")
+				return usageErrorf("app-info set: --version and --version-id are mutually exclusive")
 			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if strings.TrimSpace(*versionID) == "" && resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			platforms, err := shared.NormalizeAppStoreVersionPlatforms(splitCSVUpper(*platform))
@@ -209,14 +205,12 @@ Examples:
 				return fmt.Errorf("app-info set: %w", err)
 			}
 			if strings.TrimSpace(*version) != "" && len(platforms) != 1 {
-				fmt.Fprintln(os.Stderr, "Error: --platform is required with --version")
-				return flag.ErrHelp
+				return flagErrorf("--platform is required with --version")
 			}
 
 			localeValue := strings.TrimSpace(*locale)
 			if localeValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --locale is required")
-				return flag.ErrHelp
+				return flagErrorf("--locale is required")
 			}
 			if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
 				return fmt.Errorf("app-info set: %w", err)
@@ -234,8 +228,7 @@ Examples:
 				marketingURLValue == "" &&
 				promotionalTextValue == "" &&
 				whatsNewValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			client, err := getASCClient()
@@ -333,7 +326,7 @@ func resolveAppStoreVersionForAppInfo(
 
 	if strings.TrimSpace(version) != "" {
 		if len(platforms) != 1 {
-			return asc.Resource[asc.AppStoreVersionAttributes]{}, fmt.Errorf("--platform is required with --version")
+			return asc.Resource[asc.AppStoreVersionAttributes]{}, usageErrorf("--platform is required with --version")
 		}
 		resolvedVersionID, err := shared.ResolveAppStoreVersionID(ctx, client, appID, strings.TrimSpace(version), platforms[0])
 		if err != nil {
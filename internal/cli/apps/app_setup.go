@@ -80,7 +80,7 @@ func AppSetupInfoSetCommand() *ffcli.Command {
 	privacyPolicyURL := fs.String("privacy-policy-url", "", "Localized privacy policy URL")
 	privacyChoicesURL := fs.String("privacy-choices-url", "", "Localized privacy choices URL")
 	privacyPolicyText := fs.String("privacy-policy-text", "", "Localized privacy policy text")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -98,8 +98,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			appIDValue := strings.TrimSpace(*appID)
 			if appIDValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required")
-				return flag.ErrHelp
+				return flagErrorf("--app is required")
 			}
 
 			bundleIDValue := strings.TrimSpace(*bundleID)
@@ -124,8 +123,7 @@ Examples:
 				privacyPolicyTextValue != ""
 
 			if !hasAppUpdate && !hasLocalization {
-				fmt.Fprintln(os.Stderr, "Error: provide at least one update flag")
-				return flag.ErrHelp
+				return flagErrorf("provide at least one update flag")
 			}
 			if primaryLocaleValue != "" {
 				if err := shared.ValidateBuildLocalizationLocale(primaryLocaleValue); err != nil {
@@ -133,8 +131,7 @@ Examples:
 				}
 			}
 			if hasLocalization && localeValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --locale is required for app info localization updates")
-				return flag.ErrHelp
+				return flagErrorf("--locale is required for app info localization updates")
 			}
 			if localeValue != "" {
 				if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
@@ -373,7 +370,7 @@ func AppSetupLocalizationsUploadCommand() *ffcli.Command {
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
 	path := fs.String("path", "", "Input path (directory or .strings file)")
 	dryRun := fs.Bool("dry-run", false, "Validate file without uploading")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -391,8 +388,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*path) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --path is required")
-				return flag.ErrHelp
+				return flagErrorf("--path is required")
 			}
 
 			normalizedType, err := shared.NormalizeLocalizationType(*locType)
@@ -405,8 +401,7 @@ Examples:
 			switch normalizedType {
 			case shared.LocalizationTypeVersion:
 				if strings.TrimSpace(*versionID) == "" {
-					fmt.Fprintln(os.Stderr, "Error: --version is required for version localizations")
-					return flag.ErrHelp
+					return flagErrorf("--version is required for version localizations")
 				}
 
 				client, err := getASCClient()
@@ -438,8 +433,7 @@ Examples:
 			case shared.LocalizationTypeAppInfo:
 				resolvedAppID := resolveAppID(*appID)
 				if resolvedAppID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
-					return flag.ErrHelp
+					return flagErrorf("--app is required for app-info localizations")
 				}
 
 				client, err := getASCClient()
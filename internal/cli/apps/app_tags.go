@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -60,7 +59,7 @@ func AppTagsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -80,7 +79,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("app-tags list: --limit must be between 1 and 200")
+				return usageErrorf("app-tags list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("app-tags list: %w", err)
@@ -89,7 +88,7 @@ Examples:
 				return fmt.Errorf("app-tags list: %w", err)
 			}
 			if *territoryLimit != 0 && (*territoryLimit < 1 || *territoryLimit > 50) {
-				return fmt.Errorf("app-tags list: --territory-limit must be between 1 and 50")
+				return usageErrorf("app-tags list: --territory-limit must be between 1 and 50")
 			}
 
 			visibleValues, err := normalizeAppTagVisibilityFilter(*visible)
@@ -113,18 +112,15 @@ Examples:
 			}
 
 			if len(territoryFieldsValue) > 0 && !shared.HasInclude(includeValues, "territories") {
-				fmt.Fprintf(os.Stderr, "Error: --territory-fields requires --include territories\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--territory-fields requires --include territories")
 			}
 			if *territoryLimit != 0 && !shared.HasInclude(includeValues, "territories") {
-				fmt.Fprintf(os.Stderr, "Error: --territory-limit requires --include territories\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--territory-limit requires --include territories")
 			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -163,14 +159,13 @@ Examples:
 					return fmt.Errorf("app-tags list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppTags(ctx, resolvedAppID, asc.WithAppTagsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("app-tags list: %w", err)
 				}
-
-				return printOutput(paginated, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppTags(requestCtx, resolvedAppID, opts...)
@@ -193,7 +188,7 @@ func AppTagsGetCommand() *ffcli.Command {
 	include := fs.String("include", "", "Include related resources: territories")
 	territoryFields := fs.String("territory-fields", "", "Territory fields to include: currency")
 	territoryLimit := fs.Int("territory-limit", 0, "Maximum territories per tag when including territories (1-50)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -211,18 +206,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*tagID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			if *territoryLimit != 0 && (*territoryLimit < 1 || *territoryLimit > 50) {
-				return fmt.Errorf("app-tags get: --territory-limit must be between 1 and 50")
+				return usageErrorf("app-tags get: --territory-limit must be between 1 and 50")
 			}
 
 			fieldsValue, err := normalizeAppTagFields(*fields)
@@ -242,12 +235,10 @@ Examples:
 
 			includeTerritories := shared.HasInclude(includeValues, "territories")
 			if len(territoryFieldsValue) > 0 && !includeTerritories {
-				fmt.Fprintf(os.Stderr, "Error: --territory-fields requires --include territories\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--territory-fields requires --include territories")
 			}
 			if *territoryLimit != 0 && !includeTerritories {
-				fmt.Fprintf(os.Stderr, "Error: --territory-limit requires --include territories\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--territory-limit requires --include territories")
 			}
 
 			client, err := getASCClient()
@@ -313,7 +304,7 @@ func AppTagsUpdateCommand() *ffcli.Command {
 	tagID := fs.String("id", "", "App tag ID")
 	visibleInAppStore := fs.Bool("visible-in-app-store", false, "Set visibility in the App Store")
 	confirm := fs.Bool("confirm", false, "Confirm update")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -322,6 +313,9 @@ func AppTagsUpdateCommand() *ffcli.Command {
 		ShortHelp:  "Update an app tag.",
 		LongHelp: `Update an app tag.
 
+Only visibility can be changed. App Store Connect does not allow renaming
+app tags; the update endpoint accepts visibleInAppStore alone.
+
 Examples:
   asc app-tags update --id "TAG_ID" --visible-in-app-store --confirm
   asc app-tags update --id "TAG_ID" --visible-in-app-store=false --confirm`,
@@ -330,8 +324,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*tagID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			visited := map[string]bool{}
@@ -339,12 +332,10 @@ Examples:
 				visited[f.Name] = true
 			})
 			if !visited["visible-in-app-store"] {
-				fmt.Fprintln(os.Stderr, "Error: --visible-in-app-store is required")
-				return flag.ErrHelp
+				return flagErrorf("--visible-in-app-store is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				return flagErrorf("--confirm is required")
 			}
 
 			client, err := getASCClient()
@@ -379,7 +370,7 @@ func AppTagsTerritoriesCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -397,11 +388,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*tagID)
 			if trimmedID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("app-tags territories: --limit must be between 1 and 200")
+				return usageErrorf("app-tags territories: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("app-tags territories: %w", err)
@@ -435,14 +425,13 @@ Examples:
 					return fmt.Errorf("app-tags territories: failed to fetch: %w", err)
 				}
 
-				territories, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppTagTerritories(ctx, trimmedID, asc.WithTerritoriesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("app-tags territories: %w", err)
 				}
-
-				return printOutput(territories, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppTagTerritories(requestCtx, trimmedID, opts...)
@@ -463,7 +452,7 @@ func AppTagsTerritoriesRelationshipsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -480,11 +469,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*tagID)
 			if trimmedID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("app-tags territories-relationships: --limit must be between 1 and 200")
+				return usageErrorf("app-tags territories-relationships: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("app-tags territories-relationships: %w", err)
@@ -510,14 +498,13 @@ Examples:
 					return fmt.Errorf("app-tags territories-relationships: failed to fetch: %w", err)
 				}
 
-				linkages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppTagTerritoriesRelationships(ctx, trimmedID, asc.WithLinkagesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("app-tags territories-relationships: %w", err)
 				}
-
-				return printOutput(linkages, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppTagTerritoriesRelationships(requestCtx, trimmedID, opts...)
@@ -538,7 +525,7 @@ func AppTagsRelationshipsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -554,7 +541,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("app-tags relationships: --limit must be between 1 and 200")
+				return usageErrorf("app-tags relationships: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("app-tags relationships: %w", err)
@@ -562,8 +549,7 @@ Examples:
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -586,14 +572,13 @@ Examples:
 					return fmt.Errorf("app-tags relationships: failed to fetch: %w", err)
 				}
 
-				linkages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppTagsRelationshipsForApp(ctx, resolvedAppID, asc.WithLinkagesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("app-tags relationships: %w", err)
 				}
-
-				return printOutput(linkages, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppTagsRelationshipsForApp(requestCtx, resolvedAppID, opts...)
@@ -619,7 +604,7 @@ func normalizeAppTagVisibilityFilter(value string) ([]string, error) {
 		case "true", "false":
 			normalized = append(normalized, lower)
 		default:
-			return nil, fmt.Errorf("--visible-in-app-store must be true or false")
+			return nil, usageErrorf("--visible-in-app-store must be true or false")
 		}
 	}
 
@@ -638,7 +623,7 @@ func normalizeAppTagFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(appTagFieldsList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(appTagFieldsList(), ", "))
 		}
 	}
 
@@ -657,7 +642,7 @@ func normalizeAppTagInclude(value string) ([]string, error) {
 	}
 	for _, include := range values {
 		if _, ok := allowed[include]; !ok {
-			return nil, fmt.Errorf("--include must be one of: %s", strings.Join(appTagIncludeList(), ", "))
+			return nil, usageErrorf("--include must be one of: %s", strings.Join(appTagIncludeList(), ", "))
 		}
 	}
 
@@ -676,7 +661,7 @@ func normalizeTerritoryFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--territory-fields must be one of: %s", strings.Join(territoryFieldsList(), ", "))
+			return nil, usageErrorf("--territory-fields must be one of: %s", strings.Join(territoryFieldsList(), ", "))
 		}
 	}
 
@@ -4,7 +4,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
+	"slices"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -12,10 +12,11 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
-func appsListFlags(fs *flag.FlagSet) (output *string, pretty *bool, bundleID *string, name *string, sku *string, sort *string, limit *int, next *string, paginate *bool) {
-	output = fs.String("output", "json", "Output format: json (default), table, markdown")
+func appsListFlags(fs *flag.FlagSet) (output *string, pretty *bool, bundleID *string, bundleIDPrefix *string, name *string, sku *string, sort *string, limit *int, next *string, paginate *bool) {
+	output = fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty = fs.Bool("pretty", false, "Pretty-print JSON output")
 	bundleID = fs.String("bundle-id", "", "Filter by bundle ID(s), comma-separated")
+	bundleIDPrefix = fs.String("bundle-id-prefix", "", "Keep only apps whose bundle ID starts with this prefix (filtered locally)")
 	name = fs.String("name", "", "Filter by app name(s), comma-separated")
 	sku = fs.String("sku", "", "Filter by SKU(s), comma-separated")
 	sort = fs.String("sort", "", "Sort by name, -name, bundleId, or -bundleId")
@@ -29,7 +30,7 @@ func appsListFlags(fs *flag.FlagSet) (output *string, pretty *bool, bundleID *st
 func AppsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps", flag.ExitOnError)
 
-	output, pretty, bundleID, name, sku, sort, limit, next, paginate := appsListFlags(fs)
+	output, pretty, bundleID, bundleIDPrefix, name, sku, sort, limit, next, paginate := appsListFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "apps",
@@ -56,7 +57,7 @@ Examples:
 			AppsUpdateCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			return appsList(ctx, *output, *pretty, *bundleID, *name, *sku, *sort, *limit, *next, *paginate)
+			return appsList(ctx, *output, *pretty, *bundleID, *bundleIDPrefix, *name, *sku, *sort, *limit, *next, *paginate)
 		},
 	}
 }
@@ -65,7 +66,7 @@ Examples:
 func AppsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps list", flag.ExitOnError)
 
-	output, pretty, bundleID, name, sku, sort, limit, next, paginate := appsListFlags(fs)
+	output, pretty, bundleID, bundleIDPrefix, name, sku, sort, limit, next, paginate := appsListFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -73,9 +74,14 @@ func AppsListCommand() *ffcli.Command {
 		ShortHelp:  "List apps from App Store Connect.",
 		LongHelp: `List apps from App Store Connect.
 
+--bundle-id is an exact-match filter applied by App Store Connect.
+--bundle-id-prefix is applied locally after fetching, so it only sees the
+apps on the fetched page; combine it with --paginate to filter all apps.
+
 Examples:
   asc apps list
   asc apps list --bundle-id "com.example.app"
+  asc apps list --bundle-id-prefix "com.example." --paginate
   asc apps list --name "My App"
   asc apps list --limit 10
   asc apps list --sort name
@@ -85,7 +91,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return appsList(ctx, *output, *pretty, *bundleID, *name, *sku, *sort, *limit, *next, *paginate)
+			return appsList(ctx, *output, *pretty, *bundleID, *bundleIDPrefix, *name, *sku, *sort, *limit, *next, *paginate)
 		},
 	}
 }
@@ -95,7 +101,8 @@ func AppsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps get", flag.ExitOnError)
 
 	id := fs.String("id", "", "App Store Connect app ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(appIncludeList(), ", "))
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -104,16 +111,25 @@ func AppsGetCommand() *ffcli.Command {
 		ShortHelp:  "Get app details by ID.",
 		LongHelp: `Get app details by ID.
 
+Use --include to fetch related resources alongside the app. Included
+resources are returned under "included"; table and markdown output show a
+count per included type.
+
 Examples:
   asc apps get --id "APP_ID"
-  asc apps get --id "APP_ID" --output table`,
+  asc apps get --id "APP_ID" --output table
+  asc apps get --id "APP_ID" --include appInfos,builds,appStoreVersions`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
+			}
+
+			includeValues, err := normalizeAppInclude(*include)
+			if err != nil {
+				return flagErrorf("%v", err)
 			}
 
 			client, err := getASCClient()
@@ -124,7 +140,12 @@ Examples:
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
-			app, err := client.GetApp(requestCtx, idValue)
+			var opts []asc.AppOption
+			if len(includeValues) > 0 {
+				opts = append(opts, asc.WithAppInclude(includeValues))
+			}
+
+			app, err := client.GetApp(requestCtx, idValue, opts...)
 			if err != nil {
 				return fmt.Errorf("apps get: failed to fetch: %w", err)
 			}
@@ -134,6 +155,25 @@ Examples:
 	}
 }
 
+func appIncludeList() []string {
+	return []string{"appInfos", "builds", "appStoreVersions"}
+}
+
+func normalizeAppInclude(value string) ([]string, error) {
+	values := splitCSV(value)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	allowed := appIncludeList()
+	for _, include := range values {
+		if !slices.Contains(allowed, include) {
+			return nil, usageErrorf("--include must be one of: %s", strings.Join(allowed, ", "))
+		}
+	}
+	return values, nil
+}
+
 // AppsUpdateCommand returns the apps update subcommand.
 func AppsUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps update", flag.ExitOnError)
@@ -141,7 +181,7 @@ func AppsUpdateCommand() *ffcli.Command {
 	id := fs.String("id", "", "App Store Connect app ID")
 	bundleID := fs.String("bundle-id", "", "Update bundle ID")
 	primaryLocale := fs.String("primary-locale", "", "Update primary locale (e.g., en-US)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -158,8 +198,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			attrs := asc.AppUpdateAttributes{}
@@ -170,8 +209,7 @@ Examples:
 				attrs.PrimaryLocale = &localeValue
 			}
 			if attrs.BundleID == nil && attrs.PrimaryLocale == nil {
-				fmt.Fprintln(os.Stderr, "Error: --bundle-id or --primary-locale is required")
-				return flag.ErrHelp
+				return flagErrorf("--bundle-id or --primary-locale is required")
 			}
 
 			client, err := getASCClient()
@@ -192,9 +230,9 @@ Examples:
 	}
 }
 
-func appsList(ctx context.Context, output string, pretty bool, bundleID string, name string, sku string, sort string, limit int, next string, paginate bool) error {
+func appsList(ctx context.Context, output string, pretty bool, bundleID string, bundleIDPrefix string, name string, sku string, sort string, limit int, next string, paginate bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
-		return fmt.Errorf("apps: --limit must be between 1 and 200")
+		return usageErrorf("apps: --limit must be between 1 and 200")
 	}
 	if err := validateNextURL(next); err != nil {
 		return fmt.Errorf("apps: %w", err)
@@ -230,14 +268,28 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 			return fmt.Errorf("apps: failed to fetch: %w", err)
 		}
 
-		// Fetch all remaining pages
-		apps, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		fetchNext := func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
-		})
+		}
+		// Without a local filter, pages can be printed as they arrive.
+		if strings.TrimSpace(bundleIDPrefix) == "" {
+			if err := paginateOutput(requestCtx, firstPage, fetchNext, output, pretty); err != nil {
+				return fmt.Errorf("apps: %w", err)
+			}
+			return nil
+		}
+
+		// Fetch all remaining pages
+		all, err := asc.PaginateAll(requestCtx, firstPage, fetchNext)
 		if err != nil {
 			return fmt.Errorf("apps: %w", err)
 		}
+		apps, ok := all.(*asc.AppsResponse)
+		if !ok {
+			return fmt.Errorf("apps: unexpected response type %T", all)
+		}
 
+		filterAppsByBundleIDPrefix(apps, bundleIDPrefix)
 		return printOutput(apps, output, pretty)
 	}
 
@@ -246,5 +298,22 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 		return fmt.Errorf("apps: failed to fetch: %w", err)
 	}
 
+	filterAppsByBundleIDPrefix(apps, bundleIDPrefix)
 	return printOutput(apps, output, pretty)
 }
+
+// filterAppsByBundleIDPrefix keeps the apps whose bundle ID starts with
+// prefix. The API only supports exact bundle ID filters.
+func filterAppsByBundleIDPrefix(resp *asc.AppsResponse, prefix string) {
+	prefix = strings.TrimSpace(prefix)
+	if resp == nil || prefix == "" {
+		return
+	}
+	filtered := resp.Data[:0]
+	for _, app := range resp.Data {
+		if strings.HasPrefix(app.Attributes.BundleID, prefix) {
+			filtered = append(filtered, app)
+		}
+	}
+	resp.Data = filtered
+}
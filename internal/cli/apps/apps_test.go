@@ -0,0 +1,32 @@
+package apps
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestFilterAppsByBundleIDPrefix(t *testing.T) {
+	resp := &asc.AppsResponse{
+		Data: []asc.Resource[asc.AppAttributes]{
+			{ID: "1", Attributes: asc.AppAttributes{BundleID: "com.example.app"}},
+			{ID: "2", Attributes: asc.AppAttributes{BundleID: "com.other.app"}},
+			{ID: "3", Attributes: asc.AppAttributes{BundleID: "com.example.widget"}},
+		},
+	}
+
+	filterAppsByBundleIDPrefix(resp, " com.example. ")
+	if len(resp.Data) != 2 || resp.Data[0].ID != "1" || resp.Data[1].ID != "3" {
+		t.Fatalf("unexpected filtered apps: %+v", resp.Data)
+	}
+
+	filterAppsByBundleIDPrefix(resp, "")
+	if len(resp.Data) != 2 {
+		t.Fatalf("expected empty prefix to keep all apps, got %d", len(resp.Data))
+	}
+
+	filterAppsByBundleIDPrefix(resp, "COM.EXAMPLE.")
+	if len(resp.Data) != 0 {
+		t.Fatalf("expected prefix match to be case-sensitive, got %+v", resp.Data)
+	}
+}
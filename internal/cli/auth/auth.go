@@ -51,6 +51,7 @@ Use --strict-auth or ASC_STRICT_AUTH=1 to fail when sources are mixed.`,
 			AuthInitCommand(),
 			AuthLoginCommand(),
 			AuthSwitchCommand(),
+			AuthRotateKeyCommand(),
 			AuthLogoutCommand(),
 			AuthDoctorCommand(),
 			AuthStatusCommand(),
@@ -164,10 +165,10 @@ Examples:
 				return fmt.Errorf("auth doctor: unsupported format: %s", *output)
 			}
 			if normalizedOutput != "json" && *pretty {
-				return fmt.Errorf("--pretty is only valid with JSON output")
+				return shared.UsageErrorf("--pretty is only valid with JSON output")
 			}
 			if *fix && !*confirm {
-				return fmt.Errorf("auth doctor: --fix requires --confirm")
+				return shared.UsageErrorf("auth doctor: --fix requires --confirm")
 			}
 
 			report := authsvc.Doctor(authsvc.DoctorOptions{Fix: *fix && *confirm})
@@ -311,7 +312,7 @@ func loginStorageMessage(bypassKeychain, local bool) (string, error) {
 	if keychainAvailable {
 		return "Storing credentials in system keychain", nil
 	}
-	path, err := config.Path()
+	path, err := config.WritePath()
 	if err != nil {
 		return "", err
 	}
@@ -354,26 +355,22 @@ The private key file path is stored securely. The key content is never saved.`,
 		Exec: func(ctx context.Context, args []string) error {
 			bypassKeychainEnabled := *bypassKeychain || authsvc.ShouldBypassKeychain()
 			if *local && !bypassKeychainEnabled {
-				return fmt.Errorf("auth login: --local requires --bypass-keychain or ASC_BYPASS_KEYCHAIN=1")
+				return shared.UsageErrorf("auth login: --local requires --bypass-keychain or ASC_BYPASS_KEYCHAIN=1")
 			}
 			if *name == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return shared.FlagErrorf("--name is required")
 			}
 			if *keyID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --key-id is required")
-				return flag.ErrHelp
+				return shared.FlagErrorf("--key-id is required")
 			}
 			if *issuerID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --issuer-id is required")
-				return flag.ErrHelp
+				return shared.FlagErrorf("--issuer-id is required")
 			}
 			if *keyPath == "" {
-				fmt.Fprintln(os.Stderr, "Error: --private-key is required")
-				return flag.ErrHelp
+				return shared.FlagErrorf("--private-key is required")
 			}
 			if *skipValidation && *network {
-				return fmt.Errorf("auth login: --skip-validation and --network are mutually exclusive")
+				return shared.UsageErrorf("auth login: --skip-validation and --network are mutually exclusive")
 			}
 
 			// Validate the key file exists and is parseable
@@ -442,15 +439,14 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedName := strings.TrimSpace(*name)
 			if trimmedName == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return shared.FlagErrorf("--name is required")
 			}
 
 			credentials, err := authsvc.ListCredentials()
 			if err != nil {
 				var warning *authsvc.CredentialsWarning
 				if errors.As(err, &warning) {
-					fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+					shared.Warnf("%s", warning)
 				} else {
 					return fmt.Errorf("auth switch: failed to list credentials: %w", err)
 				}
@@ -501,10 +497,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedName := strings.TrimSpace(*name)
 			if trimmedName == "" && *name != "" {
-				return fmt.Errorf("auth logout: --name cannot be blank")
+				return shared.UsageErrorf("auth logout: --name cannot be blank")
 			}
 			if trimmedName != "" && *all {
-				return fmt.Errorf("auth logout: --all and --name are mutually exclusive")
+				return shared.UsageErrorf("auth logout: --all and --name are mutually exclusive")
 			}
 
 			if trimmedName != "" {
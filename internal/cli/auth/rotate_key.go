@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	authsvc "github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// AuthRotateKey command factory
+func AuthRotateKeyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("auth rotate-key", flag.ExitOnError)
+
+	oldKeyID := fs.String("old", "", "Key ID currently stored in the config")
+	newKeyID := fs.String("new", "", "Key ID of the replacement key")
+	newKeyPath := fs.String("new-key-path", "", "Path to the replacement private key (.p8) file")
+
+	return &ffcli.Command{
+		Name:       "rotate-key",
+		ShortUsage: "asc auth rotate-key --old KEY_ID --new KEY_ID --new-key-path NEW.p8",
+		ShortHelp:  "Switch stored config credentials to a new API key.",
+		LongHelp: `Switch stored config credentials to a new API key.
+
+The new key is validated first: a JWT is signed with it and a lightweight API
+request is made using the issuer ID of the credential being rotated. Only then
+is every config credential using --old updated to --new and --new-key-path.
+Credentials using --old must share one issuer ID. A timestamped backup of the
+previous config is written next to it.
+
+The old key is not revoked in App Store Connect; revoke it manually once the
+new key is in use. Credentials stored in the system keychain are not changed;
+use 'asc auth login' with the same --name to replace those.
+
+Examples:
+  asc auth rotate-key --old "OLDKEY123" --new "NEWKEY456" --new-key-path ./AuthKey_NEWKEY456.p8`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			oldID := strings.TrimSpace(*oldKeyID)
+			newID := strings.TrimSpace(*newKeyID)
+			keyPath := strings.TrimSpace(*newKeyPath)
+			if oldID == "" {
+				return shared.FlagErrorf("--old is required")
+			}
+			if newID == "" {
+				return shared.FlagErrorf("--new is required")
+			}
+			if keyPath == "" {
+				return shared.FlagErrorf("--new-key-path is required")
+			}
+			if oldID == newID {
+				return shared.UsageErrorf("auth rotate-key: --old and --new must be different keys")
+			}
+
+			if err := authsvc.ValidateKeyFile(keyPath); err != nil {
+				return fmt.Errorf("auth rotate-key: invalid private key: %w", err)
+			}
+
+			configPath, err := rotateKeyConfigPath(oldID)
+			if err != nil {
+				return fmt.Errorf("auth rotate-key: %w", err)
+			}
+
+			result, err := rotateConfigKey(ctx, configPath, oldID, newID, keyPath, time.Now())
+			if err != nil {
+				return fmt.Errorf("auth rotate-key: %w", err)
+			}
+
+			return asc.PrintJSON(result)
+		},
+	}
+}
+
+// rotateKeyResult reports a completed key rotation.
+type rotateKeyResult struct {
+	ConfigPath string   `json:"config_path"`
+	BackupPath string   `json:"backup_path"`
+	OldKeyID   string   `json:"old_key_id"`
+	NewKeyID   string   `json:"new_key_id"`
+	Profiles   []string `json:"profiles"`
+	Validated  bool     `json:"validated"`
+}
+
+// rotateKeyConfigPath finds the config file holding the credential for keyID.
+func rotateKeyConfigPath(keyID string) (string, error) {
+	credentials, err := authsvc.ListCredentials()
+	if err != nil {
+		var warning *authsvc.CredentialsWarning
+		if !errors.As(err, &warning) {
+			return "", fmt.Errorf("failed to list credentials: %w", err)
+		}
+		shared.Warnf("%s", warning)
+	}
+
+	var keychainName string
+	for _, cred := range credentials {
+		if strings.TrimSpace(cred.KeyID) != keyID {
+			continue
+		}
+		if cred.Source == "config" && strings.TrimSpace(cred.SourcePath) != "" {
+			return cred.SourcePath, nil
+		}
+		if keychainName == "" {
+			keychainName = cred.Name
+		}
+	}
+	if keychainName != "" {
+		return "", fmt.Errorf("key %s is stored in the system keychain as %q; replace it with 'asc auth login --name %q'", keyID, keychainName, keychainName)
+	}
+	return "", fmt.Errorf("key %s not found in stored credentials", keyID)
+}
+
+// rotateConfigKey validates the new key against the rotated credentials'
+// issuer, then backs up and rewrites the config at configPath. The config is
+// left untouched when validation fails or the credentials using oldKeyID do
+// not share one issuer ID, since a key belongs to a single issuer.
+func rotateConfigKey(ctx context.Context, configPath, oldKeyID, newKeyID, newKeyPath string, now time.Time) (*rotateKeyResult, error) {
+	cfg, err := config.LoadAt(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := config.RotateKey(cfg, oldKeyID, newKeyID, newKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	issuerID := ""
+	profiles := make([]string, 0, len(previous))
+	for _, cred := range previous {
+		if issuer := strings.TrimSpace(cred.IssuerID); issuer != "" {
+			if issuerID != "" && issuer != issuerID {
+				return nil, fmt.Errorf("credentials using key %s have different issuer IDs (%s, %s); fix the config before rotating", oldKeyID, issuerID, issuer)
+			}
+			issuerID = issuer
+		}
+		if name := strings.TrimSpace(cred.Name); name != "" {
+			profiles = append(profiles, name)
+		}
+	}
+	if issuerID == "" {
+		return nil, fmt.Errorf("no issuer ID stored for key %s", oldKeyID)
+	}
+
+	if err := validateLoginCredentials(ctx, newKeyID, issuerID, newKeyPath, true); err != nil {
+		return nil, fmt.Errorf("new key validation failed; config unchanged: %w", err)
+	}
+
+	backupPath, err := config.BackupAt(configPath, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.SaveAt(configPath, cfg); err != nil {
+		return nil, fmt.Errorf("%w (previous config kept at %s)", err, backupPath)
+	}
+
+	return &rotateKeyResult{
+		ConfigPath: configPath,
+		BackupPath: backupPath,
+		OldKeyID:   oldKeyID,
+		NewKeyID:   newKeyID,
+		Profiles:   profiles,
+		Validated:  true,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"errors"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	authsvc "github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
 )
 
@@ -41,3 +42,27 @@ func SetLoginJWTGenerator(fn func(string, string, *ecdsa.PrivateKey) (string, er
 		loginJWTGenerator = previous
 	}
 }
+
+// SetLoginNetworkValidate replaces the network validation hook for tests.
+// It returns a restore function to reset the previous handler.
+func SetLoginNetworkValidate(fn func(context.Context, string, string, string) error) func() {
+	previous := loginNetworkValidate
+	if fn != nil {
+		loginNetworkValidate = fn
+	}
+	return func() {
+		loginNetworkValidate = previous
+	}
+}
+
+// SetWhoamiFetchApps replaces the whoami apps request for tests.
+// It returns a restore function to reset the previous handler.
+func SetWhoamiFetchApps(fn func(context.Context, string, string, string) (*asc.AppsResponse, error)) func() {
+	previous := whoamiFetchApps
+	if fn != nil {
+		whoamiFetchApps = fn
+	}
+	return func() {
+		whoamiFetchApps = previous
+	}
+}
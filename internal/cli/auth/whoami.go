@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	authsvc "github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var whoamiFetchApps = fetchWhoamiApps
+
+// whoamiResult describes the credentials API commands authenticate with.
+type whoamiResult struct {
+	KeyID    string     `json:"key_id"`
+	IssuerID string     `json:"issuer_id"`
+	Profile  string     `json:"profile,omitempty"`
+	Verified bool       `json:"verified"`
+	FirstApp *whoamiApp `json:"first_app,omitempty"`
+	Warning  string     `json:"warning,omitempty"`
+}
+
+type whoamiApp struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	BundleID string `json:"bundle_id,omitempty"`
+}
+
+// WhoamiCommand returns the top-level whoami command.
+func WhoamiCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("whoami", flag.ExitOnError)
+
+	output := fs.String("output", "text", "Output format: text (default), json")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "whoami",
+		ShortUsage: "asc whoami [flags]",
+		ShortHelp:  "Show which API key commands authenticate with.",
+		LongHelp: `Show which API key commands authenticate with.
+
+Resolves credentials the same way every API command does, signs a token with
+the private key, and prints the key ID and issuer ID decoded from it. A single
+apps request (GET /v1/apps?limit=1) then confirms App Store Connect accepts
+the token; the first app returned is shown as a sanity check.
+
+Examples:
+  asc whoami
+  asc whoami --output json
+  asc --profile "client" whoami`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			normalizedOutput := strings.ToLower(strings.TrimSpace(*output))
+			if normalizedOutput != "text" && normalizedOutput != "json" {
+				return fmt.Errorf("whoami: unsupported format: %s", *output)
+			}
+			if normalizedOutput != "json" && *pretty {
+				return shared.UsageErrorf("--pretty is only valid with JSON output")
+			}
+
+			keyID, issuerID, keyPath, err := shared.ResolveCredentials()
+			if err != nil {
+				return fmt.Errorf("whoami: %w", err)
+			}
+			if err := authsvc.ValidateKeyFile(keyPath); err != nil {
+				return fmt.Errorf("whoami: invalid private key %s: %w", keyPath, err)
+			}
+			privateKey, err := authsvc.LoadPrivateKey(keyPath)
+			if err != nil {
+				return fmt.Errorf("whoami: failed to load private key %s: %w", keyPath, err)
+			}
+			token, err := asc.GenerateJWT(keyID, issuerID, privateKey)
+			if err != nil {
+				return fmt.Errorf("whoami: failed to generate JWT: %w", err)
+			}
+			tokenKeyID, tokenIssuerID, err := asc.TokenIdentity(token)
+			if err != nil {
+				return fmt.Errorf("whoami: %w", err)
+			}
+
+			result := &whoamiResult{
+				KeyID:    tokenKeyID,
+				IssuerID: tokenIssuerID,
+				Profile:  shared.ResolveProfileName(),
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			apps, err := whoamiFetchApps(requestCtx, keyID, issuerID, keyPath)
+			switch {
+			case errors.Is(err, asc.ErrForbidden):
+				result.Verified = true
+				result.Warning = "key works but cannot list apps (insufficient permissions)"
+			case errors.Is(err, asc.ErrUnauthorized):
+				return fmt.Errorf("whoami: App Store Connect rejected the token for key %s (issuer %s); check that the key is active and the issuer ID matches: %w", tokenKeyID, tokenIssuerID, err)
+			case err != nil:
+				return fmt.Errorf("whoami: %w", err)
+			default:
+				result.Verified = true
+				if apps != nil && len(apps.Data) > 0 {
+					app := apps.Data[0]
+					result.FirstApp = &whoamiApp{ID: app.ID, Name: app.Attributes.Name, BundleID: app.Attributes.BundleID}
+				}
+			}
+
+			if normalizedOutput == "json" {
+				return shared.PrintOutput(result, "json", *pretty)
+			}
+			printWhoami(result)
+			return nil
+		},
+	}
+}
+
+func fetchWhoamiApps(ctx context.Context, keyID, issuerID, keyPath string) (*asc.AppsResponse, error) {
+	client, err := asc.NewClient(keyID, issuerID, keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetApps(ctx, asc.WithAppsLimit(1))
+}
+
+func printWhoami(result *whoamiResult) {
+	fmt.Printf("Key ID: %s\n", result.KeyID)
+	fmt.Printf("Issuer ID: %s\n", result.IssuerID)
+	if result.Profile != "" {
+		fmt.Printf("Profile: %s\n", result.Profile)
+	}
+	switch {
+	case result.Warning != "":
+		fmt.Printf("Warning: %s\n", result.Warning)
+	case result.FirstApp != nil:
+		fmt.Printf("First app: %s (%s)\n", result.FirstApp.Name, result.FirstApp.ID)
+	default:
+		fmt.Println("First app: none (no apps visible to this key)")
+	}
+}
@@ -24,7 +24,7 @@ func normalizeBackgroundAssetUploadFileAssetType(value string) (asc.BackgroundAs
 	case string(asc.BackgroundAssetUploadFileAssetTypeManifest):
 		return asc.BackgroundAssetUploadFileAssetTypeManifest, nil
 	default:
-		return "", fmt.Errorf("--asset-type must be one of: %s", strings.Join(backgroundAssetUploadFileAssetTypeValues, ", "))
+		return "", usageErrorf("--asset-type must be one of: %s", strings.Join(backgroundAssetUploadFileAssetTypeValues, ", "))
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 // BackgroundAssetsUploadFilesCommand returns the upload files command group.
@@ -50,7 +51,7 @@ func BackgroundAssetsUploadFilesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -67,11 +68,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			versionIDValue := strings.TrimSpace(*versionID)
 			if versionIDValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--version-id is required")
 			}
 			if *limit != 0 && (*limit < 1 || *limit > backgroundAssetsMaxLimit) {
-				return fmt.Errorf("background-assets upload-files list: --limit must be between 1 and %d", backgroundAssetsMaxLimit)
+				return usageErrorf("background-assets upload-files list: --limit must be between 1 and %d", backgroundAssetsMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("background-assets upload-files list: %w", err)
@@ -97,14 +97,13 @@ Examples:
 					return fmt.Errorf("background-assets upload-files list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBackgroundAssetUploadFiles(ctx, versionIDValue, asc.WithBackgroundAssetUploadFilesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("background-assets upload-files list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetBackgroundAssetUploadFiles(requestCtx, versionIDValue, opts...)
@@ -122,7 +121,7 @@ func BackgroundAssetsUploadFilesGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	uploadFileID := fs.String("upload-file-id", "", "Background asset upload file ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -138,8 +137,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			uploadFileIDValue := strings.TrimSpace(*uploadFileID)
 			if uploadFileIDValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --upload-file-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--upload-file-id is required")
 			}
 
 			client, err := getASCClient()
@@ -168,7 +166,7 @@ func BackgroundAssetsUploadFilesCreateCommand() *ffcli.Command {
 	filePath := fs.String("file", "", "Path to upload file")
 	assetType := fs.String("asset-type", "", "Asset type: "+strings.Join(backgroundAssetUploadFileAssetTypeValues, ", "))
 	checksum := fs.Bool("checksum", false, "Verify source file checksums before committing")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -185,19 +183,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			versionIDValue := strings.TrimSpace(*versionID)
 			if versionIDValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--version-id is required")
 			}
 
 			pathValue := strings.TrimSpace(*filePath)
 			if pathValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
 			if strings.TrimSpace(*assetType) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --asset-type is required")
-				return flag.ErrHelp
+				return flagErrorf("--asset-type is required")
 			}
 
 			typeValue, err := normalizeBackgroundAssetUploadFileAssetType(*assetType)
@@ -246,7 +241,7 @@ Examples:
 			sourceChecksums := resp.Data.Attributes.SourceFileChecksums
 			if *checksum {
 				if sourceChecksums == nil || (sourceChecksums.File == nil && sourceChecksums.Composite == nil) {
-					fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
+					shared.Warnf("--checksum requested but API provided no checksums to verify; skipping")
 				} else {
 					computed, err := asc.VerifySourceFileChecksums(pathValue, sourceChecksums)
 					if err != nil {
@@ -284,7 +279,7 @@ func BackgroundAssetsUploadFilesUpdateCommand() *ffcli.Command {
 	uploaded := fs.String("uploaded", "", "Mark upload as complete (true/false)")
 	filePath := fs.String("file", "", "Path to file for checksum verification")
 	checksum := fs.Bool("checksum", false, "Verify source file checksums before committing")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -301,14 +296,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			uploadFileIDValue := strings.TrimSpace(*uploadFileID)
 			if uploadFileIDValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --upload-file-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--upload-file-id is required")
 			}
 
 			uploadedValue := strings.TrimSpace(*uploaded)
 			if uploadedValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --uploaded is required")
-				return flag.ErrHelp
+				return flagErrorf("--uploaded is required")
 			}
 			uploadedBool, err := parseBool(uploadedValue, "--uploaded")
 			if err != nil {
@@ -317,8 +310,7 @@ Examples:
 
 			pathValue := strings.TrimSpace(*filePath)
 			if *checksum && pathValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --checksum requires --file")
-				return flag.ErrHelp
+				return flagErrorf("--checksum requires --file")
 			}
 
 			if pathValue != "" {
@@ -355,7 +347,7 @@ Examples:
 				sourceChecksums := resp.Data.Attributes.SourceFileChecksums
 				if *checksum {
 					if sourceChecksums == nil || (sourceChecksums.File == nil && sourceChecksums.Composite == nil) {
-						fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
+						shared.Warnf("--checksum requested but API provided no checksums to verify; skipping")
 					} else {
 						computed, err := asc.VerifySourceFileChecksums(pathValue, sourceChecksums)
 						if err != nil {
@@ -18,7 +18,7 @@ func BuildsAddGroupsCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	groups := fs.String("group", "", "Comma-separated beta group IDs")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -35,14 +35,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedBuildID := strings.TrimSpace(*buildID)
 			if trimmedBuildID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --build is required")
-				return flag.ErrHelp
+				return flagErrorf("--build is required")
 			}
 
 			groupIDs := parseCommaSeparatedIDs(*groups)
 			if len(groupIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --group is required")
-				return flag.ErrHelp
+				return flagErrorf("--group is required")
 			}
 
 			client, err := getASCClient()
@@ -75,7 +73,7 @@ func BuildsRemoveGroupsCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	groups := fs.String("group", "", "Comma-separated beta group IDs")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -92,14 +90,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedBuildID := strings.TrimSpace(*buildID)
 			if trimmedBuildID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --build is required")
-				return flag.ErrHelp
+				return flagErrorf("--build is required")
 			}
 
 			groupIDs := parseCommaSeparatedIDs(*groups)
 			if len(groupIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --group is required")
-				return flag.ErrHelp
+				return flagErrorf("--group is required")
 			}
 
 			client, err := getASCClient()
@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -32,7 +33,7 @@ func BuildsUploadCommand() *ffcli.Command {
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
 	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and --test-notes")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -55,12 +56,10 @@ Examples:
 			// Validate required flags
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if *ipaPath == "" {
-				fmt.Fprintf(os.Stderr, "Error: --ipa is required\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--ipa is required")
 			}
 
 			// Validate IPA file exists
@@ -69,7 +68,7 @@ Examples:
 				return fmt.Errorf("builds upload: failed to stat IPA: %w", err)
 			}
 			if fileInfo.IsDir() {
-				return fmt.Errorf("builds upload: --ipa must be a file")
+				return usageErrorf("builds upload: --ipa must be a file")
 			}
 
 			// Validate platform
@@ -77,42 +76,40 @@ Examples:
 			switch platformValue {
 			case asc.PlatformIOS, asc.PlatformMacOS, asc.PlatformTVOS, asc.PlatformVisionOS:
 			default:
-				return fmt.Errorf("builds upload: --platform must be IOS, MAC_OS, TV_OS, or VISION_OS")
+				return usageErrorf("builds upload: --platform must be IOS, MAC_OS, TV_OS, or VISION_OS")
 			}
 			if *dryRun {
 				if *concurrency != 1 {
-					return fmt.Errorf("builds upload: --concurrency is not supported with --dry-run")
+					return usageErrorf("builds upload: --concurrency is not supported with --dry-run")
 				}
 				if *verifyChecksum {
-					return fmt.Errorf("builds upload: --checksum is not supported with --dry-run")
+					return usageErrorf("builds upload: --checksum is not supported with --dry-run")
 				}
 				if *wait {
-					return fmt.Errorf("builds upload: --wait is not supported with --dry-run")
+					return usageErrorf("builds upload: --wait is not supported with --dry-run")
 				}
 			} else if *concurrency < 1 {
-				return fmt.Errorf("builds upload: --concurrency must be at least 1")
+				return usageErrorf("builds upload: --concurrency must be at least 1")
 			}
 
 			testNotesValue := strings.TrimSpace(*testNotes)
 			localeValue := strings.TrimSpace(*locale)
 			if testNotesValue != "" && localeValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --locale is required with --test-notes")
-				return flag.ErrHelp
+				return flagErrorf("--locale is required with --test-notes")
 			}
 			if testNotesValue == "" && localeValue != "" {
-				fmt.Fprintln(os.Stderr, "Error: --test-notes is required with --locale")
-				return flag.ErrHelp
+				return flagErrorf("--test-notes is required with --locale")
 			}
 			if testNotesValue != "" {
 				if *dryRun {
-					return fmt.Errorf("builds upload: --test-notes is not supported with --dry-run")
+					return usageErrorf("builds upload: --test-notes is not supported with --dry-run")
 				}
 				if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
 					return fmt.Errorf("builds upload: %w", err)
 				}
 			}
 			if (*wait || testNotesValue != "") && *pollInterval <= 0 {
-				return fmt.Errorf("builds upload: --poll-interval must be greater than 0")
+				return usageErrorf("builds upload: --poll-interval must be greater than 0")
 			}
 
 			versionValue := strings.TrimSpace(*version)
@@ -236,7 +233,7 @@ Examples:
 				if *verifyChecksum {
 					src := fileResp.Data.Attributes.SourceFileChecksums
 					if src == nil || (src.File == nil && src.Composite == nil) {
-						fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
+						shared.Warnf("--checksum requested but API provided no checksums to verify; skipping")
 					} else {
 						checksums, err := asc.VerifySourceFileChecksums(*ipaPath, src)
 						if err != nil {
@@ -352,7 +349,9 @@ func BuildsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	version := fs.String("version", "", "Filter by version string (e.g., 1.2.3)")
+	processingState := fs.String("processing-state", "", "Filter by processing state(s), comma-separated: "+strings.Join(buildProcessingStateList, ", "))
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	sort := fs.String("sort", "", "Sort by uploadedDate or -uploadedDate")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -371,12 +370,14 @@ including processing status and expiration dates.
 Examples:
   asc builds list --app "123456789"
   asc builds list --app "123456789" --limit 10
+  asc builds list --app "123456789" --processing-state PROCESSING,FAILED
+  asc builds list --app "123456789" --version "1.2.3" --processing-state VALID --output table
   asc builds list --app "123456789" --paginate`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("builds: --limit must be between 1 and 200")
+				return usageErrorf("builds: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("builds: %w", err)
@@ -384,11 +385,14 @@ Examples:
 			if err := validateSort(*sort, "uploadedDate", "-uploadedDate"); err != nil {
 				return fmt.Errorf("builds: %w", err)
 			}
+			processingStates, err := normalizeBuildProcessingStates(splitCSVUpper(*processingState))
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -402,6 +406,9 @@ Examples:
 			opts := []asc.BuildsOption{
 				asc.WithBuildsLimit(*limit),
 				asc.WithBuildsNextURL(*next),
+				asc.WithBuildsVersion(*version),
+				asc.WithBuildsProcessingStates(processingStates),
+				asc.WithBuildsInclude([]string{"preReleaseVersion"}),
 			}
 			if strings.TrimSpace(*sort) != "" {
 				opts = append(opts, asc.WithBuildsSort(*sort))
@@ -416,15 +423,13 @@ Examples:
 				}
 
 				// Fetch all remaining pages
-				builds, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBuilds(ctx, resolvedAppID, asc.WithBuildsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("builds: %w", err)
 				}
-
-				format := *output
-				return printOutput(builds, format, *pretty)
+				return nil
 			}
 
 			builds, err := client.GetBuilds(requestCtx, resolvedAppID, opts...)
@@ -439,12 +444,28 @@ Examples:
 	}
 }
 
+var buildProcessingStateList = []string{
+	asc.BuildProcessingStateProcessing,
+	asc.BuildProcessingStateFailed,
+	asc.BuildProcessingStateInvalid,
+	asc.BuildProcessingStateValid,
+}
+
+func normalizeBuildProcessingStates(values []string) ([]string, error) {
+	for _, value := range values {
+		if !slices.Contains(buildProcessingStateList, value) {
+			return nil, usageErrorf("--processing-state must be one of: %s", strings.Join(buildProcessingStateList, ", "))
+		}
+	}
+	return values, nil
+}
+
 // BuildsInfoCommand returns a build info subcommand.
 func BuildsInfoCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("builds info", flag.ExitOnError)
 
 	buildID := fs.String("build", "", "Build ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -459,8 +480,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*buildID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --build is required")
-				return flag.ErrHelp
+				return flagErrorf("--build is required")
 			}
 
 			client, err := getASCClient()
@@ -488,7 +508,7 @@ func BuildsExpireCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("builds expire", flag.ExitOnError)
 
 	buildID := fs.String("build", "", "Build ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -505,8 +525,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*buildID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --build is required")
-				return flag.ErrHelp
+				return flagErrorf("--build is required")
 			}
 
 			client, err := getASCClient()
@@ -4,15 +4,14 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"sort"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 type buildExpireCandidate struct {
@@ -30,7 +29,7 @@ func BuildsExpireAllCommand() *ffcli.Command {
 	keepLatest := fs.Int("keep-latest", 0, "Keep the N most recent builds")
 	dryRun := fs.Bool("dry-run", false, "Preview builds that would be expired without expiring")
 	confirm := fs.Bool("confirm", false, "Confirm expiration (required unless --dry-run)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -53,27 +52,24 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			olderThanValue := strings.TrimSpace(*olderThan)
 			if olderThanValue == "" && *keepLatest == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --older-than or --keep-latest is required")
-				return flag.ErrHelp
+				return flagErrorf("--older-than or --keep-latest is required")
 			}
 			if *keepLatest < 0 {
-				return fmt.Errorf("builds expire-all: --keep-latest must be greater than or equal to 0")
+				return usageErrorf("builds expire-all: --keep-latest must be greater than or equal to 0")
 			}
 			if !*dryRun && !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required to expire builds")
-				return flag.ErrHelp
+				return flagErrorf("--confirm is required to expire builds")
 			}
 
 			now := time.Now().UTC()
 			var olderThanThreshold time.Time
 			if olderThanValue != "" {
-				threshold, err := parseOlderThanThreshold(olderThanValue, now)
+				threshold, err := shared.ParseRelativeTime(olderThanValue, "--older-than", now)
 				if err != nil {
 					return fmt.Errorf("builds expire-all: %w", err)
 				}
@@ -116,7 +112,7 @@ Examples:
 				uploadedAt, err := parseBuildTimestamp(item.Attributes.UploadedDate)
 				if err != nil {
 					skippedInvalid++
-					fmt.Fprintf(os.Stderr, "Warning: build %s has invalid uploadedDate %q: %v\n", item.ID, item.Attributes.UploadedDate, err)
+					shared.Warnf("build %s has invalid uploadedDate %q: %v", item.ID, item.Attributes.UploadedDate, err)
 					continue
 				}
 				ageDays := int(now.Sub(uploadedAt).Hours() / 24)
@@ -248,51 +244,3 @@ func parseBuildTimestamp(value string) (time.Time, error) {
 	}
 	return time.Time{}, fmt.Errorf("invalid time %q", trimmed)
 }
-
-func parseOlderThanThreshold(value string, now time.Time) (time.Time, error) {
-	trimmed := strings.TrimSpace(value)
-	if trimmed == "" {
-		return time.Time{}, fmt.Errorf("--older-than must not be empty")
-	}
-	if parsed, err := time.Parse("2006-01-02", trimmed); err == nil {
-		return parsed, nil
-	}
-	if parsed, err := time.Parse(time.RFC3339, trimmed); err == nil {
-		return parsed, nil
-	}
-	duration, err := parseOlderThanDuration(trimmed)
-	if err != nil {
-		return time.Time{}, err
-	}
-	return now.Add(-duration), nil
-}
-
-func parseOlderThanDuration(value string) (time.Duration, error) {
-	trimmed := strings.ToLower(strings.TrimSpace(value))
-	if trimmed == "" {
-		return 0, fmt.Errorf("--older-than must not be empty")
-	}
-	if len(trimmed) < 2 {
-		return 0, fmt.Errorf("--older-than must be a duration like 90d, 2w, or 3m")
-	}
-	unit := trimmed[len(trimmed)-1]
-	number := strings.TrimSpace(trimmed[:len(trimmed)-1])
-	if number == "" {
-		return 0, fmt.Errorf("--older-than must be a duration like 90d, 2w, or 3m")
-	}
-	valueInt, err := strconv.Atoi(number)
-	if err != nil || valueInt <= 0 {
-		return 0, fmt.Errorf("--older-than must be a duration like 90d, 2w, or 3m")
-	}
-
-	switch unit {
-	case 'd':
-		return time.Duration(valueInt) * 24 * time.Hour, nil
-	case 'w':
-		return time.Duration(valueInt) * 7 * 24 * time.Hour, nil
-	case 'm':
-		return time.Duration(valueInt) * 30 * 24 * time.Hour, nil
-	default:
-		return 0, fmt.Errorf("--older-than must be a duration like 90d, 2w, or 3m")
-	}
-}
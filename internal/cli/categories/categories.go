@@ -41,7 +41,7 @@ func CategoriesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("categories list", flag.ExitOnError)
 
 	limit := fs.Int("limit", 200, "Maximum results to fetch (1-200)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -60,7 +60,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit < 1 || *limit > 200 {
-				return fmt.Errorf("categories list: --limit must be between 1 and 200")
+				return usageErrorf("categories list: --limit must be between 1 and 200")
 			}
 
 			client, err := getASCClient()
@@ -24,3 +24,7 @@ func contextWithTimeout(ctx context.Context) (context.Context, context.CancelFun
 func printOutput(data interface{}, format string, pretty bool) error {
 	return shared.PrintOutput(data, format, pretty)
 }
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
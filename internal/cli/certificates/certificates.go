@@ -51,7 +51,7 @@ func CertificatesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -68,7 +68,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("certificates list: --limit must be between 1 and 200")
+				return usageErrorf("certificates list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("certificates list: %w", err)
@@ -99,14 +99,13 @@ Examples:
 					return fmt.Errorf("certificates list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("certificates list: %w", err)
 				}
-
-				return printOutput(paginated, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetCertificates(requestCtx, opts...)
@@ -125,7 +124,7 @@ func CertificatesCreateCommand() *ffcli.Command {
 
 	certificateType := fs.String("certificate-type", "", "Certificate type (e.g., IOS_DISTRIBUTION)")
 	csrPath := fs.String("csr", "", "CSR file path")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -141,13 +140,11 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			certificateValue := strings.ToUpper(strings.TrimSpace(*certificateType))
 			if certificateValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --certificate-type is required")
-				return flag.ErrHelp
+				return flagErrorf("--certificate-type is required")
 			}
 			csrValue := strings.TrimSpace(*csrPath)
 			if csrValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --csr is required")
-				return flag.ErrHelp
+				return flagErrorf("--csr is required")
 			}
 
 			csrContent, err := readCSRContent(csrValue)
@@ -179,7 +176,7 @@ func CertificatesRevokeCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Certificate ID")
 	confirm := fs.Bool("confirm", false, "Confirm revocation")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -195,12 +192,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				return flagErrorf("--confirm is required")
 			}
 
 			client, err := getASCClient()
@@ -7,6 +7,7 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"flag"
@@ -159,6 +160,29 @@ func TestCompletionZshPrintsScriptToStdout(t *testing.T) {
 	}
 }
 
+func TestCompletionScriptsIncludeEnumFlagValues(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		t.Run(shell, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse([]string{"completion", "--shell", shell}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); err != nil {
+					t.Fatalf("run error: %v", err)
+				}
+			})
+
+			for _, want := range []string{"nominations list", "DRAFT SUBMITTED ARCHIVED", "json table markdown", "create delete get list update"} {
+				if !strings.Contains(stdout, want) {
+					t.Fatalf("expected %s completion script to contain %q", shell, want)
+				}
+			}
+		})
+	}
+}
+
 func TestCompletionInvalidShellErrorsToStderr(t *testing.T) {
 	root := RootCommand("1.2.3")
 	root.FlagSet.SetOutput(io.Discard)
@@ -394,6 +418,28 @@ func TestBuildsExpireAllValidationErrors(t *testing.T) {
 	}
 }
 
+func TestBuildsListInvalidProcessingState(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "list", "--app", "APP_ID", "--processing-state", "VALID,DONE"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--processing-state must be one of: PROCESSING, FAILED, INVALID, VALID") {
+		t.Fatalf("expected processing state error, got %q", stderr)
+	}
+}
+
 func TestBuildsGroupValidationErrors(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -720,6 +766,16 @@ func TestIAPValidationErrors(t *testing.T) {
 			args:    []string{"iap", "create", "--app", "APP_ID", "--type", "CONSUMABLE", "--ref-name", "Pro"},
 			wantErr: "--product-id is required",
 		},
+		{
+			name:    "subscriptions create localization missing name",
+			args:    []string{"subscriptions", "create", "--group", "GROUP_ID", "--ref-name", "Monthly", "--product-id", "com.example.sub", "--locale", "en-US"},
+			wantErr: "--name is required when creating a localization",
+		},
+		{
+			name:    "subscriptions create keep-on-failure without locale",
+			args:    []string{"subscriptions", "create", "--group", "GROUP_ID", "--ref-name", "Monthly", "--product-id", "com.example.sub", "--keep-on-failure"},
+			wantErr: "--keep-on-failure requires --locale",
+		},
 		{
 			name:    "iap update missing id",
 			args:    []string{"iap", "update"},
@@ -923,6 +979,16 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "list"},
 			wantErr: "--group is required",
 		},
+		{
+			name:    "subscriptions list invalid include",
+			args:    []string{"subscriptions", "list", "--group", "GROUP_ID", "--include", "offers"},
+			wantErr: "--include must be one of: prices, localizations",
+		},
+		{
+			name:    "subscriptions list prices limit without include",
+			args:    []string{"subscriptions", "list", "--group", "GROUP_ID", "--prices-limit", "5"},
+			wantErr: "--prices-limit requires --include prices",
+		},
 		{
 			name:    "subscriptions create missing group",
 			args:    []string{"subscriptions", "create", "--ref-name", "Monthly", "--product-id", "com.example.sub"},
@@ -953,6 +1019,11 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "update", "--id", "SUB_ID"},
 			wantErr: "at least one update flag is required",
 		},
+		{
+			name:    "subscriptions update invalid group-level",
+			args:    []string{"subscriptions", "update", "--id", "SUB_ID", "--group-level", "0"},
+			wantErr: "--group-level must be a positive integer",
+		},
 		{
 			name:    "subscriptions delete missing confirm",
 			args:    []string{"subscriptions", "delete", "--id", "SUB_ID"},
@@ -968,6 +1039,11 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "prices", "add", "--id", "SUB_ID"},
 			wantErr: "--price-point is required",
 		},
+		{
+			name:    "subscriptions price-points list missing id",
+			args:    []string{"subscriptions", "price-points", "list", "--show-usd"},
+			wantErr: "--id is required",
+		},
 		{
 			name:    "subscriptions availability set missing id",
 			args:    []string{"subscriptions", "availability", "set", "--territory", "USA"},
@@ -978,6 +1054,121 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "availability", "set", "--id", "SUB_ID"},
 			wantErr: "--territory is required",
 		},
+		{
+			name:    "subscriptions prices bulk missing id",
+			args:    []string{"subscriptions", "prices", "bulk", "--file", "prices.json"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "subscriptions prices bulk missing file",
+			args:    []string{"subscriptions", "prices", "bulk", "--id", "SUB_ID"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "subscriptions prices list missing id",
+			args:    []string{"subscriptions", "prices", "list"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "subscriptions prices delete missing price-id",
+			args:    []string{"subscriptions", "prices", "delete", "--confirm"},
+			wantErr: "--price-id is required",
+		},
+		{
+			name:    "subscriptions prices delete missing confirm",
+			args:    []string{"subscriptions", "prices", "delete", "--price-id", "PRICE_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "subscriptions localizations list missing id",
+			args:    []string{"subscriptions", "localizations", "list"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "subscriptions localizations create missing locale",
+			args:    []string{"subscriptions", "localizations", "create", "--id", "SUB_ID", "--name", "Monthly"},
+			wantErr: "--locale is required",
+		},
+		{
+			name:    "subscriptions localizations create missing name",
+			args:    []string{"subscriptions", "localizations", "create", "--id", "SUB_ID", "--locale", "en-US"},
+			wantErr: "--name is required",
+		},
+		{
+			name:    "subscriptions localizations update missing fields",
+			args:    []string{"subscriptions", "localizations", "update", "--localization-id", "LOC_ID"},
+			wantErr: "at least one update flag is required",
+		},
+		{
+			name:    "subscriptions localizations delete missing confirm",
+			args:    []string{"subscriptions", "localizations", "delete", "--localization-id", "LOC_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "subscriptions offers list missing id",
+			args:    []string{"subscriptions", "offers", "list"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "subscriptions offers create missing offer-code",
+			args:    []string{"subscriptions", "offers", "create", "--id", "SUB_ID", "--name", "Win back"},
+			wantErr: "--offer-code is required",
+		},
+		{
+			name:    "subscriptions offers create invalid offer-mode",
+			args:    []string{"subscriptions", "offers", "create", "--id", "SUB_ID", "--name", "Win back", "--offer-code", "WINBACK", "--duration", "ONE_MONTH", "--number-of-periods", "1", "--offer-mode", "DISCOUNT"},
+			wantErr: "--offer-mode must be one of: PAY_AS_YOU_GO, PAY_UP_FRONT, FREE_TRIAL",
+		},
+		{
+			name:    "subscriptions offers create invalid duration",
+			args:    []string{"subscriptions", "offers", "create", "--id", "SUB_ID", "--name", "Win back", "--offer-code", "WINBACK", "--duration", "ONE_DAY"},
+			wantErr: "--duration must be one of:",
+		},
+		{
+			name:    "subscriptions offers create missing number-of-periods",
+			args:    []string{"subscriptions", "offers", "create", "--id", "SUB_ID", "--name", "Win back", "--offer-code", "WINBACK", "--duration", "ONE_MONTH"},
+			wantErr: "--number-of-periods is required",
+		},
+		{
+			name:    "subscriptions offers get missing offer-id",
+			args:    []string{"subscriptions", "offers", "get"},
+			wantErr: "--offer-id is required",
+		},
+		{
+			name:    "subscriptions offers delete missing confirm",
+			args:    []string{"subscriptions", "offers", "delete", "--offer-id", "OFFER_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "subscriptions intro-offers list missing id",
+			args:    []string{"subscriptions", "intro-offers", "list"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "subscriptions intro-offers create missing territory",
+			args:    []string{"subscriptions", "intro-offers", "create", "--id", "SUB_ID"},
+			wantErr: "--territory is required",
+		},
+		{
+			name:    "subscriptions intro-offers create invalid offer-mode",
+			args:    []string{"subscriptions", "intro-offers", "create", "--id", "SUB_ID", "--territory", "USA", "--duration", "ONE_WEEK", "--offer-mode", "DISCOUNT"},
+			wantErr: "--offer-mode must be one of: PAY_AS_YOU_GO, PAY_UP_FRONT, FREE_TRIAL",
+		},
+		{
+			name:    "subscriptions intro-offers create missing price-point",
+			args:    []string{"subscriptions", "intro-offers", "create", "--id", "SUB_ID", "--territory", "USA", "--duration", "ONE_MONTH", "--offer-mode", "PAY_UP_FRONT"},
+			wantErr: "--price-point is required unless --offer-mode is FREE_TRIAL",
+		},
+		{
+			name:    "subscriptions intro-offers create invalid start-date",
+			args:    []string{"subscriptions", "intro-offers", "create", "--id", "SUB_ID", "--territory", "USA", "--duration", "ONE_WEEK", "--offer-mode", "FREE_TRIAL", "--start-date", "03/01/2026"},
+			wantErr: "--start-date must be in YYYY-MM-DD or RFC3339 format",
+		},
+		{
+			name:    "subscriptions intro-offers delete missing confirm",
+			args:    []string{"subscriptions", "intro-offers", "delete", "--offer-id", "OFFER_ID"},
+			wantErr: "--confirm is required",
+		},
 	}
 
 	for _, test := range tests {
@@ -1101,6 +1292,12 @@ func TestTestFlightAppsValidationErrors(t *testing.T) {
 			wantErr:  "missing authentication",
 			wantHelp: false,
 		},
+		{
+			name:     "apps get invalid include",
+			args:     []string{"apps", "get", "--id", "APP_ID", "--include", "reviews"},
+			wantErr:  "--include must be one of: appInfos, builds, appStoreVersions",
+			wantHelp: true,
+		},
 		{
 			name:     "testflight apps get missing id",
 			args:     []string{"testflight", "apps", "get"},
@@ -1325,6 +1522,12 @@ func TestAccessibilityValidationErrors(t *testing.T) {
 			wantErr:  "--device-family is required",
 			wantHelp: true,
 		},
+		{
+			name:     "accessibility create invalid device family in list",
+			args:     []string{"accessibility", "create", "--app", "APP_ID", "--device-family", "IPHONE,TOASTER"},
+			wantErr:  "--device-family must be one of",
+			wantHelp: false,
+		},
 		{
 			name:     "accessibility update missing id",
 			args:     []string{"accessibility", "update", "--supports-voiceover", "true"},
@@ -1337,6 +1540,18 @@ func TestAccessibilityValidationErrors(t *testing.T) {
 			wantErr:  "at least one update flag is required",
 			wantHelp: false,
 		},
+		{
+			name:     "accessibility publish missing id",
+			args:     []string{"accessibility", "publish"},
+			wantErr:  "--id is required",
+			wantHelp: true,
+		},
+		{
+			name:     "accessibility unpublish missing id",
+			args:     []string{"accessibility", "unpublish"},
+			wantErr:  "--id is required",
+			wantHelp: true,
+		},
 		{
 			name:     "accessibility delete missing confirm",
 			args:     []string{"accessibility", "delete", "--id", "DECLARATION_ID"},
@@ -3687,6 +3902,16 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "workflows", "update", "--id", "WF_ID"},
 			wantErr: "--file is required",
 		},
+		{
+			name:    "xcode-cloud workflows enable missing id",
+			args:    []string{"xcode-cloud", "workflows", "enable"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "xcode-cloud workflows disable missing id",
+			args:    []string{"xcode-cloud", "workflows", "disable"},
+			wantErr: "--id is required",
+		},
 		{
 			name:    "xcode-cloud workflows delete missing id",
 			args:    []string{"xcode-cloud", "workflows", "delete", "--confirm"},
@@ -3697,16 +3922,111 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "workflows", "delete", "--id", "WF_ID"},
 			wantErr: "--confirm is required",
 		},
+		{
+			name:    "xcode-cloud workflows delete force without confirm",
+			args:    []string{"xcode-cloud", "workflows", "delete", "--id", "WF_ID", "--force"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "xcode-cloud workflows list invalid workers",
+			args:    []string{"xcode-cloud", "workflows", "list", "--app", "APP_ID", "--with-latest-status", "--workers", "0"},
+			wantErr: "--workers must be at least 1",
+		},
+		{
+			name:    "xcode-cloud issues summary missing run-id",
+			args:    []string{"xcode-cloud", "issues", "summary"},
+			wantErr: "--run-id is required",
+		},
+		{
+			name:    "xcode-cloud issues summary junit missing out",
+			args:    []string{"xcode-cloud", "issues", "summary", "--run-id", "RUN_ID", "--junit"},
+			wantErr: "--out is required with --junit",
+		},
+		{
+			name:    "xcode-cloud issues summary invalid fail-on",
+			args:    []string{"xcode-cloud", "issues", "summary", "--run-id", "RUN_ID", "--fail-on", "notes"},
+			wantErr: "--fail-on must be a comma-separated list of",
+		},
+		{
+			name:    "xcode-cloud issues summary allowlist without fail-on",
+			args:    []string{"xcode-cloud", "issues", "summary", "--run-id", "RUN_ID", "--allowlist", "known.txt"},
+			wantErr: "--allowlist requires --fail-on",
+		},
 		{
 			name:    "xcode-cloud build-runs missing workflow-id",
 			args:    []string{"xcode-cloud", "build-runs"},
 			wantErr: "--workflow-id is required",
 		},
+		{
+			name:    "xcode-cloud build-runs builds artifacts missing run-id",
+			args:    []string{"xcode-cloud", "build-runs", "builds", "artifacts", "--id", "BUILD_ID"},
+			wantErr: "--run-id is required",
+		},
+		{
+			name:    "xcode-cloud build-runs builds artifacts missing id",
+			args:    []string{"xcode-cloud", "build-runs", "builds", "artifacts", "--run-id", "RUN_ID"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "xcode-cloud build-runs builds artifacts overwrite without path",
+			args:    []string{"xcode-cloud", "build-runs", "builds", "artifacts", "--run-id", "RUN_ID", "--id", "BUILD_ID", "--overwrite"},
+			wantErr: "--overwrite requires --path",
+		},
+		{
+			name:    "xcode-cloud build-runs list concurrency below 1",
+			args:    []string{"xcode-cloud", "build-runs", "list", "--workflow-id", "WF_ID", "--paginate", "--concurrency", "0"},
+			wantErr: "--concurrency must be at least 1",
+		},
+		{
+			name:    "xcode-cloud build-runs list invalid since",
+			args:    []string{"xcode-cloud", "build-runs", "list", "--workflow-id", "WF_ID", "--since", "2026-02-01"},
+			wantErr: "--since must be in RFC3339 format",
+		},
+		{
+			name:    "xcode-cloud build-runs list since after until",
+			args:    []string{"xcode-cloud", "build-runs", "list", "--workflow-id", "WF_ID", "--since", "2026-02-08T00:00:00Z", "--until", "2026-02-01T00:00:00Z"},
+			wantErr: "--since must not be after --until",
+		},
+		{
+			name:    "xcode-cloud build-runs list concurrency without paginate",
+			args:    []string{"xcode-cloud", "build-runs", "list", "--workflow-id", "WF_ID", "--concurrency", "4"},
+			wantErr: "--concurrency requires --paginate",
+		},
+		{
+			name:    "xcode-cloud products build-runs concurrency without paginate",
+			args:    []string{"xcode-cloud", "products", "build-runs", "--id", "PRODUCT_ID", "--concurrency", "4"},
+			wantErr: "--concurrency requires --paginate",
+		},
+		{
+			name:    "xcode-cloud build-runs get missing run-id",
+			args:    []string{"xcode-cloud", "build-runs", "get"},
+			wantErr: "--run-id is required",
+		},
 		{
 			name:    "xcode-cloud build-runs builds missing run-id",
 			args:    []string{"xcode-cloud", "build-runs", "builds"},
 			wantErr: "--run-id is required",
 		},
+		{
+			name:    "xcode-cloud build-runs watch missing workflow-id",
+			args:    []string{"xcode-cloud", "build-runs", "watch"},
+			wantErr: "--workflow-id is required",
+		},
+		{
+			name:    "xcode-cloud build-runs retry missing run-id",
+			args:    []string{"xcode-cloud", "build-runs", "retry"},
+			wantErr: "--run-id is required",
+		},
+		{
+			name:    "xcode-cloud actions logs missing id",
+			args:    []string{"xcode-cloud", "actions", "logs", "--path", "./logs.zip"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "xcode-cloud actions logs missing path",
+			args:    []string{"xcode-cloud", "actions", "logs", "--id", "ACTION_ID"},
+			wantErr: "--path is required",
+		},
 		{
 			name:    "xcode-cloud actions missing run-id",
 			args:    []string{"xcode-cloud", "actions"},
@@ -3717,11 +4037,21 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "actions", "get"},
 			wantErr: "--id is required",
 		},
+		{
+			name:    "xcode-cloud actions get artifacts-limit without include",
+			args:    []string{"xcode-cloud", "actions", "get", "--id", "ACTION_ID", "--artifacts-limit", "10"},
+			wantErr: "--artifacts-limit requires --include artifacts",
+		},
 		{
 			name:    "xcode-cloud actions build-run missing id",
 			args:    []string{"xcode-cloud", "actions", "build-run"},
 			wantErr: "--id is required",
 		},
+		{
+			name:    "xcode-cloud actions wait missing id",
+			args:    []string{"xcode-cloud", "actions", "wait"},
+			wantErr: "--id is required",
+		},
 		{
 			name:    "xcode-cloud artifacts list missing action-id",
 			args:    []string{"xcode-cloud", "artifacts", "list"},
@@ -3742,6 +4072,21 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "artifacts", "download", "--id", "ART_ID"},
 			wantErr: "--path is required",
 		},
+		{
+			name:    "xcode-cloud artifacts download-all missing action id",
+			args:    []string{"xcode-cloud", "artifacts", "download-all", "--dir", "./artifacts"},
+			wantErr: "--action-id is required",
+		},
+		{
+			name:    "xcode-cloud artifacts download-all missing dir",
+			args:    []string{"xcode-cloud", "artifacts", "download-all", "--action-id", "ACTION_ID"},
+			wantErr: "--dir is required",
+		},
+		{
+			name:    "xcode-cloud artifacts download-all invalid concurrency",
+			args:    []string{"xcode-cloud", "artifacts", "download-all", "--action-id", "ACTION_ID", "--dir", "./artifacts", "--concurrency", "0"},
+			wantErr: "--concurrency must be at least 1",
+		},
 		{
 			name:    "xcode-cloud test-results list missing action-id",
 			args:    []string{"xcode-cloud", "test-results", "list"},
@@ -3752,11 +4097,21 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "test-results", "get"},
 			wantErr: "--id is required",
 		},
+		{
+			name:    "xcode-cloud test-results summary missing action-id",
+			args:    []string{"xcode-cloud", "test-results", "summary"},
+			wantErr: "--action-id is required",
+		},
 		{
 			name:    "xcode-cloud issues list missing action-id",
 			args:    []string{"xcode-cloud", "issues", "list"},
 			wantErr: "--action-id is required",
 		},
+		{
+			name:    "xcode-cloud issues list invalid severity",
+			args:    []string{"xcode-cloud", "issues", "list", "--action-id", "ACTION_ID", "--severity", "FATAL"},
+			wantErr: "--severity must be a comma-separated list of",
+		},
 		{
 			name:    "xcode-cloud issues get missing id",
 			args:    []string{"xcode-cloud", "issues", "get"},
@@ -3777,6 +4132,11 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "products", "build-runs"},
 			wantErr: "--id is required",
 		},
+		{
+			name:    "xcode-cloud products build-runs resolve-names without group-by-workflow",
+			args:    []string{"xcode-cloud", "products", "build-runs", "--id", "PROD_ID", "--resolve-names"},
+			wantErr: "--resolve-names requires --group-by-workflow",
+		},
 		{
 			name:    "xcode-cloud products workflows missing id",
 			args:    []string{"xcode-cloud", "products", "workflows"},
@@ -3802,6 +4162,11 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "products", "delete", "--id", "PROD_ID"},
 			wantErr: "--confirm is required",
 		},
+		{
+			name:    "xcode-cloud products delete cascade missing confirm",
+			args:    []string{"xcode-cloud", "products", "delete", "--id", "PROD_ID", "--cascade"},
+			wantErr: "--confirm is required",
+		},
 		{
 			name:    "xcode-cloud macos-versions get missing id",
 			args:    []string{"xcode-cloud", "macos-versions", "get"},
@@ -3875,6 +4240,81 @@ func TestXcodeCloudMutualExclusiveFlags(t *testing.T) {
 			args:    []string{"xcode-cloud", "status", "--run-id", "RUN_ID", "--timeout", "-1s"},
 			wantErr: "--timeout must be greater than or equal to 0",
 		},
+		{
+			name:    "xcode-cloud status invalid wait-timeout",
+			args:    []string{"xcode-cloud", "status", "--run-id", "RUN_ID", "--wait", "--wait-timeout", "0s"},
+			wantErr: "--wait-timeout must be greater than 0",
+		},
+		{
+			name:    "xcode-cloud run invalid wait-timeout",
+			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--branch", "main", "--wait", "--wait-timeout", "-1m"},
+			wantErr: "--wait-timeout must be greater than 0",
+		},
+		{
+			name:    "xcode-cloud products build-runs invalid since",
+			args:    []string{"xcode-cloud", "products", "build-runs", "--id", "PROD_ID", "--since", "7y"},
+			wantErr: "--since must be a duration",
+		},
+		{
+			name:    "xcode-cloud run download-artifacts requires wait",
+			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--branch", "main", "--download-artifacts", "--artifacts-dir", "./out"},
+			wantErr: "--download-artifacts requires --wait",
+		},
+		{
+			name:    "xcode-cloud run artifacts-dir requires download-artifacts",
+			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--branch", "main", "--wait", "--artifacts-dir", "./out"},
+			wantErr: "require --download-artifacts",
+		},
+		{
+			name:    "xcode-cloud run notify-url requires wait",
+			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--branch", "main", "--notify-url", "https://hooks.example.com/ci"},
+			wantErr: "--notify-url requires --wait",
+		},
+		{
+			name:    "xcode-cloud run invalid notify-on",
+			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--branch", "main", "--wait", "--notify-url", "https://hooks.example.com/ci", "--notify-on", "sometimes"},
+			wantErr: "--notify-on must be one of",
+		},
+		{
+			name:    "xcode-cloud run invalid git-reference-kind",
+			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--branch", "main", "--git-reference-kind", "commit"},
+			wantErr: "--git-reference-kind must be one of",
+		},
+		{
+			name:    "xcode-cloud run git-reference-kind without branch",
+			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--git-reference-id", "REF_ID", "--git-reference-kind", "tag"},
+			wantErr: "--git-reference-kind requires --branch",
+		},
+		{
+			name:    "xcode-cloud run relative notify-url",
+			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--branch", "main", "--wait", "--notify-url", "/hooks/ci"},
+			wantErr: "--notify-url must be an absolute http(s) URL",
+		},
+		{
+			name:    "xcode-cloud xcode-versions latest and next are mutually exclusive",
+			args:    []string{"xcode-cloud", "xcode-versions", "list", "--latest", "--next", "https://api.appstoreconnect.apple.com/v1/ciXcodeVersions?cursor=abc"},
+			wantErr: "--latest and --next are mutually exclusive",
+		},
+		{
+			name:    "xcode-cloud actions get invalid include",
+			args:    []string{"xcode-cloud", "actions", "get", "--id", "ACTION_ID", "--include", "logs"},
+			wantErr: "--include must be one of",
+		},
+		{
+			name:    "xcode-cloud actions get invalid artifacts-limit",
+			args:    []string{"xcode-cloud", "actions", "get", "--id", "ACTION_ID", "--include", "artifacts", "--artifacts-limit", "500"},
+			wantErr: "--artifacts-limit must be between 1 and 200",
+		},
+		{
+			name:    "xcode-cloud build-runs watch invalid interval",
+			args:    []string{"xcode-cloud", "build-runs", "watch", "--workflow-id", "WF_ID", "--interval", "0s"},
+			wantErr: "--interval must be greater than 0",
+		},
+		{
+			name:    "xcode-cloud build-runs watch requires terminal",
+			args:    []string{"xcode-cloud", "build-runs", "watch", "--workflow-id", "WF_ID"},
+			wantErr: "requires an interactive terminal",
+		},
 	}
 
 	for _, test := range tests {
@@ -4302,3 +4742,223 @@ func TestAppEventsSubmitValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthRotateKeyValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing old",
+			args:    []string{"auth", "rotate-key", "--new", "NEW", "--new-key-path", "new.p8"},
+			wantErr: "Error: --old is required",
+		},
+		{
+			name:    "missing new",
+			args:    []string{"auth", "rotate-key", "--old", "OLD", "--new-key-path", "new.p8"},
+			wantErr: "Error: --new is required",
+		},
+		{
+			name:    "missing new-key-path",
+			args:    []string{"auth", "rotate-key", "--old", "OLD", "--new", "NEW"},
+			wantErr: "Error: --new-key-path is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestAuthRotateKeySwapsConfigAndKeepsBackup(t *testing.T) {
+	tempDir := t.TempDir()
+	newKeyPath := filepath.Join(tempDir, "AuthKey_NEW.p8")
+	writeECDSAPEM(t, newKeyPath)
+
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	cfg := &config.Config{
+		DefaultKeyName: "work",
+		Keys: []config.Credential{
+			{Name: "work", KeyID: "OLD123", IssuerID: "ISS456", PrivateKeyPath: "/tmp/AuthKey_OLD.p8"},
+		},
+	}
+	if err := config.SaveAt(configPath, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+
+	var validatedIssuer string
+	restoreNetwork := authcli.SetLoginNetworkValidate(func(_ context.Context, keyID, issuerID, _ string) error {
+		if keyID != "NEW789" {
+			t.Fatalf("expected new key to be validated, got %q", keyID)
+		}
+		validatedIssuer = issuerID
+		return nil
+	})
+	t.Cleanup(restoreNetwork)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"auth", "rotate-key", "--old", "OLD123", "--new", "NEW789", "--new-key-path", newKeyPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if validatedIssuer != "ISS456" {
+		t.Fatalf("expected validation with stored issuer, got %q", validatedIssuer)
+	}
+
+	var result struct {
+		BackupPath string   `json:"backup_path"`
+		Profiles   []string `json:"profiles"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	if len(result.Profiles) != 1 || result.Profiles[0] != "work" {
+		t.Fatalf("unexpected profiles %v", result.Profiles)
+	}
+
+	updated, err := config.LoadAt(configPath)
+	if err != nil {
+		t.Fatalf("LoadAt() error: %v", err)
+	}
+	if updated.Keys[0].KeyID != "NEW789" || updated.Keys[0].PrivateKeyPath != newKeyPath {
+		t.Fatalf("expected rotated credential, got %+v", updated.Keys[0])
+	}
+
+	backup, err := config.LoadAt(result.BackupPath)
+	if err != nil {
+		t.Fatalf("LoadAt(backup) error: %v", err)
+	}
+	if backup.Keys[0].KeyID != "OLD123" {
+		t.Fatalf("expected backup to keep old key, got %+v", backup.Keys[0])
+	}
+}
+
+func TestAuthRotateKeyRejectsMixedIssuers(t *testing.T) {
+	tempDir := t.TempDir()
+	newKeyPath := filepath.Join(tempDir, "AuthKey_NEW.p8")
+	writeECDSAPEM(t, newKeyPath)
+
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	cfg := &config.Config{
+		DefaultKeyName: "work",
+		Keys: []config.Credential{
+			{Name: "work", KeyID: "OLD123", IssuerID: "ISS456", PrivateKeyPath: "/tmp/AuthKey_OLD.p8"},
+			{Name: "client", KeyID: "OLD123", IssuerID: "ISS999", PrivateKeyPath: "/tmp/AuthKey_OLD.p8"},
+		},
+	}
+	if err := config.SaveAt(configPath, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+
+	restoreNetwork := authcli.SetLoginNetworkValidate(func(context.Context, string, string, string) error {
+		t.Fatal("expected no validation request for mixed issuers")
+		return nil
+	})
+	t.Cleanup(restoreNetwork)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"auth", "rotate-key", "--old", "OLD123", "--new", "NEW789", "--new-key-path", newKeyPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "different issuer IDs") {
+			t.Fatalf("expected mixed issuer error, got %v", err)
+		}
+	})
+
+	unchanged, err := config.LoadAt(configPath)
+	if err != nil {
+		t.Fatalf("LoadAt() error: %v", err)
+	}
+	if unchanged.Keys[0].KeyID != "OLD123" || unchanged.Keys[1].KeyID != "OLD123" {
+		t.Fatalf("expected config unchanged, got %+v", unchanged.Keys)
+	}
+}
+
+func TestAuthRotateKeyValidationFailureLeavesConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	newKeyPath := filepath.Join(tempDir, "AuthKey_NEW.p8")
+	writeECDSAPEM(t, newKeyPath)
+
+	configDir := t.TempDir()
+	configPath := filepath.Join(configDir, "config.json")
+	cfg := &config.Config{
+		DefaultKeyName: "work",
+		Keys: []config.Credential{
+			{Name: "work", KeyID: "OLD123", IssuerID: "ISS456", PrivateKeyPath: "/tmp/AuthKey_OLD.p8"},
+		},
+	}
+	if err := config.SaveAt(configPath, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+
+	restoreNetwork := authcli.SetLoginNetworkValidate(func(context.Context, string, string, string) error {
+		return errors.New("401 unauthorized")
+	})
+	t.Cleanup(restoreNetwork)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"auth", "rotate-key", "--old", "OLD123", "--new", "NEW789", "--new-key-path", newKeyPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "config unchanged") {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+
+	unchanged, err := config.LoadAt(configPath)
+	if err != nil {
+		t.Fatalf("LoadAt() error: %v", err)
+	}
+	if unchanged.Keys[0].KeyID != "OLD123" {
+		t.Fatalf("expected config unchanged, got %+v", unchanged.Keys[0])
+	}
+	entries, err := os.ReadDir(configDir)
+	if err != nil {
+		t.Fatalf("ReadDir() error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected no backup to be written, got %d files", len(entries))
+	}
+}
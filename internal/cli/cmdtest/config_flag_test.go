@@ -0,0 +1,111 @@
+package cmdtest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+func clearCredentialEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_PRIVATE_KEY", "")
+	t.Setenv("ASC_PRIVATE_KEY_B64", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+}
+
+func TestRunConfigFlagMissingFile(t *testing.T) {
+	clearCredentialEnv(t)
+	missing := filepath.Join(t.TempDir(), "missing.toml")
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"--config", missing, "testflight", "apps", "list"}, "1.2.3")
+		if code != 1 {
+			t.Fatalf("expected exit code 1, got %d", code)
+		}
+	})
+
+	if !strings.Contains(stderr, "config file not found: "+missing) {
+		t.Fatalf("expected missing config error, got %q", stderr)
+	}
+}
+
+func TestRunConfigFlagMissingAuthDocumentsPrecedence(t *testing.T) {
+	clearCredentialEnv(t)
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("app_id = \"APP123\"\ndefault_output = \"table\"\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"--config", path, "testflight", "apps", "list"}, "1.2.3")
+		if code != 3 {
+			t.Fatalf("expected exit code 3, got %d", code)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	for _, want := range []string{"missing authentication", path, "then ASC_KEY_ID/ASC_ISSUER_ID/ASC_PRIVATE_KEY_PATH, then the config file"} {
+		if !strings.Contains(stderr, want) {
+			t.Fatalf("expected %q in stderr, got %q", want, stderr)
+		}
+	}
+}
+
+func TestRunAuthLogoutWithOnlyTOMLConfig(t *testing.T) {
+	for _, args := range [][]string{{"auth", "logout"}, {"auth", "logout", "--all"}} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			clearCredentialEnv(t)
+			t.Setenv("ASC_CONFIG_PATH", "")
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			t.Chdir(t.TempDir())
+
+			tomlPath := filepath.Join(home, ".config", "asc", "config.toml")
+			if err := os.MkdirAll(filepath.Dir(tomlPath), 0o700); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+			original := "app_id = \"APP123\"\nkey_id = \"KEY123\"\nissuer_id = \"ISS456\"\nprivate_key_path = \"/tmp/AuthKey.p8\"\n"
+			if err := os.WriteFile(tomlPath, []byte(original), 0o600); err != nil {
+				t.Fatalf("write config: %v", err)
+			}
+
+			_, stderr := captureOutput(t, func() {
+				if code := cmd.Run(args, "1.2.3"); code != 0 {
+					t.Fatalf("expected exit code 0, got %d", code)
+				}
+			})
+			if strings.Contains(stderr, "Error") {
+				t.Fatalf("unexpected stderr %q", stderr)
+			}
+
+			data, err := os.ReadFile(tomlPath)
+			if err != nil {
+				t.Fatalf("read config: %v", err)
+			}
+			if string(data) != original {
+				t.Fatalf("expected config.toml untouched, got %q", data)
+			}
+			cfg, err := config.Load()
+			if err != nil {
+				t.Fatalf("Load() error: %v", err)
+			}
+			if cfg.KeyID != "" || cfg.IssuerID != "" || cfg.PrivateKeyPath != "" {
+				t.Fatalf("expected credentials cleared, got %+v", cfg)
+			}
+			if cfg.AppID != "APP123" {
+				t.Fatalf("expected app_id kept, got %q", cfg.AppID)
+			}
+		})
+	}
+}
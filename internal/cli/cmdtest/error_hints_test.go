@@ -1,10 +1,14 @@
 package cmdtest
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 func TestRunPrintsHintForMissingAuth(t *testing.T) {
@@ -19,8 +23,8 @@ func TestRunPrintsHintForMissingAuth(t *testing.T) {
 
 	stdout, stderr := captureOutput(t, func() {
 		code := cmd.Run([]string{"testflight", "apps", "list"}, "1.2.3")
-		if code != 1 {
-			t.Fatalf("expected exit code 1, got %d", code)
+		if code != 3 {
+			t.Fatalf("expected exit code 3, got %d", code)
 		}
 	})
 
@@ -35,3 +39,138 @@ func TestRunPrintsHintForMissingAuth(t *testing.T) {
 	}
 }
 
+
+func TestRunLogFormatJSONEmitsStructuredError(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_PRIVATE_KEY", "")
+	t.Setenv("ASC_PRIVATE_KEY_B64", "")
+	t.Setenv("ASC_CONFIG_PATH", t.TempDir()+"/config.json")
+	t.Cleanup(func() { _ = asc.SetLogFormat(asc.LogFormatText) })
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"--log-format", "json", "testflight", "apps", "list"}, "1.2.3")
+		if code != 3 {
+			t.Fatalf("expected exit code 3, got %d", code)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	line := strings.TrimSpace(stderr)
+	if strings.Contains(line, "\n") || !strings.HasPrefix(line, "{") {
+		t.Fatalf("expected a single JSON line on stderr, got %q", stderr)
+	}
+	for _, want := range []string{`"level":"ERROR"`, `missing authentication`, `"hint":"Run`} {
+		if !strings.Contains(line, want) {
+			t.Fatalf("expected %s in %q", want, line)
+		}
+	}
+}
+
+func TestRunLogFormatJSONReportsFlagErrorsWithoutUsage(t *testing.T) {
+	t.Cleanup(func() { _ = asc.SetLogFormat(asc.LogFormatText) })
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"--log-format", "json", "xcode-cloud", "build-runs", "list"}, "1.2.3")
+		if code != 2 {
+			t.Fatalf("expected exit code 2, got %d", code)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	line := strings.TrimSpace(stderr)
+	if strings.Contains(line, "\n") || strings.Contains(line, "USAGE") {
+		t.Fatalf("expected a single JSON line without usage on stderr, got %q", stderr)
+	}
+	var record struct {
+		Level string `json:"level"`
+		Msg   string `json:"msg"`
+	}
+	if err := json.Unmarshal([]byte(line), &record); err != nil {
+		t.Fatalf("decode %q: %v", line, err)
+	}
+	if record.Level != "ERROR" || record.Msg != "--workflow-id is required" {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+}
+
+func TestRunRejectsInvalidLogFormat(t *testing.T) {
+	t.Setenv("ASC_LOG_FORMAT", "xml")
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"testflight", "apps", "list"}, "1.2.3")
+		if code != 1 {
+			t.Fatalf("expected exit code 1, got %d", code)
+		}
+	})
+
+	if !strings.Contains(stderr, "ASC_LOG_FORMAT: log format must be text or json") {
+		t.Fatalf("expected log format error, got %q", stderr)
+	}
+}
+
+func TestRunEnvelopeWrapsJSONOutput(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_CONFIG_PATH", t.TempDir()+"/config.json")
+
+	stdout, _ := captureOutput(t, func() {
+		_ = cmd.Run([]string{"--envelope", "auth", "doctor", "--output", "json"}, "1.2.3")
+	})
+
+	var envelope struct {
+		APIVersion int             `json:"apiVersion"`
+		Command    string          `json:"command"`
+		Data       json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &envelope); err != nil {
+		t.Fatalf("failed to decode envelope %q: %v", stdout, err)
+	}
+	if envelope.APIVersion != 1 || envelope.Command != "auth doctor" {
+		t.Fatalf("unexpected envelope header: %+v", envelope)
+	}
+	if len(envelope.Data) == 0 || envelope.Data[0] != '{' {
+		t.Fatalf("expected result object in data, got %s", envelope.Data)
+	}
+}
+
+func TestRunStrictValidationRejectsUnknownWorkflowKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "workflow.json")
+	payload := `{"data":{"type":"ciWorkflows","attributes":{"name":"CI","isEnabeld":true,"actions":[{"name":"Build"}]}}}`
+	if err := os.WriteFile(path, []byte(payload), 0o600); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"--strict-validation", "xcode-cloud", "workflows", "create", "--file", path}, "1.2.3")
+		if code == 0 {
+			t.Fatal("expected non-zero exit code")
+		}
+	})
+	if !strings.Contains(stderr, `unknown field "isEnabeld"`) {
+		t.Fatalf("expected offending field in stderr, got %q", stderr)
+	}
+}
+
+func TestRunStrictValidationRejectsUnknownLeaderboardPatchKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "patch.json")
+	if err := os.WriteFile(path, []byte(`{"referenceName":"Weekly","visibilty":"SHOW_FOR_ALL"}`), 0o600); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"--strict-validation", "game-center", "leaderboards", "update", "--id", "LB_ID", "--file", path}, "1.2.3")
+		if code == 0 {
+			t.Fatal("expected non-zero exit code")
+		}
+	})
+	if !strings.Contains(stderr, `unknown field "visibilty"`) {
+		t.Fatalf("expected offending field in stderr, got %q", stderr)
+	}
+}
@@ -0,0 +1,34 @@
+package cmdtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestRunUsageErrorExitsWithUsageCode(t *testing.T) {
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"apps", "get"}, "1.2.3")
+		if code != 2 {
+			t.Fatalf("expected exit code 2, got %d", code)
+		}
+	})
+
+	if !strings.Contains(stderr, "--id is required") {
+		t.Fatalf("expected usage error in stderr, got %q", stderr)
+	}
+}
+
+func TestRunFlagValidationErrorExitsWithUsageCode(t *testing.T) {
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"builds", "list", "--app", "APP_ID", "--limit", "500"}, "1.2.3")
+		if code != 2 {
+			t.Fatalf("expected exit code 2, got %d", code)
+		}
+	})
+
+	if !strings.Contains(stderr, "--limit must be between 1 and 200") {
+		t.Fatalf("expected validation error in stderr, got %q", stderr)
+	}
+}
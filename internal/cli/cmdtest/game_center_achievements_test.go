@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"io"
+	"strings"
 	"testing"
 )
 
@@ -48,6 +49,28 @@ func TestGameCenterAchievementsGetValidationErrors(t *testing.T) {
 	}
 }
 
+func TestGameCenterAchievementsGetInvalidInclude(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"game-center", "achievements", "get", "--id", "ACH_ID", "--include", "releases"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--include must be one of: localizations, image") {
+		t.Fatalf("expected include error, got %q", stderr)
+	}
+}
+
 func TestGameCenterAchievementsCreateValidationErrors(t *testing.T) {
 	t.Setenv("ASC_APP_ID", "")
 
@@ -71,6 +94,14 @@ func TestGameCenterAchievementsCreateValidationErrors(t *testing.T) {
 			name: "missing points",
 			args: []string{"game-center", "achievements", "create", "--app", "APP_ID", "--reference-name", "Test", "--vendor-id", "com.test"},
 		},
+		{
+			name: "partial localization",
+			args: []string{"game-center", "achievements", "create", "--app", "APP_ID", "--reference-name", "Test", "--vendor-id", "com.test", "--points", "10", "--locale", "en-US", "--title", "Test"},
+		},
+		{
+			name: "keep-on-failure without locale",
+			args: []string{"game-center", "achievements", "create", "--app", "APP_ID", "--reference-name", "Test", "--vendor-id", "com.test", "--points", "10", "--keep-on-failure"},
+		},
 	}
 
 	for _, test := range tests {
@@ -232,6 +263,18 @@ func TestGameCenterAchievementImagesUploadValidationErrors(t *testing.T) {
 			name: "missing file",
 			args: []string{"game-center", "achievements", "images", "upload", "--localization-id", "LOC_ID"},
 		},
+		{
+			name: "file and url",
+			args: []string{"game-center", "achievements", "images", "upload", "--localization-id", "LOC_ID", "--file", "test.png", "--url", "https://assets.example.com/test.png"},
+		},
+		{
+			name: "manifest and localization-id",
+			args: []string{"game-center", "achievements", "images", "upload", "--manifest", "images.json", "--localization-id", "LOC_ID"},
+		},
+		{
+			name: "manifest with zero concurrency",
+			args: []string{"game-center", "achievements", "images", "upload", "--manifest", "images.json", "--concurrency", "0"},
+		},
 	}
 
 	for _, test := range tests {
@@ -48,6 +48,14 @@ func TestGameCenterLeaderboardSetsCreateValidationErrors(t *testing.T) {
 			name: "missing vendor-id",
 			args: []string{"game-center", "leaderboard-sets", "create", "--app", "APP_ID", "--reference-name", "Test"},
 		},
+		{
+			name: "partial localization",
+			args: []string{"game-center", "leaderboard-sets", "create", "--app", "APP_ID", "--reference-name", "Test", "--vendor-id", "com.test", "--locale", "en-US"},
+		},
+		{
+			name: "keep-on-failure without locale",
+			args: []string{"game-center", "leaderboard-sets", "create", "--app", "APP_ID", "--reference-name", "Test", "--vendor-id", "com.test", "--keep-on-failure"},
+		},
 	}
 
 	for _, test := range tests {
@@ -91,6 +99,51 @@ func TestGameCenterLeaderboardSetMembersListValidationErrors(t *testing.T) {
 	}
 }
 
+func TestGameCenterLeaderboardSetMembersAddRemoveValidationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "add missing set-id",
+			args: []string{"game-center", "leaderboard-sets", "members", "add", "--leaderboard-ids", "LB_ID"},
+		},
+		{
+			name: "add missing leaderboard-ids",
+			args: []string{"game-center", "leaderboard-sets", "members", "add", "--set-id", "SET_ID"},
+		},
+		{
+			name: "remove missing set-id",
+			args: []string{"game-center", "leaderboard-sets", "members", "remove", "--leaderboard-ids", "LB_ID"},
+		},
+		{
+			name: "remove missing leaderboard-ids",
+			args: []string{"game-center", "leaderboard-sets", "members", "remove", "--set-id", "SET_ID"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+		})
+	}
+}
+
 func TestGameCenterLeaderboardSetsListLimitValidation(t *testing.T) {
 	t.Setenv("ASC_APP_ID", "APP_ID")
 
@@ -111,3 +164,22 @@ func TestGameCenterLeaderboardSetsListLimitValidation(t *testing.T) {
 		t.Fatalf("expected empty stdout, got %q", stdout)
 	}
 }
+
+func TestGameCenterDetailListWorkersValidation(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"game-center", "detail", "list", "--app", "APP_ID", "--workers", "0"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"io"
+	"strings"
 	"testing"
 )
 
@@ -164,3 +165,111 @@ func TestGameCenterLeaderboardsListLimitValidation(t *testing.T) {
 		t.Fatalf("expected empty stdout, got %q", stdout)
 	}
 }
+
+func TestGameCenterLeaderboardsListFieldsValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantHelp bool
+	}{
+		{
+			name: "unknown field",
+			args: []string{"game-center", "leaderboards", "list", "--app", "APP_ID", "--fields", "title"},
+		},
+		{
+			name:     "sort without referenceName",
+			args:     []string{"game-center", "leaderboards", "list", "--app", "APP_ID", "--fields", "vendorIdentifier", "--sort", "referenceName"},
+			wantHelp: true,
+		},
+		{
+			name:     "name filter without referenceName",
+			args:     []string{"game-center", "leaderboards", "list", "--app", "APP_ID", "--fields", "vendorIdentifier", "--name-contains", "weekly"},
+			wantHelp: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if test.wantHelp != errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+		})
+	}
+}
+
+func TestGameCenterLeaderboardImagesDownloadValidationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "missing id",
+			args: []string{"game-center", "leaderboards", "images", "download", "--path", "./leaderboard.png"},
+		},
+		{
+			name: "missing path",
+			args: []string{"game-center", "leaderboards", "images", "download", "--id", "IMAGE_ID"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+		})
+	}
+}
+
+func TestGameCenterLeaderboardsDeleteCascadeRequiresApp(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"game-center", "leaderboards", "delete", "--id", "LB_ID", "--cascade", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--app is required with --cascade") {
+		t.Fatalf("expected cascade app error, got %q", stderr)
+	}
+}
@@ -24,6 +24,11 @@ func TestNominationsValidationErrors(t *testing.T) {
 			args:    []string{"nominations", "list"},
 			wantErr: "--status is required",
 		},
+		{
+			name:    "nominations list report with table output",
+			args:    []string{"nominations", "list", "--status", "DRAFT", "--report", "--output", "table"},
+			wantErr: "--report only supports --output markdown",
+		},
 		{
 			name:    "nominations create missing app",
 			args:    []string{"nominations", "create", "--name", "Launch", "--type", "APP_LAUNCH", "--description", "desc", "--submitted=false", "--publish-start-date", "2026-02-01T08:00:00Z"},
@@ -79,6 +84,16 @@ func TestNominationsValidationErrors(t *testing.T) {
 			args:    []string{"nominations", "update", "--id", "NOM_ID", "--notes", "Updated"},
 			wantErr: "--submitted or --archived is required",
 		},
+		{
+			name:    "nominations submit missing id",
+			args:    []string{"nominations", "submit"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "nominations archive missing id",
+			args:    []string{"nominations", "archive"},
+			wantErr: "--id is required",
+		},
 		{
 			name:    "nominations delete missing id",
 			args:    []string{"nominations", "delete", "--confirm"},
@@ -0,0 +1,149 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestRawValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing path",
+			args:    []string{"raw"},
+			wantErr: "--path is required",
+		},
+		{
+			name:    "unsupported method",
+			args:    []string{"raw", "--method", "PUT", "--path", "/v1/apps"},
+			wantErr: "--method must be one of: GET, POST, PATCH, DELETE",
+		},
+		{
+			name:    "post without body",
+			args:    []string{"raw", "--method", "post", "--path", "/v1/apps"},
+			wantErr: "--body is required for POST",
+		},
+		{
+			name:    "patch without body",
+			args:    []string{"raw", "--method", "PATCH", "--path", "/v1/apps/APP_ID"},
+			wantErr: "--body is required for PATCH",
+		},
+		{
+			name:    "get with body",
+			args:    []string{"raw", "--path", "/v1/apps", "--body", "body.json"},
+			wantErr: "--body is not allowed for GET",
+		},
+		{
+			name:    "paginate with delete",
+			args:    []string{"raw", "--method", "DELETE", "--path", "/v1/apps/APP_ID", "--paginate"},
+			wantErr: "--paginate requires --method GET",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestRawRejectsInvalidBody(t *testing.T) {
+	bodyPath := filepath.Join(t.TempDir(), "body.json")
+	if err := os.WriteFile(bodyPath, []byte(`["not", "an", "object"]`), 0o600); err != nil {
+		t.Fatalf("write body: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"raw", "--method", "POST", "--path", "/v1/apps", "--body", bodyPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "raw: read --body: invalid JSON") {
+		t.Fatalf("expected invalid JSON error, got %v", runErr)
+	}
+}
+
+// writeRawReplay writes a GET recording for path that --replay serves.
+func writeRawReplay(t *testing.T, dir, name, path, body string) {
+	t.Helper()
+	recording := `{"recordedAt":"2026-10-15T12:00:00Z","method":"GET","path":"` + path + `","response":{"status":200,"body":` + body + `}}`
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(recording), 0o600); err != nil {
+		t.Fatalf("write recording: %v", err)
+	}
+}
+
+func TestRawPaginateCombinesPages(t *testing.T) {
+	t.Cleanup(func() { asc.SetReplayDir("") })
+	dir := t.TempDir()
+	writeRawReplay(t, dir, "0001.json", "/v1/apps?limit=1",
+		`{"data":[{"type":"apps","id":"app-1"}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps?cursor=Mg&limit=1"}}`)
+	writeRawReplay(t, dir, "0002.json", "/v1/apps?cursor=Mg&limit=1",
+		`{"data":[{"type":"apps","id":"app-2"}],"links":{}}`)
+
+	stdout, _, err := runRootCommand(t, "--replay", dir, "raw", "--path", "/v1/apps?limit=1", "--paginate")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	var result struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	if len(result.Data) != 2 || result.Data[0].ID != "app-1" || result.Data[1].ID != "app-2" {
+		t.Fatalf("expected both pages in order, got %+v", result.Data)
+	}
+}
+
+func TestRawPaginateRejectsSingleResource(t *testing.T) {
+	t.Cleanup(func() { asc.SetReplayDir("") })
+	dir := t.TempDir()
+	writeRawReplay(t, dir, "0001.json", "/v1/apps/app-1", `{"data":{"type":"apps","id":"app-1"}}`)
+
+	stdout, _, err := runRootCommand(t, "--replay", dir, "raw", "--path", "/v1/apps/app-1", "--paginate")
+	if err == nil || !errors.Is(err, asc.ErrNotJSONAPICollection) {
+		t.Fatalf("expected ErrNotJSONAPICollection, got %v", err)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+}
@@ -0,0 +1,67 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func runRootCommand(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestRecordAndReplayAreMutuallyExclusive(t *testing.T) {
+	dir := t.TempDir()
+	_, _, err := runRootCommand(t, "--record", dir, "--replay", dir, "apps", "list")
+	if err == nil || !strings.Contains(err.Error(), "--record and --replay cannot be used together") {
+		t.Fatalf("expected mutually exclusive error, got %v", err)
+	}
+}
+
+func TestReplayServesAppsListWithoutCredentials(t *testing.T) {
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_PRIVATE_KEY", "")
+	t.Setenv("ASC_PRIVATE_KEY_B64", "")
+	t.Cleanup(func() { asc.SetReplayDir("") })
+
+	dir := t.TempDir()
+	recording := `{
+  "recordedAt": "2026-10-15T12:00:00Z",
+  "method": "GET",
+  "path": "/v1/apps?limit=1",
+  "response": {
+    "status": 200,
+    "body": {"data":[{"type":"apps","id":"123456789","attributes":{"name":"Replayed App"}}]}
+  }
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "20261015T120000.000000000Z-0001-GET-v1-apps.json"), []byte(recording), 0o600); err != nil {
+		t.Fatalf("write recording: %v", err)
+	}
+
+	stdout, _, err := runRootCommand(t, "--replay", dir, "apps", "list", "--limit", "1")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.Contains(stdout, `"name":"Replayed App"`) {
+		t.Fatalf("expected replayed app in output, got %q", stdout)
+	}
+}
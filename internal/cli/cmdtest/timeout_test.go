@@ -0,0 +1,27 @@
+package cmdtest
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestGlobalTimeoutFlagSetsOverride(t *testing.T) {
+	t.Cleanup(func() { asc.SetTimeoutOverride(0) })
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	if err := root.Parse([]string{"--timeout", "2m", "apps", "list"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if got := asc.ResolveTimeout(); got != 2*time.Minute {
+		t.Fatalf("expected --timeout to set the request timeout, got %v", got)
+	}
+
+	RootCommand("1.2.3")
+	if got := asc.TimeoutOverride(); got != 0 {
+		t.Fatalf("expected a new root command to clear the override, got %v", got)
+	}
+}
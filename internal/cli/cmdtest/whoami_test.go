@@ -0,0 +1,153 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	authcli "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func setupWhoamiEnv(t *testing.T, keyPath string) {
+	t.Helper()
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "KEY123")
+	t.Setenv("ASC_ISSUER_ID", "ISS456")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", keyPath)
+
+	previousProfile := shared.SelectedProfile()
+	shared.SetSelectedProfile("")
+	t.Cleanup(func() {
+		shared.SetSelectedProfile(previousProfile)
+	})
+}
+
+func runWhoami(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"whoami"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestWhoamiPrintsKeyContextAndFirstApp(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+	setupWhoamiEnv(t, keyPath)
+
+	restore := authcli.SetWhoamiFetchApps(func(ctx context.Context, keyID, issuerID, path string) (*asc.AppsResponse, error) {
+		if keyID != "KEY123" || issuerID != "ISS456" || path != keyPath {
+			t.Fatalf("unexpected credentials: %q %q %q", keyID, issuerID, path)
+		}
+		return &asc.AppsResponse{Data: []asc.Resource[asc.AppAttributes]{
+			{ID: "123456789", Attributes: asc.AppAttributes{Name: "Demo App", BundleID: "com.example.demo"}},
+		}}, nil
+	})
+	t.Cleanup(restore)
+
+	stdout, _, err := runWhoami(t)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	for _, want := range []string{"Key ID: KEY123", "Issuer ID: ISS456", "First app: Demo App (123456789)"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout)
+		}
+	}
+
+	stdout, _, err = runWhoami(t, "--output", "json")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	var result struct {
+		KeyID    string `json:"key_id"`
+		IssuerID string `json:"issuer_id"`
+		Verified bool   `json:"verified"`
+		FirstApp struct {
+			ID       string `json:"id"`
+			Name     string `json:"name"`
+			BundleID string `json:"bundle_id"`
+		} `json:"first_app"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse JSON output %q: %v", stdout, err)
+	}
+	if result.KeyID != "KEY123" || result.IssuerID != "ISS456" || !result.Verified {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.FirstApp.ID != "123456789" || result.FirstApp.Name != "Demo App" || result.FirstApp.BundleID != "com.example.demo" {
+		t.Fatalf("unexpected first app: %+v", result.FirstApp)
+	}
+}
+
+func TestWhoamiMissingKeyFile(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "missing.p8")
+	setupWhoamiEnv(t, keyPath)
+
+	restore := authcli.SetWhoamiFetchApps(func(context.Context, string, string, string) (*asc.AppsResponse, error) {
+		t.Fatal("did not expect an API request")
+		return nil, nil
+	})
+	t.Cleanup(restore)
+
+	_, _, err := runWhoami(t)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "invalid private key "+keyPath) {
+		t.Fatalf("expected missing key error, got %v", err)
+	}
+}
+
+func TestWhoamiRejectedToken(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+	setupWhoamiEnv(t, keyPath)
+
+	restore := authcli.SetWhoamiFetchApps(func(context.Context, string, string, string) (*asc.AppsResponse, error) {
+		return nil, fmt.Errorf("request failed: %w", asc.ErrUnauthorized)
+	})
+	t.Cleanup(restore)
+
+	_, _, err := runWhoami(t)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "App Store Connect rejected the token for key KEY123 (issuer ISS456)") {
+		t.Fatalf("expected rejected token error, got %v", err)
+	}
+}
+
+func TestWhoamiForbiddenStillVerifies(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+	setupWhoamiEnv(t, keyPath)
+
+	restore := authcli.SetWhoamiFetchApps(func(context.Context, string, string, string) (*asc.AppsResponse, error) {
+		return nil, asc.ErrForbidden
+	})
+	t.Cleanup(restore)
+
+	stdout, _, err := runWhoami(t)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.Contains(stdout, "Warning: key works but cannot list apps") {
+		t.Fatalf("expected permission warning, got %q", stdout)
+	}
+}
@@ -0,0 +1,87 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestXcodeCloudWorkflowsGetYAMLFeedsCreate(t *testing.T) {
+	clearCredentialEnv(t)
+	t.Cleanup(func() {
+		asc.SetReplayDir("")
+		asc.SetDryRun(false)
+	})
+
+	dir := t.TempDir()
+	writeRawReplay(t, dir, "0001.json", "/v1/ciWorkflows/wf-1", `{
+		"data": {
+			"type": "ciWorkflows",
+			"id": "wf-1",
+			"attributes": {
+				"name": "Nightly",
+				"containerFilePath": "App.xcodeproj",
+				"isEnabled": true,
+				"actions": [{"name": "Build", "actionType": "BUILD"}],
+				"lastModifiedDate": "2026-10-01T08:00:00Z"
+			},
+			"relationships": {
+				"product": {"data": {"type": "ciProducts", "id": "prod-1"}}
+			},
+			"links": {"self": "https://api.appstoreconnect.apple.com/v1/ciWorkflows/wf-1"}
+		},
+		"links": {"self": "https://api.appstoreconnect.apple.com/v1/ciWorkflows/wf-1"}
+	}`)
+
+	stdout, _, err := runRootCommand(t, "--replay", dir, "xcode-cloud", "workflows", "get", "--id", "wf-1", "--output", "yaml")
+	if err != nil {
+		t.Fatalf("get error: %v", err)
+	}
+	workflowPath := filepath.Join(t.TempDir(), "workflow.yaml")
+	if err := os.WriteFile(workflowPath, []byte(stdout), 0o600); err != nil {
+		t.Fatalf("write workflow: %v", err)
+	}
+
+	_, stderr, err := runRootCommand(t, "--replay", dir, "--dry-run", "--strict-validation", "xcode-cloud", "workflows", "create", "--file", workflowPath)
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+
+	prefix := "dry-run: POST /v1/ciWorkflows\n"
+	start := strings.Index(stderr, prefix)
+	if start < 0 {
+		t.Fatalf("expected dry-run POST in stderr, got %q", stderr)
+	}
+	body, _, _ := strings.Cut(stderr[start+len(prefix):], "\n")
+
+	var payload struct {
+		Links json.RawMessage `json:"links"`
+		Data  struct {
+			ID            *string                    `json:"id"`
+			Links         json.RawMessage            `json:"links"`
+			Attributes    map[string]json.RawMessage `json:"attributes"`
+			Relationships map[string]json.RawMessage `json:"relationships"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(body), &payload); err != nil {
+		t.Fatalf("decode create body %q: %v", body, err)
+	}
+	if payload.Links != nil || payload.Data.Links != nil || payload.Data.ID != nil {
+		t.Fatalf("expected id and links to be dropped, got %s", body)
+	}
+	if _, ok := payload.Data.Attributes["lastModifiedDate"]; ok {
+		t.Fatalf("expected lastModifiedDate to be dropped, got %s", body)
+	}
+	for _, key := range []string{"name", "containerFilePath", "actions"} {
+		if _, ok := payload.Data.Attributes[key]; !ok {
+			t.Fatalf("expected attribute %q to be kept, got %s", key, body)
+		}
+	}
+	if _, ok := payload.Data.Relationships["product"]; !ok {
+		t.Fatalf("expected product relationship to be kept, got %s", body)
+	}
+}
@@ -23,8 +23,18 @@ func CompletionCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
 		Name:       "completion",
 		ShortUsage: "asc completion --shell <bash|zsh|fish>",
 		ShortHelp:  "Print shell completion scripts.",
-		FlagSet:    fs,
-		UsageFunc:  shared.DefaultUsageFunc,
+		LongHelp: `Print shell completion scripts.
+
+Scripts complete subcommands at every level and suggest known values for
+enum flags, such as --output <TAB> (json, table, markdown) or
+asc nominations list --status <TAB> (DRAFT, SUBMITTED, ARCHIVED).
+
+Examples:
+  source <(asc completion --shell bash)
+  asc completion --shell zsh > "${fpath[1]}/_asc"
+  asc completion --shell fish > ~/.config/fish/completions/asc.fish`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
 	}
 
 	cmd.Exec = func(ctx context.Context, args []string) error {
@@ -33,24 +43,22 @@ func CompletionCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
 
 		s := strings.ToLower(strings.TrimSpace(*shell))
 		if s == "" {
-			fmt.Fprintln(os.Stderr, "Error: --shell is required")
-			return flag.ErrHelp
+			return shared.FlagErrorf("--shell is required")
 		}
 
-		names := rootCommandNames(rootSubcommands)
+		spec := buildCompletionSpec(rootSubcommands)
 		switch s {
 		case "bash":
-			fmt.Fprint(os.Stdout, bashScript(names))
+			fmt.Fprint(os.Stdout, bashScript(spec))
 			return nil
 		case "zsh":
-			fmt.Fprint(os.Stdout, zshScript(names))
+			fmt.Fprint(os.Stdout, zshScript(spec))
 			return nil
 		case "fish":
-			fmt.Fprint(os.Stdout, fishScript(names))
+			fmt.Fprint(os.Stdout, fishScript(spec))
 			return nil
 		default:
-			fmt.Fprintf(os.Stderr, "Error: unsupported shell: %s\n", shared.SanitizeTerminal(s))
-			return flag.ErrHelp
+			return shared.FlagErrorf("unsupported shell: %s", shared.SanitizeTerminal(s))
 		}
 	}
 
@@ -82,38 +90,207 @@ func rootCommandNames(rootSubcommands []*ffcli.Command) []string {
 	return names
 }
 
-func bashScript(subcommands []string) string {
-	words := strings.Join(subcommands, " ")
-	return fmt.Sprintf(`# bash completion for asc
+// completionSpec is the command tree data embedded into completion scripts.
+// Command paths are space-separated subcommand names; "" is the root.
+type completionSpec struct {
+	paths       []string
+	subcommands map[string][]string
+	flagValues  map[string][]flagValues
+}
+
+type flagValues struct {
+	name   string
+	values []string
+}
+
+func buildCompletionSpec(rootSubcommands []*ffcli.Command) completionSpec {
+	spec := completionSpec{
+		subcommands: map[string][]string{"": rootCommandNames(rootSubcommands)},
+		flagValues:  make(map[string][]flagValues),
+	}
+	for _, c := range rootSubcommands {
+		spec.add("", c)
+	}
+
+	seen := make(map[string]struct{})
+	for path := range spec.subcommands {
+		seen[path] = struct{}{}
+	}
+	for path := range spec.flagValues {
+		seen[path] = struct{}{}
+	}
+	for path := range seen {
+		spec.paths = append(spec.paths, path)
+	}
+	sort.Strings(spec.paths)
+	return spec
+}
+
+func (s completionSpec) add(parent string, c *ffcli.Command) {
+	if c == nil || strings.TrimSpace(c.Name) == "" {
+		return
+	}
+	path := strings.TrimSpace(parent + " " + c.Name)
+
+	if c.FlagSet != nil {
+		c.FlagSet.VisitAll(func(f *flag.Flag) {
+			if values := shared.FlagValues(f); len(values) > 0 {
+				s.flagValues[path] = append(s.flagValues[path], flagValues{name: f.Name, values: values})
+			}
+		})
+	}
+
+	names := make([]string, 0, len(c.Subcommands))
+	for _, sub := range c.Subcommands {
+		if sub == nil || strings.TrimSpace(sub.Name) == "" {
+			continue
+		}
+		names = append(names, sub.Name)
+		s.add(path, sub)
+	}
+	if len(names) > 0 {
+		sort.Strings(names)
+		s.subcommands[path] = names
+	}
+}
+
+// shellQuote single-quotes value for bash, zsh, and fish.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// posixLookupFunctions renders the _asc_subcommands and _asc_flag_values
+// helpers shared by the bash and zsh scripts.
+func posixLookupFunctions(spec completionSpec) string {
+	var b strings.Builder
+	b.WriteString("_asc_subcommands() {\n  case \"$1\" in\n")
+	for _, path := range spec.paths {
+		if names, ok := spec.subcommands[path]; ok {
+			fmt.Fprintf(&b, "    %s) echo %s ;;\n", shellQuote(path), shellQuote(strings.Join(names, " ")))
+		}
+	}
+	b.WriteString("  esac\n}\n\n")
+
+	b.WriteString("_asc_flag_values() {\n  case \"$1|$2\" in\n")
+	for _, path := range spec.paths {
+		for _, fv := range spec.flagValues[path] {
+			fmt.Fprintf(&b, "    %s) echo %s ;;\n", shellQuote(path+"|"+fv.name), shellQuote(strings.Join(fv.values, " ")))
+		}
+	}
+	b.WriteString("  esac\n}\n")
+	return b.String()
+}
+
+func bashScript(spec completionSpec) string {
+	return `# bash completion for asc
+` + posixLookupFunctions(spec) + `
 _asc_completions() {
-  local cur
+  local cur prev word cmdpath values i
   COMPREPLY=()
   cur="${COMP_WORDS[COMP_CWORD]}"
+  prev="${COMP_WORDS[COMP_CWORD-1]}"
+  if [[ $prev == "=" && $COMP_CWORD -gt 1 ]]; then
+    prev="${COMP_WORDS[COMP_CWORD-2]}"
+  fi
+
+  cmdpath=""
+  for ((i = 1; i < COMP_CWORD; i++)); do
+    word="${COMP_WORDS[i]}"
+    [[ $word == -* ]] && continue
+    if [[ " $(_asc_subcommands "$cmdpath") " == *" $word "* ]]; then
+      cmdpath="${cmdpath:+$cmdpath }$word"
+    fi
+  done
 
-  if [[ $COMP_CWORD -eq 1 ]]; then
-    COMPREPLY=( $(compgen -W "%s" -- "$cur") )
-    return 0
+  if [[ $prev == -* ]]; then
+    values="$(_asc_flag_values "$cmdpath" "${prev##*-}")"
+    if [[ -n $values ]]; then
+      COMPREPLY=( $(compgen -W "$values" -- "$cur") )
+      return 0
+    fi
   fi
+
+  [[ $cur == -* ]] && return 0
+  COMPREPLY=( $(compgen -W "$(_asc_subcommands "$cmdpath")" -- "$cur") )
+  return 0
 }
 
 complete -F _asc_completions asc
-`, words)
+`
 }
 
-func zshScript(subcommands []string) string {
-	// zsh _arguments wants a space-separated list inside ((...))
-	words := strings.Join(subcommands, " ")
-	return fmt.Sprintf(`#compdef asc
+func zshScript(spec completionSpec) string {
+	return `#compdef asc
 
-_arguments \
-  '1:command:(%s)' \
-  '*::arg:->args'
-`, words)
+` + posixLookupFunctions(spec) + `
+_asc() {
+  local word cmdpath values flag i
+  cmdpath=""
+  for ((i = 2; i < CURRENT; i++)); do
+    word="${words[i]}"
+    [[ $word == -* ]] && continue
+    if [[ " $(_asc_subcommands "$cmdpath") " == *" $word "* ]]; then
+      cmdpath="${cmdpath:+$cmdpath }$word"
+    fi
+  done
+
+  flag="${words[CURRENT-1]}"
+  if [[ $flag == -* ]]; then
+    values="$(_asc_flag_values "$cmdpath" "${flag##*-}")"
+    if [[ -n $values ]]; then
+      compadd -- ${=values}
+      return
+    fi
+  fi
+
+  [[ ${words[CURRENT]} == -* ]] && return
+  compadd -- ${=$(_asc_subcommands "$cmdpath")}
 }
 
-func fishScript(subcommands []string) string {
-	words := strings.Join(subcommands, " ")
-	return fmt.Sprintf(`# fish completion for asc
-complete -c asc -f -a '%s'
-`, words)
+if [[ $zsh_eval_context[-1] == loadautofunc ]]; then
+  _asc "$@"
+else
+  compdef _asc asc
+fi
+`
+}
+
+func fishScript(spec completionSpec) string {
+	var b strings.Builder
+	b.WriteString(`# fish completion for asc
+function __asc_subcommands
+    switch $argv[1]
+`)
+	for _, path := range spec.paths {
+		if names, ok := spec.subcommands[path]; ok {
+			fmt.Fprintf(&b, "        case %s\n            printf '%%s\\n' %s\n", shellQuote(path), strings.Join(names, " "))
+		}
+	}
+	b.WriteString(`    end
+end
+
+function __asc_cmdpath
+    set -l cmdpath
+    for word in (commandline -opc)[2..-1]
+        string match -q -- '-*' $word; and continue
+        if contains -- $word (__asc_subcommands "$cmdpath")
+            set cmdpath (string join ' ' $cmdpath $word)
+        end
+    end
+    echo "$cmdpath"
+end
+
+function __asc_at
+    test (__asc_cmdpath) = "$argv[1]"
+end
+
+complete -c asc -f -a '(__asc_subcommands (__asc_cmdpath))'
+`)
+	for _, path := range spec.paths {
+		for _, fv := range spec.flagValues[path] {
+			fmt.Fprintf(&b, "complete -c asc -n %s -l %s -x -a %s\n",
+				shellQuote("__asc_at "+shellQuote(path)), fv.name, shellQuote(strings.Join(fv.values, " ")))
+		}
+	}
+	return b.String()
 }
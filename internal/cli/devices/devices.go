@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"os/exec"
 	"runtime"
 	"strings"
@@ -56,12 +55,15 @@ func DevicesListCommand() *ffcli.Command {
 	ids := fs.String("id", "", "Filter by device ID(s), comma-separated")
 	sort := fs.String("sort", "", "Sort by id, -id, name, -name, platform, -platform, status, -status, udid, -udid")
 	fields := fs.String("fields", "", "Fields to include: addedDate, deviceClass, model, name, platform, status, udid")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
 
+	registerFlagValues(fs, "platform", devicePlatformList()...)
+	registerFlagValues(fs, "status", deviceStatusList()...)
+
 	return &ffcli.Command{
 		Name:       "list",
 		ShortUsage: "asc devices list [flags]",
@@ -80,7 +82,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("devices list: --limit must be between 1 and 200")
+				return usageErrorf("devices list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("devices list: %w", err)
@@ -139,14 +141,13 @@ Examples:
 					return fmt.Errorf("devices list: failed to fetch: %w", err)
 				}
 
-				devices, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("devices list: %w", err)
 				}
-
-				return printOutput(devices, *output, *pretty)
+				return nil
 			}
 
 			devices, err := client.GetDevices(requestCtx, opts...)
@@ -165,7 +166,7 @@ func DevicesGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Device ID")
 	fields := fs.String("fields", "", "Fields to include: addedDate, deviceClass, model, name, platform, status, udid")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -182,8 +183,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			fieldsValue, err := normalizeDeviceFields(*fields)
@@ -213,7 +213,7 @@ Examples:
 func DevicesLocalUDIDCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("local-udid", flag.ExitOnError)
 
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -251,9 +251,11 @@ func DevicesRegisterCommand() *ffcli.Command {
 	udid := fs.String("udid", "", "Device UDID (required unless --udid-from-system)")
 	udidFromSystem := fs.Bool("udid-from-system", false, "Use local macOS hardware UUID as UDID (macOS only)")
 	platform := fs.String("platform", "", "Device platform: "+strings.Join(devicePlatformList(), ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	registerFlagValues(fs, "platform", devicePlatformList()...)
+
 	return &ffcli.Command{
 		Name:       "register",
 		ShortUsage: "asc devices register --name NAME --udid UDID --platform " + strings.Join(devicePlatformList(), "|"),
@@ -268,14 +270,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			nameValue := strings.TrimSpace(*name)
 			if nameValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return flagErrorf("--name is required")
 			}
 
 			udidValue := strings.TrimSpace(*udid)
 			if *udidFromSystem && udidValue != "" {
-				fmt.Fprintln(os.Stderr, "Error: --udid and --udid-from-system are mutually exclusive")
-				return flag.ErrHelp
+				return flagErrorf("--udid and --udid-from-system are mutually exclusive")
 			}
 			if *udidFromSystem {
 				localUDID, err := localMacUDID()
@@ -285,8 +285,7 @@ Examples:
 				udidValue = localUDID
 			}
 			if udidValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --udid is required")
-				return flag.ErrHelp
+				return flagErrorf("--udid is required")
 			}
 
 			platformValue := strings.TrimSpace(*platform)
@@ -294,12 +293,10 @@ Examples:
 				platformValue = "MAC_OS"
 			}
 			if platformValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --platform is required")
-				return flag.ErrHelp
+				return flagErrorf("--platform is required")
 			}
 			if *udidFromSystem && strings.ToUpper(platformValue) != "MAC_OS" {
-				fmt.Fprintln(os.Stderr, "Error: --udid-from-system requires --platform MAC_OS")
-				return flag.ErrHelp
+				return flagErrorf("--udid-from-system requires --platform MAC_OS")
 			}
 
 			platformValue, err := normalizeDevicePlatform(platformValue)
@@ -338,9 +335,11 @@ func DevicesUpdateCommand() *ffcli.Command {
 	id := fs.String("id", "", "Device ID")
 	name := fs.String("name", "", "Device name")
 	status := fs.String("status", "", "Device status: ENABLED, DISABLED")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	registerFlagValues(fs, "status", deviceStatusList()...)
+
 	return &ffcli.Command{
 		Name:       "update",
 		ShortUsage: "asc devices update --id DEVICE_ID [--name NAME] [--status ENABLED|DISABLED]",
@@ -355,15 +354,13 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			nameValue := strings.TrimSpace(*name)
 			statusRaw := strings.TrimSpace(*status)
 			if nameValue == "" && statusRaw == "" {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			statusValue, err := normalizeDeviceStatus(statusRaw)
@@ -409,7 +406,7 @@ func normalizeDevicePlatform(value string) (string, error) {
 			return normalized, nil
 		}
 	}
-	return "", fmt.Errorf("--platform must be one of: %s", strings.Join(devicePlatformList(), ", "))
+	return "", usageErrorf("--platform must be one of: %s", strings.Join(devicePlatformList(), ", "))
 }
 
 func normalizeDevicePlatforms(values []string) ([]string, error) {
@@ -444,7 +441,7 @@ func normalizeDeviceStatus(value string) (string, error) {
 			return normalized, nil
 		}
 	}
-	return "", fmt.Errorf("--status must be one of: %s", strings.Join(deviceStatusList(), ", "))
+	return "", usageErrorf("--status must be one of: %s", strings.Join(deviceStatusList(), ", "))
 }
 
 func normalizeDeviceFields(value string) ([]string, error) {
@@ -459,7 +456,7 @@ func normalizeDeviceFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(deviceFieldsList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(deviceFieldsList(), ", "))
 		}
 	}
 
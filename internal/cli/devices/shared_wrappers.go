@@ -2,6 +2,7 @@ package devices
 
 import (
 	"context"
+	"flag"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -25,6 +26,10 @@ func printOutput(data interface{}, format string, pretty bool) error {
 	return shared.PrintOutput(data, format, pretty)
 }
 
+func paginateOutput(ctx context.Context, firstPage asc.PaginatedResponse, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	return shared.PaginateOutput(ctx, firstPage, fetchNext, format, pretty)
+}
+
 func validateNextURL(next string) error {
 	return shared.ValidateNextURL(next)
 }
@@ -36,3 +41,15 @@ func validateSort(value string, allowed ...string) error {
 func splitCSV(value string) []string {
 	return shared.SplitCSV(value)
 }
+
+func registerFlagValues(fs *flag.FlagSet, name string, values ...string) {
+	shared.RegisterFlagValues(fs, name, values...)
+}
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
@@ -78,7 +78,7 @@ func EncryptionDeclarationsListCommand() *ffcli.Command {
 	buildLimit := fs.Int("build-limit", 0, "Maximum included builds per declaration (1-50)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -95,10 +95,10 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("encryption declarations list: --limit must be between 1 and 200")
+				return usageErrorf("encryption declarations list: --limit must be between 1 and 200")
 			}
 			if *buildLimit != 0 && (*buildLimit < 1 || *buildLimit > 50) {
-				return fmt.Errorf("encryption declarations list: --build-limit must be between 1 and 50")
+				return usageErrorf("encryption declarations list: --build-limit must be between 1 and 50")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("encryption declarations list: %w", err)
@@ -119,8 +119,7 @@ Examples:
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			buildIDs := parseCommaSeparatedIDs(*builds)
@@ -150,14 +149,13 @@ Examples:
 					return fmt.Errorf("encryption declarations list: failed to fetch: %w", err)
 				}
 
-				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEncryptionDeclarations(ctx, resolvedAppID, asc.WithAppEncryptionDeclarationsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("encryption declarations list: %w", err)
 				}
-
-				return printOutput(pages, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppEncryptionDeclarations(requestCtx, resolvedAppID, opts...)
@@ -179,7 +177,7 @@ func EncryptionDeclarationsGetCommand() *ffcli.Command {
 	documentFields := fs.String("document-fields", "", "Document fields to include: "+strings.Join(encryptionDocumentFieldList(), ", "))
 	include := fs.String("include", "", "Include relationships: "+strings.Join(encryptionDeclarationIncludeList(), ", "))
 	buildLimit := fs.Int("build-limit", 0, "Maximum included builds (1-50)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -196,11 +194,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			declarationValue := strings.TrimSpace(*declarationID)
 			if declarationValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if *buildLimit != 0 && (*buildLimit < 1 || *buildLimit > 50) {
-				return fmt.Errorf("encryption declarations get: --build-limit must be between 1 and 50")
+				return usageErrorf("encryption declarations get: --build-limit must be between 1 and 50")
 			}
 
 			fieldsValue, err := normalizeEncryptionDeclarationFields(*fields)
@@ -248,7 +245,7 @@ func EncryptionDeclarationsCreateCommand() *ffcli.Command {
 	containsProprietary := fs.Bool("contains-proprietary-cryptography", false, "App contains proprietary cryptography (required)")
 	containsThirdParty := fs.Bool("contains-third-party-cryptography", false, "App contains third-party cryptography (required)")
 	availableOnFrenchStore := fs.Bool("available-on-french-store", false, "App is available on the French store (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -264,8 +261,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			visited := map[string]bool{}
@@ -275,20 +271,16 @@ Examples:
 
 			descriptionValue := strings.TrimSpace(*appDescription)
 			if descriptionValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app-description is required")
-				return flag.ErrHelp
+				return flagErrorf("--app-description is required")
 			}
 			if !visited["contains-proprietary-cryptography"] {
-				fmt.Fprintln(os.Stderr, "Error: --contains-proprietary-cryptography is required")
-				return flag.ErrHelp
+				return flagErrorf("--contains-proprietary-cryptography is required")
 			}
 			if !visited["contains-third-party-cryptography"] {
-				fmt.Fprintln(os.Stderr, "Error: --contains-third-party-cryptography is required")
-				return flag.ErrHelp
+				return flagErrorf("--contains-third-party-cryptography is required")
 			}
 			if !visited["available-on-french-store"] {
-				fmt.Fprintln(os.Stderr, "Error: --available-on-french-store is required")
-				return flag.ErrHelp
+				return flagErrorf("--available-on-french-store is required")
 			}
 
 			client, err := getASCClient()
@@ -322,7 +314,7 @@ func EncryptionDeclarationsAssignBuildsCommand() *ffcli.Command {
 
 	declarationID := fs.String("id", "", "Encryption declaration ID (required)")
 	builds := fs.String("build", "", "Build IDs to assign (comma-separated)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -339,14 +331,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			declarationValue := strings.TrimSpace(*declarationID)
 			if declarationValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			buildIDs := parseCommaSeparatedIDs(*builds)
 			if len(buildIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --build is required")
-				return flag.ErrHelp
+				return flagErrorf("--build is required")
 			}
 
 			client, err := getASCClient()
@@ -401,7 +391,7 @@ func EncryptionDocumentsGetCommand() *ffcli.Command {
 
 	documentID := fs.String("id", "", "Document ID (required)")
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(encryptionDocumentFieldList(), ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -417,8 +407,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			documentValue := strings.TrimSpace(*documentID)
 			if documentValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			fieldsValue, err := normalizeEncryptionDocumentFields(*fields, "--fields")
@@ -450,7 +439,7 @@ func EncryptionDocumentsUploadCommand() *ffcli.Command {
 
 	declarationID := fs.String("declaration", "", "Encryption declaration ID (required)")
 	filePath := fs.String("file", "", "Path to document file (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -466,14 +455,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			declarationValue := strings.TrimSpace(*declarationID)
 			if declarationValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --declaration is required")
-				return flag.ErrHelp
+				return flagErrorf("--declaration is required")
 			}
 
 			pathValue := strings.TrimSpace(*filePath)
 			if pathValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
 			info, err := os.Lstat(pathValue)
@@ -547,7 +534,7 @@ func normalizeEncryptionDeclarationFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(encryptionDeclarationFieldList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(encryptionDeclarationFieldList(), ", "))
 		}
 	}
 	return fields, nil
@@ -581,7 +568,7 @@ func normalizeEncryptionDeclarationInclude(value string) ([]string, error) {
 	}
 	for _, item := range include {
 		if _, ok := allowed[item]; !ok {
-			return nil, fmt.Errorf("--include must be one of: %s", strings.Join(encryptionDeclarationIncludeList(), ", "))
+			return nil, usageErrorf("--include must be one of: %s", strings.Join(encryptionDeclarationIncludeList(), ", "))
 		}
 	}
 	return include, nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -51,7 +50,7 @@ func EULAGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "EULA ID")
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -72,12 +71,10 @@ Examples:
 				appValue = resolveAppID(*appID)
 			}
 			if idValue == "" && appValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id or --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--id or --app is required (or set ASC_APP_ID)")
 			}
 			if idValue != "" && strings.TrimSpace(*appID) != "" {
-				fmt.Fprintln(os.Stderr, "Error: --id and --app are mutually exclusive")
-				return flag.ErrHelp
+				return flagErrorf("--id and --app are mutually exclusive")
 			}
 
 			client, err := getASCClient()
@@ -108,7 +105,7 @@ func EULAListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -124,8 +121,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			appValue := resolveAppID(*appID)
 			if appValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -153,7 +149,7 @@ func EULACreateCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	agreementText := fs.String("agreement-text", "", "Agreement text")
 	territories := fs.String("territory", "", "Territory IDs, comma-separated")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -169,19 +165,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			appValue := resolveAppID(*appID)
 			if appValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			agreementValue := strings.TrimSpace(*agreementText)
 			if agreementValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --agreement-text is required")
-				return flag.ErrHelp
+				return flagErrorf("--agreement-text is required")
 			}
 
 			territoryIDs := parseCommaSeparatedIDs(*territories)
 			if len(territoryIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --territory is required")
-				return flag.ErrHelp
+				return flagErrorf("--territory is required")
 			}
 
 			client, err := getASCClient()
@@ -209,7 +202,7 @@ func EULAUpdateCommand() *ffcli.Command {
 	id := fs.String("id", "", "EULA ID")
 	agreementText := fs.String("agreement-text", "", "Agreement text")
 	territories := fs.String("territory", "", "Territory IDs, comma-separated")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -226,8 +219,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			var agreementValue *string
@@ -238,8 +230,7 @@ Examples:
 
 			territoryIDs := parseCommaSeparatedIDs(*territories)
 			if agreementValue == nil && len(territoryIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --agreement-text or --territory is required")
-				return flag.ErrHelp
+				return flagErrorf("--agreement-text or --territory is required")
 			}
 
 			client, err := getASCClient()
@@ -266,7 +257,7 @@ func EULADeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "EULA ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -282,12 +273,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("EULA %q", idValue))
+				if err != nil {
+					return fmt.Errorf("eula delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
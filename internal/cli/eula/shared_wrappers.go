@@ -40,3 +40,11 @@ func splitCSVUpper(value string) []string {
 func parseCommaSeparatedIDs(value string) []string {
 	return shared.SplitCSV(value)
 }
+
+func confirmDestructive(resourceDescription string) (bool, error) {
+	return shared.ConfirmDestructive(resourceDescription)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
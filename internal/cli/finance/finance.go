@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -106,20 +105,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			vendorNumber := shared.ResolveVendorNumber(*vendor)
 			if vendorNumber == "" {
-				fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER)")
-				return flag.ErrHelp
+				return flagErrorf("--vendor is required (or set ASC_VENDOR_NUMBER)")
 			}
 			if strings.TrimSpace(*reportType) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --report-type is required")
-				return flag.ErrHelp
+				return flagErrorf("--report-type is required")
 			}
 			if strings.TrimSpace(*region) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --region is required")
-				return flag.ErrHelp
+				return flagErrorf("--region is required")
 			}
 			if strings.TrimSpace(*date) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --date is required")
-				return flag.ErrHelp
+				return flagErrorf("--date is required")
 			}
 
 			normalizedReportType, err := normalizeFinanceReportType(*reportType)
@@ -190,7 +185,7 @@ Examples:
 func FinanceRegionsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("regions", flag.ExitOnError)
 
-	outputFormat := fs.String("output", "json", "Output format: json (default), table, markdown")
+	outputFormat := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
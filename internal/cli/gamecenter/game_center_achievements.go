@@ -2,9 +2,9 @@ package gamecenter
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -60,7 +60,7 @@ func GameCenterAchievementsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -77,7 +77,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("game-center achievements list: --limit must be between 1 and 200")
+				return usageErrorf("game-center achievements list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("game-center achievements list: %w", err)
@@ -86,8 +86,7 @@ Examples:
 			resolvedAppID := resolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
 			if resolvedAppID == "" && nextURL == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -120,14 +119,13 @@ Examples:
 					return fmt.Errorf("game-center achievements list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterAchievements(ctx, gcDetailID, asc.WithGCAchievementsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("game-center achievements list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterAchievements(requestCtx, gcDetailID, opts...)
@@ -145,7 +143,9 @@ func GameCenterAchievementsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	achievementID := fs.String("id", "", "Game Center achievement ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(gameCenterAchievementIncludeList(), ", "))
+	localizationsLimit := fs.Int("localizations-limit", 0, "Maximum included localizations (1-50)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -154,15 +154,32 @@ func GameCenterAchievementsGetCommand() *ffcli.Command {
 		ShortHelp:  "Get a Game Center achievement by ID.",
 		LongHelp: `Get a Game Center achievement by ID.
 
+Use --include to fetch the achievement's localizations and their images
+alongside the achievement. Included resources are returned under "included".
+
 Examples:
-  asc game-center achievements get --id "ACHIEVEMENT_ID"`,
+  asc game-center achievements get --id "ACHIEVEMENT_ID"
+  asc game-center achievements get --id "ACHIEVEMENT_ID" --include localizations
+  asc game-center achievements get --id "ACHIEVEMENT_ID" --include localizations,image --localizations-limit 50 --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*achievementID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
+			}
+
+			includeValues, err := normalizeGameCenterAchievementInclude(*include)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+			if *localizationsLimit != 0 {
+				if *localizationsLimit < 1 || *localizationsLimit > 50 {
+					return usageErrorf("game-center achievements get: --localizations-limit must be between 1 and 50")
+				}
+				if len(includeValues) == 0 {
+					return usageErrorf("game-center achievements get: --localizations-limit requires --include")
+				}
 			}
 
 			client, err := getASCClient()
@@ -173,11 +190,35 @@ Examples:
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.GetGameCenterAchievement(requestCtx, id)
+			var opts []asc.GCAchievementOption
+			if containsString(includeValues, "localizations") {
+				opts = append(opts,
+					asc.WithGCAchievementInclude([]string{"localizations"}),
+					asc.WithGCAchievementIncludedLocalizationsLimit(*localizationsLimit),
+				)
+			}
+
+			resp, err := client.GetGameCenterAchievement(requestCtx, id, opts...)
 			if err != nil {
 				return fmt.Errorf("game-center achievements get: failed to fetch: %w", err)
 			}
 
+			// Images hang off localizations, so fetch them through the localizations endpoint.
+			if containsString(includeValues, "image") {
+				localizations, err := client.GetGameCenterAchievementLocalizations(requestCtx, id,
+					asc.WithGCAchievementLocalizationsLimit(*localizationsLimit),
+					asc.WithGCAchievementLocalizationsInclude([]string{"gameCenterAchievementImage"}),
+				)
+				if err != nil {
+					return fmt.Errorf("game-center achievements get: failed to fetch images: %w", err)
+				}
+				included, err := asc.MergeIncluded(resp.Included, localizations.Included)
+				if err != nil {
+					return fmt.Errorf("game-center achievements get: %w", err)
+				}
+				resp.Included = included
+			}
+
 			return printOutput(resp, *output, *pretty)
 		},
 	}
@@ -193,7 +234,12 @@ func GameCenterAchievementsCreateCommand() *ffcli.Command {
 	points := fs.Int("points", 0, "Points value (1-100)")
 	showBeforeEarned := fs.Bool("show-before-earned", true, "Show achievement before it is earned")
 	repeatable := fs.Bool("repeatable", false, "Achievement can be earned multiple times")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	locale := fs.String("locale", "", "Also create a localization for this locale (e.g., en-US)")
+	title := fs.String("title", "", "Localized display name (with --locale)")
+	beforeEarnedDescription := fs.String("before-earned-description", "", "Localized description shown before the achievement is earned (with --locale)")
+	afterEarnedDescription := fs.String("after-earned-description", "", "Localized description shown after the achievement is earned (with --locale)")
+	keepOnFailure := fs.Bool("keep-on-failure", false, "Keep the achievement if creating the localization fails")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -202,33 +248,43 @@ func GameCenterAchievementsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a new Game Center achievement.",
 		LongHelp: `Create a new Game Center achievement.
 
+With --locale, --title, --before-earned-description, and
+--after-earned-description, the achievement's first localization is created
+in the same command. If the localization fails, the new achievement is
+deleted again unless --keep-on-failure is set.
+
 Examples:
   asc game-center achievements create --app "APP_ID" --reference-name "First Win" --vendor-id "com.example.firstwin" --points 10
-  asc game-center achievements create --app "APP_ID" --reference-name "Master" --vendor-id "com.example.master" --points 100 --repeatable`,
+  asc game-center achievements create --app "APP_ID" --reference-name "Master" --vendor-id "com.example.master" --points 100 --repeatable
+  asc game-center achievements create --app "APP_ID" --reference-name "First Win" --vendor-id "com.example.firstwin" --points 10 --locale en-US --title "First Win" --before-earned-description "Win your first game" --after-earned-description "You won!"`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			name := strings.TrimSpace(*referenceName)
 			if name == "" {
-				fmt.Fprintln(os.Stderr, "Error: --reference-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--reference-name is required")
 			}
 
 			vendor := strings.TrimSpace(*vendorID)
 			if vendor == "" {
-				fmt.Fprintln(os.Stderr, "Error: --vendor-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--vendor-id is required")
 			}
 
 			if *points < 1 || *points > 100 {
-				fmt.Fprintln(os.Stderr, "Error: --points must be between 1 and 100")
-				return flag.ErrHelp
+				return flagErrorf("--points must be between 1 and 100")
+			}
+
+			localization, err := achievementCreateLocalization(*locale, *title, *beforeEarnedDescription, *afterEarnedDescription)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+			if *keepOnFailure && localization == nil {
+				return flagErrorf("--keep-on-failure requires --locale")
 			}
 
 			client, err := getASCClient()
@@ -257,12 +313,59 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("game-center achievements create: failed to create: %w", err)
 			}
+			if localization == nil {
+				return printOutput(resp, *output, *pretty)
+			}
 
-			return printOutput(resp, *output, *pretty)
+			achievementID := resp.Data.ID
+			locResp, err := createLocalizationOrRollback(requestCtx, "achievement", achievementID, *keepOnFailure,
+				func(ctx context.Context) (*asc.GameCenterAchievementLocalizationResponse, error) {
+					return client.CreateGameCenterAchievementLocalization(ctx, achievementID, *localization)
+				},
+				func(ctx context.Context) error {
+					return client.DeleteGameCenterAchievement(ctx, achievementID)
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("game-center achievements create: %w", err)
+			}
+
+			return printOutput(&asc.GameCenterAchievementCreateResult{Achievement: resp, Localization: locResp}, *output, *pretty)
 		},
 	}
 }
 
+// achievementCreateLocalization builds the localization requested on
+// achievements create. It returns nil when no localization flags are set and
+// an error when only some of them are.
+func achievementCreateLocalization(locale, title, beforeEarned, afterEarned string) (*asc.GameCenterAchievementLocalizationCreateAttributes, error) {
+	attrs := asc.GameCenterAchievementLocalizationCreateAttributes{
+		Locale:                  strings.TrimSpace(locale),
+		Name:                    strings.TrimSpace(title),
+		BeforeEarnedDescription: strings.TrimSpace(beforeEarned),
+		AfterEarnedDescription:  strings.TrimSpace(afterEarned),
+	}
+	if attrs == (asc.GameCenterAchievementLocalizationCreateAttributes{}) {
+		return nil, nil
+	}
+
+	required := []struct {
+		flag  string
+		value string
+	}{
+		{"--locale", attrs.Locale},
+		{"--title", attrs.Name},
+		{"--before-earned-description", attrs.BeforeEarnedDescription},
+		{"--after-earned-description", attrs.AfterEarnedDescription},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			return nil, fmt.Errorf("%s is required when creating a localization", field.flag)
+		}
+	}
+	return &attrs, nil
+}
+
 // GameCenterAchievementsUpdateCommand returns the achievements update subcommand.
 func GameCenterAchievementsUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("update", flag.ExitOnError)
@@ -273,7 +376,7 @@ func GameCenterAchievementsUpdateCommand() *ffcli.Command {
 	showBeforeEarned := fs.String("show-before-earned", "", "Show achievement before it is earned (true/false)")
 	repeatable := fs.String("repeatable", "", "Achievement can be earned multiple times (true/false)")
 	archived := fs.String("archived", "", "Archive the achievement (true/false)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -291,8 +394,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*achievementID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			attrs := asc.GameCenterAchievementUpdateAttributes{}
@@ -306,8 +408,7 @@ Examples:
 
 			if *points != 0 {
 				if *points < 1 || *points > 100 {
-					fmt.Fprintln(os.Stderr, "Error: --points must be between 1 and 100")
-					return flag.ErrHelp
+					return flagErrorf("--points must be between 1 and 100")
 				}
 				attrs.Points = points
 				hasUpdate = true
@@ -316,8 +417,7 @@ Examples:
 			if strings.TrimSpace(*showBeforeEarned) != "" {
 				val, err := parseBool(*showBeforeEarned, "--show-before-earned")
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				attrs.ShowBeforeEarned = &val
 				hasUpdate = true
@@ -326,8 +426,7 @@ Examples:
 			if strings.TrimSpace(*repeatable) != "" {
 				val, err := parseBool(*repeatable, "--repeatable")
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				attrs.Repeatable = &val
 				hasUpdate = true
@@ -336,16 +435,14 @@ Examples:
 			if strings.TrimSpace(*archived) != "" {
 				val, err := parseBool(*archived, "--archived")
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				attrs.Archived = &val
 				hasUpdate = true
 			}
 
 			if !hasUpdate {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			client, err := getASCClient()
@@ -371,8 +468,9 @@ func GameCenterAchievementsDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 
 	achievementID := fs.String("id", "", "Game Center achievement ID")
+	cascade := fs.Bool("cascade", false, "Delete the achievement's releases, localizations, and images first")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -381,19 +479,29 @@ func GameCenterAchievementsDeleteCommand() *ffcli.Command {
 		ShortHelp:  "Delete a Game Center achievement.",
 		LongHelp: `Delete a Game Center achievement.
 
+An achievement that has releases or localizations may fail to delete. With
+--cascade, its releases are deleted first, then each localization's image and
+the localization itself, and finally the achievement. Each dependent deletion
+is reported in the output.
+
 Examples:
-  asc game-center achievements delete --id "ACHIEVEMENT_ID" --confirm`,
+  asc game-center achievements delete --id "ACHIEVEMENT_ID" --confirm
+  asc game-center achievements delete --id "ACHIEVEMENT_ID" --cascade --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*achievementID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("Game Center achievement %q", id))
+				if err != nil {
+					return fmt.Errorf("game-center achievements delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -404,20 +512,113 @@ Examples:
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
-			if err := client.DeleteGameCenterAchievement(requestCtx, id); err != nil {
-				return fmt.Errorf("game-center achievements delete: failed to delete: %w", err)
+			result := &asc.GameCenterAchievementDeleteResult{ID: id}
+
+			if *cascade {
+				steps, err := deleteAchievementDependents(requestCtx, client, id)
+				result.Cascade = steps
+				if err != nil {
+					return fmt.Errorf("game-center achievements delete: cascade failed after %d step(s): %w", len(steps), err)
+				}
 			}
 
-			result := &asc.GameCenterAchievementDeleteResult{
-				ID:      id,
-				Deleted: true,
+			if err := client.DeleteGameCenterAchievement(requestCtx, id); err != nil {
+				if !*cascade && isGameCenterDependencyError(err) {
+					return fmt.Errorf("game-center achievements delete: failed to delete: %w (the achievement may have releases or localizations; retry with --cascade)", err)
+				}
+				return fmt.Errorf("game-center achievements delete: failed to delete: %w", err)
 			}
+			result.Deleted = true
 
 			return printOutput(result, *output, *pretty)
 		},
 	}
 }
 
+// deleteAchievementDependents deletes an achievement's releases, then each
+// localization's image and the localization itself. The steps completed so
+// far are returned even when an error stops the cascade.
+func deleteAchievementDependents(ctx context.Context, client *asc.Client, achievementID string) ([]asc.GameCenterCascadeStep, error) {
+	steps := []asc.GameCenterCascadeStep{}
+
+	firstReleases, err := client.GetGameCenterAchievementReleases(ctx, achievementID, asc.WithGCAchievementReleasesLimit(200))
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	allReleases, err := asc.PaginateAll(ctx, firstReleases, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterAchievementReleases(ctx, achievementID, asc.WithGCAchievementReleasesNextURL(nextURL))
+	})
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	releases, ok := allReleases.(*asc.GameCenterAchievementReleasesResponse)
+	if !ok {
+		return steps, fmt.Errorf("unexpected releases response type %T", allReleases)
+	}
+	for _, release := range releases.Data {
+		if err := client.DeleteGameCenterAchievementRelease(ctx, release.ID); err != nil {
+			return steps, fmt.Errorf("failed to delete release %s: %w", release.ID, err)
+		}
+		steps = append(steps, asc.GameCenterCascadeStep{Action: "deleted", ResourceType: "gameCenterAchievementReleases", ID: release.ID})
+	}
+
+	firstLocalizations, err := client.GetGameCenterAchievementLocalizations(ctx, achievementID,
+		asc.WithGCAchievementLocalizationsLimit(200),
+		asc.WithGCAchievementLocalizationsInclude([]string{"gameCenterAchievementImage"}),
+	)
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	allLocalizations, err := asc.PaginateAll(ctx, firstLocalizations, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterAchievementLocalizations(ctx, achievementID, asc.WithGCAchievementLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	localizations, ok := allLocalizations.(*asc.GameCenterAchievementLocalizationsResponse)
+	if !ok {
+		return steps, fmt.Errorf("unexpected localizations response type %T", allLocalizations)
+	}
+	for _, localization := range localizations.Data {
+		imageID, err := achievementLocalizationImageID(localization.Relationships)
+		if err != nil {
+			return steps, fmt.Errorf("localization %s: %w", localization.ID, err)
+		}
+		if imageID != "" {
+			if err := client.DeleteGameCenterAchievementImage(ctx, imageID); err != nil {
+				return steps, fmt.Errorf("failed to delete image %s: %w", imageID, err)
+			}
+			steps = append(steps, asc.GameCenterCascadeStep{Action: "deleted", ResourceType: "gameCenterAchievementImages", ID: imageID})
+		}
+		if err := client.DeleteGameCenterAchievementLocalization(ctx, localization.ID); err != nil {
+			return steps, fmt.Errorf("failed to delete localization %s: %w", localization.ID, err)
+		}
+		steps = append(steps, asc.GameCenterCascadeStep{Action: "deleted", ResourceType: "gameCenterAchievementLocalizations", ID: localization.ID})
+	}
+
+	return steps, nil
+}
+
+// achievementLocalizationImageID returns the image linked from a localization's
+// relationships, or "" when it has none.
+func achievementLocalizationImageID(relationships json.RawMessage) (string, error) {
+	if len(relationships) == 0 {
+		return "", nil
+	}
+	var parsed struct {
+		Image *struct {
+			Data *asc.ResourceData `json:"data"`
+		} `json:"gameCenterAchievementImage"`
+	}
+	if err := json.Unmarshal(relationships, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse relationships: %w", err)
+	}
+	if parsed.Image == nil || parsed.Image.Data == nil {
+		return "", nil
+	}
+	return strings.TrimSpace(parsed.Image.Data.ID), nil
+}
+
 // GameCenterAchievementLocalizationsCommand returns the achievement localizations command group.
 func GameCenterAchievementLocalizationsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("localizations", flag.ExitOnError)
@@ -457,7 +658,7 @@ func GameCenterAchievementLocalizationsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -474,7 +675,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("game-center achievements localizations list: --limit must be between 1 and 200")
+				return usageErrorf("game-center achievements localizations list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("game-center achievements localizations list: %w", err)
@@ -482,8 +683,7 @@ Examples:
 
 			achID := strings.TrimSpace(*achievementID)
 			if achID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --achievement-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--achievement-id is required")
 			}
 
 			client, err := getASCClient()
@@ -506,14 +706,13 @@ Examples:
 					return fmt.Errorf("game-center achievements localizations list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterAchievementLocalizations(ctx, achID, asc.WithGCAchievementLocalizationsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("game-center achievements localizations list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterAchievementLocalizations(requestCtx, achID, opts...)
@@ -531,7 +730,7 @@ func GameCenterAchievementLocalizationsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	localizationID := fs.String("id", "", "Game Center achievement localization ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -547,8 +746,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*localizationID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -578,7 +776,7 @@ func GameCenterAchievementLocalizationsCreateCommand() *ffcli.Command {
 	name := fs.String("name", "", "Display name")
 	beforeEarnedDescription := fs.String("before-earned-description", "", "Description shown before achievement is earned")
 	afterEarnedDescription := fs.String("after-earned-description", "", "Description shown after achievement is earned")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -595,32 +793,27 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			achID := strings.TrimSpace(*achievementID)
 			if achID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --achievement-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--achievement-id is required")
 			}
 
 			localeVal := strings.TrimSpace(*locale)
 			if localeVal == "" {
-				fmt.Fprintln(os.Stderr, "Error: --locale is required")
-				return flag.ErrHelp
+				return flagErrorf("--locale is required")
 			}
 
 			nameVal := strings.TrimSpace(*name)
 			if nameVal == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return flagErrorf("--name is required")
 			}
 
 			beforeVal := strings.TrimSpace(*beforeEarnedDescription)
 			if beforeVal == "" {
-				fmt.Fprintln(os.Stderr, "Error: --before-earned-description is required")
-				return flag.ErrHelp
+				return flagErrorf("--before-earned-description is required")
 			}
 
 			afterVal := strings.TrimSpace(*afterEarnedDescription)
 			if afterVal == "" {
-				fmt.Fprintln(os.Stderr, "Error: --after-earned-description is required")
-				return flag.ErrHelp
+				return flagErrorf("--after-earned-description is required")
 			}
 
 			client, err := getASCClient()
@@ -656,7 +849,7 @@ func GameCenterAchievementLocalizationsUpdateCommand() *ffcli.Command {
 	name := fs.String("name", "", "Display name")
 	beforeEarnedDescription := fs.String("before-earned-description", "", "Description shown before achievement is earned")
 	afterEarnedDescription := fs.String("after-earned-description", "", "Description shown after achievement is earned")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -673,8 +866,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*localizationID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			attrs := asc.GameCenterAchievementLocalizationUpdateAttributes{}
@@ -699,8 +891,7 @@ Examples:
 			}
 
 			if !hasUpdate {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			client, err := getASCClient()
@@ -727,7 +918,7 @@ func GameCenterAchievementLocalizationsDeleteCommand() *ffcli.Command {
 
 	localizationID := fs.String("id", "", "Game Center achievement localization ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -743,12 +934,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*localizationID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("Game Center achievement localization %q", id))
+				if err != nil {
+					return fmt.Errorf("game-center achievements localizations delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -808,7 +1003,7 @@ func GameCenterAchievementReleasesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -825,7 +1020,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("game-center achievements releases list: --limit must be between 1 and 200")
+				return usageErrorf("game-center achievements releases list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("game-center achievements releases list: %w", err)
@@ -833,8 +1028,7 @@ Examples:
 
 			id := strings.TrimSpace(*achievementID)
 			if id == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --achievement-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--achievement-id is required")
 			}
 
 			client, err := getASCClient()
@@ -857,14 +1051,13 @@ Examples:
 					return fmt.Errorf("game-center achievements releases list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterAchievementReleases(ctx, id, asc.WithGCAchievementReleasesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("game-center achievements releases list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterAchievementReleases(requestCtx, id, opts...)
@@ -883,7 +1076,7 @@ func GameCenterAchievementReleasesCreateCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	achievementID := fs.String("achievement-id", "", "Game Center achievement ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -899,14 +1092,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			id := strings.TrimSpace(*achievementID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --achievement-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--achievement-id is required")
 			}
 
 			client, err := getASCClient()
@@ -939,7 +1130,7 @@ func GameCenterAchievementReleasesDeleteCommand() *ffcli.Command {
 
 	releaseID := fs.String("id", "", "Game Center achievement release ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -955,12 +1146,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*releaseID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("Game Center achievement release %q", id))
+				if err != nil {
+					return fmt.Errorf("game-center achievements releases delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -1018,32 +1213,58 @@ func GameCenterAchievementImagesUploadCommand() *ffcli.Command {
 
 	localizationID := fs.String("localization-id", "", "Game Center achievement localization ID")
 	filePath := fs.String("file", "", "Path to the image file to upload")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	imageURL := fs.String("url", "", "HTTPS URL of the image to upload (instead of --file)")
+	manifest := fs.String("manifest", "", "JSON file mapping localization IDs to image paths, to upload many images at once")
+	concurrency := fs.Int("concurrency", 4, "Images to upload in parallel with --manifest")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "upload",
-		ShortUsage: "asc game-center achievements images upload --localization-id \"LOC_ID\" --file \"path/to/image.png\"",
+		ShortUsage: "asc game-center achievements images upload (--localization-id \"LOC_ID\" (--file \"path/to/image.png\" | --url \"https://...\") | --manifest images.json)",
 		ShortHelp:  "Upload an image for a Game Center achievement localization.",
 		LongHelp: `Upload an image for a Game Center achievement localization.
 
 The image file will be validated, reserved, uploaded in chunks, and committed.
 
+With --url, the image is first downloaded to a temporary file (https only),
+for example from a pre-signed storage bucket URL. The last segment of the URL
+path is used as the file name.
+
+With --manifest, every image listed in a JSON object of localization IDs to
+file paths (relative to the manifest) is uploaded, --concurrency at a time.
+Each image is attempted even after a failure; the result lists which uploads
+succeeded, and the command exits non-zero if any failed.
+
 Examples:
-  asc game-center achievements images upload --localization-id "LOC_ID" --file "path/to/image.png"`,
+  asc game-center achievements images upload --localization-id "LOC_ID" --file "path/to/image.png"
+  asc game-center achievements images upload --localization-id "LOC_ID" --url "https://assets.example.com/achievements/first-win.png"
+  asc game-center achievements images upload --manifest images.json --concurrency 8`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			if manifestValue := strings.TrimSpace(*manifest); manifestValue != "" {
+				if strings.TrimSpace(*localizationID) != "" || strings.TrimSpace(*filePath) != "" || strings.TrimSpace(*imageURL) != "" {
+					return flagErrorf("--manifest cannot be combined with --localization-id, --file, or --url")
+				}
+				if *concurrency < 1 {
+					return flagErrorf("--concurrency must be at least 1")
+				}
+				return runGameCenterImageBatch(ctx, "game-center achievements images upload", asc.GameCenterImageKindAchievement, manifestValue, *concurrency, *output, *pretty)
+			}
+
 			locID := strings.TrimSpace(*localizationID)
 			if locID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --localization-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--localization-id is required")
 			}
 
 			path := strings.TrimSpace(*filePath)
-			if path == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+			urlValue := strings.TrimSpace(*imageURL)
+			if path == "" && urlValue == "" {
+				return flagErrorf("--file or --url is required")
+			}
+			if path != "" && urlValue != "" {
+				return flagErrorf("--file and --url are mutually exclusive")
 			}
 
 			client, err := getASCClient()
@@ -1054,7 +1275,12 @@ Examples:
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
-			result, err := client.UploadGameCenterAchievementImage(requestCtx, locID, path)
+			var result *asc.GameCenterAchievementImageUploadResult
+			if urlValue != "" {
+				result, err = client.UploadGameCenterAchievementImageFromURL(requestCtx, locID, urlValue)
+			} else {
+				result, err = client.UploadGameCenterAchievementImage(requestCtx, locID, path)
+			}
 			if err != nil {
 				return fmt.Errorf("game-center achievements images upload: %w", err)
 			}
@@ -1069,7 +1295,7 @@ func GameCenterAchievementImagesGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	imageID := fs.String("id", "", "Game Center achievement image ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -1085,8 +1311,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*imageID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -1113,7 +1338,7 @@ func GameCenterAchievementImagesDeleteCommand() *ffcli.Command {
 
 	imageID := fs.String("id", "", "Game Center achievement image ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -1129,12 +1354,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*imageID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("Game Center achievement image %q", id))
+				if err != nil {
+					return fmt.Errorf("game-center achievements images delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -0,0 +1,111 @@
+package gamecenter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestAchievementCreateLocalization(t *testing.T) {
+	attrs, err := achievementCreateLocalization("", " ", "", "")
+	if err != nil || attrs != nil {
+		t.Fatalf("expected no localization without flags, got %+v, %v", attrs, err)
+	}
+
+	if _, err := achievementCreateLocalization("en-US", "First Win", "", "You won!"); err == nil || !strings.Contains(err.Error(), "--before-earned-description") {
+		t.Fatalf("expected missing --before-earned-description error, got %v", err)
+	}
+
+	attrs, err = achievementCreateLocalization(" en-US ", "First Win", "Win a game", "You won!")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attrs.Locale != "en-US" || attrs.Name != "First Win" {
+		t.Fatalf("unexpected attributes: %+v", attrs)
+	}
+}
+
+func TestCreateLocalizationOrRollback(t *testing.T) {
+	createErr := errors.New("locale not supported")
+	failingCreate := func(context.Context) (*asc.GameCenterAchievementLocalizationResponse, error) {
+		return nil, createErr
+	}
+
+	t.Run("success skips rollback", func(t *testing.T) {
+		rolledBack := false
+		resp, err := createLocalizationOrRollback(context.Background(), "achievement", "ach-1", false,
+			func(context.Context) (*asc.GameCenterAchievementLocalizationResponse, error) {
+				return &asc.GameCenterAchievementLocalizationResponse{}, nil
+			},
+			func(context.Context) error { rolledBack = true; return nil },
+		)
+		if err != nil || resp == nil {
+			t.Fatalf("expected localization, got %v, %v", resp, err)
+		}
+		if rolledBack {
+			t.Fatal("expected no rollback on success")
+		}
+	})
+
+	t.Run("failure deletes achievement", func(t *testing.T) {
+		rolledBack := false
+		_, err := createLocalizationOrRollback(context.Background(), "achievement", "ach-1", false, failingCreate,
+			func(context.Context) error { rolledBack = true; return nil },
+		)
+		if !rolledBack {
+			t.Fatal("expected rollback")
+		}
+		if !errors.Is(err, createErr) || !strings.Contains(err.Error(), "achievement ach-1 was deleted") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("failure with keep-on-failure", func(t *testing.T) {
+		_, err := createLocalizationOrRollback(context.Background(), "achievement", "ach-1", true, failingCreate,
+			func(context.Context) error { t.Fatal("unexpected rollback"); return nil },
+		)
+		if !errors.Is(err, createErr) || !strings.Contains(err.Error(), "achievement ach-1 was kept") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("rollback failure is reported", func(t *testing.T) {
+		_, err := createLocalizationOrRollback(context.Background(), "achievement", "ach-1", false, failingCreate,
+			func(context.Context) error { return errors.New("forbidden") },
+		)
+		if !errors.Is(err, createErr) || !strings.Contains(err.Error(), "rollback of achievement ach-1 also failed: forbidden") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestAchievementLocalizationImageID(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{name: "no relationships", raw: "", want: ""},
+		{name: "no image", raw: `{"gameCenterAchievementImage":{"data":null}}`, want: ""},
+		{name: "image", raw: `{"gameCenterAchievement":{"data":{"type":"gameCenterAchievements","id":"ach-1"}},"gameCenterAchievementImage":{"data":{"type":"gameCenterAchievementImages","id":"img-1"}}}`, want: "img-1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := achievementLocalizationImageID(json.RawMessage(test.raw))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+
+	if _, err := achievementLocalizationImageID(json.RawMessage(`[`)); err == nil {
+		t.Fatal("expected parse error")
+	}
+}
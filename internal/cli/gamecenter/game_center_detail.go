@@ -0,0 +1,168 @@
+package gamecenter
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// GameCenterDetailCommand returns the detail command group.
+func GameCenterDetailCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("detail", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "detail",
+		ShortUsage: "asc game-center detail <subcommand> [flags]",
+		ShortHelp:  "Inspect Game Center enablement for apps.",
+		LongHelp: `Inspect Game Center enablement for apps.
+
+Examples:
+  asc game-center detail list --app "APP_ID_1,APP_ID_2"
+  asc game-center detail list --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			GameCenterDetailListCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// GameCenterDetailListCommand returns the detail list subcommand.
+func GameCenterDetailListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+
+	appIDs := fs.String("app", "", "Comma-separated app IDs (default: all apps)")
+	workers := fs.Int("workers", 5, "Number of apps to check in parallel")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc game-center detail list [--app \"APP_ID_1,APP_ID_2\"]",
+		ShortHelp:  "Report Game Center enablement across apps.",
+		LongHelp: `Report Game Center enablement across apps.
+
+Resolves each app's Game Center detail and reports whether Game Center is
+configured and whether the app is an Apple Arcade title. Apps without Game
+Center are reported as not-configured. When --app is omitted, every app in
+the account is checked.
+
+Examples:
+  asc game-center detail list --app "APP_ID_1,APP_ID_2,APP_ID_3"
+  asc game-center detail list --output table
+  asc game-center detail list --workers 10`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *workers < 1 {
+				return flagErrorf("--workers must be at least 1")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center detail list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			apps := make([]asc.GameCenterAppDetailStatus, 0)
+			if ids := splitCSV(*appIDs); len(ids) > 0 {
+				for _, id := range ids {
+					apps = append(apps, asc.GameCenterAppDetailStatus{AppID: id})
+				}
+			} else {
+				apps, err = listAllAppsForGameCenterDetail(requestCtx, client)
+				if err != nil {
+					return fmt.Errorf("game-center detail list: %w", err)
+				}
+			}
+
+			if err := resolveGameCenterDetails(requestCtx, *workers, apps, client.GetAppGameCenterDetail); err != nil {
+				return fmt.Errorf("game-center detail list: %w", err)
+			}
+
+			return printOutput(&asc.GameCenterDetailsSummary{Apps: apps}, *output, *pretty)
+		},
+	}
+}
+
+func listAllAppsForGameCenterDetail(ctx context.Context, client *asc.Client) ([]asc.GameCenterAppDetailStatus, error) {
+	firstPage, err := client.GetApps(ctx, asc.WithAppsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch apps: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch apps: %w", err)
+	}
+	resp, ok := all.(*asc.AppsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected apps response type %T", all)
+	}
+
+	apps := make([]asc.GameCenterAppDetailStatus, 0, len(resp.Data))
+	for _, app := range resp.Data {
+		apps = append(apps, asc.GameCenterAppDetailStatus{AppID: app.ID, AppName: app.Attributes.Name})
+	}
+	return apps, nil
+}
+
+// resolveGameCenterDetails fills in each app's Game Center status in place,
+// fetching at most workers details at a time. Apps without a Game Center
+// detail are left as not-configured; any other error aborts the report.
+func resolveGameCenterDetails(ctx context.Context, workers int, apps []asc.GameCenterAppDetailStatus, fetch func(context.Context, string) (*asc.GameCenterDetailResponse, error)) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, workers)
+
+	for i := range apps {
+		wg.Add(1)
+		go func(app *asc.GameCenterAppDetailStatus) {
+			defer wg.Done()
+
+			select {
+			case <-ctx.Done():
+				return
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			}
+
+			detail, err := fetch(ctx, app.AppID)
+			if err != nil {
+				if asc.IsNotFound(err) {
+					return
+				}
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("app %s: %w", app.AppID, err)
+				}
+				mu.Unlock()
+				return
+			}
+
+			app.Configured = true
+			app.DetailID = detail.Data.ID
+			app.ArcadeEnabled = detail.Data.Attributes.ArcadeEnabled
+		}(&apps[i])
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
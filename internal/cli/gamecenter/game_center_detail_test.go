@@ -0,0 +1,52 @@
+package gamecenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestResolveGameCenterDetails(t *testing.T) {
+	apps := []asc.GameCenterAppDetailStatus{{AppID: "app-1"}, {AppID: "app-2"}, {AppID: "app-3"}}
+	fetch := func(ctx context.Context, appID string) (*asc.GameCenterDetailResponse, error) {
+		switch appID {
+		case "app-1":
+			return &asc.GameCenterDetailResponse{Data: asc.Resource[asc.GameCenterDetailAttributes]{ID: "gc-1"}}, nil
+		case "app-2":
+			return nil, fmt.Errorf("wrapped: %w", asc.ErrNotFound)
+		default:
+			return &asc.GameCenterDetailResponse{Data: asc.Resource[asc.GameCenterDetailAttributes]{
+				ID:         "gc-3",
+				Attributes: asc.GameCenterDetailAttributes{ArcadeEnabled: true},
+			}}, nil
+		}
+	}
+
+	if err := resolveGameCenterDetails(context.Background(), 2, apps, fetch); err != nil {
+		t.Fatalf("resolveGameCenterDetails() error: %v", err)
+	}
+
+	if !apps[0].Configured || apps[0].DetailID != "gc-1" || apps[0].ArcadeEnabled {
+		t.Fatalf("unexpected app-1 status: %+v", apps[0])
+	}
+	if apps[1].Configured || apps[1].DetailID != "" {
+		t.Fatalf("expected app-2 to be not configured, got %+v", apps[1])
+	}
+	if !apps[2].Configured || !apps[2].ArcadeEnabled {
+		t.Fatalf("unexpected app-3 status: %+v", apps[2])
+	}
+}
+
+func TestResolveGameCenterDetailsReturnsOtherErrors(t *testing.T) {
+	apps := []asc.GameCenterAppDetailStatus{{AppID: "app-1"}}
+	boom := errors.New("boom")
+	err := resolveGameCenterDetails(context.Background(), 1, apps, func(ctx context.Context, appID string) (*asc.GameCenterDetailResponse, error) {
+		return nil, boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom error, got %v", err)
+	}
+}
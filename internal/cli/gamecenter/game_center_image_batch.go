@@ -0,0 +1,77 @@
+package gamecenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// readGameCenterImageManifest reads a --manifest file: a JSON (or YAML)
+// object mapping localization IDs to image paths. Relative paths are resolved
+// against the manifest's directory.
+func readGameCenterImageManifest(path string) (map[string]string, error) {
+	payload, err := readJSONFilePayloadFor(path, &map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]string
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, fmt.Errorf("manifest must map localization IDs to file paths: %w", err)
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("manifest has no images")
+	}
+
+	dir := filepath.Dir(path)
+	images := make(map[string]string, len(raw))
+	for id, file := range raw {
+		id = strings.TrimSpace(id)
+		file = strings.TrimSpace(file)
+		if id == "" {
+			return nil, fmt.Errorf("manifest has an empty localization ID")
+		}
+		if file == "" {
+			return nil, fmt.Errorf("manifest entry %q has no file path", id)
+		}
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		images[id] = file
+	}
+	return images, nil
+}
+
+// runGameCenterImageBatch uploads every image in a manifest and prints the
+// per-image results. It fails after printing if any upload failed.
+func runGameCenterImageBatch(ctx context.Context, command string, kind asc.GameCenterImageKind, manifestPath string, concurrency int, output string, pretty bool) error {
+	images, err := readGameCenterImageManifest(manifestPath)
+	if err != nil {
+		return fmt.Errorf("%s: --manifest: %w", command, err)
+	}
+
+	client, err := getASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+
+	requestCtx, cancel := contextWithUploadTimeout(ctx)
+	defer cancel()
+
+	result, err := client.UploadGameCenterImagesBatch(requestCtx, kind, images, concurrency)
+	if err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+
+	if err := printOutput(result, output, pretty); err != nil {
+		return err
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("%s: %d of %d images failed to upload", command, result.Failed, result.Total)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package gamecenter
+
+import "strings"
+
+func gameCenterAchievementIncludeList() []string {
+	return []string{"localizations", "image"}
+}
+
+func normalizeGameCenterAchievementInclude(value string) ([]string, error) {
+	values := splitCSV(value)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	allowed := gameCenterAchievementIncludeList()
+	for _, include := range values {
+		if !containsString(allowed, include) {
+			return nil, usageErrorf("--include must be one of: %s", strings.Join(allowed, ", "))
+		}
+	}
+	return values, nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -43,32 +42,48 @@ func GameCenterLeaderboardSetImagesUploadCommand() *ffcli.Command {
 
 	localizationID := fs.String("localization-id", "", "Leaderboard set localization ID")
 	filePath := fs.String("file", "", "Path to image file (PNG)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	manifest := fs.String("manifest", "", "JSON file mapping localization IDs to image paths, to upload many images at once")
+	concurrency := fs.Int("concurrency", 4, "Images to upload in parallel with --manifest")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "upload",
-		ShortUsage: "asc game-center leaderboard-sets images upload --localization-id \"LOC_ID\" --file path/to/image.png",
+		ShortUsage: "asc game-center leaderboard-sets images upload (--localization-id \"LOC_ID\" --file path/to/image.png | --manifest images.json)",
 		ShortHelp:  "Upload an image for a leaderboard set localization.",
 		LongHelp: `Upload an image for a leaderboard set localization.
 
 The upload process reserves an upload slot, uploads the image file, and commits the upload.
 
+With --manifest, every image listed in a JSON object of localization IDs to
+file paths (relative to the manifest) is uploaded, --concurrency at a time.
+Each image is attempted even after a failure; the result lists which uploads
+succeeded, and the command exits non-zero if any failed.
+
 Examples:
-  asc game-center leaderboard-sets images upload --localization-id "LOC_ID" --file path/to/image.png`,
+  asc game-center leaderboard-sets images upload --localization-id "LOC_ID" --file path/to/image.png
+  asc game-center leaderboard-sets images upload --manifest images.json`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			if manifestValue := strings.TrimSpace(*manifest); manifestValue != "" {
+				if strings.TrimSpace(*localizationID) != "" || strings.TrimSpace(*filePath) != "" {
+					return flagErrorf("--manifest cannot be combined with --localization-id or --file")
+				}
+				if *concurrency < 1 {
+					return flagErrorf("--concurrency must be at least 1")
+				}
+				return runGameCenterImageBatch(ctx, "game-center leaderboard-sets images upload", asc.GameCenterImageKindLeaderboardSet, manifestValue, *concurrency, *output, *pretty)
+			}
+
 			locID := strings.TrimSpace(*localizationID)
 			if locID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --localization-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--localization-id is required")
 			}
 
 			file := strings.TrimSpace(*filePath)
 			if file == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
 			client, err := getASCClient()
@@ -95,7 +110,7 @@ func GameCenterLeaderboardSetImagesDeleteCommand() *ffcli.Command {
 
 	imageID := fs.String("id", "", "Leaderboard set image ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -111,12 +126,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*imageID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("leaderboard set image %q", id))
+				if err != nil {
+					return fmt.Errorf("game-center leaderboard-sets images delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
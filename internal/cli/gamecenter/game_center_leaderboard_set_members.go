@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -24,11 +23,15 @@ func GameCenterLeaderboardSetMembersCommand() *ffcli.Command {
 
 Examples:
   asc game-center leaderboard-sets members list --set-id "SET_ID"
+  asc game-center leaderboard-sets members add --set-id "SET_ID" --leaderboard-ids "id4"
+  asc game-center leaderboard-sets members remove --set-id "SET_ID" --leaderboard-ids "id2"
   asc game-center leaderboard-sets members set --set-id "SET_ID" --leaderboard-ids "id1,id2,id3"`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterLeaderboardSetMembersListCommand(),
+			GameCenterLeaderboardSetMembersAddCommand(),
+			GameCenterLeaderboardSetMembersRemoveCommand(),
 			GameCenterLeaderboardSetMembersSetCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -45,7 +48,7 @@ func GameCenterLeaderboardSetMembersListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -62,7 +65,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("game-center leaderboard-sets members list: --limit must be between 1 and 200")
+				return usageErrorf("game-center leaderboard-sets members list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("game-center leaderboard-sets members list: %w", err)
@@ -70,8 +73,7 @@ Examples:
 
 			id := strings.TrimSpace(*setID)
 			if id == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --set-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--set-id is required")
 			}
 
 			client, err := getASCClient()
@@ -94,14 +96,13 @@ Examples:
 					return fmt.Errorf("game-center leaderboard-sets members list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterLeaderboardSetMembers(ctx, id, asc.WithGCLeaderboardSetMembersNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("game-center leaderboard-sets members list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterLeaderboardSetMembers(requestCtx, id, opts...)
@@ -120,7 +121,7 @@ func GameCenterLeaderboardSetMembersSetCommand() *ffcli.Command {
 
 	setID := fs.String("set-id", "", "Game Center leaderboard set ID")
 	leaderboardIDs := fs.String("leaderboard-ids", "", "Comma-separated list of leaderboard IDs to set as members")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -140,8 +141,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*setID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --set-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--set-id is required")
 			}
 
 			// Parse leaderboard IDs from comma-separated string
@@ -178,3 +178,179 @@ Examples:
 		},
 	}
 }
+
+// GameCenterLeaderboardSetMembersAddCommand returns the members add subcommand.
+func GameCenterLeaderboardSetMembersAddCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("add", flag.ExitOnError)
+
+	setID := fs.String("set-id", "", "Game Center leaderboard set ID")
+	leaderboardIDs := fs.String("leaderboard-ids", "", "Comma-separated list of leaderboard IDs to add")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "add",
+		ShortUsage: "asc game-center leaderboard-sets members add --set-id \"SET_ID\" --leaderboard-ids \"id1,id2\"",
+		ShortHelp:  "Add leaderboards to a leaderboard set.",
+		LongHelp: `Add leaderboards to a leaderboard set.
+
+Current members are read first and the new leaderboards are appended, so
+existing members are kept. Leaderboards that are already members are ignored.
+
+Examples:
+  asc game-center leaderboard-sets members add --set-id "SET_ID" --leaderboard-ids "id1"
+  asc game-center leaderboard-sets members add --set-id "SET_ID" --leaderboard-ids "id1,id2"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*setID)
+			if id == "" {
+				return flagErrorf("--set-id is required")
+			}
+			ids := splitCSV(*leaderboardIDs)
+			if len(ids) == 0 {
+				return flagErrorf("--leaderboard-ids is required")
+			}
+
+			return updateLeaderboardSetMembers(ctx, "add", id, false, *output, *pretty, func(current []string) []string {
+				return addLeaderboardSetMembers(current, ids)
+			})
+		},
+	}
+}
+
+// GameCenterLeaderboardSetMembersRemoveCommand returns the members remove subcommand.
+func GameCenterLeaderboardSetMembersRemoveCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("remove", flag.ExitOnError)
+
+	setID := fs.String("set-id", "", "Game Center leaderboard set ID")
+	leaderboardIDs := fs.String("leaderboard-ids", "", "Comma-separated list of leaderboard IDs to remove")
+	confirm := fs.Bool("confirm", false, "Confirm removal when it would leave the set empty")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "remove",
+		ShortUsage: "asc game-center leaderboard-sets members remove --set-id \"SET_ID\" --leaderboard-ids \"id1,id2\"",
+		ShortHelp:  "Remove leaderboards from a leaderboard set.",
+		LongHelp: `Remove leaderboards from a leaderboard set.
+
+Current members are read first and only the given leaderboards are removed.
+Removing the last remaining members requires --confirm.
+
+Examples:
+  asc game-center leaderboard-sets members remove --set-id "SET_ID" --leaderboard-ids "id1"
+  asc game-center leaderboard-sets members remove --set-id "SET_ID" --leaderboard-ids "id1,id2" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*setID)
+			if id == "" {
+				return flagErrorf("--set-id is required")
+			}
+			ids := splitCSV(*leaderboardIDs)
+			if len(ids) == 0 {
+				return flagErrorf("--leaderboard-ids is required")
+			}
+
+			return updateLeaderboardSetMembers(ctx, "remove", id, *confirm, *output, *pretty, func(current []string) []string {
+				return removeLeaderboardSetMembers(current, ids)
+			})
+		},
+	}
+}
+
+// updateLeaderboardSetMembers reads the current members of a set, applies
+// change, and submits the result when it differs from the current members.
+func updateLeaderboardSetMembers(ctx context.Context, action, setID string, confirm bool, output string, pretty bool, change func(current []string) []string) error {
+	errPrefix := "game-center leaderboard-sets members " + action
+
+	client, err := getASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	current, err := fetchLeaderboardSetMemberIDs(requestCtx, client, setID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	updated := change(current)
+	result := &asc.GameCenterLeaderboardSetMembersUpdateResult{
+		SetID:       setID,
+		MemberCount: len(updated),
+		MemberIDs:   updated,
+	}
+	if sameLeaderboardSetMembers(current, updated) {
+		return printOutput(result, output, pretty)
+	}
+	if len(updated) == 0 && !confirm {
+		return flagErrorf("--confirm is required to remove all members from the set")
+	}
+
+	if err := client.UpdateGameCenterLeaderboardSetMembers(requestCtx, setID, updated); err != nil {
+		return fmt.Errorf("%s: failed to update: %w", errPrefix, err)
+	}
+	result.Updated = true
+
+	return printOutput(result, output, pretty)
+}
+
+// fetchLeaderboardSetMemberIDs returns the IDs of every leaderboard in a set.
+func fetchLeaderboardSetMemberIDs(ctx context.Context, client *asc.Client, setID string) ([]string, error) {
+	firstPage, err := client.GetGameCenterLeaderboardSetMembers(ctx, setID, asc.WithGCLeaderboardSetMembersLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch members: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterLeaderboardSetMembers(ctx, setID, asc.WithGCLeaderboardSetMembersNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch members: %w", err)
+	}
+
+	members, ok := all.(*asc.GameCenterLeaderboardsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected members response type %T", all)
+	}
+	ids := make([]string, 0, len(members.Data))
+	for _, item := range members.Data {
+		ids = append(ids, item.ID)
+	}
+	return ids, nil
+}
+
+func addLeaderboardSetMembers(current, add []string) []string {
+	result := append([]string{}, current...)
+	for _, id := range add {
+		if !containsString(result, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func removeLeaderboardSetMembers(current, remove []string) []string {
+	result := make([]string, 0, len(current))
+	for _, id := range current {
+		if !containsString(remove, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func sameLeaderboardSetMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,26 @@
+package gamecenter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAddLeaderboardSetMembers(t *testing.T) {
+	got := addLeaderboardSetMembers([]string{"lb-1", "lb-2"}, []string{"lb-2", "lb-3"})
+	want := []string{"lb-1", "lb-2", "lb-3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestRemoveLeaderboardSetMembers(t *testing.T) {
+	got := removeLeaderboardSetMembers([]string{"lb-1", "lb-2", "lb-3"}, []string{"lb-2", "lb-9"})
+	want := []string{"lb-1", "lb-3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	if got := removeLeaderboardSetMembers([]string{"lb-1"}, []string{"lb-1"}); len(got) != 0 {
+		t.Fatalf("expected empty members, got %v", got)
+	}
+}
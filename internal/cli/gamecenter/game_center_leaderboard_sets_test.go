@@ -0,0 +1,52 @@
+package gamecenter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestLeaderboardSetCreateLocalization(t *testing.T) {
+	attrs, err := leaderboardSetCreateLocalization(" ", "")
+	if err != nil || attrs != nil {
+		t.Fatalf("expected no localization without flags, got %+v, %v", attrs, err)
+	}
+
+	if _, err := leaderboardSetCreateLocalization("en-US", ""); err == nil || !strings.Contains(err.Error(), "--name") {
+		t.Fatalf("expected missing --name error, got %v", err)
+	}
+	if _, err := leaderboardSetCreateLocalization("", "Season 1"); err == nil || !strings.Contains(err.Error(), "--locale") {
+		t.Fatalf("expected missing --locale error, got %v", err)
+	}
+
+	attrs, err = leaderboardSetCreateLocalization(" en-US ", " Season 1 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attrs.Locale != "en-US" || attrs.Name != "Season 1" {
+		t.Fatalf("unexpected attributes: %+v", attrs)
+	}
+}
+
+func TestCreateLocalizationOrRollbackLeaderboardSet(t *testing.T) {
+	createErr := errors.New("locale not supported")
+	deleted := ""
+	_, err := createLocalizationOrRollback(context.Background(), "leaderboard set", "set-1", false,
+		func(context.Context) (*asc.GameCenterLeaderboardSetLocalizationResponse, error) {
+			return nil, createErr
+		},
+		func(context.Context) error { deleted = "set-1"; return nil },
+	)
+	if !errors.Is(err, createErr) {
+		t.Fatalf("expected wrapped create error, got %v", err)
+	}
+	if deleted != "set-1" {
+		t.Fatal("expected leaderboard set to be rolled back")
+	}
+	if !strings.Contains(err.Error(), "leaderboard set set-1 was deleted") {
+		t.Fatalf("expected rollback message, got %v", err)
+	}
+}
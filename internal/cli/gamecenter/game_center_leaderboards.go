@@ -1,10 +1,15 @@
 package gamecenter
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
-	"os"
+	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -65,11 +70,13 @@ Images are attached to leaderboard localizations. Use the localization ID when u
 
 Examples:
   asc game-center leaderboards images upload --localization-id "LOC_ID" --file path/to/image.png
+  asc game-center leaderboards images download --id "IMAGE_ID" --path ./leaderboard.png
   asc game-center leaderboards images delete --id "IMAGE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterLeaderboardImagesUploadCommand(),
+			GameCenterLeaderboardImagesDownloadCommand(),
 			GameCenterLeaderboardImagesDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -84,32 +91,48 @@ func GameCenterLeaderboardImagesUploadCommand() *ffcli.Command {
 
 	localizationID := fs.String("localization-id", "", "Game Center leaderboard localization ID")
 	filePath := fs.String("file", "", "Path to image file")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	manifest := fs.String("manifest", "", "JSON file mapping localization IDs to image paths, to upload many images at once")
+	concurrency := fs.Int("concurrency", 4, "Images to upload in parallel with --manifest")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "upload",
-		ShortUsage: "asc game-center leaderboards images upload --localization-id \"LOC_ID\" --file path/to/image.png",
+		ShortUsage: "asc game-center leaderboards images upload (--localization-id \"LOC_ID\" --file path/to/image.png | --manifest images.json)",
 		ShortHelp:  "Upload an image for a Game Center leaderboard localization.",
 		LongHelp: `Upload an image for a Game Center leaderboard localization.
 
 This command performs the full upload flow: reserves the upload, uploads the file, and commits.
 
+With --manifest, every image listed in a JSON object of localization IDs to
+file paths (relative to the manifest) is uploaded, --concurrency at a time.
+Each image is attempted even after a failure; the result lists which uploads
+succeeded, and the command exits non-zero if any failed.
+
 Examples:
-  asc game-center leaderboards images upload --localization-id "LOC_ID" --file leaderboard.png`,
+  asc game-center leaderboards images upload --localization-id "LOC_ID" --file leaderboard.png
+  asc game-center leaderboards images upload --manifest images.json`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			if manifestValue := strings.TrimSpace(*manifest); manifestValue != "" {
+				if strings.TrimSpace(*localizationID) != "" || strings.TrimSpace(*filePath) != "" {
+					return flagErrorf("--manifest cannot be combined with --localization-id or --file")
+				}
+				if *concurrency < 1 {
+					return flagErrorf("--concurrency must be at least 1")
+				}
+				return runGameCenterImageBatch(ctx, "game-center leaderboards images upload", asc.GameCenterImageKindLeaderboard, manifestValue, *concurrency, *output, *pretty)
+			}
+
 			locID := strings.TrimSpace(*localizationID)
 			if locID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --localization-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--localization-id is required")
 			}
 
 			file := strings.TrimSpace(*filePath)
 			if file == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
 			client, err := getASCClient()
@@ -130,13 +153,121 @@ Examples:
 	}
 }
 
+// GameCenterLeaderboardImagesDownloadCommand returns the leaderboard images download subcommand.
+func GameCenterLeaderboardImagesDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+
+	imageID := fs.String("id", "", "Game Center leaderboard image ID")
+	path := fs.String("path", "", "Output file path (format taken from the extension: png, jpg; default png)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc game-center leaderboards images download --id \"IMAGE_ID\" --path ./leaderboard.png",
+		ShortHelp:  "Download a Game Center leaderboard image.",
+		LongHelp: `Download a Game Center leaderboard image.
+
+Downloads the processed image at its full size, so you can check what is live
+after an upload. Images that are still processing have no download URL yet;
+try again once processing completes.
+
+Examples:
+  asc game-center leaderboards images download --id "IMAGE_ID" --path ./leaderboard.png
+  asc game-center leaderboards images download --id "IMAGE_ID" --path ./leaderboard.jpg`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*imageID)
+			if id == "" {
+				return flagErrorf("--id is required")
+			}
+			pathValue := strings.TrimSpace(*path)
+			if pathValue == "" {
+				return flagErrorf("--path is required")
+			}
+			format, err := imageFormatForPath(pathValue)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards images download: %w", err)
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards images download: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetGameCenterLeaderboardImage(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards images download: failed to fetch image: %w", err)
+			}
+
+			attrs := resp.Data.Attributes
+			downloadURL := attrs.ImageAsset.ResolvedURL(format)
+			if downloadURL == "" {
+				return fmt.Errorf("game-center leaderboards images download: %w", imageNotReadyError(attrs.AssetDeliveryState))
+			}
+
+			download, err := client.DownloadImageAsset(requestCtx, downloadURL)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards images download: %w", err)
+			}
+			defer download.Body.Close()
+
+			bytesWritten, err := writeStreamToFile(pathValue, download.Body)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards images download: %w", err)
+			}
+
+			result := &asc.GameCenterLeaderboardImageDownloadResult{
+				ID:           resp.Data.ID,
+				FileName:     attrs.FileName,
+				Width:        attrs.ImageAsset.Width,
+				Height:       attrs.ImageAsset.Height,
+				URL:          downloadURL,
+				OutputPath:   pathValue,
+				BytesWritten: bytesWritten,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// imageFormatForPath picks the image asset format from the output file
+// extension, defaulting to png.
+func imageFormatForPath(path string) (string, error) {
+	switch ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")); ext {
+	case "", "png":
+		return "png", nil
+	case "jpg", "jpeg":
+		return "jpg", nil
+	default:
+		return "", usageErrorf("--path extension %q is not supported (use .png or .jpg)", ext)
+	}
+}
+
+// imageNotReadyError explains why an image has no download URL, which
+// happens while it is still being processed or after processing failed.
+func imageNotReadyError(state *asc.AssetDeliveryState) error {
+	if state == nil || strings.TrimSpace(state.State) == "" {
+		return fmt.Errorf("image has no download URL yet; it may still be processing")
+	}
+	if strings.EqualFold(state.State, "FAILED") {
+		return fmt.Errorf("image processing failed (delivery state: %s); upload it again", state.State)
+	}
+	return fmt.Errorf("image is not available yet (delivery state: %s); try again once processing completes", state.State)
+}
+
 // GameCenterLeaderboardImagesDeleteCommand returns the leaderboard images delete subcommand.
 func GameCenterLeaderboardImagesDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 
 	imageID := fs.String("id", "", "Game Center leaderboard image ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -152,12 +283,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*imageID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("Game Center leaderboard image %q", id))
+				if err != nil {
+					return fmt.Errorf("game-center leaderboards images delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -190,34 +325,59 @@ func GameCenterLeaderboardsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	sortValue := fs.String("sort", "", "Sort by: "+strings.Join(leaderboardSortValues, ", "))
+	nameContains := fs.String("name-contains", "", "Only include leaderboards whose reference name contains this text (case-insensitive)")
+	fields := fs.String("fields", "", "Fields to include: "+strings.Join(gcLeaderboardFieldsList(), ", "))
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	registerFlagValues(fs, "sort", leaderboardSortValues...)
+
 	return &ffcli.Command{
 		Name:       "list",
 		ShortUsage: "asc game-center leaderboards list [flags]",
 		ShortHelp:  "List Game Center leaderboards for an app.",
 		LongHelp: `List Game Center leaderboards for an app.
 
+--sort and --name-contains are applied client-side to the fetched results;
+combine them with --paginate to cover every leaderboard.
+
+--fields requests a sparse fieldset so large lists only return the
+attributes you need. It must include referenceName when combined with
+--sort or --name-contains.
+
 Examples:
   asc game-center leaderboards list --app "APP_ID"
   asc game-center leaderboards list --app "APP_ID" --limit 50
-  asc game-center leaderboards list --app "APP_ID" --paginate`,
+  asc game-center leaderboards list --app "APP_ID" --paginate
+  asc game-center leaderboards list --app "APP_ID" --paginate --sort referenceName --name-contains "weekly"
+  asc game-center leaderboards list --app "APP_ID" --paginate --fields referenceName,vendorIdentifier`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("game-center leaderboards list: --limit must be between 1 and 200")
+				return usageErrorf("game-center leaderboards list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("game-center leaderboards list: %w", err)
 			}
+			sortField := strings.TrimSpace(*sortValue)
+			if err := validateSort(sortField, leaderboardSortValues...); err != nil {
+				return fmt.Errorf("game-center leaderboards list: %w", err)
+			}
+			nameFilter := strings.TrimSpace(*nameContains)
+			fieldsValue, err := normalizeGCLeaderboardFields(*fields)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards list: %w", err)
+			}
+			if len(fieldsValue) > 0 && (sortField != "" || nameFilter != "") && !slices.Contains(fieldsValue, "referenceName") {
+				return flagErrorf("--fields must include referenceName when using --sort or --name-contains")
+			}
 
 			resolvedAppID := resolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
 			if resolvedAppID == "" && nextURL == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -242,6 +402,9 @@ Examples:
 				asc.WithGCLeaderboardsLimit(*limit),
 				asc.WithGCLeaderboardsNextURL(*next),
 			}
+			if len(fieldsValue) > 0 {
+				opts = append(opts, asc.WithGCLeaderboardsFields(fieldsValue))
+			}
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithGCLeaderboardsLimit(200))
@@ -256,6 +419,10 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("game-center leaderboards list: %w", err)
 				}
+				if leaderboards, ok := resp.(*asc.GameCenterLeaderboardsResponse); ok {
+					leaderboards.Data = filterLeaderboardsByName(leaderboards.Data, nameFilter)
+					sortLeaderboards(leaderboards.Data, sortField)
+				}
 
 				return printOutput(resp, *output, *pretty)
 			}
@@ -264,18 +431,103 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("game-center leaderboards list: failed to fetch: %w", err)
 			}
+			resp.Data = filterLeaderboardsByName(resp.Data, nameFilter)
+			sortLeaderboards(resp.Data, sortField)
 
 			return printOutput(resp, *output, *pretty)
 		},
 	}
 }
 
+var leaderboardSortValues = []string{"referenceName", "-referenceName"}
+
+func normalizeGCLeaderboardFields(value string) ([]string, error) {
+	fields := splitCSV(value)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	allowed := map[string]struct{}{}
+	for _, field := range gcLeaderboardFieldsList() {
+		allowed[field] = struct{}{}
+	}
+	for _, field := range fields {
+		if _, ok := allowed[field]; !ok {
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(gcLeaderboardFieldsList(), ", "))
+		}
+	}
+
+	return fields, nil
+}
+
+func gcLeaderboardFieldsList() []string {
+	return []string{
+		"defaultFormatter",
+		"referenceName",
+		"vendorIdentifier",
+		"submissionType",
+		"scoreSortType",
+		"scoreRangeStart",
+		"scoreRangeEnd",
+		"recurrenceStartDate",
+		"recurrenceDuration",
+		"recurrenceRule",
+		"archived",
+		"activityProperties",
+		"visibility",
+		"gameCenterDetail",
+		"gameCenterGroup",
+		"groupLeaderboard",
+		"gameCenterLeaderboardSets",
+		"localizations",
+		"releases",
+	}
+}
+
+// compareLeaderboardsByReferenceName orders leaderboards by reference name,
+// ignoring case.
+func compareLeaderboardsByReferenceName(a, b asc.Resource[asc.GameCenterLeaderboardAttributes]) int {
+	return strings.Compare(strings.ToLower(a.Attributes.ReferenceName), strings.ToLower(b.Attributes.ReferenceName))
+}
+
+// leaderboardNameContains reports whether the leaderboard's reference name
+// contains substr, ignoring case. An empty substr matches everything.
+func leaderboardNameContains(leaderboard asc.Resource[asc.GameCenterLeaderboardAttributes], substr string) bool {
+	return strings.Contains(strings.ToLower(leaderboard.Attributes.ReferenceName), strings.ToLower(substr))
+}
+
+func sortLeaderboards(leaderboards []asc.Resource[asc.GameCenterLeaderboardAttributes], sortField string) {
+	switch sortField {
+	case "referenceName":
+		sort.SliceStable(leaderboards, func(i, j int) bool {
+			return compareLeaderboardsByReferenceName(leaderboards[i], leaderboards[j]) < 0
+		})
+	case "-referenceName":
+		sort.SliceStable(leaderboards, func(i, j int) bool {
+			return compareLeaderboardsByReferenceName(leaderboards[i], leaderboards[j]) > 0
+		})
+	}
+}
+
+func filterLeaderboardsByName(leaderboards []asc.Resource[asc.GameCenterLeaderboardAttributes], substr string) []asc.Resource[asc.GameCenterLeaderboardAttributes] {
+	if substr == "" {
+		return leaderboards
+	}
+	filtered := make([]asc.Resource[asc.GameCenterLeaderboardAttributes], 0, len(leaderboards))
+	for _, leaderboard := range leaderboards {
+		if leaderboardNameContains(leaderboard, substr) {
+			filtered = append(filtered, leaderboard)
+		}
+	}
+	return filtered
+}
+
 // GameCenterLeaderboardsGetCommand returns the leaderboards get subcommand.
 func GameCenterLeaderboardsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	leaderboardID := fs.String("id", "", "Game Center leaderboard ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -291,8 +543,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*leaderboardID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -325,7 +576,7 @@ func GameCenterLeaderboardsCreateCommand() *ffcli.Command {
 	submissionType := fs.String("submission-type", "", "Submission type: BEST_SCORE, MOST_RECENT_SCORE")
 	scoreRangeStart := fs.String("score-range-start", "", "Score range start (optional)")
 	scoreRangeEnd := fs.String("score-range-end", "", "Score range end (optional)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -342,50 +593,41 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			name := strings.TrimSpace(*referenceName)
 			if name == "" {
-				fmt.Fprintln(os.Stderr, "Error: --reference-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--reference-name is required")
 			}
 
 			vendor := strings.TrimSpace(*vendorID)
 			if vendor == "" {
-				fmt.Fprintln(os.Stderr, "Error: --vendor-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--vendor-id is required")
 			}
 
 			formatterVal := strings.TrimSpace(strings.ToUpper(*formatter))
 			if formatterVal == "" {
-				fmt.Fprintln(os.Stderr, "Error: --formatter is required")
-				return flag.ErrHelp
+				return flagErrorf("--formatter is required")
 			}
 			if !isValidLeaderboardFormatter(formatterVal) {
-				fmt.Fprintf(os.Stderr, "Error: --formatter must be one of: %s\n", strings.Join(asc.ValidLeaderboardFormatters, ", "))
-				return flag.ErrHelp
+				return flagErrorf("--formatter must be one of: %s", strings.Join(asc.ValidLeaderboardFormatters, ", "))
 			}
 
 			sortVal := strings.TrimSpace(strings.ToUpper(*sortType))
 			if sortVal == "" {
-				fmt.Fprintln(os.Stderr, "Error: --sort is required")
-				return flag.ErrHelp
+				return flagErrorf("--sort is required")
 			}
 			if !isValidScoreSortType(sortVal) {
-				fmt.Fprintf(os.Stderr, "Error: --sort must be one of: %s\n", strings.Join(asc.ValidScoreSortTypes, ", "))
-				return flag.ErrHelp
+				return flagErrorf("--sort must be one of: %s", strings.Join(asc.ValidScoreSortTypes, ", "))
 			}
 
 			submissionVal := strings.TrimSpace(strings.ToUpper(*submissionType))
 			if submissionVal == "" {
-				fmt.Fprintln(os.Stderr, "Error: --submission-type is required")
-				return flag.ErrHelp
+				return flagErrorf("--submission-type is required")
 			}
 			if !isValidSubmissionType(submissionVal) {
-				fmt.Fprintf(os.Stderr, "Error: --submission-type must be one of: %s\n", strings.Join(asc.ValidSubmissionTypes, ", "))
-				return flag.ErrHelp
+				return flagErrorf("--submission-type must be one of: %s", strings.Join(asc.ValidSubmissionTypes, ", "))
 			}
 
 			client, err := getASCClient()
@@ -429,7 +671,8 @@ func GameCenterLeaderboardsUpdateCommand() *ffcli.Command {
 	leaderboardID := fs.String("id", "", "Game Center leaderboard ID")
 	referenceName := fs.String("reference-name", "", "Reference name for the leaderboard")
 	archived := fs.String("archived", "", "Archive the leaderboard (true/false)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	file := fs.String("file", "", "Path to a JSON object of leaderboard attributes to update")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -440,19 +683,35 @@ func GameCenterLeaderboardsUpdateCommand() *ffcli.Command {
 
 Examples:
   asc game-center leaderboards update --id "LEADERBOARD_ID" --reference-name "New Name"
-  asc game-center leaderboards update --id "LEADERBOARD_ID" --archived true`,
+  asc game-center leaderboards update --id "LEADERBOARD_ID" --archived true
+  asc game-center leaderboards update --id "LEADERBOARD_ID" --file patch.json
+
+--file reads a partial attributes object such as
+  {"scoreRangeStart": "0", "scoreRangeEnd": "1000", "visibility": "SHOW_FOR_ALL"}
+and only the keys present are sent. Flags override values from the file.`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*leaderboardID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			attrs := asc.GameCenterLeaderboardUpdateAttributes{}
 			hasUpdate := false
 
+			if fileValue := strings.TrimSpace(*file); fileValue != "" {
+				payload, err := readJSONFilePayloadFor(fileValue, &asc.GameCenterLeaderboardUpdateAttributes{})
+				if err != nil {
+					return fmt.Errorf("game-center leaderboards update: %w", err)
+				}
+				attrs, err = parseLeaderboardUpdatePatch(payload)
+				if err != nil {
+					return fmt.Errorf("game-center leaderboards update: %w", err)
+				}
+				hasUpdate = true
+			}
+
 			if strings.TrimSpace(*referenceName) != "" {
 				name := strings.TrimSpace(*referenceName)
 				attrs.ReferenceName = &name
@@ -462,16 +721,14 @@ Examples:
 			if strings.TrimSpace(*archived) != "" {
 				val, err := parseBool(*archived, "--archived")
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err.Error())
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				attrs.Archived = &val
 				hasUpdate = true
 			}
 
 			if !hasUpdate {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			client, err := getASCClient()
@@ -492,13 +749,43 @@ Examples:
 	}
 }
 
+// parseLeaderboardUpdatePatch decodes a partial leaderboard attributes
+// object, rejecting null values and patches that update nothing. Unknown keys
+// are ignored unless --strict-validation rejected them when reading the file.
+func parseLeaderboardUpdatePatch(payload json.RawMessage) (asc.GameCenterLeaderboardUpdateAttributes, error) {
+	var attrs asc.GameCenterLeaderboardUpdateAttributes
+	if err := json.Unmarshal(payload, &attrs); err != nil {
+		return attrs, fmt.Errorf("invalid leaderboard patch: %w", err)
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &keys); err != nil {
+		return attrs, fmt.Errorf("invalid leaderboard patch: %w", err)
+	}
+	for key, value := range keys {
+		if string(bytes.TrimSpace(value)) == "null" {
+			return attrs, fmt.Errorf("invalid leaderboard patch: %q must not be null", key)
+		}
+	}
+	known, err := json.Marshal(attrs)
+	if err != nil {
+		return attrs, fmt.Errorf("invalid leaderboard patch: %w", err)
+	}
+	if string(known) == "{}" {
+		return attrs, fmt.Errorf("leaderboard patch must contain at least one attribute")
+	}
+	return attrs, nil
+}
+
 // GameCenterLeaderboardsDeleteCommand returns the leaderboards delete subcommand.
 func GameCenterLeaderboardsDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 
 	leaderboardID := fs.String("id", "", "Game Center leaderboard ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env); used with --cascade to find leaderboard sets")
+	cascade := fs.Bool("cascade", false, "Remove the leaderboard from sets and delete its releases and localizations first")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -507,19 +794,33 @@ func GameCenterLeaderboardsDeleteCommand() *ffcli.Command {
 		ShortHelp:  "Delete a Game Center leaderboard.",
 		LongHelp: `Delete a Game Center leaderboard.
 
+A leaderboard that belongs to a leaderboard set or has releases may fail to
+delete. With --cascade, the leaderboard is first removed from every set of the
+app, then its releases and localizations are deleted, and finally the
+leaderboard itself. Each dependent change is reported in the output.
+
 Examples:
-  asc game-center leaderboards delete --id "LEADERBOARD_ID" --confirm`,
+  asc game-center leaderboards delete --id "LEADERBOARD_ID" --confirm
+  asc game-center leaderboards delete --id "LEADERBOARD_ID" --app "APP_ID" --cascade --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*leaderboardID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("Game Center leaderboard %q", id))
+				if err != nil {
+					return fmt.Errorf("game-center leaderboards delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
+			}
+			resolvedAppID := resolveAppID(*appID)
+			if *cascade && resolvedAppID == "" {
+				return flagErrorf("--app is required with --cascade (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -530,20 +831,117 @@ Examples:
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
-			if err := client.DeleteGameCenterLeaderboard(requestCtx, id); err != nil {
-				return fmt.Errorf("game-center leaderboards delete: failed to delete: %w", err)
+			result := &asc.GameCenterLeaderboardDeleteResult{ID: id}
+
+			if *cascade {
+				steps, err := deleteLeaderboardDependents(requestCtx, client, resolvedAppID, id)
+				result.Cascade = steps
+				if err != nil {
+					return fmt.Errorf("game-center leaderboards delete: cascade failed after %d step(s): %w", len(steps), err)
+				}
 			}
 
-			result := &asc.GameCenterLeaderboardDeleteResult{
-				ID:      id,
-				Deleted: true,
+			if err := client.DeleteGameCenterLeaderboard(requestCtx, id); err != nil {
+				if !*cascade && isGameCenterDependencyError(err) {
+					return fmt.Errorf("game-center leaderboards delete: failed to delete: %w (the leaderboard may belong to a leaderboard set or have releases; retry with --cascade --app APP_ID)", err)
+				}
+				return fmt.Errorf("game-center leaderboards delete: failed to delete: %w", err)
 			}
+			result.Deleted = true
 
 			return printOutput(result, *output, *pretty)
 		},
 	}
 }
 
+// deleteLeaderboardDependents removes a leaderboard from the app's leaderboard
+// sets and deletes its releases and localizations. The steps completed so far
+// are returned even when an error stops the cascade.
+func deleteLeaderboardDependents(ctx context.Context, client *asc.Client, appID, leaderboardID string) ([]asc.GameCenterCascadeStep, error) {
+	steps := []asc.GameCenterCascadeStep{}
+
+	gcDetailID, err := client.GetGameCenterDetailID(ctx, appID)
+	if err != nil {
+		return steps, fmt.Errorf("failed to get Game Center detail: %w", err)
+	}
+
+	firstSets, err := client.GetGameCenterLeaderboardSets(ctx, gcDetailID, asc.WithGCLeaderboardSetsLimit(200))
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch leaderboard sets: %w", err)
+	}
+	allSets, err := asc.PaginateAll(ctx, firstSets, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterLeaderboardSets(ctx, gcDetailID, asc.WithGCLeaderboardSetsNextURL(nextURL))
+	})
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch leaderboard sets: %w", err)
+	}
+	sets, ok := allSets.(*asc.GameCenterLeaderboardSetsResponse)
+	if !ok {
+		return steps, fmt.Errorf("unexpected leaderboard sets response type %T", allSets)
+	}
+	for _, set := range sets.Data {
+		members, err := fetchLeaderboardSetMemberIDs(ctx, client, set.ID)
+		if err != nil {
+			return steps, fmt.Errorf("leaderboard set %s: %w", set.ID, err)
+		}
+		if !containsString(members, leaderboardID) {
+			continue
+		}
+		remaining := removeLeaderboardSetMembers(members, []string{leaderboardID})
+		if err := client.UpdateGameCenterLeaderboardSetMembers(ctx, set.ID, remaining); err != nil {
+			return steps, fmt.Errorf("failed to remove leaderboard from set %s: %w", set.ID, err)
+		}
+		steps = append(steps, asc.GameCenterCascadeStep{Action: "removed-from-set", ResourceType: "gameCenterLeaderboardSets", ID: set.ID})
+	}
+
+	firstReleases, err := client.GetGameCenterLeaderboardReleases(ctx, leaderboardID, asc.WithGCLeaderboardReleasesLimit(200))
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	allReleases, err := asc.PaginateAll(ctx, firstReleases, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterLeaderboardReleases(ctx, leaderboardID, asc.WithGCLeaderboardReleasesNextURL(nextURL))
+	})
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	releases, ok := allReleases.(*asc.GameCenterLeaderboardReleasesResponse)
+	if !ok {
+		return steps, fmt.Errorf("unexpected releases response type %T", allReleases)
+	}
+	for _, release := range releases.Data {
+		if err := client.DeleteGameCenterLeaderboardRelease(ctx, release.ID); err != nil {
+			return steps, fmt.Errorf("failed to delete release %s: %w", release.ID, err)
+		}
+		steps = append(steps, asc.GameCenterCascadeStep{Action: "deleted", ResourceType: "gameCenterLeaderboardReleases", ID: release.ID})
+	}
+
+	localizations, err := client.GetAllGameCenterLeaderboardLocalizations(ctx, leaderboardID, asc.WithGCLeaderboardLocalizationsLimit(200))
+	if err != nil {
+		return steps, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	for _, localization := range localizations.Data {
+		if err := client.DeleteGameCenterLeaderboardLocalization(ctx, localization.ID); err != nil {
+			return steps, fmt.Errorf("failed to delete localization %s: %w", localization.ID, err)
+		}
+		steps = append(steps, asc.GameCenterCascadeStep{Action: "deleted", ResourceType: "gameCenterLeaderboardLocalizations", ID: localization.ID})
+	}
+
+	return steps, nil
+}
+
+// isGameCenterDependencyError reports whether a delete was rejected because
+// other resources still reference the target.
+func isGameCenterDependencyError(err error) bool {
+	var apiErr *asc.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	code := strings.ToUpper(strings.TrimSpace(apiErr.Code))
+	return strings.HasPrefix(code, "STATE_ERROR") ||
+		strings.HasPrefix(code, "ENTITY_ERROR.RELATIONSHIP") ||
+		code == "CONFLICT"
+}
+
 // GameCenterLeaderboardReleasesCommand returns the releases command group.
 func GameCenterLeaderboardReleasesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("releases", flag.ExitOnError)
@@ -579,7 +977,7 @@ func GameCenterLeaderboardReleasesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -596,7 +994,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("game-center leaderboards releases list: --limit must be between 1 and 200")
+				return usageErrorf("game-center leaderboards releases list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("game-center leaderboards releases list: %w", err)
@@ -604,8 +1002,7 @@ Examples:
 
 			lbID := strings.TrimSpace(*leaderboardID)
 			if lbID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --leaderboard-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--leaderboard-id is required")
 			}
 
 			client, err := getASCClient()
@@ -628,14 +1025,13 @@ Examples:
 					return fmt.Errorf("game-center leaderboards releases list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterLeaderboardReleases(ctx, lbID, asc.WithGCLeaderboardReleasesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("game-center leaderboards releases list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterLeaderboardReleases(requestCtx, lbID, opts...)
@@ -654,7 +1050,7 @@ func GameCenterLeaderboardReleasesCreateCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	leaderboardID := fs.String("leaderboard-id", "", "Game Center leaderboard ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -672,14 +1068,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			lbID := strings.TrimSpace(*leaderboardID)
 			if lbID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --leaderboard-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--leaderboard-id is required")
 			}
 
 			client, err := getASCClient()
@@ -712,7 +1106,7 @@ func GameCenterLeaderboardReleasesDeleteCommand() *ffcli.Command {
 
 	releaseID := fs.String("id", "", "Game Center leaderboard release ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -728,12 +1122,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*releaseID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("Game Center leaderboard release %q", id))
+				if err != nil {
+					return fmt.Errorf("game-center leaderboards releases delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
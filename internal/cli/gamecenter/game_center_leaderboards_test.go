@@ -0,0 +1,182 @@
+package gamecenter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseLeaderboardUpdatePatch(t *testing.T) {
+	attrs, err := parseLeaderboardUpdatePatch(json.RawMessage(`{"scoreRangeEnd":"1000","archived":false,"activityProperties":{"mode":"ranked"}}`))
+	if err != nil {
+		t.Fatalf("parseLeaderboardUpdatePatch() error: %v", err)
+	}
+
+	body, err := json.Marshal(attrs)
+	if err != nil {
+		t.Fatalf("marshal attrs: %v", err)
+	}
+	if string(body) != `{"scoreRangeEnd":"1000","archived":false,"activityProperties":{"mode":"ranked"}}` {
+		t.Fatalf("expected only provided keys to be sent, got %s", body)
+	}
+}
+
+func TestParseLeaderboardUpdatePatchRejectsInvalidPatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr string
+	}{
+		{name: "empty object", payload: `{}`, wantErr: "at least one attribute"},
+		{name: "only unknown keys", payload: `{"title":"High Scores"}`, wantErr: "at least one attribute"},
+		{name: "wrong type", payload: `{"archived":"yes"}`, wantErr: "invalid leaderboard patch"},
+		{name: "null value", payload: `{"referenceName":null}`, wantErr: "must not be null"},
+		{name: "array", payload: `[{"referenceName":"x"}]`, wantErr: "invalid leaderboard patch"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseLeaderboardUpdatePatch(json.RawMessage(test.payload))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeGCLeaderboardFields(t *testing.T) {
+	fields, err := normalizeGCLeaderboardFields(" referenceName, vendorIdentifier ")
+	if err != nil {
+		t.Fatalf("normalizeGCLeaderboardFields() error: %v", err)
+	}
+	if strings.Join(fields, ",") != "referenceName,vendorIdentifier" {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+
+	if fields, err := normalizeGCLeaderboardFields(""); err != nil || fields != nil {
+		t.Fatalf("expected no fields for empty value, got %v, %v", fields, err)
+	}
+
+	if _, err := normalizeGCLeaderboardFields("referenceName,title"); err == nil || !strings.Contains(err.Error(), "--fields must be one of") {
+		t.Fatalf("expected allowlist error, got %v", err)
+	}
+}
+
+func TestImageFormatForPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "leaderboard.png", want: "png"},
+		{path: "out/leaderboard.JPEG", want: "jpg"},
+		{path: "leaderboard.jpg", want: "jpg"},
+		{path: "leaderboard", want: "png"},
+	}
+	for _, test := range tests {
+		got, err := imageFormatForPath(test.path)
+		if err != nil || got != test.want {
+			t.Fatalf("imageFormatForPath(%q) = %q, %v; want %q", test.path, got, err, test.want)
+		}
+	}
+	if _, err := imageFormatForPath("leaderboard.gif"); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported extension error, got %v", err)
+	}
+}
+
+func TestImageNotReadyError(t *testing.T) {
+	tests := []struct {
+		state   *asc.AssetDeliveryState
+		wantErr string
+	}{
+		{state: nil, wantErr: "may still be processing"},
+		{state: &asc.AssetDeliveryState{State: "UPLOAD_COMPLETE"}, wantErr: "delivery state: UPLOAD_COMPLETE"},
+		{state: &asc.AssetDeliveryState{State: "FAILED"}, wantErr: "processing failed"},
+	}
+	for _, test := range tests {
+		if err := imageNotReadyError(test.state); !strings.Contains(err.Error(), test.wantErr) {
+			t.Fatalf("imageNotReadyError(%+v) = %v, want %q", test.state, err, test.wantErr)
+		}
+	}
+}
+
+func TestIsGameCenterDependencyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "state error", err: &asc.APIError{Code: "STATE_ERROR.ENTITY_STATE_INVALID"}, want: true},
+		{name: "relationship error", err: fmt.Errorf("wrapped: %w", &asc.APIError{Code: "ENTITY_ERROR.RELATIONSHIP.INVALID"}), want: true},
+		{name: "not found", err: &asc.APIError{Code: "NOT_FOUND"}, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isGameCenterDependencyError(test.err); got != test.want {
+				t.Fatalf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func testLeaderboard(id, referenceName string) asc.Resource[asc.GameCenterLeaderboardAttributes] {
+	return asc.Resource[asc.GameCenterLeaderboardAttributes]{
+		ID:         id,
+		Attributes: asc.GameCenterLeaderboardAttributes{ReferenceName: referenceName},
+	}
+}
+
+func leaderboardIDs(leaderboards []asc.Resource[asc.GameCenterLeaderboardAttributes]) string {
+	ids := make([]string, 0, len(leaderboards))
+	for _, leaderboard := range leaderboards {
+		ids = append(ids, leaderboard.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestSortLeaderboardsIsStableAndCaseInsensitive(t *testing.T) {
+	leaderboards := []asc.Resource[asc.GameCenterLeaderboardAttributes]{
+		testLeaderboard("1", "weekly"),
+		testLeaderboard("2", "All Time"),
+		testLeaderboard("3", "Weekly"),
+		testLeaderboard("4", "daily"),
+	}
+
+	sortLeaderboards(leaderboards, "referenceName")
+	if got := leaderboardIDs(leaderboards); got != "2,4,1,3" {
+		t.Fatalf("referenceName: expected 2,4,1,3, got %s", got)
+	}
+
+	sortLeaderboards(leaderboards, "-referenceName")
+	if got := leaderboardIDs(leaderboards); got != "1,3,4,2" {
+		t.Fatalf("-referenceName: expected 1,3,4,2, got %s", got)
+	}
+
+	sortLeaderboards(leaderboards, "")
+	if got := leaderboardIDs(leaderboards); got != "1,3,4,2" {
+		t.Fatalf("empty sort should keep order, got %s", got)
+	}
+}
+
+func TestFilterLeaderboardsByName(t *testing.T) {
+	leaderboards := []asc.Resource[asc.GameCenterLeaderboardAttributes]{
+		testLeaderboard("1", "Weekly High Score"),
+		testLeaderboard("2", "All Time"),
+		testLeaderboard("3", "weekly-streak"),
+	}
+
+	if got := leaderboardIDs(filterLeaderboardsByName(leaderboards, "WEEKLY")); got != "1,3" {
+		t.Fatalf("expected 1,3, got %s", got)
+	}
+	if got := leaderboardIDs(filterLeaderboardsByName(leaderboards, "")); got != "1,2,3" {
+		t.Fatalf("empty filter should match all, got %s", got)
+	}
+	if got := filterLeaderboardsByName(leaderboards, "monthly"); len(got) != 0 {
+		t.Fatalf("expected no matches, got %s", leaderboardIDs(got))
+	}
+}
@@ -2,6 +2,9 @@ package gamecenter
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
+	"io"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -25,6 +28,17 @@ func contextWithTimeout(ctx context.Context) (context.Context, context.CancelFun
 	return shared.ContextWithTimeout(ctx)
 }
 
+func createLocalizationOrRollback[T any](
+	ctx context.Context,
+	resource string,
+	resourceID string,
+	keepOnFailure bool,
+	create func(context.Context) (T, error),
+	rollback func(context.Context) error,
+) (T, error) {
+	return shared.CreateLocalizationOrRollback(ctx, resource, resourceID, keepOnFailure, create, rollback)
+}
+
 func contextWithUploadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	return shared.ContextWithUploadTimeout(ctx)
 }
@@ -33,10 +47,22 @@ func printOutput(data interface{}, format string, pretty bool) error {
 	return shared.PrintOutput(data, format, pretty)
 }
 
+func paginateOutput(ctx context.Context, firstPage asc.PaginatedResponse, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	return shared.PaginateOutput(ctx, firstPage, fetchNext, format, pretty)
+}
+
 func validateNextURL(next string) error {
 	return shared.ValidateNextURL(next)
 }
 
+func validateSort(value string, allowed ...string) error {
+	return shared.ValidateSort(value, allowed...)
+}
+
+func registerFlagValues(fs *flag.FlagSet, name string, values ...string) {
+	shared.RegisterFlagValues(fs, name, values...)
+}
+
 func splitCSV(value string) []string {
 	return shared.SplitCSV(value)
 }
@@ -44,3 +70,23 @@ func splitCSV(value string) []string {
 func splitCSVUpper(value string) []string {
 	return shared.SplitCSVUpper(value)
 }
+
+func readJSONFilePayloadFor(path string, target any) (json.RawMessage, error) {
+	return shared.ReadJSONFilePayloadFor(path, target)
+}
+
+func writeStreamToFile(path string, reader io.Reader) (int64, error) {
+	return shared.WriteStreamToFile(path, reader)
+}
+
+func confirmDestructive(resourceDescription string) (bool, error) {
+	return shared.ConfirmDestructive(resourceDescription)
+}
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
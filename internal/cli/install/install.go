@@ -77,7 +77,7 @@ Examples:
 func installSkills(ctx context.Context, pkg string) error {
 	pkg = strings.TrimSpace(pkg)
 	if pkg == "" {
-		return fmt.Errorf("--package is required")
+		return shared.UsageErrorf("--package is required")
 	}
 
 	path, err := lookupNpx("npx")
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -52,7 +51,7 @@ func LocalizationsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -70,7 +69,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("localizations list: --limit must be between 1 and 200")
+				return usageErrorf("localizations list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("localizations list: %w", err)
@@ -86,8 +85,7 @@ Examples:
 			switch normalizedType {
 			case shared.LocalizationTypeVersion:
 				if strings.TrimSpace(*versionID) == "" {
-					fmt.Fprintln(os.Stderr, "Error: --version is required for version localizations")
-					return flag.ErrHelp
+					return flagErrorf("--version is required for version localizations")
 				}
 
 				client, err := getASCClient()
@@ -115,13 +113,13 @@ Examples:
 					}
 
 					// Fetch all remaining pages
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionLocalizations(ctx, strings.TrimSpace(*versionID), asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
-					})
+					}, *output, *pretty)
 					if err != nil {
 						return fmt.Errorf("localizations list: %w", err)
 					}
-					return printOutput(resp, *output, *pretty)
+					return nil
 				}
 
 				resp, err := client.GetAppStoreVersionLocalizations(requestCtx, strings.TrimSpace(*versionID), opts...)
@@ -132,8 +130,7 @@ Examples:
 			case shared.LocalizationTypeAppInfo:
 				resolvedAppID := resolveAppID(*appID)
 				if resolvedAppID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
-					return flag.ErrHelp
+					return flagErrorf("--app is required for app-info localizations")
 				}
 
 				client, err := getASCClient()
@@ -166,13 +163,13 @@ Examples:
 					}
 
 					// Fetch all remaining pages
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppInfoLocalizations(ctx, appInfo, asc.WithAppInfoLocalizationsNextURL(nextURL))
-					})
+					}, *output, *pretty)
 					if err != nil {
 						return fmt.Errorf("localizations list: %w", err)
 					}
-					return printOutput(resp, *output, *pretty)
+					return nil
 				}
 
 				resp, err := client.GetAppInfoLocalizations(requestCtx, appInfo, opts...)
@@ -200,7 +197,7 @@ func LocalizationsDownloadCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -218,7 +215,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("localizations download: --limit must be between 1 and 200")
+				return usageErrorf("localizations download: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("localizations download: %w", err)
@@ -234,8 +231,7 @@ Examples:
 			switch normalizedType {
 			case shared.LocalizationTypeVersion:
 				if strings.TrimSpace(*versionID) == "" {
-					fmt.Fprintln(os.Stderr, "Error: --version is required for version localizations")
-					return flag.ErrHelp
+					return flagErrorf("--version is required for version localizations")
 				}
 
 				client, err := getASCClient()
@@ -309,8 +305,7 @@ Examples:
 			case shared.LocalizationTypeAppInfo:
 				resolvedAppID := resolveAppID(*appID)
 				if resolvedAppID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
-					return flag.ErrHelp
+					return flagErrorf("--app is required for app-info localizations")
 				}
 
 				client, err := getASCClient()
@@ -406,7 +401,7 @@ func LocalizationsUploadCommand() *ffcli.Command {
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
 	path := fs.String("path", "", "Input path (directory or .strings file)")
 	dryRun := fs.Bool("dry-run", false, "Validate file without uploading")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -424,8 +419,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*path) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --path is required")
-				return flag.ErrHelp
+				return flagErrorf("--path is required")
 			}
 
 			normalizedType, err := shared.NormalizeLocalizationType(*locType)
@@ -438,8 +432,7 @@ Examples:
 			switch normalizedType {
 			case shared.LocalizationTypeVersion:
 				if strings.TrimSpace(*versionID) == "" {
-					fmt.Fprintln(os.Stderr, "Error: --version is required for version localizations")
-					return flag.ErrHelp
+					return flagErrorf("--version is required for version localizations")
 				}
 
 				client, err := getASCClient()
@@ -471,8 +464,7 @@ Examples:
 			case shared.LocalizationTypeAppInfo:
 				resolvedAppID := resolveAppID(*appID)
 				if resolvedAppID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
-					return flag.ErrHelp
+					return flagErrorf("--app is required for app-info localizations")
 				}
 
 				client, err := getASCClient()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -47,7 +46,7 @@ func MarketplaceSearchDetailsGetCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(marketplaceSearchDetailFieldsList(), ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -63,8 +62,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			fieldsValue, err := normalizeMarketplaceSearchDetailFields(*fields)
@@ -96,7 +94,7 @@ func MarketplaceSearchDetailsCreateCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	catalogURL := fs.String("catalog-url", "", "Marketplace catalog URL")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -112,14 +110,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			catalogURLValue := strings.TrimSpace(*catalogURL)
 			if catalogURLValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --catalog-url is required")
-				return flag.ErrHelp
+				return flagErrorf("--catalog-url is required")
 			}
 
 			client, err := getASCClient()
@@ -146,7 +142,7 @@ func MarketplaceSearchDetailsUpdateCommand() *ffcli.Command {
 
 	detailID := fs.String("search-detail-id", "", "Marketplace search detail ID")
 	catalogURL := fs.String("catalog-url", "", "Marketplace catalog URL")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -162,8 +158,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*detailID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --search-detail-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--search-detail-id is required")
 			}
 
 			visited := map[string]bool{}
@@ -172,8 +167,7 @@ Examples:
 			})
 
 			if !visited["catalog-url"] {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			attrs := asc.MarketplaceSearchDetailUpdateAttributes{}
@@ -206,7 +200,7 @@ func MarketplaceSearchDetailsDeleteCommand() *ffcli.Command {
 
 	detailID := fs.String("search-detail-id", "", "Marketplace search detail ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -222,12 +216,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*detailID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --search-detail-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--search-detail-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("marketplace search details %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("marketplace search-details delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -263,7 +261,7 @@ func normalizeMarketplaceSearchDetailFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(marketplaceSearchDetailFieldsList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(marketplaceSearchDetailFieldsList(), ", "))
 		}
 	}
 	return fields, nil
@@ -4,12 +4,12 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 // MarketplaceWebhooksCommand returns the marketplace webhooks command group.
@@ -48,7 +48,7 @@ func MarketplaceWebhooksListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -67,7 +67,7 @@ Examples:
 			warnMarketplaceWebhooksDeprecated()
 
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("marketplace webhooks list: --limit must be between 1 and 200")
+				return usageErrorf("marketplace webhooks list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("marketplace webhooks list: %w", err)
@@ -101,14 +101,13 @@ Examples:
 					return fmt.Errorf("marketplace webhooks list: failed to fetch: %w", err)
 				}
 
-				webhooks, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetMarketplaceWebhooks(ctx, asc.WithMarketplaceWebhooksNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("marketplace webhooks list: %w", err)
 				}
-
-				return printOutput(webhooks, *output, *pretty)
+				return nil
 			}
 
 			webhooks, err := client.GetMarketplaceWebhooks(requestCtx, opts...)
@@ -126,7 +125,7 @@ func MarketplaceWebhooksGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	webhookID := fs.String("webhook-id", "", "Marketplace webhook ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -144,8 +143,7 @@ Examples:
 
 			trimmedID := strings.TrimSpace(*webhookID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--webhook-id is required")
 			}
 
 			client, err := getASCClient()
@@ -172,7 +170,7 @@ func MarketplaceWebhooksCreateCommand() *ffcli.Command {
 
 	url := fs.String("url", "", "Webhook endpoint URL")
 	secret := fs.String("secret", "", "Webhook secret")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -190,13 +188,11 @@ Examples:
 
 			endpointURL := strings.TrimSpace(*url)
 			if endpointURL == "" {
-				fmt.Fprintln(os.Stderr, "Error: --url is required")
-				return flag.ErrHelp
+				return flagErrorf("--url is required")
 			}
 			secretValue := strings.TrimSpace(*secret)
 			if secretValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --secret is required")
-				return flag.ErrHelp
+				return flagErrorf("--secret is required")
 			}
 
 			client, err := getASCClient()
@@ -224,7 +220,7 @@ func MarketplaceWebhooksUpdateCommand() *ffcli.Command {
 	webhookID := fs.String("webhook-id", "", "Marketplace webhook ID")
 	url := fs.String("url", "", "Webhook endpoint URL")
 	secret := fs.String("secret", "", "Webhook secret")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -243,8 +239,7 @@ Examples:
 
 			trimmedID := strings.TrimSpace(*webhookID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--webhook-id is required")
 			}
 
 			visited := map[string]bool{}
@@ -253,8 +248,7 @@ Examples:
 			})
 
 			if !visited["url"] && !visited["secret"] {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			attrs := asc.MarketplaceWebhookUpdateAttributes{}
@@ -291,7 +285,7 @@ func MarketplaceWebhooksDeleteCommand() *ffcli.Command {
 
 	webhookID := fs.String("webhook-id", "", "Marketplace webhook ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -309,12 +303,16 @@ Examples:
 
 			trimmedID := strings.TrimSpace(*webhookID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--webhook-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("marketplace webhook %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("marketplace webhooks delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -350,7 +348,7 @@ func normalizeMarketplaceWebhookFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(marketplaceWebhookFieldsList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(marketplaceWebhookFieldsList(), ", "))
 		}
 	}
 	return fields, nil
@@ -361,5 +359,5 @@ func marketplaceWebhookFieldsList() []string {
 }
 
 func warnMarketplaceWebhooksDeprecated() {
-	fmt.Fprintln(os.Stderr, "Warning: marketplace webhooks endpoints are deprecated in App Store Connect API.")
+	shared.Warnf("marketplace webhooks endpoints are deprecated in App Store Connect API.")
 }
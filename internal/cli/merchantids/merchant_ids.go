@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -59,7 +58,7 @@ func MerchantIDsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -77,10 +76,10 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("merchant-ids list: --limit must be between 1 and 200")
+				return usageErrorf("merchant-ids list: --limit must be between 1 and 200")
 			}
 			if *certificatesLimit != 0 && (*certificatesLimit < 1 || *certificatesLimit > 50) {
-				return fmt.Errorf("merchant-ids list: --certificates-limit must be between 1 and 50")
+				return usageErrorf("merchant-ids list: --certificates-limit must be between 1 and 50")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("merchant-ids list: %w", err)
@@ -102,12 +101,10 @@ Examples:
 				return fmt.Errorf("merchant-ids list: %w", err)
 			}
 			if len(certificateFieldsValue) > 0 && !hasInclude(includeValue, "certificates") {
-				fmt.Fprintln(os.Stderr, "Error: --certificate-fields requires --include certificates")
-				return flag.ErrHelp
+				return flagErrorf("--certificate-fields requires --include certificates")
 			}
 			if *certificatesLimit != 0 && !hasInclude(includeValue, "certificates") {
-				fmt.Fprintln(os.Stderr, "Error: --certificates-limit requires --include certificates")
-				return flag.ErrHelp
+				return flagErrorf("--certificates-limit requires --include certificates")
 			}
 
 			client, err := getASCClient()
@@ -141,14 +138,13 @@ Examples:
 					return fmt.Errorf("merchant-ids list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetMerchantIDs(ctx, asc.WithMerchantIDsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("merchant-ids list: %w", err)
 				}
-
-				return printOutput(paginated, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetMerchantIDs(requestCtx, opts...)
@@ -170,7 +166,7 @@ func MerchantIDsGetCommand() *ffcli.Command {
 	certificateFields := fs.String("certificate-fields", "", "Certificate fields to include: "+strings.Join(certificateFieldsList(), ", "))
 	include := fs.String("include", "", "Include related resources: "+strings.Join(merchantIDIncludeList(), ", "))
 	certificatesLimit := fs.Int("certificates-limit", 0, "Maximum included certificates per merchant ID (1-50)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -186,11 +182,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			merchantIDValue := strings.TrimSpace(*merchantID)
 			if merchantIDValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --merchant-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--merchant-id is required")
 			}
 			if *certificatesLimit != 0 && (*certificatesLimit < 1 || *certificatesLimit > 50) {
-				return fmt.Errorf("merchant-ids get: --certificates-limit must be between 1 and 50")
+				return usageErrorf("merchant-ids get: --certificates-limit must be between 1 and 50")
 			}
 
 			fieldsValue, err := normalizeMerchantIDFields(*fields, "--fields")
@@ -206,12 +201,10 @@ Examples:
 				return fmt.Errorf("merchant-ids get: %w", err)
 			}
 			if len(certificateFieldsValue) > 0 && !hasInclude(includeValue, "certificates") {
-				fmt.Fprintln(os.Stderr, "Error: --certificate-fields requires --include certificates")
-				return flag.ErrHelp
+				return flagErrorf("--certificate-fields requires --include certificates")
 			}
 			if *certificatesLimit != 0 && !hasInclude(includeValue, "certificates") {
-				fmt.Fprintln(os.Stderr, "Error: --certificates-limit requires --include certificates")
-				return flag.ErrHelp
+				return flagErrorf("--certificates-limit requires --include certificates")
 			}
 
 			client, err := getASCClient()
@@ -245,7 +238,7 @@ func MerchantIDsCreateCommand() *ffcli.Command {
 
 	identifier := fs.String("identifier", "", "Merchant ID identifier (e.g., merchant.com.example)")
 	name := fs.String("name", "", "Merchant ID name")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -261,13 +254,11 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			identifierValue := strings.TrimSpace(*identifier)
 			if identifierValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --identifier is required")
-				return flag.ErrHelp
+				return flagErrorf("--identifier is required")
 			}
 			nameValue := strings.TrimSpace(*name)
 			if nameValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return flagErrorf("--name is required")
 			}
 
 			client, err := getASCClient()
@@ -299,7 +290,7 @@ func MerchantIDsUpdateCommand() *ffcli.Command {
 	merchantID := fs.String("merchant-id", "", "Merchant ID")
 	name := fs.String("name", "", "Merchant ID name")
 	clearName := fs.Bool("clear-name", false, "Clear the merchant ID name")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -315,17 +306,14 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			merchantIDValue := strings.TrimSpace(*merchantID)
 			if merchantIDValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --merchant-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--merchant-id is required")
 			}
 			nameValue := strings.TrimSpace(*name)
 			if nameValue == "" && !*clearName {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return flagErrorf("--name is required")
 			}
 			if nameValue != "" && *clearName {
-				fmt.Fprintln(os.Stderr, "Error: --name cannot be used with --clear-name")
-				return flag.ErrHelp
+				return flagErrorf("--name cannot be used with --clear-name")
 			}
 
 			client, err := getASCClient()
@@ -357,7 +345,7 @@ func MerchantIDsDeleteCommand() *ffcli.Command {
 
 	merchantID := fs.String("merchant-id", "", "Merchant ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -373,12 +361,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			merchantIDValue := strings.TrimSpace(*merchantID)
 			if merchantIDValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --merchant-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--merchant-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("merchant ID %q", merchantIDValue))
+				if err != nil {
+					return fmt.Errorf("merchant-ids delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -49,7 +49,7 @@ func MigrateImportCommand() *ffcli.Command {
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (required)")
 	dryRun := fs.Bool("dry-run", false, "Preview changes without uploading")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -82,18 +82,15 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*versionID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--version-id is required")
 			}
 			if strings.TrimSpace(*fastlaneDir) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --fastlane-dir is required")
-				return flag.ErrHelp
+				return flagErrorf("--fastlane-dir is required")
 			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			// Check if directory exists
@@ -258,7 +255,7 @@ func MigrateExportCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	outputDir := fs.String("output-dir", "", "Output directory for fastlane structure (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -275,18 +272,15 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*versionID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--version-id is required")
 			}
 			if strings.TrimSpace(*outputDir) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --output-dir is required")
-				return flag.ErrHelp
+				return flagErrorf("--output-dir is required")
 			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -502,6 +496,18 @@ func printMigrateOutput(data interface{}, format string, pretty bool) error {
 		}
 		return asc.PrintJSON(data)
 	}
+	if format == "yaml" || format == "yml" {
+		return asc.PrintYAML(data)
+	}
+	if format == "jsonl" {
+		if pretty {
+			return usageErrorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintJSON(data)
+	}
+	if format == "template" {
+		return printOutput(data, format, pretty)
+	}
 
 	switch v := data.(type) {
 	case *MigrateImportResult:
@@ -586,7 +592,7 @@ func MigrateValidateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("migrate validate", flag.ExitOnError)
 
 	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -610,8 +616,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*fastlaneDir) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --fastlane-dir is required")
-				return flag.ErrHelp
+				return flagErrorf("--fastlane-dir is required")
 			}
 
 			// Check if directory exists
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -29,6 +28,8 @@ Examples:
   asc nominations get --id "NOMINATION_ID"
   asc nominations create --app "APP_ID" --name "Launch" --type APP_LAUNCH --description "New launch" --submitted=false --publish-start-date "2026-02-01T08:00:00Z"
   asc nominations update --id "NOMINATION_ID" --notes "Updated notes"
+  asc nominations submit --id "NOMINATION_ID"
+  asc nominations archive --id "NOMINATION_ID"
   asc nominations delete --id "NOMINATION_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
@@ -37,6 +38,8 @@ Examples:
 			NominationsGetCommand(),
 			NominationsCreateCommand(),
 			NominationsUpdateCommand(),
+			NominationsSubmitCommand(),
+			NominationsArchiveCommand(),
 			NominationsDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -61,9 +64,14 @@ func NominationsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	report := fs.Bool("report", false, "Print a Markdown summary grouped by state (implies --output markdown)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	shared.RegisterFlagValues(fs, "status", nominationStateList()...)
+	shared.RegisterFlagValues(fs, "type", nominationTypeList()...)
+	shared.RegisterFlagValues(fs, "sort", nominationSortList()...)
+
 	return &ffcli.Command{
 		Name:       "list",
 		ShortUsage: "asc nominations list --status STATE [flags]",
@@ -74,12 +82,16 @@ Examples:
   asc nominations list --status DRAFT
   asc nominations list --status DRAFT --type APP_LAUNCH
   asc nominations list --app "APP_ID" --status SUBMITTED --output table
-  asc nominations list --include relatedApps --related-apps-limit 10`,
+  asc nominations list --include relatedApps --related-apps-limit 10
+  asc nominations list --app "APP_ID" --status DRAFT,SUBMITTED,ARCHIVED --paginate --report
+
+With --report, nominations are grouped by state into a Markdown summary with
+per-state counts and tables, ready to paste into release notes or docs.`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("nominations list: --limit must be between 1 and 200")
+				return usageErrorf("nominations list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("nominations list: %w", err)
@@ -88,13 +100,24 @@ Examples:
 				return fmt.Errorf("nominations list: %w", err)
 			}
 			if *inAppEventsLimit != 0 && (*inAppEventsLimit < 1 || *inAppEventsLimit > 50) {
-				return fmt.Errorf("nominations list: --in-app-events-limit must be between 1 and 50")
+				return usageErrorf("nominations list: --in-app-events-limit must be between 1 and 50")
 			}
 			if *relatedAppsLimit != 0 && (*relatedAppsLimit < 1 || *relatedAppsLimit > 50) {
-				return fmt.Errorf("nominations list: --related-apps-limit must be between 1 and 50")
+				return usageErrorf("nominations list: --related-apps-limit must be between 1 and 50")
 			}
 			if *supportedTerritoriesLimit != 0 && (*supportedTerritoriesLimit < 1 || *supportedTerritoriesLimit > 200) {
-				return fmt.Errorf("nominations list: --supported-territories-limit must be between 1 and 200")
+				return usageErrorf("nominations list: --supported-territories-limit must be between 1 and 200")
+			}
+			if *report {
+				outputSet := false
+				fs.Visit(func(f *flag.Flag) {
+					if f.Name == "output" {
+						outputSet = true
+					}
+				})
+				if outputSet && strings.ToLower(strings.TrimSpace(*output)) != "markdown" {
+					return flagErrorf("--report only supports --output markdown")
+				}
 			}
 
 			statusValues, err := normalizeNominationStates(splitCSVUpper(*status))
@@ -102,8 +125,7 @@ Examples:
 				return fmt.Errorf("nominations list: %w", err)
 			}
 			if len(statusValues) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --status is required")
-				return flag.ErrHelp
+				return flagErrorf("--status is required")
 			}
 
 			typeValues, err := normalizeNominationTypes(splitCSVUpper(*nomType))
@@ -122,16 +144,13 @@ Examples:
 			}
 
 			if *inAppEventsLimit != 0 && !shared.HasInclude(includeValues, "inAppEvents") {
-				fmt.Fprintf(os.Stderr, "Error: --in-app-events-limit requires --include inAppEvents\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--in-app-events-limit requires --include inAppEvents")
 			}
 			if *relatedAppsLimit != 0 && !shared.HasInclude(includeValues, "relatedApps") {
-				fmt.Fprintf(os.Stderr, "Error: --related-apps-limit requires --include relatedApps\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--related-apps-limit requires --include relatedApps")
 			}
 			if *supportedTerritoriesLimit != 0 && !shared.HasInclude(includeValues, "supportedTerritories") {
-				fmt.Fprintf(os.Stderr, "Error: --supported-territories-limit requires --include supportedTerritories\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--supported-territories-limit requires --include supportedTerritories")
 			}
 
 			client, err := getASCClient()
@@ -182,6 +201,13 @@ Examples:
 					return fmt.Errorf("nominations list: %w", err)
 				}
 
+				if *report {
+					all, ok := nominations.(*asc.NominationsResponse)
+					if !ok {
+						return fmt.Errorf("nominations list: unexpected paginated response %T", nominations)
+					}
+					return printOutput(&asc.NominationsReport{Nominations: all}, "markdown", *pretty)
+				}
 				return printOutput(nominations, *output, *pretty)
 			}
 
@@ -190,6 +216,9 @@ Examples:
 				return fmt.Errorf("nominations list: failed to fetch: %w", err)
 			}
 
+			if *report {
+				return printOutput(&asc.NominationsReport{Nominations: resp}, "markdown", *pretty)
+			}
 			return printOutput(resp, *output, *pretty)
 		},
 	}
@@ -205,7 +234,7 @@ func NominationsGetCommand() *ffcli.Command {
 	inAppEventsLimit := fs.Int("in-app-events-limit", 0, "Maximum included in-app events (1-50)")
 	relatedAppsLimit := fs.Int("related-apps-limit", 0, "Maximum included related apps (1-50)")
 	supportedTerritoriesLimit := fs.Int("supported-territories-limit", 0, "Maximum included supported territories (1-200)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -222,17 +251,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*nominationID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if *inAppEventsLimit != 0 && (*inAppEventsLimit < 1 || *inAppEventsLimit > 50) {
-				return fmt.Errorf("nominations get: --in-app-events-limit must be between 1 and 50")
+				return usageErrorf("nominations get: --in-app-events-limit must be between 1 and 50")
 			}
 			if *relatedAppsLimit != 0 && (*relatedAppsLimit < 1 || *relatedAppsLimit > 50) {
-				return fmt.Errorf("nominations get: --related-apps-limit must be between 1 and 50")
+				return usageErrorf("nominations get: --related-apps-limit must be between 1 and 50")
 			}
 			if *supportedTerritoriesLimit != 0 && (*supportedTerritoriesLimit < 1 || *supportedTerritoriesLimit > 200) {
-				return fmt.Errorf("nominations get: --supported-territories-limit must be between 1 and 200")
+				return usageErrorf("nominations get: --supported-territories-limit must be between 1 and 200")
 			}
 
 			fieldsValue, err := normalizeNominationFields(*fields)
@@ -246,16 +274,13 @@ Examples:
 			}
 
 			if *inAppEventsLimit != 0 && !shared.HasInclude(includeValues, "inAppEvents") {
-				fmt.Fprintf(os.Stderr, "Error: --in-app-events-limit requires --include inAppEvents\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--in-app-events-limit requires --include inAppEvents")
 			}
 			if *relatedAppsLimit != 0 && !shared.HasInclude(includeValues, "relatedApps") {
-				fmt.Fprintf(os.Stderr, "Error: --related-apps-limit requires --include relatedApps\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--related-apps-limit requires --include relatedApps")
 			}
 			if *supportedTerritoriesLimit != 0 && !shared.HasInclude(includeValues, "supportedTerritories") {
-				fmt.Fprintf(os.Stderr, "Error: --supported-territories-limit requires --include supportedTerritories\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--supported-territories-limit requires --include supportedTerritories")
 			}
 
 			client, err := getASCClient()
@@ -313,18 +338,30 @@ func NominationsCreateCommand() *ffcli.Command {
 	preOrderEnabled := fs.Bool("pre-order-enabled", false, "Enable pre-order")
 	inAppEvents := fs.String("in-app-events", "", "In-app event IDs, comma-separated")
 	supportedTerritories := fs.String("supported-territories", "", "Supported territory IDs, comma-separated")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	file := fs.String("file", "", "Path to a JSON file with nomination attributes and relationship IDs")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	shared.RegisterFlagValues(fs, "type", nominationTypeList()...)
+	shared.RegisterFlagValues(fs, "device-families", nominationDeviceFamilyList()...)
+
 	return &ffcli.Command{
 		Name:       "create",
 		ShortUsage: "asc nominations create --app APP_ID --name NAME --type TYPE --description DESC --submitted [true|false] --publish-start-date RFC3339 [flags]",
 		ShortHelp:  "Create a featuring nomination.",
 		LongHelp: `Create a featuring nomination.
 
+Use --file to read the nomination from JSON instead of flags. The file accepts
+the nomination attributes (name, type, description, submitted,
+publishStartDate, publishEndDate, deviceFamilies, locales,
+supplementalMaterialsUris, hasInAppEvents, launchInSelectMarketsFirst, notes,
+preOrderEnabled) plus relationship ID lists (relatedApps, inAppEvents,
+supportedTerritories). --app fills relatedApps when the file omits it.
+
 Examples:
   asc nominations create --app "APP_ID" --name "Launch" --type APP_LAUNCH --description "New launch" --submitted=false --publish-start-date "2026-02-01T08:00:00Z"
-  asc nominations create --app "APP_ID" --name "Update" --type APP_ENHANCEMENTS --description "Major update" --submitted=true --publish-start-date "2026-03-01T08:00:00Z" --publish-end-date "2026-04-01T08:00:00Z"`,
+  asc nominations create --app "APP_ID" --name "Update" --type APP_ENHANCEMENTS --description "Major update" --submitted=true --publish-start-date "2026-03-01T08:00:00Z" --publish-end-date "2026-04-01T08:00:00Z"
+  asc nominations create --file "./nomination.json"`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -333,56 +370,75 @@ Examples:
 				visited[f.Name] = true
 			})
 
+			if strings.TrimSpace(*file) != "" {
+				for _, name := range nominationCreateAttributeFlags {
+					if visited[name] {
+						return usageErrorf("nominations create: --file cannot be combined with --%s", name)
+					}
+				}
+
+				attrs, relationships, err := readNominationCreateFile(strings.TrimSpace(*file), resolveAppID(*appID))
+				if err != nil {
+					return fmt.Errorf("nominations create: %w", err)
+				}
+
+				client, err := getASCClient()
+				if err != nil {
+					return fmt.Errorf("nominations create: %w", err)
+				}
+
+				requestCtx, cancel := contextWithTimeout(ctx)
+				defer cancel()
+
+				resp, err := client.CreateNomination(requestCtx, attrs, relationships)
+				if err != nil {
+					return fmt.Errorf("nominations create: failed to create: %w", err)
+				}
+
+				return printOutput(resp, *output, *pretty)
+			}
+
 			relatedApps := splitCSV(resolveAppID(*appID))
 			if len(relatedApps) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			trimmedName := strings.TrimSpace(*name)
 			if trimmedName == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return flagErrorf("--name is required")
 			}
 
 			trimmedDescription := strings.TrimSpace(*description)
 			if trimmedDescription == "" {
-				fmt.Fprintln(os.Stderr, "Error: --description is required")
-				return flag.ErrHelp
+				return flagErrorf("--description is required")
 			}
 
 			if !visited["submitted"] {
-				fmt.Fprintln(os.Stderr, "Error: --submitted is required")
-				return flag.ErrHelp
+				return flagErrorf("--submitted is required")
 			}
 
 			if strings.TrimSpace(*nomType) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --type is required")
-				return flag.ErrHelp
+				return flagErrorf("--type is required")
 			}
 
 			normalizedType, err := normalizeNominationType(*nomType)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err)
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			normalizedPublishStart, err := normalizeNominationPublishDate("--publish-start-date", *publishStartDate, true)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err)
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			deviceFamilyValues, err := normalizeNominationDeviceFamilies(splitCSVUpper(*deviceFamilies))
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err)
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			normalizedPublishEnd, err := normalizeNominationPublishDate("--publish-end-date", *publishEndDate, false)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err)
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			client, err := getASCClient()
@@ -471,9 +527,12 @@ func NominationsUpdateCommand() *ffcli.Command {
 	appIDs := fs.String("app", "", "Replace related app ID(s), comma-separated")
 	inAppEvents := fs.String("in-app-events", "", "Replace in-app event IDs, comma-separated")
 	supportedTerritories := fs.String("supported-territories", "", "Replace supported territory IDs, comma-separated")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	shared.RegisterFlagValues(fs, "type", nominationTypeList()...)
+	shared.RegisterFlagValues(fs, "device-families", nominationDeviceFamilyList()...)
+
 	return &ffcli.Command{
 		Name:       "update",
 		ShortUsage: "asc nominations update --id NOMINATION_ID --submitted [true|false] [flags]",
@@ -491,8 +550,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*nominationID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			visited := map[string]bool{}
@@ -517,12 +575,10 @@ Examples:
 			hasRelationshipUpdates := visited["app"] || visited["in-app-events"] || visited["supported-territories"]
 
 			if !hasAttributeUpdates && !hasRelationshipUpdates {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 			if !visited["submitted"] && !visited["archived"] {
-				fmt.Fprintln(os.Stderr, "Error: --submitted or --archived is required")
-				return flag.ErrHelp
+				return flagErrorf("--submitted or --archived is required")
 			}
 
 			var attrs *asc.NominationUpdateAttributes
@@ -534,13 +590,11 @@ Examples:
 				}
 				if visited["type"] {
 					if strings.TrimSpace(*nomType) == "" {
-						fmt.Fprintln(os.Stderr, "Error: --type is required")
-						return flag.ErrHelp
+						return flagErrorf("--type is required")
 					}
 					normalized, err := normalizeNominationType(*nomType)
 					if err != nil {
-						fmt.Fprintln(os.Stderr, "Error:", err)
-						return flag.ErrHelp
+						return flagErrorf("%v", err)
 					}
 					nomTypeValue := asc.NominationType(normalized)
 					attrsValue.Type = &nomTypeValue
@@ -560,41 +614,38 @@ Examples:
 				if visited["publish-start-date"] {
 					normalized, err := normalizeNominationPublishDate("--publish-start-date", *publishStartDate, true)
 					if err != nil {
-						fmt.Fprintln(os.Stderr, "Error:", err)
-						return flag.ErrHelp
+						return flagErrorf("%v", err)
 					}
 					attrsValue.PublishStartDate = &normalized
 				}
 				if visited["publish-end-date"] {
 					normalized, err := normalizeNominationPublishDate("--publish-end-date", *publishEndDate, true)
 					if err != nil {
-						fmt.Fprintln(os.Stderr, "Error:", err)
-						return flag.ErrHelp
+						return flagErrorf("%v", err)
 					}
 					attrsValue.PublishEndDate = &normalized
 				}
 				if visited["device-families"] {
 					deviceFamilyValues, err := normalizeNominationDeviceFamilies(splitCSVUpper(*deviceFamilies))
 					if err != nil {
-						fmt.Fprintln(os.Stderr, "Error:", err)
-						return flag.ErrHelp
+						return flagErrorf("%v", err)
 					}
 					if len(deviceFamilyValues) == 0 {
-						return fmt.Errorf("nominations update: --device-families is required")
+						return usageErrorf("nominations update: --device-families is required")
 					}
 					attrsValue.DeviceFamilies = normalizeNominationDeviceFamilyAttributes(deviceFamilyValues)
 				}
 				if visited["locales"] {
 					localesValue := splitCSV(*locales)
 					if len(localesValue) == 0 {
-						return fmt.Errorf("nominations update: --locales is required")
+						return usageErrorf("nominations update: --locales is required")
 					}
 					attrsValue.Locales = localesValue
 				}
 				if visited["supplemental-materials-uris"] {
 					supplementalValue := splitCSV(*supplementalMaterialsURIs)
 					if len(supplementalValue) == 0 {
-						return fmt.Errorf("nominations update: --supplemental-materials-uris is required")
+						return usageErrorf("nominations update: --supplemental-materials-uris is required")
 					}
 					attrsValue.SupplementalMaterialsURIs = supplementalValue
 				}
@@ -623,21 +674,21 @@ Examples:
 				if visited["app"] {
 					appValues := splitCSV(*appIDs)
 					if len(appValues) == 0 {
-						return fmt.Errorf("nominations update: --app is required")
+						return usageErrorf("nominations update: --app is required")
 					}
 					relationshipValue.RelatedApps = buildNominationRelationshipList(asc.ResourceTypeApps, appValues)
 				}
 				if visited["in-app-events"] {
 					eventValues := splitCSV(*inAppEvents)
 					if len(eventValues) == 0 {
-						return fmt.Errorf("nominations update: --in-app-events is required")
+						return usageErrorf("nominations update: --in-app-events is required")
 					}
 					relationshipValue.InAppEvents = buildNominationRelationshipList(asc.ResourceTypeAppEvents, eventValues)
 				}
 				if visited["supported-territories"] {
 					territoryValues := splitCSV(*supportedTerritories)
 					if len(territoryValues) == 0 {
-						return fmt.Errorf("nominations update: --supported-territories is required")
+						return usageErrorf("nominations update: --supported-territories is required")
 					}
 					relationshipValue.SupportedTerritories = buildNominationRelationshipList(asc.ResourceTypeTerritories, territoryValues)
 				}
@@ -662,13 +713,81 @@ Examples:
 	}
 }
 
+// NominationsSubmitCommand returns the nominations submit subcommand.
+func NominationsSubmitCommand() *ffcli.Command {
+	submitted := true
+	return nominationStateCommand(
+		"submit",
+		"Submit a featuring nomination to Apple.",
+		asc.NominationUpdateAttributes{Submitted: &submitted},
+	)
+}
+
+// NominationsArchiveCommand returns the nominations archive subcommand.
+func NominationsArchiveCommand() *ffcli.Command {
+	archived := true
+	return nominationStateCommand(
+		"archive",
+		"Archive a featuring nomination.",
+		asc.NominationUpdateAttributes{Archived: &archived},
+	)
+}
+
+// nominationStateCommand builds a subcommand that sends only attrs to
+// UpdateNomination. It is shorthand for the matching nominations update
+// --submitted/--archived call.
+func nominationStateCommand(name, shortHelp string, attrs asc.NominationUpdateAttributes) *ffcli.Command {
+	fs := flag.NewFlagSet("nominations "+name, flag.ExitOnError)
+
+	nominationID := fs.String("id", "", "Nomination ID (required)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       name,
+		ShortUsage: fmt.Sprintf("asc nominations %s --id NOMINATION_ID", name),
+		ShortHelp:  shortHelp,
+		LongHelp: fmt.Sprintf(`%s
+
+Only the nomination's state changes; use nominations update to change
+anything else.
+
+Examples:
+  asc nominations %s --id "NOMINATION_ID"`, shortHelp, name),
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*nominationID)
+			if trimmedID == "" {
+				return flagErrorf("--id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("nominations %s: %w", name, err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			attrsValue := attrs
+			resp, err := client.UpdateNomination(requestCtx, trimmedID, &attrsValue, nil)
+			if err != nil {
+				return fmt.Errorf("nominations %s: failed to update: %w", name, err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
 // NominationsDeleteCommand returns the nominations delete subcommand.
 func NominationsDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("nominations delete", flag.ExitOnError)
 
 	nominationID := fs.String("id", "", "Nomination ID (required)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -684,12 +803,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*nominationID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required to delete")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("featuring nomination %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("nominations delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required to delete")
+				}
 			}
 
 			client, err := getASCClient()
@@ -717,10 +840,10 @@ Examples:
 func normalizeNominationType(value string) (string, error) {
 	trimmed := strings.ToUpper(strings.TrimSpace(value))
 	if trimmed == "" {
-		return "", fmt.Errorf("--type is required")
+		return "", usageErrorf("--type is required")
 	}
 	if _, ok := nominationTypes[trimmed]; !ok {
-		return "", fmt.Errorf("--type must be one of: %s", strings.Join(nominationTypeList(), ", "))
+		return "", usageErrorf("--type must be one of: %s", strings.Join(nominationTypeList(), ", "))
 	}
 	return trimmed, nil
 }
@@ -731,7 +854,7 @@ func normalizeNominationTypes(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := nominationTypes[value]; !ok {
-			return nil, fmt.Errorf("--type must be one of: %s", strings.Join(nominationTypeList(), ", "))
+			return nil, usageErrorf("--type must be one of: %s", strings.Join(nominationTypeList(), ", "))
 		}
 	}
 	return values, nil
@@ -743,7 +866,7 @@ func normalizeNominationStates(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := nominationStates[value]; !ok {
-			return nil, fmt.Errorf("--status must be one of: %s", strings.Join(nominationStateList(), ", "))
+			return nil, usageErrorf("--status must be one of: %s", strings.Join(nominationStateList(), ", "))
 		}
 	}
 	return values, nil
@@ -761,7 +884,7 @@ func normalizeNominationFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(nominationFieldsList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(nominationFieldsList(), ", "))
 		}
 	}
 
@@ -780,7 +903,7 @@ func normalizeNominationInclude(value string) ([]string, error) {
 	}
 	for _, include := range values {
 		if _, ok := allowed[include]; !ok {
-			return nil, fmt.Errorf("--include must be one of: %s", strings.Join(nominationIncludeList(), ", "))
+			return nil, usageErrorf("--include must be one of: %s", strings.Join(nominationIncludeList(), ", "))
 		}
 	}
 
@@ -793,7 +916,7 @@ func normalizeNominationDeviceFamilies(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := nominationDeviceFamilies[value]; !ok {
-			return nil, fmt.Errorf("--device-families must be one of: %s", strings.Join(nominationDeviceFamilyList(), ", "))
+			return nil, usageErrorf("--device-families must be one of: %s", strings.Join(nominationDeviceFamilyList(), ", "))
 		}
 	}
 	return values, nil
@@ -807,13 +930,11 @@ func normalizeNominationPublishDate(flagName, value string, required bool) (stri
 		}
 		return "", nil
 	}
-	if parsed, err := time.Parse(time.RFC3339, trimmed); err == nil {
-		return parsed.Format(time.RFC3339), nil
-	}
-	if parsed, err := time.Parse(time.RFC3339Nano, trimmed); err == nil {
-		return parsed.Format(time.RFC3339Nano), nil
+	parsed, err := shared.ParseRFC3339Flag(trimmed, flagName)
+	if err != nil {
+		return "", err
 	}
-	return "", fmt.Errorf("%s must be in RFC3339 format (e.g., 2026-02-01T08:00:00Z)", flagName)
+	return parsed.Format(time.RFC3339), nil
 }
 
 func normalizeNominationDeviceFamilyAttributes(values []string) []asc.DeviceFamily {
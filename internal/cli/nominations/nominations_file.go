@@ -0,0 +1,158 @@
+package nominations
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// nominationCreateAttributeFlags lists create flags that --file replaces.
+var nominationCreateAttributeFlags = []string{
+	"name",
+	"type",
+	"description",
+	"submitted",
+	"publish-start-date",
+	"publish-end-date",
+	"device-families",
+	"locales",
+	"supplemental-materials-uris",
+	"has-in-app-events",
+	"launch-in-select-markets-first",
+	"notes",
+	"pre-order-enabled",
+	"in-app-events",
+	"supported-territories",
+}
+
+// nominationCreateFile is the JSON shape accepted by nominations create --file.
+type nominationCreateFile struct {
+	Name                       string   `json:"name"`
+	Type                       string   `json:"type"`
+	Description                string   `json:"description"`
+	Submitted                  *bool    `json:"submitted"`
+	PublishStartDate           string   `json:"publishStartDate"`
+	PublishEndDate             string   `json:"publishEndDate"`
+	DeviceFamilies             []string `json:"deviceFamilies"`
+	Locales                    []string `json:"locales"`
+	SupplementalMaterialsURIs  []string `json:"supplementalMaterialsUris"`
+	HasInAppEvents             *bool    `json:"hasInAppEvents"`
+	LaunchInSelectMarketsFirst *bool    `json:"launchInSelectMarketsFirst"`
+	Notes                      *string  `json:"notes"`
+	PreOrderEnabled            *bool    `json:"preOrderEnabled"`
+	RelatedApps                []string `json:"relatedApps"`
+	InAppEvents                []string `json:"inAppEvents"`
+	SupportedTerritories       []string `json:"supportedTerritories"`
+}
+
+func readNominationCreateFile(path, fallbackAppID string) (asc.NominationCreateAttributes, asc.NominationRelationships, error) {
+	payload, err := shared.ReadJSONFilePayloadFor(path, &nominationCreateFile{})
+	if err != nil {
+		return asc.NominationCreateAttributes{}, asc.NominationRelationships{}, fmt.Errorf("--file: %w", err)
+	}
+
+	var file nominationCreateFile
+	if err := json.Unmarshal(payload, &file); err != nil {
+		return asc.NominationCreateAttributes{}, asc.NominationRelationships{}, fmt.Errorf("--file: invalid nomination: %w", err)
+	}
+
+	return buildNominationCreateFromFile(file, fallbackAppID)
+}
+
+func buildNominationCreateFromFile(file nominationCreateFile, fallbackAppID string) (asc.NominationCreateAttributes, asc.NominationRelationships, error) {
+	var attrs asc.NominationCreateAttributes
+	var relationships asc.NominationRelationships
+
+	relatedApps := trimNominationValues(file.RelatedApps)
+	if len(relatedApps) == 0 {
+		relatedApps = splitCSV(fallbackAppID)
+	}
+	if len(relatedApps) == 0 {
+		return attrs, relationships, usageErrorf("--file: relatedApps is required (or pass --app / set ASC_APP_ID)")
+	}
+
+	name := strings.TrimSpace(file.Name)
+	if name == "" {
+		return attrs, relationships, usageErrorf("--file: name is required")
+	}
+	description := strings.TrimSpace(file.Description)
+	if description == "" {
+		return attrs, relationships, usageErrorf("--file: description is required")
+	}
+	if file.Submitted == nil {
+		return attrs, relationships, usageErrorf("--file: submitted is required")
+	}
+
+	normalizedType, err := normalizeNominationType(file.Type)
+	if err != nil {
+		return attrs, relationships, fmt.Errorf("--file: %w", err)
+	}
+	publishStart, err := normalizeNominationPublishDate("publishStartDate", file.PublishStartDate, true)
+	if err != nil {
+		return attrs, relationships, fmt.Errorf("--file: %w", err)
+	}
+	publishEnd, err := normalizeNominationPublishDate("publishEndDate", file.PublishEndDate, false)
+	if err != nil {
+		return attrs, relationships, fmt.Errorf("--file: %w", err)
+	}
+	deviceFamilies := trimNominationValues(file.DeviceFamilies)
+	for i, value := range deviceFamilies {
+		deviceFamilies[i] = strings.ToUpper(value)
+	}
+	deviceFamilies, err = normalizeNominationDeviceFamilies(deviceFamilies)
+	if err != nil {
+		return attrs, relationships, fmt.Errorf("--file: %w", err)
+	}
+
+	attrs = asc.NominationCreateAttributes{
+		Name:                       name,
+		Type:                       asc.NominationType(normalizedType),
+		Description:                description,
+		Submitted:                  *file.Submitted,
+		PublishStartDate:           publishStart,
+		Locales:                    trimNominationValues(file.Locales),
+		SupplementalMaterialsURIs:  trimNominationValues(file.SupplementalMaterialsURIs),
+		HasInAppEvents:             file.HasInAppEvents,
+		LaunchInSelectMarketsFirst: file.LaunchInSelectMarketsFirst,
+		PreOrderEnabled:            file.PreOrderEnabled,
+	}
+	if publishEnd != "" {
+		attrs.PublishEndDate = &publishEnd
+	}
+	if len(deviceFamilies) > 0 {
+		attrs.DeviceFamilies = normalizeNominationDeviceFamilyAttributes(deviceFamilies)
+	}
+	if file.Notes != nil {
+		value := strings.TrimSpace(*file.Notes)
+		attrs.Notes = &value
+	}
+
+	relationships = asc.NominationRelationships{
+		RelatedApps:          buildNominationRelationshipList(asc.ResourceTypeApps, relatedApps),
+		InAppEvents:          buildNominationRelationshipList(asc.ResourceTypeAppEvents, trimNominationValues(file.InAppEvents)),
+		SupportedTerritories: buildNominationRelationshipList(asc.ResourceTypeTerritories, trimNominationValues(file.SupportedTerritories)),
+	}
+
+	return attrs, relationships, nil
+}
+
+func trimNominationValues(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	cleaned := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		cleaned = append(cleaned, value)
+	}
+	if len(cleaned) == 0 {
+		return nil
+	}
+	return cleaned
+}
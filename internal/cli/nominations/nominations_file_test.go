@@ -0,0 +1,128 @@
+package nominations
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func writeNominationFile(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "nomination.json")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	return path
+}
+
+func TestReadNominationCreateFile(t *testing.T) {
+	path := writeNominationFile(t, `{
+		"name": "Launch",
+		"type": "app_launch",
+		"description": "New launch",
+		"submitted": false,
+		"publishStartDate": "2026-02-01T08:00:00Z",
+		"deviceFamilies": ["iphone", "IPAD"],
+		"notes": " internal ",
+		"relatedApps": ["APP_1", "APP_2"],
+		"supportedTerritories": ["USA"]
+	}`)
+
+	attrs, relationships, err := readNominationCreateFile(path, "")
+	if err != nil {
+		t.Fatalf("readNominationCreateFile() error: %v", err)
+	}
+	if attrs.Name != "Launch" || string(attrs.Type) != "APP_LAUNCH" {
+		t.Fatalf("unexpected attributes: %+v", attrs)
+	}
+	if attrs.PublishEndDate != nil {
+		t.Fatalf("expected no publish end date, got %v", *attrs.PublishEndDate)
+	}
+	if len(attrs.DeviceFamilies) != 2 || attrs.DeviceFamilies[0] != "IPHONE" {
+		t.Fatalf("unexpected device families: %v", attrs.DeviceFamilies)
+	}
+	if attrs.Notes == nil || *attrs.Notes != "internal" {
+		t.Fatalf("expected trimmed notes, got %v", attrs.Notes)
+	}
+	if relationships.RelatedApps == nil || len(relationships.RelatedApps.Data) != 2 {
+		t.Fatalf("expected 2 related apps, got %+v", relationships.RelatedApps)
+	}
+	if relationships.InAppEvents != nil {
+		t.Fatalf("expected no in-app events, got %+v", relationships.InAppEvents)
+	}
+	if relationships.SupportedTerritories == nil || relationships.SupportedTerritories.Data[0].ID != "USA" {
+		t.Fatalf("unexpected territories: %+v", relationships.SupportedTerritories)
+	}
+}
+
+func TestReadNominationCreateFileFallsBackToAppID(t *testing.T) {
+	path := writeNominationFile(t, `{"name":"Launch","type":"APP_LAUNCH","description":"d","submitted":true,"publishStartDate":"2026-02-01T08:00:00Z"}`)
+
+	_, relationships, err := readNominationCreateFile(path, "APP_ID")
+	if err != nil {
+		t.Fatalf("readNominationCreateFile() error: %v", err)
+	}
+	if relationships.RelatedApps == nil || relationships.RelatedApps.Data[0].ID != "APP_ID" {
+		t.Fatalf("expected fallback app, got %+v", relationships.RelatedApps)
+	}
+}
+
+func TestReadNominationCreateFileValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{
+			name:    "missing related apps",
+			body:    `{"name":"Launch","type":"APP_LAUNCH","description":"d","submitted":true,"publishStartDate":"2026-02-01T08:00:00Z"}`,
+			wantErr: "relatedApps is required",
+		},
+		{
+			name:    "missing submitted",
+			body:    `{"name":"Launch","type":"APP_LAUNCH","description":"d","publishStartDate":"2026-02-01T08:00:00Z","relatedApps":["A"]}`,
+			wantErr: "submitted is required",
+		},
+		{
+			name:    "invalid type",
+			body:    `{"name":"Launch","type":"OTHER","description":"d","submitted":true,"publishStartDate":"2026-02-01T08:00:00Z","relatedApps":["A"]}`,
+			wantErr: "--type must be one of",
+		},
+		{
+			name:    "invalid date",
+			body:    `{"name":"Launch","type":"APP_LAUNCH","description":"d","submitted":true,"publishStartDate":"2026-02-01","relatedApps":["A"]}`,
+			wantErr: "publishStartDate must be in RFC3339 format",
+		},
+		{
+			name:    "invalid device family",
+			body:    `{"name":"Launch","type":"APP_LAUNCH","description":"d","submitted":true,"publishStartDate":"2026-02-01T08:00:00Z","deviceFamilies":["TOASTER"],"relatedApps":["A"]}`,
+			wantErr: "--device-families must be one of",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := readNominationCreateFile(writeNominationFile(t, test.body), "")
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestReadNominationCreateFileUnknownFields(t *testing.T) {
+	path := writeNominationFile(t, `{"name":"Launch","title":"Launch","type":"APP_LAUNCH","description":"d","submitted":true,"publishStartDate":"2026-02-01T08:00:00Z","relatedApps":["A"]}`)
+
+	if _, _, err := readNominationCreateFile(path, ""); err != nil {
+		t.Fatalf("expected unknown field to be ignored by default, got %v", err)
+	}
+
+	shared.SetStrictValidation(true)
+	t.Cleanup(func() { shared.SetStrictValidation(false) })
+	if _, _, err := readNominationCreateFile(path, ""); err == nil || !strings.Contains(err.Error(), `unknown field "title"`) {
+		t.Fatalf("expected unknown field error with --strict-validation, got %v", err)
+	}
+}
@@ -49,7 +49,7 @@ func OfferCodesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	offerCodeID := fs.String("offer-code", "", "Subscription offer code ID (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -69,7 +69,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > offerCodesMaxLimit) {
-				return fmt.Errorf("offer-codes list: --limit must be between 1 and %d", offerCodesMaxLimit)
+				return usageErrorf("offer-codes list: --limit must be between 1 and %d", offerCodesMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("offer-codes list: %w", err)
@@ -77,8 +77,7 @@ Examples:
 
 			trimmedOfferCodeID := strings.TrimSpace(*offerCodeID)
 			if trimmedOfferCodeID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --offer-code is required\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--offer-code is required")
 			}
 
 			client, err := getASCClient()
@@ -101,14 +100,13 @@ Examples:
 					return fmt.Errorf("offer-codes list: failed to fetch: %w", err)
 				}
 
-				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionOfferCodeOneTimeUseCodes(ctx, trimmedOfferCodeID, asc.WithSubscriptionOfferCodeOneTimeUseCodesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("offer-codes list: %w", err)
 				}
-
-				return printOutput(pages, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetSubscriptionOfferCodeOneTimeUseCodes(requestCtx, trimmedOfferCodeID, opts...)
@@ -146,17 +144,14 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedOfferCodeID := strings.TrimSpace(*offerCodeID)
 			if trimmedOfferCodeID == "" {
-				fmt.Fprintf(os.Stderr, "Error: --offer-code is required\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--offer-code is required")
 			}
 			if *quantity <= 0 {
-				fmt.Fprintln(os.Stderr, "Error: --quantity is required")
-				return flag.ErrHelp
+				return flagErrorf("--quantity is required")
 			}
 			normalizedExpirationDate, err := normalizeOfferCodeExpirationDate(*expirationDate)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err)
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			client, err := getASCClient()
@@ -239,8 +234,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*id)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -47,7 +46,7 @@ func PerformanceDiagnosticsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Limit number of signatures (max 200)")
 	next := fs.String("next", "", "Next page URL")
 	paginate := fs.Bool("paginate", false, "Fetch all pages")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -64,11 +63,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedBuildID := strings.TrimSpace(*buildID)
 			if trimmedBuildID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --build is required")
-				return flag.ErrHelp
+				return flagErrorf("--build is required")
 			}
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("performance diagnostics list: --limit must be between 1 and 200")
+				return usageErrorf("performance diagnostics list: --limit must be between 1 and 200")
 			}
 
 			diagnosticTypes, err := normalizeDiagnosticSignatureTypes(splitCSVUpper(*diagnosticType))
@@ -102,14 +100,13 @@ Examples:
 					return fmt.Errorf("performance diagnostics list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetDiagnosticSignaturesForBuild(ctx, trimmedBuildID, asc.WithDiagnosticSignaturesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("performance diagnostics list: %w", err)
 				}
-
-				return printOutput(paginated, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetDiagnosticSignaturesForBuild(requestCtx, trimmedBuildID, opts...)
@@ -128,7 +125,7 @@ func PerformanceDiagnosticsGetCommand() *ffcli.Command {
 
 	signatureID := fs.String("id", "", "Diagnostic signature ID")
 	limit := fs.Int("limit", 0, "Limit number of logs (max 200)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -145,11 +142,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*signatureID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("performance diagnostics get: --limit must be between 1 and 200")
+				return usageErrorf("performance diagnostics get: --limit must be between 1 and 200")
 			}
 
 			client, err := getASCClient()
@@ -190,7 +186,7 @@ func normalizeDiagnosticSignatureTypes(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := diagnosticSignatureTypes[value]; !ok {
-			return nil, fmt.Errorf("--diagnostic-type must be one of: %s", strings.Join(diagnosticSignatureTypeList(), ", "))
+			return nil, usageErrorf("--diagnostic-type must be one of: %s", strings.Join(diagnosticSignatureTypeList(), ", "))
 		}
 	}
 	return values, nil
@@ -218,7 +214,7 @@ func normalizeDiagnosticSignatureFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(diagnosticSignatureFieldList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(diagnosticSignatureFieldList(), ", "))
 		}
 	}
 
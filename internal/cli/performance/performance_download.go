@@ -7,7 +7,6 @@ import (
 	"flag"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -62,22 +61,21 @@ Examples:
 			if selectionCount == 0 {
 				appFlag = resolveAppID(*appID)
 				if appFlag == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app, --build, or --diagnostic-id is required")
-					return flag.ErrHelp
+					return flagErrorf("--app, --build, or --diagnostic-id is required")
 				}
 				selectionCount = 1
 			}
 			if selectionCount > 1 {
-				return fmt.Errorf("performance download: --app, --build, and --diagnostic-id are mutually exclusive")
+				return usageErrorf("performance download: --app, --build, and --diagnostic-id are mutually exclusive")
 			}
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("performance download: --limit must be between 1 and 200")
+				return usageErrorf("performance download: --limit must be between 1 and 200")
 			}
 			if trimmedDiagnosticID != "" && (strings.TrimSpace(*platform) != "" || strings.TrimSpace(*metricType) != "" || strings.TrimSpace(*deviceType) != "") {
 				return fmt.Errorf("performance download: metric filters are not valid with --diagnostic-id")
 			}
 			if trimmedDiagnosticID == "" && *limit > 0 {
-				return fmt.Errorf("performance download: --limit is only valid with --diagnostic-id")
+				return usageErrorf("performance download: --limit is only valid with --diagnostic-id")
 			}
 
 			platforms, err := normalizePerfPowerMetricPlatforms(splitCSVUpper(*platform), "--platform")
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -50,7 +49,7 @@ func PreOrdersGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders get", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -66,8 +65,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -96,7 +94,7 @@ func PreOrdersListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders list", flag.ExitOnError)
 
 	availabilityID := fs.String("availability", "", "App availability ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -112,8 +110,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedAvailabilityID := strings.TrimSpace(*availabilityID)
 			if trimmedAvailabilityID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --availability is required")
-				return flag.ErrHelp
+				return flagErrorf("--availability is required")
 			}
 
 			client, err := getASCClient()
@@ -143,7 +140,7 @@ func PreOrdersEnableCommand() *ffcli.Command {
 	releaseDate := fs.String("release-date", "", "Release date (YYYY-MM-DD)")
 	var availableInNewTerritories shared.OptionalBool
 	fs.Var(&availableInNewTerritories, "available-in-new-territories", "Set available-in-new-territories: true or false")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -159,20 +156,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if strings.TrimSpace(*territory) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --territory is required")
-				return flag.ErrHelp
+				return flagErrorf("--territory is required")
 			}
 			if strings.TrimSpace(*releaseDate) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --release-date is required")
-				return flag.ErrHelp
+				return flagErrorf("--release-date is required")
 			}
 			if !availableInNewTerritories.IsSet() {
-				fmt.Fprintln(os.Stderr, "Error: --available-in-new-territories is required")
-				return flag.ErrHelp
+				return flagErrorf("--available-in-new-territories is required")
 			}
 
 			normalizedReleaseDate, err := normalizePreOrderReleaseDate(*releaseDate)
@@ -182,8 +175,7 @@ Examples:
 
 			territories := splitCSVUpper(*territory)
 			if len(territories) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --territory must include at least one value")
-				return flag.ErrHelp
+				return flagErrorf("--territory must include at least one value")
 			}
 
 			client, err := getASCClient()
@@ -277,7 +269,7 @@ func PreOrdersUpdateCommand() *ffcli.Command {
 
 	territoryAvailabilityID := fs.String("territory-availability", "", "Territory availability ID")
 	releaseDate := fs.String("release-date", "", "Release date (YYYY-MM-DD)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -293,12 +285,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*territoryAvailabilityID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --territory-availability is required")
-				return flag.ErrHelp
+				return flagErrorf("--territory-availability is required")
 			}
 			if strings.TrimSpace(*releaseDate) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --release-date is required")
-				return flag.ErrHelp
+				return flagErrorf("--release-date is required")
 			}
 
 			normalizedReleaseDate, err := normalizePreOrderReleaseDate(*releaseDate)
@@ -331,7 +321,7 @@ func PreOrdersDisableCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders disable", flag.ExitOnError)
 
 	territoryAvailabilityID := fs.String("territory-availability", "", "Territory availability ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -347,8 +337,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*territoryAvailabilityID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --territory-availability is required")
-				return flag.ErrHelp
+				return flagErrorf("--territory-availability is required")
 			}
 
 			preOrderEnabled := false
@@ -377,7 +366,7 @@ func PreOrdersEndCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders end", flag.ExitOnError)
 
 	territoryAvailabilityIDs := fs.String("territory-availability", "", "Territory availability IDs (comma-separated)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -393,8 +382,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			ids := splitCSV(*territoryAvailabilityIDs)
 			if len(ids) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --territory-availability is required")
-				return flag.ErrHelp
+				return flagErrorf("--territory-availability is required")
 			}
 
 			client, err := getASCClient()
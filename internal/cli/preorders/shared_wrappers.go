@@ -44,3 +44,7 @@ func isAppAvailabilityMissing(err error) bool {
 func normalizeDate(value, flagName string) (string, error) {
 	return shared.NormalizeDate(value, flagName)
 }
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -74,7 +73,7 @@ func PricingTerritoriesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Next page URL from a previous response")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -90,7 +89,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("pricing territories list: --limit must be between 1 and 200")
+				return usageErrorf("pricing territories list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("pricing territories list: %w", err)
@@ -116,14 +115,13 @@ Examples:
 					return fmt.Errorf("pricing territories list: failed to fetch: %w", err)
 				}
 
-				territories, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetTerritories(ctx, asc.WithTerritoriesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("pricing territories list: %w", err)
 				}
-
-				return printOutput(territories, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetTerritories(requestCtx, opts...)
@@ -145,7 +143,7 @@ func PricingPricePointsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Next page URL from a previous response")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -168,7 +166,7 @@ Examples:
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("pricing price-points: --limit must be between 1 and 200")
+				return usageErrorf("pricing price-points: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("pricing price-points: %w", err)
@@ -176,8 +174,7 @@ Examples:
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -201,14 +198,13 @@ Examples:
 					return fmt.Errorf("pricing price-points: failed to fetch: %w", err)
 				}
 
-				points, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppPricePoints(ctx, resolvedAppID, asc.WithPricePointsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("pricing price-points: %w", err)
 				}
-
-				return printOutput(points, *output, *pretty)
+				return nil
 			}
 
 			points, err := client.GetAppPricePoints(requestCtx, resolvedAppID, opts...)
@@ -226,7 +222,7 @@ func PricingPricePointsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing price-points get", flag.ExitOnError)
 
 	pricePointID := fs.String("price-point", "", "App price point ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -242,8 +238,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedPricePointID := strings.TrimSpace(*pricePointID)
 			if trimmedPricePointID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --price-point is required")
-				return flag.ErrHelp
+				return flagErrorf("--price-point is required")
 			}
 
 			client, err := getASCClient()
@@ -269,7 +264,7 @@ func PricingPricePointsEqualizationsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing price-points equalizations", flag.ExitOnError)
 
 	pricePointID := fs.String("price-point", "", "App price point ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -285,8 +280,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedPricePointID := strings.TrimSpace(*pricePointID)
 			if trimmedPricePointID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --price-point is required")
-				return flag.ErrHelp
+				return flagErrorf("--price-point is required")
 			}
 
 			client, err := getASCClient()
@@ -338,7 +332,7 @@ func PricingScheduleGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing schedule get", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -354,8 +348,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -398,7 +391,7 @@ func PricingScheduleManualPricesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing schedule manual-prices", flag.ExitOnError)
 
 	scheduleID := fs.String("schedule", "", "App price schedule ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -414,8 +407,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedScheduleID := strings.TrimSpace(*scheduleID)
 			if trimmedScheduleID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --schedule is required")
-				return flag.ErrHelp
+				return flagErrorf("--schedule is required")
 			}
 
 			client, err := getASCClient()
@@ -441,7 +433,7 @@ func PricingScheduleAutomaticPricesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing schedule automatic-prices", flag.ExitOnError)
 
 	scheduleID := fs.String("schedule", "", "App price schedule ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -457,8 +449,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedScheduleID := strings.TrimSpace(*scheduleID)
 			if trimmedScheduleID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --schedule is required")
-				return flag.ErrHelp
+				return flagErrorf("--schedule is required")
 			}
 
 			client, err := getASCClient()
@@ -508,7 +499,7 @@ func PricingAvailabilityGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing availability get", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -524,8 +515,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -554,7 +544,8 @@ func PricingAvailabilityTerritoryAvailabilitiesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing availability territory-availabilities", flag.ExitOnError)
 
 	availabilityID := fs.String("availability", "", "App availability ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	resolveNames := fs.Bool("resolve-names", false, "Add each territory's ID and country name")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -563,15 +554,19 @@ func PricingAvailabilityTerritoryAvailabilitiesCommand() *ffcli.Command {
 		ShortHelp:  "List territory availabilities for an app availability.",
 		LongHelp: `List territory availabilities for an app availability.
 
+With --resolve-names, each entry includes its territory ID (e.g. USA) and
+country name (e.g. United States). Names come from a table bundled with the
+CLI, so no extra requests are made.
+
 Examples:
-  asc pricing availability territory-availabilities --availability "AVAILABILITY_ID"`,
+  asc pricing availability territory-availabilities --availability "AVAILABILITY_ID"
+  asc pricing availability territory-availabilities --availability "AVAILABILITY_ID" --resolve-names --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedAvailabilityID := strings.TrimSpace(*availabilityID)
 			if trimmedAvailabilityID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --availability is required")
-				return flag.ErrHelp
+				return flagErrorf("--availability is required")
 			}
 
 			client, err := getASCClient()
@@ -587,6 +582,14 @@ Examples:
 				return fmt.Errorf("pricing availability territory-availabilities: %w", err)
 			}
 
+			if *resolveNames {
+				result, err := asc.NewTerritoryAvailabilitiesNamedResult(resp)
+				if err != nil {
+					return fmt.Errorf("pricing availability territory-availabilities: %w", err)
+				}
+				return printOutput(result, *output, *pretty)
+			}
+
 			return printOutput(resp, *output, *pretty)
 		},
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -55,7 +54,7 @@ func ExperimentsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	v2 := fs.Bool("v2", false, "Use v2 experiments endpoint")
 
@@ -74,7 +73,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > productPagesMaxLimit) {
-				return fmt.Errorf("experiments list: --limit must be between 1 and %d", productPagesMaxLimit)
+				return usageErrorf("experiments list: --limit must be between 1 and %d", productPagesMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("experiments list: %w", err)
@@ -82,15 +81,13 @@ Examples:
 
 			stateValues, err := normalizeExperimentStates(splitCSVUpper(*state))
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err)
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			if *v2 {
 				resolvedAppID := resolveAppID(*appID)
 				if resolvedAppID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-					return flag.ErrHelp
+					return flagErrorf("--app is required (or set ASC_APP_ID)")
 				}
 
 				client, err := getASCClient()
@@ -114,14 +111,13 @@ Examples:
 						return fmt.Errorf("experiments list: failed to fetch: %w", err)
 					}
 
-					paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionExperimentsV2(ctx, resolvedAppID, asc.WithAppStoreVersionExperimentsV2NextURL(nextURL))
-					})
+					}, *output, *pretty)
 					if err != nil {
 						return fmt.Errorf("experiments list: %w", err)
 					}
-
-					return printOutput(paginated, *output, *pretty)
+					return nil
 				}
 
 				resp, err := client.GetAppStoreVersionExperimentsV2(requestCtx, resolvedAppID, opts...)
@@ -134,8 +130,7 @@ Examples:
 
 			trimmedVersionID := strings.TrimSpace(*versionID)
 			if trimmedVersionID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--version-id is required")
 			}
 
 			client, err := getASCClient()
@@ -159,14 +154,13 @@ Examples:
 					return fmt.Errorf("experiments list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionExperiments(ctx, trimmedVersionID, asc.WithAppStoreVersionExperimentsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("experiments list: %w", err)
 				}
-
-				return printOutput(paginated, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppStoreVersionExperiments(requestCtx, trimmedVersionID, opts...)
@@ -184,7 +178,7 @@ func ExperimentsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("experiments get", flag.ExitOnError)
 
 	experimentID := fs.String("experiment-id", "", "Experiment ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	v2 := fs.Bool("v2", false, "Use v2 experiments endpoint")
 
@@ -202,8 +196,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*experimentID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --experiment-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--experiment-id is required")
 			}
 
 			client, err := getASCClient()
@@ -241,7 +234,7 @@ func ExperimentsCreateCommand() *ffcli.Command {
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (v2 experiments)")
 	name := fs.String("name", "", "Experiment name")
 	trafficProportion := fs.String("traffic-proportion", "", "Traffic proportion (integer)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	v2 := fs.Bool("v2", false, "Use v2 experiments endpoint")
 
@@ -259,27 +252,23 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			nameValue := strings.TrimSpace(*name)
 			if nameValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return flagErrorf("--name is required")
 			}
 
 			trafficValue, err := parseTrafficProportion(*trafficProportion)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error:", err)
-				return flag.ErrHelp
+				return flagErrorf("%v", err)
 			}
 
 			if *v2 {
 				resolvedAppID := resolveAppID(*appID)
 				if resolvedAppID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-					return flag.ErrHelp
+					return flagErrorf("--app is required (or set ASC_APP_ID)")
 				}
 
 				platformValue, err := shared.NormalizePlatform(*platform)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err)
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 
 				client, err := getASCClient()
@@ -300,8 +289,7 @@ Examples:
 
 			trimmedVersionID := strings.TrimSpace(*versionID)
 			if trimmedVersionID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--version-id is required")
 			}
 
 			client, err := getASCClient()
@@ -331,7 +319,7 @@ func ExperimentsUpdateCommand() *ffcli.Command {
 	trafficProportion := fs.String("traffic-proportion", "", "Update traffic proportion (integer)")
 	var started shared.OptionalBool
 	fs.Var(&started, "started", "Start or stop the experiment: true or false")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	v2 := fs.Bool("v2", false, "Use v2 experiments endpoint")
 
@@ -351,8 +339,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*experimentID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --experiment-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--experiment-id is required")
 			}
 
 			attrsName := strings.TrimSpace(*name)
@@ -360,15 +347,13 @@ Examples:
 			if strings.TrimSpace(*trafficProportion) != "" {
 				value, err := parseTrafficProportion(*trafficProportion)
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Error:", err)
-					return flag.ErrHelp
+					return flagErrorf("%v", err)
 				}
 				trafficPtr = &value
 			}
 
 			if attrsName == "" && trafficPtr == nil && !started.IsSet() {
-				fmt.Fprintln(os.Stderr, "Error: --name, --traffic-proportion, or --started is required")
-				return flag.ErrHelp
+				return flagErrorf("--name, --traffic-proportion, or --started is required")
 			}
 
 			client, err := getASCClient()
@@ -428,7 +413,7 @@ func ExperimentsDeleteCommand() *ffcli.Command {
 
 	experimentID := fs.String("experiment-id", "", "Experiment ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	v2 := fs.Bool("v2", false, "Use v2 experiments endpoint")
 
@@ -446,12 +431,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*experimentID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --experiment-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--experiment-id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("experiment %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("experiments delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -496,7 +485,7 @@ func normalizeExperimentStates(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := experimentStateValues[value]; !ok {
-			return nil, fmt.Errorf("--state must be one of: %s", strings.Join(experimentStateList(), ", "))
+			return nil, usageErrorf("--state must be one of: %s", strings.Join(experimentStateList(), ", "))
 		}
 	}
 	return values, nil
@@ -519,14 +508,14 @@ func experimentStateList() []string {
 func parseTrafficProportion(raw string) (int, error) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
-		return 0, fmt.Errorf("--traffic-proportion is required")
+		return 0, usageErrorf("--traffic-proportion is required")
 	}
 	value, err := strconv.Atoi(raw)
 	if err != nil {
-		return 0, fmt.Errorf("--traffic-proportion must be an integer")
+		return 0, usageErrorf("--traffic-proportion must be an integer")
 	}
 	if value < 0 {
-		return 0, fmt.Errorf("--traffic-proportion must be 0 or greater")
+		return 0, usageErrorf("--traffic-proportion must be 0 or greater")
 	}
 	return value, nil
 }
@@ -1,9 +1,6 @@
 package promotedpurchases
 
-import (
-	"fmt"
-	"strings"
-)
+import "strings"
 
 type promotedPurchaseProductType string
 
@@ -22,6 +19,6 @@ func normalizePromotedPurchaseProductType(value string) (promotedPurchaseProduct
 	case string(promotedPurchaseProductTypeInAppPurchase):
 		return promotedPurchaseProductTypeInAppPurchase, nil
 	default:
-		return "", fmt.Errorf("--product-type must be one of: SUBSCRIPTION, IN_APP_PURCHASE")
+		return "", usageErrorf("--product-type must be one of: SUBSCRIPTION, IN_APP_PURCHASE")
 	}
 }
@@ -58,7 +58,7 @@ func PublishTestFlightCommand() *ffcli.Command {
 	timeout := fs.Duration("timeout", 0, "Override upload + processing timeout (e.g., 30m)")
 	testNotes := fs.String("test-notes", "", "What to Test notes for the build")
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -82,29 +82,24 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if strings.TrimSpace(*ipaPath) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --ipa is required\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--ipa is required")
 			}
 
 			parsedGroupIDs := parseCommaSeparatedIDs(*groupIDs)
 			if len(parsedGroupIDs) == 0 {
-				fmt.Fprintf(os.Stderr, "Error: --group is required\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--group is required")
 			}
 
 			testNotesValue := strings.TrimSpace(*testNotes)
 			localeValue := strings.TrimSpace(*locale)
 			if testNotesValue != "" && localeValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --locale is required with --test-notes")
-				return flag.ErrHelp
+				return flagErrorf("--locale is required with --test-notes")
 			}
 			if testNotesValue == "" && localeValue != "" {
-				fmt.Fprintln(os.Stderr, "Error: --test-notes is required with --locale")
-				return flag.ErrHelp
+				return flagErrorf("--test-notes is required with --locale")
 			}
 			if testNotesValue != "" {
 				if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
@@ -113,10 +108,10 @@ Examples:
 			}
 
 			if *pollInterval <= 0 {
-				return fmt.Errorf("publish testflight: --poll-interval must be greater than 0")
+				return usageErrorf("publish testflight: --poll-interval must be greater than 0")
 			}
 			if *timeout < 0 {
-				return fmt.Errorf("publish testflight: --timeout must be greater than 0")
+				return usageErrorf("publish testflight: --timeout must be greater than 0")
 			}
 
 			normalizedPlatform, err := normalizeSubmitPlatform(*platform)
@@ -197,7 +192,7 @@ func PublishAppStoreCommand() *ffcli.Command {
 	wait := fs.Bool("wait", false, "Wait for build processing")
 	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and build discovery")
 	timeout := fs.Duration("timeout", 0, "Override upload + processing timeout (e.g., 30m)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -220,24 +215,21 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *submit && !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required with --submit")
-				return flag.ErrHelp
+				return flagErrorf("--confirm is required with --submit")
 			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if strings.TrimSpace(*ipaPath) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --ipa is required\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--ipa is required")
 			}
 			if *pollInterval <= 0 {
-				return fmt.Errorf("publish appstore: --poll-interval must be greater than 0")
+				return usageErrorf("publish appstore: --poll-interval must be greater than 0")
 			}
 			if *timeout < 0 {
-				return fmt.Errorf("publish appstore: --timeout must be greater than 0")
+				return usageErrorf("publish appstore: --timeout must be greater than 0")
 			}
 
 			normalizedPlatform, err := normalizeSubmitPlatform(*platform)
@@ -385,7 +377,7 @@ func validateIPAPath(ipaPath string) (os.FileInfo, error) {
 		return nil, fmt.Errorf("failed to stat IPA: %w", err)
 	}
 	if fileInfo.IsDir() {
-		return nil, fmt.Errorf("--ipa must be a file")
+		return nil, usageErrorf("--ipa must be a file")
 	}
 	return fileInfo, nil
 }
@@ -56,3 +56,11 @@ func normalizeSubmitPlatform(value string) (string, error) {
 func contextWithUploadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	return shared.ContextWithUploadTimeout(ctx)
 }
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
@@ -0,0 +1,8 @@
+package raw
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the raw command.
+func Command() *ffcli.Command {
+	return RawCommand()
+}
@@ -0,0 +1,138 @@
+package raw
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+var rawMethods = []string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodDelete}
+
+// RawCommand returns the raw passthrough command.
+func RawCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("raw", flag.ExitOnError)
+
+	method := fs.String("method", http.MethodGet, "HTTP method: "+strings.Join(rawMethods, ", "))
+	path := fs.String("path", "", "API path including any query string (e.g. /v1/apps?limit=1)")
+	body := fs.String("body", "", "JSON (or YAML) request body file (required for POST and PATCH)")
+	paginate := fs.Bool("paginate", false, "Follow links.next and combine every page of a collection (GET only)")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "raw",
+		ShortUsage: "asc raw --path \"/v1/...\" [--method GET] [--body file.json] [--paginate] [flags]",
+		ShortHelp:  "Call any App Store Connect API endpoint directly.",
+		LongHelp: `Call any App Store Connect API endpoint directly.
+
+An escape hatch for endpoints without a dedicated command. The request uses
+the same credentials, retries, timeouts, and --dry-run handling as every other
+command, and the response body is printed as returned.
+
+--path is relative to https://api.appstoreconnect.apple.com and may include a
+query string. --body is required for POST and PATCH, optional for DELETE
+(relationship removals), and not allowed for GET. It is validated like other
+--file payloads: it must contain a JSON object.
+
+--paginate follows links.next from a GET of a collection and prints one
+document with every page's data. Responses without a top-level data array
+are rejected.
+
+Examples:
+  asc raw --path "/v1/apps?limit=1"
+  asc raw --path "/v1/apps/APP_ID/appStoreVersions?filter[platform]=IOS" --pretty
+  asc raw --path "/v1/apps?limit=200" --paginate
+  asc raw --method PATCH --path "/v1/apps/APP_ID" --body app.json
+  asc raw --method DELETE --path "/v1/betaGroups/GROUP_ID/relationships/betaTesters" --body testers.json`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			methodValue := strings.ToUpper(strings.TrimSpace(*method))
+			if !slices.Contains(rawMethods, methodValue) {
+				return flagErrorf("--method must be one of: %s", strings.Join(rawMethods, ", "))
+			}
+			pathValue := strings.TrimSpace(*path)
+			if pathValue == "" {
+				return flagErrorf("--path is required")
+			}
+			bodyPath := strings.TrimSpace(*body)
+			switch {
+			case bodyPath == "" && (methodValue == http.MethodPost || methodValue == http.MethodPatch):
+				return flagErrorf("--body is required for %s", methodValue)
+			case bodyPath != "" && methodValue == http.MethodGet:
+				return flagErrorf("--body is not allowed for GET")
+			case *paginate && methodValue != http.MethodGet:
+				return flagErrorf("--paginate requires --method GET")
+			}
+
+			var payload []byte
+			if bodyPath != "" {
+				data, err := readJSONFilePayload(bodyPath)
+				if err != nil {
+					return fmt.Errorf("raw: read --body: %w", err)
+				}
+				payload = data
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("raw: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if *paginate {
+				firstPage, err := client.GetRawList(requestCtx, pathValue)
+				if err != nil {
+					return fmt.Errorf("raw: %w", err)
+				}
+				all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetRawList(ctx, nextURL)
+				})
+				if err != nil {
+					return fmt.Errorf("raw: %w", err)
+				}
+				data, err := json.Marshal(all)
+				if err != nil {
+					return fmt.Errorf("raw: %w", err)
+				}
+				return printRawResponse(data, *pretty)
+			}
+
+			resp, err := client.Raw(requestCtx, methodValue, pathValue, payload)
+			if err != nil {
+				return fmt.Errorf("raw: %w", err)
+			}
+
+			return printRawResponse(resp, *pretty)
+		},
+	}
+}
+
+// printRawResponse writes the response body to stdout, indenting it when
+// pretty is set and the body is JSON. Empty bodies (e.g. 204) print nothing.
+func printRawResponse(data []byte, pretty bool) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	if pretty && json.Valid(trimmed) {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, trimmed, "", "  "); err != nil {
+			return fmt.Errorf("raw: pretty-print json: %w", err)
+		}
+		trimmed = buf.Bytes()
+	}
+	_, err := fmt.Fprintf(os.Stdout, "%s\n", trimmed)
+	return err
+}
@@ -0,0 +1,31 @@
+package raw
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func DefaultUsageFunc(c *ffcli.Command) string {
+	return shared.DefaultUsageFunc(c)
+}
+
+func getASCClient() (*asc.Client, error) {
+	return shared.GetASCClient()
+}
+
+func contextWithTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return shared.ContextWithTimeout(ctx)
+}
+
+func readJSONFilePayload(path string) (json.RawMessage, error) {
+	return shared.ReadJSONFilePayload(path)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
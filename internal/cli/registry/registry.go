@@ -49,6 +49,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/profiles"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/promotedpurchases"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/publish"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/raw"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/reviews"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/routingcoverage"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/sandbox"
@@ -82,6 +83,7 @@ func VersionCommand(version string) *ffcli.Command {
 func Subcommands(version string) []*ffcli.Command {
 	subs := []*ffcli.Command{
 		auth.AuthCommand(),
+		auth.WhoamiCommand(),
 		install.InstallCommand(),
 		feedback.FeedbackCommand(),
 		crashes.CrashesCommand(),
@@ -141,6 +143,7 @@ func Subcommands(version string) []*ffcli.Command {
 		encryption.EncryptionCommand(),
 		promotedpurchases.PromotedPurchasesCommand(),
 		migrate.MigrateCommand(),
+		raw.RawCommand(),
 		gamecenter.GameCenterCommand(),
 		VersionCommand(version),
 	}
@@ -24,7 +24,7 @@ func ReviewDetailsAttachmentsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -41,7 +41,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("review attachments-list: --limit must be between 1 and 200")
+				return usageErrorf("review attachments-list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("review attachments-list: %w", err)
@@ -62,8 +62,7 @@ Examples:
 
 			reviewDetailValue := strings.TrimSpace(*reviewDetailID)
 			if reviewDetailValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --review-detail is required")
-				return flag.ErrHelp
+				return flagErrorf("--review-detail is required")
 			}
 
 			client, err := getASCClient()
@@ -89,14 +88,13 @@ Examples:
 					return fmt.Errorf("review attachments-list: failed to fetch: %w", err)
 				}
 
-				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreReviewAttachmentsForReviewDetail(ctx, reviewDetailValue, asc.WithAppStoreReviewAttachmentsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("review attachments-list: %w", err)
 				}
-
-				return printOutput(pages, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppStoreReviewAttachmentsForReviewDetail(requestCtx, reviewDetailValue, opts...)
@@ -117,7 +115,7 @@ func ReviewDetailsAttachmentsGetCommand() *ffcli.Command {
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(reviewAttachmentFieldList(), ", "))
 	detailFields := fs.String("detail-fields", "", "Review detail fields to include: "+strings.Join(reviewDetailFieldList(), ", "))
 	include := fs.String("include", "", "Include relationships: "+strings.Join(reviewAttachmentIncludeList(), ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -134,8 +132,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			attachmentValue := strings.TrimSpace(*attachmentID)
 			if attachmentValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			fieldsValue, err := normalizeReviewAttachmentFields(*fields)
@@ -179,7 +176,7 @@ func ReviewDetailsAttachmentsUploadCommand() *ffcli.Command {
 
 	reviewDetailID := fs.String("review-detail", "", "App Store review detail ID (required)")
 	filePath := fs.String("file", "", "Path to attachment file (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -195,14 +192,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			reviewDetailValue := strings.TrimSpace(*reviewDetailID)
 			if reviewDetailValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --review-detail is required")
-				return flag.ErrHelp
+				return flagErrorf("--review-detail is required")
 			}
 
 			pathValue := strings.TrimSpace(*filePath)
 			if pathValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
 			info, err := os.Lstat(pathValue)
@@ -271,7 +266,7 @@ func ReviewDetailsAttachmentsDeleteCommand() *ffcli.Command {
 
 	attachmentID := fs.String("id", "", "Review attachment ID (required)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -287,12 +282,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			attachmentValue := strings.TrimSpace(*attachmentID)
 			if attachmentValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("review attachment %q", attachmentValue))
+				if err != nil {
+					return fmt.Errorf("review attachments-delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -328,7 +327,7 @@ func normalizeReviewAttachmentFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--fields must be one of: %s", strings.Join(reviewAttachmentFieldList(), ", "))
+			return nil, usageErrorf("--fields must be one of: %s", strings.Join(reviewAttachmentFieldList(), ", "))
 		}
 	}
 	return fields, nil
@@ -345,7 +344,7 @@ func normalizeReviewDetailFields(value string) ([]string, error) {
 	}
 	for _, field := range fields {
 		if _, ok := allowed[field]; !ok {
-			return nil, fmt.Errorf("--detail-fields must be one of: %s", strings.Join(reviewDetailFieldList(), ", "))
+			return nil, usageErrorf("--detail-fields must be one of: %s", strings.Join(reviewDetailFieldList(), ", "))
 		}
 	}
 	return fields, nil
@@ -362,7 +361,7 @@ func normalizeReviewAttachmentInclude(value string) ([]string, error) {
 	}
 	for _, item := range include {
 		if _, ok := allowed[item]; !ok {
-			return nil, fmt.Errorf("--include must be one of: %s", strings.Join(reviewAttachmentIncludeList(), ", "))
+			return nil, usageErrorf("--include must be one of: %s", strings.Join(reviewAttachmentIncludeList(), ", "))
 		}
 	}
 	return include, nil
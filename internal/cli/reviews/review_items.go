@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -20,7 +19,7 @@ func ReviewItemsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Next page URL from a previous response")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -36,14 +35,13 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("review items-list: --limit must be between 1 and 200")
+				return usageErrorf("review items-list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("review items-list: %w", err)
 			}
 			if strings.TrimSpace(*submissionID) == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --submission is required")
-				return flag.ErrHelp
+				return flagErrorf("--submission is required")
 			}
 
 			client, err := getASCClient()
@@ -66,14 +64,13 @@ Examples:
 					return fmt.Errorf("review items-list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetReviewSubmissionItems(ctx, strings.TrimSpace(*submissionID), asc.WithReviewSubmissionItemsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("review items-list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetReviewSubmissionItems(requestCtx, strings.TrimSpace(*submissionID), opts...)
@@ -93,7 +90,7 @@ func ReviewItemsAddCommand() *ffcli.Command {
 	submissionID := fs.String("submission", "", "Review submission ID (required)")
 	itemType := fs.String("item-type", "", "Item type: appStoreVersions, appCustomProductPages, appEvents, appStoreVersionExperiments, appStoreVersionExperimentTreatments (required)")
 	itemID := fs.String("item-id", "", "Item ID (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -108,16 +105,13 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*submissionID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --submission is required")
-				return flag.ErrHelp
+				return flagErrorf("--submission is required")
 			}
 			if strings.TrimSpace(*itemType) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --item-type is required")
-				return flag.ErrHelp
+				return flagErrorf("--item-type is required")
 			}
 			if strings.TrimSpace(*itemID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --item-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--item-id is required")
 			}
 
 			normalizedType, err := normalizeReviewSubmissionItemType(*itemType)
@@ -149,7 +143,7 @@ func ReviewItemsRemoveCommand() *ffcli.Command {
 
 	itemID := fs.String("id", "", "Review submission item ID (required)")
 	confirm := fs.Bool("confirm", false, "Confirm removal (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -163,13 +157,17 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required to remove")
-				return flag.ErrHelp
-			}
 			if strings.TrimSpace(*itemID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
+			}
+			if !*confirm {
+				confirmed, err := confirmDestructive(fmt.Sprintf("review submission item %q", strings.TrimSpace(*itemID)))
+				if err != nil {
+					return fmt.Errorf("review items-remove: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required to remove")
+				}
 			}
 
 			client, err := getASCClient()
@@ -197,12 +195,12 @@ Examples:
 func normalizeReviewSubmissionItemType(value string) (asc.ReviewSubmissionItemType, error) {
 	normalized := strings.TrimSpace(value)
 	if normalized == "" {
-		return "", fmt.Errorf("--item-type is required")
+		return "", usageErrorf("--item-type is required")
 	}
 	if itemType, ok := reviewSubmissionItemTypes[normalized]; ok {
 		return itemType, nil
 	}
-	return "", fmt.Errorf("--item-type must be one of: %s", strings.Join(reviewSubmissionItemTypeList(), ", "))
+	return "", usageErrorf("--item-type must be one of: %s", strings.Join(reviewSubmissionItemTypeList(), ", "))
 }
 
 func reviewSubmissionItemTypeList() []string {
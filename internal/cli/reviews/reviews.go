@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -17,7 +16,7 @@ func ReviewsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("reviews", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	stars := fs.Int("stars", 0, "Filter by star rating (1-5)")
 	territory := fs.String("territory", "", "Filter by territory (e.g., US, GBR)")
@@ -61,8 +60,7 @@ Examples:
 			// If no flags are set and no args, show help
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			// Execute the list functionality directly
@@ -76,7 +74,7 @@ func ReviewsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	stars := fs.Int("stars", 0, "Filter by star rating (1-5)")
 	territory := fs.String("territory", "", "Filter by territory (e.g., US, GBR)")
@@ -102,8 +100,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			return executeReviewsList(ctx, resolvedAppID, *output, *pretty, *stars, *territory, *sort, *limit, *next, *paginate)
@@ -113,10 +110,10 @@ Examples:
 
 func executeReviewsList(ctx context.Context, appID, output string, pretty bool, stars int, territory, sort string, limit int, next string, paginate bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
-		return fmt.Errorf("reviews: --limit must be between 1 and 200")
+		return usageErrorf("reviews: --limit must be between 1 and 200")
 	}
 	if stars != 0 && (stars < 1 || stars > 5) {
-		return fmt.Errorf("reviews: --stars must be between 1 and 5")
+		return usageErrorf("reviews: --stars must be between 1 and 5")
 	}
 	if err := validateNextURL(next); err != nil {
 		return fmt.Errorf("reviews: %w", err)
@@ -152,14 +149,13 @@ func executeReviewsList(ctx context.Context, appID, output string, pretty bool,
 		}
 
 		// Fetch all remaining pages
-		reviews, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetReviews(ctx, appID, asc.WithNextURL(nextURL))
-		})
+		}, output, pretty)
 		if err != nil {
 			return fmt.Errorf("reviews: %w", err)
 		}
-
-		return printOutput(reviews, output, pretty)
+		return nil
 	}
 
 	reviews, err := client.GetReviews(requestCtx, appID, opts...)
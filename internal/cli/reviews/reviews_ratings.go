@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -21,7 +20,7 @@ func ReviewsRatingsCommand() *ffcli.Command {
 	country := fs.String("country", "us", "Country code (e.g., us, gb, de)")
 	all := fs.Bool("all", false, "Fetch ratings from all countries")
 	workers := fs.Int("workers", 10, "Number of parallel workers for --all")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -45,13 +44,11 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*appID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required")
-				return flag.ErrHelp
+				return flagErrorf("--app is required")
 			}
 
 			if *workers < 1 {
-				fmt.Fprintln(os.Stderr, "Error: --workers must be at least 1")
-				return flag.ErrHelp
+				return flagErrorf("--workers must be at least 1")
 			}
 
 			return executeRatings(ctx, *appID, *country, *all, *workers, *output, *pretty)
@@ -89,7 +86,7 @@ func executeSingleRatings(ctx context.Context, client *itunes.Client, appID, cou
 	case "markdown":
 		return printRatingsMarkdown(ratings)
 	default:
-		return printOutput(ratings, "json", pretty)
+		return printOutput(ratings, output, pretty)
 	}
 }
 
@@ -105,7 +102,7 @@ func executeAllRatings(ctx context.Context, client *itunes.Client, appID string,
 	case "markdown":
 		return printGlobalRatingsMarkdown(global)
 	default:
-		return printOutput(global, "json", pretty)
+		return printOutput(global, output, pretty)
 	}
 }
 
@@ -117,13 +114,16 @@ func normalizeRatingsOutput(output string, pretty bool) (string, error) {
 	if format == "md" {
 		format = "markdown"
 	}
+	if format == "yml" {
+		format = "yaml"
+	}
 
 	switch format {
-	case "json":
+	case "json", "yaml":
 		return format, nil
-	case "table", "markdown":
+	case "table", "markdown", "jsonl", "template":
 		if pretty {
-			return "", fmt.Errorf("--pretty is only valid with JSON output")
+			return "", usageErrorf("--pretty is only valid with JSON output")
 		}
 		return format, nil
 	default:
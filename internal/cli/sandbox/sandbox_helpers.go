@@ -13,10 +13,10 @@ import (
 func validateSandboxEmail(value string) error {
 	address := strings.TrimSpace(value)
 	if address == "" {
-		return fmt.Errorf("--email is required")
+		return usageErrorf("--email is required")
 	}
 	if _, err := mail.ParseAddress(address); err != nil {
-		return fmt.Errorf("--email must be a valid email address")
+		return usageErrorf("--email must be a valid email address")
 	}
 	return nil
 }
@@ -24,11 +24,11 @@ func validateSandboxEmail(value string) error {
 func normalizeSandboxTerritory(value string) (string, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
-		return "", fmt.Errorf("--territory is required")
+		return "", usageErrorf("--territory is required")
 	}
 	upper := strings.ToUpper(trimmed)
 	if _, ok := sandboxTerritoryCodes[upper]; !ok {
-		return "", fmt.Errorf("--territory must be a valid App Store territory code")
+		return "", usageErrorf("--territory must be a valid App Store territory code")
 	}
 	return upper, nil
 }
@@ -59,7 +59,7 @@ func normalizeSandboxRenewalRate(value string) (asc.SandboxTesterSubscriptionRen
 	if rate, ok := sandboxRenewalRates[normalized]; ok {
 		return rate, nil
 	}
-	return "", fmt.Errorf("--subscription-renewal-rate must be one of: %s", strings.Join(sandboxRenewalRateValues(), ", "))
+	return "", usageErrorf("--subscription-renewal-rate must be one of: %s", strings.Join(sandboxRenewalRateValues(), ", "))
 }
 
 func sandboxRenewalRateValues() []string {
@@ -1,9 +1,6 @@
 package shared
 
-import (
-	"fmt"
-	"strings"
-)
+import "strings"
 
 var appStoreVersionPlatforms = map[string]struct{}{
 	"IOS":       {},
@@ -39,10 +36,10 @@ var appStoreVersionStates = map[string]struct{}{
 func NormalizeAppStoreVersionPlatform(value string) (string, error) {
 	normalized := strings.ToUpper(strings.TrimSpace(value))
 	if normalized == "" {
-		return "", fmt.Errorf("--platform is required")
+		return "", UsageErrorf("--platform is required")
 	}
 	if _, ok := appStoreVersionPlatforms[normalized]; !ok {
-		return "", fmt.Errorf("--platform must be one of: %s", strings.Join(appStoreVersionPlatformList(), ", "))
+		return "", UsageErrorf("--platform must be one of: %s", strings.Join(appStoreVersionPlatformList(), ", "))
 	}
 	return normalized, nil
 }
@@ -54,7 +51,7 @@ func NormalizeAppStoreVersionPlatforms(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := appStoreVersionPlatforms[value]; !ok {
-			return nil, fmt.Errorf("--platform must be one of: %s", strings.Join(appStoreVersionPlatformList(), ", "))
+			return nil, UsageErrorf("--platform must be one of: %s", strings.Join(appStoreVersionPlatformList(), ", "))
 		}
 	}
 	return values, nil
@@ -67,7 +64,7 @@ func NormalizeAppStoreVersionStates(values []string) ([]string, error) {
 	}
 	for _, value := range values {
 		if _, ok := appStoreVersionStates[value]; !ok {
-			return nil, fmt.Errorf("--state must be one of: %s", strings.Join(appStoreVersionStateList(), ", "))
+			return nil, UsageErrorf("--state must be one of: %s", strings.Join(appStoreVersionStateList(), ", "))
 		}
 	}
 	return values, nil
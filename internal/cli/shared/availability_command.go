@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -35,7 +34,7 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 	if config.IncludeAvailableInNewTerritories {
 		fs.Var(&availableInNewTerritories, "available-in-new-territories", "Set availability for new territories: true or false")
 	}
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -48,26 +47,21 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return FlagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if strings.TrimSpace(*territory) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --territory is required")
-				return flag.ErrHelp
+				return FlagErrorf("--territory is required")
 			}
 			if !available.IsSet() {
-				fmt.Fprintln(os.Stderr, "Error: --available is required (true or false)")
-				return flag.ErrHelp
+				return FlagErrorf("--available is required (true or false)")
 			}
 			if config.IncludeAvailableInNewTerritories && !availableInNewTerritories.IsSet() {
-				fmt.Fprintln(os.Stderr, "Error: --available-in-new-territories is required (true or false)")
-				return flag.ErrHelp
+				return FlagErrorf("--available-in-new-territories is required (true or false)")
 			}
 
 			territories := splitCSVUpper(*territory)
 			if len(territories) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --territory must include at least one value")
-				return flag.ErrHelp
+				return FlagErrorf("--territory must include at least one value")
 			}
 
 			client, err := getASCClient()
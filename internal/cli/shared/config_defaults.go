@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// ApplyConfigDefaults fills in flags of the command selected by a parsed root
+// that the config file provides defaults for and that were not set on the
+// command line. Today that is the standard --output flag (default_output).
+//
+// A missing or unreadable config file is only an error when it was named
+// explicitly with --config.
+func ApplyConfigDefaults(root *ffcli.Command) error {
+	chain := selectedCommands(root)
+	if len(chain) == 0 {
+		return nil
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		if path := config.PathOverride(); path != "" {
+			if errors.Is(err, config.ErrNotFound) {
+				return fmt.Errorf("config file not found: %s", path)
+			}
+			return err
+		}
+		return nil
+	}
+	return applyConfigDefaults(chain[len(chain)-1].FlagSet, cfg)
+}
+
+func applyConfigDefaults(fs *flag.FlagSet, cfg *config.Config) error {
+	if fs == nil || cfg == nil {
+		return nil
+	}
+	output := strings.TrimSpace(cfg.DefaultOutput)
+	if output == "" {
+		return nil
+	}
+	f := fs.Lookup("output")
+	if f == nil || f.Usage != outputFormatUsage || flagWasSet(fs, "output") {
+		return nil
+	}
+	output = strings.ToLower(output)
+	if !slices.Contains(OutputFormatValues(), output) {
+		return fmt.Errorf("invalid default_output %q in config: must be one of %s", cfg.DefaultOutput, strings.Join(OutputFormatValues(), ", "))
+	}
+	return fs.Set("output", output)
+}
+
+func flagWasSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
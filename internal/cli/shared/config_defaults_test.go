@@ -0,0 +1,70 @@
+package shared
+
+import (
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+func newOutputFlagSet() (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	output := fs.String("output", "json", outputFormatUsage)
+	return fs, output
+}
+
+func TestApplyConfigDefaultsSetsOutput(t *testing.T) {
+	fs, output := newOutputFlagSet()
+	if err := fs.Parse(nil); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	if err := applyConfigDefaults(fs, &config.Config{DefaultOutput: "Table"}); err != nil {
+		t.Fatalf("applyConfigDefaults() error: %v", err)
+	}
+	if *output != "table" {
+		t.Fatalf("expected output from config, got %q", *output)
+	}
+}
+
+func TestApplyConfigDefaultsKeepsExplicitFlag(t *testing.T) {
+	fs, output := newOutputFlagSet()
+	if err := fs.Parse([]string{"--output", "markdown"}); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	if err := applyConfigDefaults(fs, &config.Config{DefaultOutput: "table"}); err != nil {
+		t.Fatalf("applyConfigDefaults() error: %v", err)
+	}
+	if *output != "markdown" {
+		t.Fatalf("expected explicit --output to win, got %q", *output)
+	}
+}
+
+func TestApplyConfigDefaultsSkipsNonStandardOutputFlag(t *testing.T) {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	output := fs.String("output", "text", "Output format: text (default), json")
+	if err := fs.Parse(nil); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	if err := applyConfigDefaults(fs, &config.Config{DefaultOutput: "table"}); err != nil {
+		t.Fatalf("applyConfigDefaults() error: %v", err)
+	}
+	if *output != "text" {
+		t.Fatalf("expected non-standard --output to be left alone, got %q", *output)
+	}
+}
+
+func TestApplyConfigDefaultsRejectsUnknownFormat(t *testing.T) {
+	fs, _ := newOutputFlagSet()
+	if err := fs.Parse(nil); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	err := applyConfigDefaults(fs, &config.Config{DefaultOutput: "csv"})
+	if err == nil || !strings.Contains(err.Error(), "default_output") {
+		t.Fatalf("expected default_output error, got %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrDeleteCanceled is returned when the user answers no to a delete prompt.
+var ErrDeleteCanceled = errors.New("delete canceled")
+
+var (
+	confirmInput  io.Reader = os.Stdin
+	confirmOutput io.Writer = os.Stderr
+)
+
+// ConfirmDestructive asks "Delete <resourceDescription>? [y/N]" on stderr and
+// reads the answer from stdin. It returns false without prompting when stdin
+// is not a terminal, so scripts keep failing fast on a missing --confirm
+// instead of hanging. Any answer other than y or yes returns ErrDeleteCanceled.
+func ConfirmDestructive(resourceDescription string) (bool, error) {
+	if !isTerminal(int(os.Stdin.Fd())) {
+		return false, nil
+	}
+
+	fmt.Fprintf(confirmOutput, "Delete %s? [y/N] ", resourceDescription)
+	answer, err := bufio.NewReader(confirmInput).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("read confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, ErrDeleteCanceled
+	}
+}
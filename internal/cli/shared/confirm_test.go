@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func stubConfirmPrompt(t *testing.T, terminal bool, input string) *bytes.Buffer {
+	t.Helper()
+	prevIsTerminal := isTerminal
+	prevInput := confirmInput
+	prevOutput := confirmOutput
+	t.Cleanup(func() {
+		isTerminal = prevIsTerminal
+		confirmInput = prevInput
+		confirmOutput = prevOutput
+	})
+
+	var output bytes.Buffer
+	isTerminal = func(int) bool { return terminal }
+	confirmInput = strings.NewReader(input)
+	confirmOutput = &output
+	return &output
+}
+
+func TestConfirmDestructive_NotTerminalDoesNotPrompt(t *testing.T) {
+	output := stubConfirmPrompt(t, false, "y\n")
+
+	confirmed, err := ConfirmDestructive(`subscription group "GROUP_ID"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if confirmed {
+		t.Fatal("expected no confirmation when stdin is not a terminal")
+	}
+	if output.Len() != 0 {
+		t.Fatalf("expected no prompt, got %q", output.String())
+	}
+}
+
+func TestConfirmDestructive_Answers(t *testing.T) {
+	tests := []struct {
+		input     string
+		confirmed bool
+	}{
+		{input: "y\n", confirmed: true},
+		{input: " YES \n", confirmed: true},
+		{input: "yes", confirmed: true},
+		{input: "n\n", confirmed: false},
+		{input: "\n", confirmed: false},
+		{input: "", confirmed: false},
+		{input: "sure\n", confirmed: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			output := stubConfirmPrompt(t, true, test.input)
+
+			confirmed, err := ConfirmDestructive(`subscription group "GROUP_ID"`)
+			if want := `Delete subscription group "GROUP_ID"? [y/N] `; output.String() != want {
+				t.Fatalf("prompt = %q, want %q", output.String(), want)
+			}
+			if confirmed != test.confirmed {
+				t.Fatalf("confirmed = %v, want %v", confirmed, test.confirmed)
+			}
+			if test.confirmed && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.confirmed && !errors.Is(err, ErrDeleteCanceled) {
+				t.Fatalf("expected ErrDeleteCanceled, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/suggest"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// ConfigCheckEnabled reports whether --config-check was passed.
+func ConfigCheckEnabled() bool {
+	return configCheck
+}
+
+// EnvVarWarnings returns warnings for ASC_* variables in environ that the CLI
+// does not read, suggesting the supported name when one is likely intended.
+// environ uses the os.Environ format (KEY=value).
+func EnvVarWarnings(environ []string) []string {
+	names := make([]string, 0)
+	for _, entry := range environ {
+		name, _, _ := strings.Cut(entry, "=")
+		if !strings.HasPrefix(name, "ASC_") || config.IsKnownEnvVar(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	warnings := make([]string, 0, len(names))
+	for _, name := range names {
+		if replacement, ok := config.RenamedEnvVar(name); ok {
+			warnings = append(warnings, fmt.Sprintf("%s is not recognized; use %s instead", name, replacement))
+			continue
+		}
+		if suggestions := suggest.Commands(name, config.KnownEnvVars()); len(suggestions) > 0 {
+			for i, suggestion := range suggestions {
+				suggestions[i] = strings.ToUpper(suggestion)
+			}
+			warnings = append(warnings, fmt.Sprintf("%s is not recognized; did you mean %s?", name, strings.Join(suggestions, " or ")))
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("%s is not recognized and will be ignored", name))
+	}
+	return warnings
+}
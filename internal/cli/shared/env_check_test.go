@@ -0,0 +1,32 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEnvVarWarnings(t *testing.T) {
+	environ := []string{
+		"ASC_KEY_ID=ABC",
+		"ASC_ISSUER=issuer",
+		"ASC_VENDOR_NUMBR=123",
+		"ASC_SOMETHING_ELSE_ENTIRELY=1",
+		"HOME=/root",
+	}
+
+	got := EnvVarWarnings(environ)
+	want := []string{
+		"ASC_ISSUER is not recognized; use ASC_ISSUER_ID instead",
+		"ASC_SOMETHING_ELSE_ENTIRELY is not recognized and will be ignored",
+		"ASC_VENDOR_NUMBR is not recognized; did you mean ASC_VENDOR_NUMBER?",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestEnvVarWarningsKnownOnly(t *testing.T) {
+	if got := EnvVarWarnings([]string{"ASC_KEY_ID=ABC", "ASC_APP_ID=123"}); len(got) != 0 {
+		t.Fatalf("expected no warnings, got %q", got)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"os"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
@@ -64,3 +66,30 @@ func FormatStderr(err error) string {
 	return fmt.Sprintf("Error: %s\nHint: %s\n", ce.Message, ce.Hint)
 }
 
+
+// PrintStderr reports err on stderr in the active log format.
+func PrintStderr(err error) {
+	if asc.LogFormat() == asc.LogFormatJSON {
+		LogError(asc.Logger(), err)
+		return
+	}
+	fmt.Fprint(os.Stderr, FormatStderr(err))
+}
+
+// LogError emits err as a single structured ERROR record, attaching the hint
+// and the App Store Connect error ID when available.
+func LogError(logger *slog.Logger, err error) {
+	ce := Classify(err)
+	if ce.Message == "" {
+		return
+	}
+	attrs := make([]any, 0, 4)
+	if ce.Hint != "" {
+		attrs = append(attrs, "hint", ce.Hint)
+	}
+	var apiErr *asc.APIError
+	if errors.As(err, &apiErr) && apiErr.ID != "" {
+		attrs = append(attrs, "request_id", apiErr.ID)
+	}
+	logger.Error(ce.Message, attrs...)
+}
@@ -1,8 +1,12 @@
 package errfmt
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
@@ -48,3 +52,25 @@ func wrap(base error, target error) error {
 	return isWrapper{target: target}
 }
 
+
+func TestLogError_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	apiErr := &asc.APIError{Code: "FORBIDDEN", Title: "Forbidden", Detail: "Nope", ID: "err-123"}
+
+	LogError(asc.NewLogger(&buf, asc.LogFormatJSON), fmt.Errorf("apps list: %w", apiErr))
+
+	output := strings.TrimSpace(buf.String())
+	if strings.Contains(output, "\n") {
+		t.Fatalf("expected a single JSON line, got %q", output)
+	}
+	var record map[string]any
+	if err := json.Unmarshal([]byte(output), &record); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", output, err)
+	}
+	if record["level"] != "ERROR" || record["msg"] != "apps list: Forbidden: Nope" {
+		t.Fatalf("unexpected record: %v", record)
+	}
+	if record["request_id"] != "err-123" || record["hint"] == nil || record["time"] == nil {
+		t.Fatalf("expected request_id, hint, and time in record: %v", record)
+	}
+}
@@ -1,10 +1,100 @@
 package shared
 
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// Process exit codes. Scripts can rely on these to tell failure classes apart.
+const (
+	ExitSuccess     = 0
+	ExitError       = 1
+	ExitUsage       = 2
+	ExitAuth        = 3
+	ExitNotFound    = 4
+	ExitRateLimited = 5
+	ExitNetwork     = 6
+)
+
+// ErrorCategory is the failure class of an error returned by a command.
+type ErrorCategory string
+
+const (
+	ErrorCategoryGeneric     ErrorCategory = "generic"
+	ErrorCategoryUsage       ErrorCategory = "usage"
+	ErrorCategoryAuth        ErrorCategory = "auth"
+	ErrorCategoryNotFound    ErrorCategory = "not_found"
+	ErrorCategoryRateLimited ErrorCategory = "rate_limited"
+	ErrorCategoryNetwork     ErrorCategory = "network"
+)
+
+// ExitCode returns the process exit code for the category.
+func (c ErrorCategory) ExitCode() int {
+	switch c {
+	case ErrorCategoryUsage:
+		return ExitUsage
+	case ErrorCategoryAuth:
+		return ExitAuth
+	case ErrorCategoryNotFound:
+		return ExitNotFound
+	case ErrorCategoryRateLimited:
+		return ExitRateLimited
+	case ErrorCategoryNetwork:
+		return ExitNetwork
+	default:
+		return ExitError
+	}
+}
+
+// CategoryOf classifies err by inspecting its chain, so wrapped and already
+// reported errors keep the category of the error they carry.
+func CategoryOf(err error) ErrorCategory {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, flag.ErrHelp), errors.Is(err, ErrUsage):
+		return ErrorCategoryUsage
+	case errors.Is(err, ErrMissingAuth), errors.Is(err, asc.ErrUnauthorized), errors.Is(err, asc.ErrForbidden):
+		return ErrorCategoryAuth
+	case asc.IsNotFound(err):
+		return ErrorCategoryNotFound
+	case asc.IsRateLimited(err):
+		return ErrorCategoryRateLimited
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorCategoryNetwork
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrorCategoryNetwork
+	}
+	return ErrorCategoryGeneric
+}
+
+// ExitCodeFor returns the process exit code for err. An explicit ExitCoder in
+// the chain wins over the category.
+func ExitCodeFor(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+	var exitCoder ExitCoder
+	if errors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+	return CategoryOf(err).ExitCode()
+}
+
 // ReportedError marks an error as already reported to the user.
 // The main entrypoint should exit non-zero without duplicating output.
 type ReportedError interface {
 	error
 	Reported() bool
+	Category() ErrorCategory
 }
 
 type reportedError struct {
@@ -23,6 +113,10 @@ func (e reportedError) Reported() bool {
 	return true
 }
 
+func (e reportedError) Category() ErrorCategory {
+	return CategoryOf(e.err)
+}
+
 // NewReportedError wraps an error that has already been printed.
 func NewReportedError(err error) error {
 	if err == nil {
@@ -30,3 +124,68 @@ func NewReportedError(err error) error {
 	}
 	return reportedError{err: err}
 }
+
+// ErrUsage marks errors caused by invalid flags or arguments, so they exit
+// with ExitUsage even when the command doesn't return flag.ErrHelp.
+var ErrUsage = errors.New("usage error")
+
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e usageError) Unwrap() []error {
+	return []error{e.err, ErrUsage}
+}
+
+// UsageErrorf formats a flag or argument validation error that exits with
+// ExitUsage. The message is kept as is; ErrUsage only tags it.
+func UsageErrorf(format string, args ...any) error {
+	return usageError{err: fmt.Errorf(format, args...)}
+}
+
+// FlagErrorf reports an invalid flag or argument from a command's Exec. With
+// text logging it prints "Error: ..." and returns flag.ErrHelp, so the
+// command's usage follows. With --log-format json it returns a usage error
+// instead, which Run logs as a single ERROR record without the usage text.
+func FlagErrorf(format string, args ...any) error {
+	if asc.LogFormat() == asc.LogFormatJSON {
+		return UsageErrorf(format, args...)
+	}
+	fmt.Fprintf(os.Stderr, "Error: %s\n", fmt.Sprintf(format, args...))
+	return flag.ErrHelp
+}
+
+// ExitCoder is implemented by errors that request a specific process exit code.
+type ExitCoder interface {
+	error
+	ExitCode() int
+}
+
+type exitCodeError struct {
+	err  error
+	code int
+}
+
+func (e exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e exitCodeError) Unwrap() error {
+	return e.err
+}
+
+func (e exitCodeError) ExitCode() int {
+	return e.code
+}
+
+// NewExitCodeError wraps an error with the process exit code it should produce.
+func NewExitCodeError(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+	return exitCodeError{err: err, code: code}
+}
@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestExitCodeFor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "nil", err: nil, want: ExitSuccess},
+		{name: "generic", err: errors.New("boom"), want: ExitError},
+		{name: "usage", err: flag.ErrHelp, want: ExitUsage},
+		{name: "usage error", err: fmt.Errorf("apps get: %w", UsageErrorf("--include must be one of: %s", "builds")), want: ExitUsage},
+		{name: "missing auth", err: fmt.Errorf("apps list: %w", ErrMissingAuth), want: ExitAuth},
+		{name: "unauthorized", err: &asc.APIError{Code: "UNAUTHORIZED"}, want: ExitAuth},
+		{name: "forbidden", err: &asc.APIError{Code: "FORBIDDEN"}, want: ExitAuth},
+		{name: "not found", err: fmt.Errorf("apps get: %w", &asc.APIError{Code: "NOT_FOUND"}), want: ExitNotFound},
+		{name: "service unavailable", err: &asc.RetryableError{Err: errors.New("unavailable"), StatusCode: http.StatusServiceUnavailable}, want: ExitError},
+		{name: "timeout", err: fmt.Errorf("request failed: %w", context.DeadlineExceeded), want: ExitNetwork},
+		{name: "network", err: fmt.Errorf("request failed: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), want: ExitNetwork},
+		{name: "explicit exit code", err: NewExitCodeError(&asc.APIError{Code: "NOT_FOUND"}, 11), want: 11},
+		{name: "reported", err: NewReportedError(&asc.APIError{Code: "NOT_FOUND"}), want: ExitNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ExitCodeFor(test.err); got != test.want {
+				t.Fatalf("ExitCodeFor() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestExitCodeForRateLimitedAfterRetries(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "2")
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	t.Setenv("ASC_MAX_DELAY", "1ms")
+	t.Setenv("ASC_RETRY_LOG", "")
+
+	var calls atomic.Int32
+	original := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Status:     "429 Too Many Requests",
+			Header:     http.Header{"Retry-After": []string{"0"}},
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = original })
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+	client, err := asc.NewClient("KEY123", "ISS456", keyPath)
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+
+	_, err = client.GetApp(context.Background(), "app-1")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("expected 3 requests, got %d", got)
+	}
+	if got := ExitCodeFor(fmt.Errorf("apps get: %w", err)); got != ExitRateLimited {
+		t.Fatalf("ExitCodeFor() = %d, want %d (err: %v)", got, ExitRateLimited, err)
+	}
+}
+
+func TestReportedErrorCategory(t *testing.T) {
+	var reported ReportedError
+	if !errors.As(NewReportedError(fmt.Errorf("auth status: %w", ErrMissingAuth)), &reported) {
+		t.Fatal("expected ReportedError")
+	}
+	if got := reported.Category(); got != ErrorCategoryAuth {
+		t.Fatalf("Category() = %q, want %q", got, ErrorCategoryAuth)
+	}
+}
+
+func TestUsageErrorfKeepsMessage(t *testing.T) {
+	err := UsageErrorf("--limit must be between %d and %d", 1, 200)
+	if err.Error() != "--limit must be between 1 and 200" {
+		t.Fatalf("Error() = %q", err.Error())
+	}
+	if !errors.Is(err, ErrUsage) {
+		t.Fatal("expected errors.Is(err, ErrUsage)")
+	}
+}
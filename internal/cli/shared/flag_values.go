@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"flag"
+	"sync"
+)
+
+// outputFormatUsage is the help text shared by the standard --output flag.
+const outputFormatUsage = "Output format: json (default), table, markdown, yaml, jsonl, template"
+
+var flagValueRegistry = struct {
+	sync.RWMutex
+	values map[*flag.Flag][]string
+}{values: make(map[*flag.Flag][]string)}
+
+// OutputFormatValues returns the formats accepted by the standard --output flag.
+func OutputFormatValues() []string {
+	return []string{"json", "table", "markdown", "yaml", "jsonl", "template"}
+}
+
+// RegisterFlagValues records the allowed values of an already-defined flag so
+// shell completion can suggest them. Unknown flags are ignored.
+func RegisterFlagValues(fs *flag.FlagSet, name string, values ...string) {
+	f := fs.Lookup(name)
+	if f == nil || len(values) == 0 {
+		return
+	}
+	flagValueRegistry.Lock()
+	defer flagValueRegistry.Unlock()
+	flagValueRegistry.values[f] = append([]string(nil), values...)
+}
+
+// FlagValues returns the completion values for f, or nil when the flag takes
+// free-form input. The standard --output flag is recognized without
+// registration.
+func FlagValues(f *flag.Flag) []string {
+	if f == nil {
+		return nil
+	}
+	flagValueRegistry.RLock()
+	values, ok := flagValueRegistry.values[f]
+	flagValueRegistry.RUnlock()
+	if ok {
+		return append([]string(nil), values...)
+	}
+	if f.Name == "output" && f.Usage == outputFormatUsage {
+		return OutputFormatValues()
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package shared
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+)
+
+func TestFlagValues(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("status", "", "Filter by status")
+	fs.String("name", "", "Filter by name")
+	fs.String("output", "json", outputFormatUsage)
+	fs.String("out", "", "Output file path")
+
+	RegisterFlagValues(fs, "status", "DRAFT", "SUBMITTED")
+	RegisterFlagValues(fs, "missing", "IGNORED")
+
+	if got, want := FlagValues(fs.Lookup("status")), []string{"DRAFT", "SUBMITTED"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("status values = %v, want %v", got, want)
+	}
+	if got := FlagValues(fs.Lookup("name")); got != nil {
+		t.Fatalf("expected no values for free-form flag, got %v", got)
+	}
+	if got, want := FlagValues(fs.Lookup("output")), OutputFormatValues(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("output values = %v, want %v", got, want)
+	}
+	if got := FlagValues(fs.Lookup("out")); got != nil {
+		t.Fatalf("expected no values for path flag, got %v", got)
+	}
+	if got := FlagValues(nil); got != nil {
+		t.Fatalf("expected no values for nil flag, got %v", got)
+	}
+}
@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReadJSONFilePayload reads a JSON object from path and returns the raw bytes.
+// Files ending in .yaml or .yml (e.g. saved --output yaml results) are
+// converted to JSON first.
+func ReadJSONFilePayload(path string) (json.RawMessage, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("payload path must be a file")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return nil, fmt.Errorf("payload file is empty")
+	}
+	if isYAMLPath(path) {
+		data, err = yamlPayloadToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return json.RawMessage(data), nil
+}
+
+func isYAMLPath(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// yamlPayloadToJSON converts a YAML document to the equivalent JSON.
+func yamlPayloadToJSON(data []byte) ([]byte, error) {
+	var payload interface{}
+	if err := yaml.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	converted, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	return converted, nil
+}
+
+var strictValidation bool
+
+// SetStrictValidation sets --strict-validation (tests only).
+func SetStrictValidation(value bool) {
+	strictValidation = value
+}
+
+// ReadJSONFilePayloadFor reads a JSON object from path like
+// ReadJSONFilePayload. With --strict-validation, the payload is also decoded
+// into target and keys that target does not define are rejected.
+func ReadJSONFilePayloadFor(path string, target any) (json.RawMessage, error) {
+	payload, err := ReadJSONFilePayload(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateJSONPayloadFor(payload, target); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// ValidateJSONPayloadFor applies --strict-validation to a payload that was
+// read with ReadJSONFilePayload and then adjusted by the caller.
+func ValidateJSONPayloadFor(payload json.RawMessage, target any) error {
+	if !strictValidation {
+		return nil
+	}
+	return validateJSONPayloadFields(payload, target)
+}
+
+// validateJSONPayloadFields decodes payload into target, rejecting unknown
+// keys and naming the first offending one.
+func validateJSONPayloadFields(payload json.RawMessage, target any) error {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		message := strings.TrimPrefix(err.Error(), "json: ")
+		return fmt.Errorf("invalid payload (--strict-validation): %s", message)
+	}
+	return nil
+}
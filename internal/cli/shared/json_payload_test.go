@@ -0,0 +1,80 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type strictPayloadFixture struct {
+	Data struct {
+		Type       string `json:"type"`
+		Attributes struct {
+			Name string `json:"name"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+func writePayloadFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "payload.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+	return path
+}
+
+func TestReadJSONFilePayloadForStrictValidation(t *testing.T) {
+	t.Cleanup(func() {
+		SetStrictValidation(false)
+	})
+	path := writePayloadFile(t, `{"data":{"type":"ciWorkflows","attributes":{"name":"CI","nmae":"typo"}}}`)
+
+	if _, err := ReadJSONFilePayloadFor(path, &strictPayloadFixture{}); err != nil {
+		t.Fatalf("expected unknown keys to pass without --strict-validation, got %v", err)
+	}
+
+	SetStrictValidation(true)
+	_, err := ReadJSONFilePayloadFor(path, &strictPayloadFixture{})
+	if err == nil {
+		t.Fatal("expected unknown key to be rejected")
+	}
+	if !strings.Contains(err.Error(), `unknown field "nmae"`) {
+		t.Fatalf("expected error to name the offending field, got %v", err)
+	}
+
+	valid := writePayloadFile(t, `{"data":{"type":"ciWorkflows","attributes":{"name":"CI"}}}`)
+	payload, err := ReadJSONFilePayloadFor(valid, &strictPayloadFixture{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(payload), `"name":"CI"`) {
+		t.Fatalf("expected raw payload to be returned, got %s", payload)
+	}
+}
+
+func TestReadJSONFilePayloadConvertsYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "workflow.yaml")
+	content := "data:\n  type: ciWorkflows\n  attributes:\n    name: CI\n    isEnabled: true\n    containerFilePath: \"123\"\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+
+	payload, err := ReadJSONFilePayload(path)
+	if err != nil {
+		t.Fatalf("ReadJSONFilePayload() error: %v", err)
+	}
+	want := `{"data":{"attributes":{"containerFilePath":"123","isEnabled":true,"name":"CI"},"type":"ciWorkflows"}}`
+	if string(payload) != want {
+		t.Fatalf("expected %s, got %s", want, payload)
+	}
+
+	invalid := filepath.Join(t.TempDir(), "invalid.yml")
+	if err := os.WriteFile(invalid, []byte("data: [unclosed\n"), 0o600); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+	if _, err := ReadJSONFilePayload(invalid); err == nil || !strings.Contains(err.Error(), "invalid YAML") {
+		t.Fatalf("expected invalid YAML error, got %v", err)
+	}
+}
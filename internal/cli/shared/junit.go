@@ -0,0 +1,116 @@
+package shared
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// JUnitTestSuites is the root element of a JUnit XML report.
+type JUnitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr,omitempty"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestSuite groups test cases in a JUnit XML report.
+type JUnitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []JUnitTestCase `xml:"testcase"`
+}
+
+// JUnitTestCase is a single test case in a JUnit XML report.
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr,omitempty"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *JUnitFailure `xml:"failure,omitempty"`
+}
+
+// JUnitFailure marks a JUnit test case as failed.
+type JUnitFailure struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// MarshalJUnitReport renders suites as an indented JUnit XML document,
+// filling in suite and report totals from the test cases.
+func MarshalJUnitReport(report JUnitTestSuites) ([]byte, error) {
+	report.Tests = 0
+	report.Failures = 0
+	for i := range report.Suites {
+		suite := &report.Suites[i]
+		suite.Tests = len(suite.Cases)
+		suite.Failures = 0
+		for _, testCase := range suite.Cases {
+			if testCase.Failure != nil {
+				suite.Failures++
+			}
+		}
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+	}
+
+	data, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// WriteJUnitReport writes a JUnit XML report to path atomically so CI
+// systems never observe a partially written file.
+func WriteJUnitReport(path string, report JUnitTestSuites) error {
+	data, err := MarshalJUnitReport(report)
+	if err != nil {
+		return fmt.Errorf("render junit report: %w", err)
+	}
+
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("refusing to overwrite symlink %q", path)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("output path %q is a directory", path)
+		}
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(path), ".asc-junit-*")
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+	success := false
+	defer func() {
+		if !success {
+			_ = tempFile.Close()
+			_ = os.Remove(tempPath)
+		}
+	}()
+
+	if _, err := tempFile.Write(data); err != nil {
+		return err
+	}
+	if err := tempFile.Sync(); err != nil {
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tempPath, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return err
+	}
+
+	success = true
+	return nil
+}
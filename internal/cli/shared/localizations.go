@@ -45,7 +45,7 @@ func NormalizeLocalizationType(value string) (string, error) {
 	case LocalizationTypeVersion, LocalizationTypeAppInfo:
 		return normalized, nil
 	default:
-		return "", fmt.Errorf("--type must be %q or %q", LocalizationTypeVersion, LocalizationTypeAppInfo)
+		return "", UsageErrorf("--type must be %q or %q", LocalizationTypeVersion, LocalizationTypeAppInfo)
 	}
 }
 
@@ -682,3 +682,33 @@ func escapeStringsValue(value string) string {
 	)
 	return replacer.Replace(value)
 }
+
+// CreateLocalizationOrRollback creates the localization for a just-created
+// resource (such as a subscription or Game Center achievement). On failure the
+// resource is deleted again unless keepOnFailure is set, so a failed one-shot
+// create leaves nothing behind.
+func CreateLocalizationOrRollback[T any](
+	ctx context.Context,
+	resource string,
+	resourceID string,
+	keepOnFailure bool,
+	create func(context.Context) (T, error),
+	rollback func(context.Context) error,
+) (T, error) {
+	var zero T
+	resp, err := create(ctx)
+	if err == nil {
+		return resp, nil
+	}
+	if keepOnFailure {
+		return zero, fmt.Errorf("failed to create localization (%s %s was kept): %w", resource, resourceID, err)
+	}
+
+	// Roll back even if the original context was canceled or timed out.
+	rollbackCtx, cancel := ContextWithTimeout(context.WithoutCancel(ctx))
+	defer cancel()
+	if rollbackErr := rollback(rollbackCtx); rollbackErr != nil {
+		return zero, fmt.Errorf("failed to create localization: %w (rollback of %s %s also failed: %v)", err, resource, resourceID, rollbackErr)
+	}
+	return zero, fmt.Errorf("failed to create localization (%s %s was deleted): %w", resource, resourceID, err)
+}
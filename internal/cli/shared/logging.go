@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const logFormatEnvVar = "ASC_LOG_FORMAT"
+
+// ConfigureLogging applies --log-format (or ASC_LOG_FORMAT) to diagnostic
+// output on stderr. Result output on stdout is never affected.
+func ConfigureLogging() error {
+	value := strings.TrimSpace(logFormat)
+	source := "--log-format"
+	if value == "" {
+		value = strings.TrimSpace(os.Getenv(logFormatEnvVar))
+		source = logFormatEnvVar
+	}
+	if err := asc.SetLogFormat(value); err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	return nil
+}
+
+// Warnf reports a non-fatal diagnostic on stderr. Text output keeps the
+// familiar "Warning: ..." line; JSON output emits a WARN record.
+func Warnf(format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	if asc.LogFormat() == asc.LogFormatJSON {
+		asc.Logger().Warn(message)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %s\n", message)
+}
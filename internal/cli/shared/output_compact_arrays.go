@@ -0,0 +1,135 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+var compactArrays bool
+
+// withCompactArrays applies --compact-arrays to JSON output: relationship
+// linkage arrays ("relationships.<name>.data") holding a single resource
+// identifier are collapsed to that identifier. Key order is preserved.
+func withCompactArrays(data interface{}) (interface{}, error) {
+	if !compactArrays {
+		return data, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	compacted, err := compactJSONArrays(raw)
+	if err != nil {
+		return nil, fmt.Errorf("--compact-arrays: %w", err)
+	}
+	return compacted, nil
+}
+
+// compactJSONArrays rewrites raw JSON, collapsing the single-element "data"
+// arrays of every relationship object it contains.
+func compactJSONArrays(raw json.RawMessage) (json.RawMessage, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return raw, nil
+	}
+	switch raw[0] {
+	case '{':
+		return rewriteJSONObject(raw, func(key string, value json.RawMessage) (json.RawMessage, error) {
+			if key == "relationships" {
+				return compactRelationships(value)
+			}
+			return compactJSONArrays(value)
+		})
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i, item := range items {
+			compacted, err := compactJSONArrays(item)
+			if err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(compacted)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes(), nil
+	default:
+		return raw, nil
+	}
+}
+
+// compactRelationships collapses the "data" linkage of each relationship in
+// a relationships object when it is an array with exactly one element.
+func compactRelationships(raw json.RawMessage) (json.RawMessage, error) {
+	if !isJSONObject(raw) {
+		return compactJSONArrays(raw)
+	}
+	return rewriteJSONObject(raw, func(_ string, relationship json.RawMessage) (json.RawMessage, error) {
+		if !isJSONObject(relationship) {
+			return compactJSONArrays(relationship)
+		}
+		return rewriteJSONObject(relationship, func(key string, value json.RawMessage) (json.RawMessage, error) {
+			if key == "data" {
+				var items []json.RawMessage
+				if err := json.Unmarshal(value, &items); err == nil && len(items) == 1 {
+					value = items[0]
+				}
+			}
+			return compactJSONArrays(value)
+		})
+	})
+}
+
+func isJSONObject(raw json.RawMessage) bool {
+	raw = bytes.TrimSpace(raw)
+	return len(raw) > 0 && raw[0] == '{'
+}
+
+// rewriteJSONObject re-encodes a JSON object in its original key order,
+// replacing each value with the result of rewrite.
+func rewriteJSONObject(raw json.RawMessage, rewrite func(key string, value json.RawMessage) (json.RawMessage, error)) (json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for first := true; dec.More(); first = false {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object key %v", token)
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		value, err = rewrite(key, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,86 @@
+package shared
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCompactJSONArraysCollapsesSingleRelationshipLinkage(t *testing.T) {
+	input := `{"data":[{"type":"apps","id":"1","attributes":{"tags":["one"]},"relationships":{"builds":{"data":[{"type":"builds","id":"b1"}],"links":{"self":"x"}},"versions":{"data":[{"type":"appStoreVersions","id":"v1"},{"type":"appStoreVersions","id":"v2"}]},"owner":{"data":{"type":"users","id":"u1"}}}}],"included":[{"type":"builds","id":"b1","relationships":{"icons":{"data":[{"type":"buildIcons","id":"i1"}]}}}]}`
+
+	got, err := compactJSONArrays(json.RawMessage(input))
+	if err != nil {
+		t.Fatalf("compactJSONArrays() error: %v", err)
+	}
+
+	want := `{"data":[{"type":"apps","id":"1","attributes":{"tags":["one"]},"relationships":{"builds":{"data":{"type":"builds","id":"b1"},"links":{"self":"x"}},"versions":{"data":[{"type":"appStoreVersions","id":"v1"},{"type":"appStoreVersions","id":"v2"}]},"owner":{"data":{"type":"users","id":"u1"}}}}],"included":[{"type":"builds","id":"b1","relationships":{"icons":{"data":{"type":"buildIcons","id":"i1"}}}}]}`
+	if string(got) != want {
+		t.Fatalf("unexpected output:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestPrintOutputCompactArrays(t *testing.T) {
+	t.Cleanup(func() {
+		compactArrays = false
+	})
+	data := map[string]any{
+		"data": []any{map[string]any{
+			"id": "1",
+			"relationships": map[string]any{
+				"app": map[string]any{"data": []any{map[string]any{"type": "apps", "id": "a1"}}},
+			},
+		}},
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(data, "json", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"app":{"data":[{`) {
+		t.Fatalf("expected arrays to be kept by default, got %s", stdout)
+	}
+
+	compactArrays = true
+	stdout, _ = captureOutput(t, func() {
+		if err := printOutput(data, "json", true); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	var got struct {
+		Data []struct {
+			Relationships struct {
+				App struct {
+					Data struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				} `json:"app"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("failed to decode %q: %v", stdout, err)
+	}
+	if len(got.Data) != 1 || got.Data[0].Relationships.App.Data.ID != "a1" {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestFlattenSingleAliasesCompactArrays(t *testing.T) {
+	t.Cleanup(func() {
+		compactArrays = false
+	})
+	fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	BindRootFlags(fs)
+
+	if err := fs.Parse([]string{"--flatten-single"}); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if !compactArrays {
+		t.Fatal("expected --flatten-single to enable --compact-arrays")
+	}
+}
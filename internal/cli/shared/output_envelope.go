@@ -0,0 +1,77 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+// OutputEnvelopeVersion is the apiVersion reported in --envelope output.
+// Bump it only when the envelope or result shapes change incompatibly.
+const OutputEnvelopeVersion = 1
+
+// OutputEnvelope wraps a command result when --envelope is set.
+type OutputEnvelope struct {
+	APIVersion int    `json:"apiVersion"`
+	Command    string `json:"command"`
+	Data       any    `json:"data"`
+}
+
+var (
+	envelopeOutput bool
+	commandPath    string
+)
+
+// SetCommandPath records the executing command path (e.g. "apps list") for
+// the envelope's command field.
+func SetCommandPath(path string) {
+	commandPath = strings.TrimSpace(path)
+}
+
+// CommandPath returns the path of the subcommand selected by a parsed root
+// command, without the root name.
+func CommandPath(root *ffcli.Command) string {
+	var names []string
+	for _, cmd := range selectedCommands(root) {
+		names = append(names, cmd.Name)
+	}
+	return strings.Join(names, " ")
+}
+
+// selectedCommands returns the chain of subcommands selected by a parsed root
+// command, without the root itself.
+func selectedCommands(root *ffcli.Command) []*ffcli.Command {
+	var chain []*ffcli.Command
+	current := root
+	for current != nil && current.FlagSet != nil && current.FlagSet.Parsed() {
+		rest := current.FlagSet.Args()
+		if len(rest) == 0 {
+			break
+		}
+		var next *ffcli.Command
+		for _, sub := range current.Subcommands {
+			if strings.EqualFold(sub.Name, rest[0]) {
+				next = sub
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		chain = append(chain, next)
+		current = next
+	}
+	return chain
+}
+
+// withOutputEnvelope wraps data in an OutputEnvelope when --envelope is set.
+func withOutputEnvelope(data interface{}) interface{} {
+	if !envelopeOutput {
+		return data
+	}
+	return OutputEnvelope{
+		APIVersion: OutputEnvelopeVersion,
+		Command:    commandPath,
+		Data:       data,
+	}
+}
@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func TestPrintOutputEnvelope(t *testing.T) {
+	t.Cleanup(func() {
+		envelopeOutput = false
+		SetCommandPath("")
+	})
+	envelopeOutput = true
+	SetCommandPath("apps list")
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(map[string]string{"id": "app-1"}, "json", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+
+	var got struct {
+		APIVersion int               `json:"apiVersion"`
+		Command    string            `json:"command"`
+		Data       map[string]string `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("failed to decode %q: %v", stdout, err)
+	}
+	if got.APIVersion != OutputEnvelopeVersion || got.Command != "apps list" || got.Data["id"] != "app-1" {
+		t.Fatalf("unexpected envelope: %+v", got)
+	}
+}
+
+func TestPrintOutputWithoutEnvelopeIsRaw(t *testing.T) {
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(map[string]string{"id": "app-1"}, "json", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout != "{\"id\":\"app-1\"}\n" {
+		t.Fatalf("expected raw JSON, got %q", stdout)
+	}
+}
+
+func TestCommandPath(t *testing.T) {
+	newCommand := func(name string, subs ...*ffcli.Command) *ffcli.Command {
+		fs := flag.NewFlagSet(name, flag.ContinueOnError)
+		fs.String("output", "json", "")
+		return &ffcli.Command{
+			Name:        name,
+			FlagSet:     fs,
+			Subcommands: subs,
+			Exec:        func(context.Context, []string) error { return nil },
+		}
+	}
+	root := newCommand("asc", newCommand("apps", newCommand("list")), newCommand("builds"))
+
+	if got := CommandPath(root); got != "" {
+		t.Fatalf("expected empty path before parse, got %q", got)
+	}
+	if err := root.Parse([]string{"apps", "list", "--output", "table"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if got := CommandPath(root); got != "apps list" {
+		t.Fatalf("expected %q, got %q", "apps list", got)
+	}
+}
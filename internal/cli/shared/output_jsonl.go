@@ -0,0 +1,81 @@
+package shared
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// isJSONLinesFormat reports whether format selects JSON Lines output.
+func isJSONLinesFormat(format string) bool {
+	return strings.EqualFold(strings.TrimSpace(format), "jsonl")
+}
+
+// printJSONLines writes one compact JSON document per line. Responses with a
+// Data slice get one line per element; anything else is a single line.
+// --envelope only wraps single-object output, since a line per element has no
+// enclosing document to wrap.
+func printJSONLines(data interface{}) error {
+	items, ok := jsonLinesItems(data)
+	if !ok {
+		compacted, err := withCompactArrays(data)
+		if err != nil {
+			return err
+		}
+		return asc.PrintJSON(withOutputEnvelope(compacted))
+	}
+	for _, item := range items {
+		compacted, err := withCompactArrays(item)
+		if err != nil {
+			return err
+		}
+		if err := asc.PrintJSON(compacted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonLinesItems returns the elements of data's Data field when it is a slice.
+func jsonLinesItems(data interface{}) ([]interface{}, bool) {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, false
+	}
+	field := value.FieldByName("Data")
+	if !field.IsValid() || field.Kind() != reflect.Slice {
+		return nil, false
+	}
+	items := make([]interface{}, field.Len())
+	for i := range items {
+		items[i] = field.Index(i).Interface()
+	}
+	return items, true
+}
+
+// PaginateOutput fetches every page after firstPage and prints the combined
+// result. With --output jsonl each page is printed as soon as it arrives, so
+// large listings stream instead of being aggregated in memory first.
+func PaginateOutput(ctx context.Context, firstPage asc.PaginatedResponse, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	if !isJSONLinesFormat(format) {
+		resp, err := asc.PaginateAll(ctx, firstPage, fetchNext)
+		if err != nil {
+			return err
+		}
+		return printOutput(resp, format, pretty)
+	}
+	if err := validateOutputFormat("jsonl", pretty); err != nil {
+		return err
+	}
+	return asc.PaginateEach(ctx, firstPage, fetchNext, func(_ int, resp asc.PaginatedResponse) error {
+		return printJSONLines(resp)
+	})
+}
@@ -0,0 +1,171 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestPrintOutputJSONLinesListEmitsLinePerItem(t *testing.T) {
+	resp := &asc.CiBuildRunsResponse{
+		Data: []asc.CiBuildRunResource{
+			{Type: asc.ResourceTypeCiBuildRuns, ID: "run-1"},
+			{Type: asc.ResourceTypeCiBuildRuns, ID: "run-2"},
+		},
+		Links: asc.Links{Self: "https://example.com"},
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(resp, "jsonl", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+
+	lines := strings.Split(strings.TrimSuffix(stdout, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), stdout)
+	}
+	for i, line := range lines {
+		want := fmt.Sprintf(`"id":"run-%d"`, i+1)
+		if !strings.HasPrefix(line, "{") || !strings.Contains(line, want) {
+			t.Fatalf("line %d = %q, want object containing %s", i, line, want)
+		}
+		if strings.Contains(line, "links") {
+			t.Fatalf("expected list links to be dropped, got %q", line)
+		}
+	}
+}
+
+func TestPrintOutputJSONLinesSingleObject(t *testing.T) {
+	data := map[string]any{"data": map[string]any{"id": "app-1"}}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(data, "jsonl", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout != "{\"data\":{\"id\":\"app-1\"}}\n" {
+		t.Fatalf("unexpected jsonl output %q", stdout)
+	}
+}
+
+func TestPrintOutputJSONLinesRejectsPretty(t *testing.T) {
+	err := printOutput(map[string]any{}, "jsonl", true)
+	if err == nil || !strings.Contains(err.Error(), "--pretty is only valid with JSON output") {
+		t.Fatalf("expected --pretty error, got %v", err)
+	}
+}
+
+func TestPaginateOutputJSONLinesStreamsEachPage(t *testing.T) {
+	makePage := func(page int) *asc.CiBuildRunsResponse {
+		links := asc.Links{}
+		if page < 3 {
+			links.Next = fmt.Sprintf("page=%d", page+1)
+		}
+		return &asc.CiBuildRunsResponse{
+			Data:  []asc.CiBuildRunResource{{Type: asc.ResourceTypeCiBuildRuns, ID: fmt.Sprintf("run-%d", page)}},
+			Links: links,
+		}
+	}
+
+	var fetched []int
+	var stdout string
+	stdout, _ = captureOutput(t, func() {
+		err := PaginateOutput(context.Background(), makePage(1), func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			var page int
+			if _, err := fmt.Sscanf(nextURL, "page=%d", &page); err != nil {
+				return nil, err
+			}
+			fetched = append(fetched, page)
+			return makePage(page), nil
+		}, "jsonl", false)
+		if err != nil {
+			t.Fatalf("PaginateOutput() error: %v", err)
+		}
+	})
+
+	lines := strings.Split(strings.TrimSuffix(stdout, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d: %q", len(lines), stdout)
+	}
+	for i, line := range lines {
+		if !strings.Contains(line, fmt.Sprintf(`"id":"run-%d"`, i+1)) {
+			t.Fatalf("line %d = %q, expected run-%d", i, line, i+1)
+		}
+	}
+	if fmt.Sprint(fetched) != "[2 3]" {
+		t.Fatalf("unexpected fetch sequence %v", fetched)
+	}
+}
+
+func TestPaginateOutputAggregatesForJSON(t *testing.T) {
+	firstPage := &asc.CiBuildRunsResponse{
+		Data:  []asc.CiBuildRunResource{{Type: asc.ResourceTypeCiBuildRuns, ID: "run-1"}},
+		Links: asc.Links{Next: "page=2"},
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		err := PaginateOutput(context.Background(), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return &asc.CiBuildRunsResponse{
+				Data: []asc.CiBuildRunResource{{Type: asc.ResourceTypeCiBuildRuns, ID: "run-2"}},
+			}, nil
+		}, "json", false)
+		if err != nil {
+			t.Fatalf("PaginateOutput() error: %v", err)
+		}
+	})
+
+	if strings.Count(stdout, "\n") != 1 || !strings.Contains(stdout, "run-1") || !strings.Contains(stdout, "run-2") {
+		t.Fatalf("expected one aggregated JSON document, got %q", stdout)
+	}
+}
+
+func TestPaginateOutputReportsPaginationMeta(t *testing.T) {
+	fetchNext := func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return &asc.CiBuildRunsResponse{
+			Data: []asc.CiBuildRunResource{{Type: asc.ResourceTypeCiBuildRuns, ID: "run-2"}},
+		}, nil
+	}
+	newFirstPage := func() *asc.CiBuildRunsResponse {
+		return &asc.CiBuildRunsResponse{
+			Data:  []asc.CiBuildRunResource{{Type: asc.ResourceTypeCiBuildRuns, ID: "run-1"}},
+			Links: asc.Links{Next: "page=2"},
+		}
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := PaginateOutput(context.Background(), newFirstPage(), fetchNext, "json", false); err != nil {
+			t.Fatalf("PaginateOutput() error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"meta":{"pagination":{"items":2,"pages":2}}`) {
+		t.Fatalf("expected pagination meta in JSON output, got %q", stdout)
+	}
+
+	stdout, _ = captureOutput(t, func() {
+		if err := PaginateOutput(context.Background(), newFirstPage(), fetchNext, "table", false); err != nil {
+			t.Fatalf("PaginateOutput() error: %v", err)
+		}
+	})
+	if !strings.HasSuffix(stdout, "\nFetched 2 items across 2 pages\n") {
+		t.Fatalf("expected pagination footer in table output, got %q", stdout)
+	}
+}
+
+func TestPrintOutputTableOmitsFooterWithoutPagination(t *testing.T) {
+	resp := &asc.CiBuildRunsResponse{
+		Data: []asc.CiBuildRunResource{{Type: asc.ResourceTypeCiBuildRuns, ID: "run-1"}},
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(resp, "markdown", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if strings.Contains(stdout, "Fetched") {
+		t.Fatalf("expected no pagination footer, got %q", stdout)
+	}
+}
@@ -0,0 +1,123 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var selectPath string
+
+// printSelected applies --select: it evaluates a dotted path against the JSON
+// form of data and prints only the value found there. Strings are printed
+// without quotes so they can be used directly in scripts; anything else is
+// printed as JSON.
+func printSelected(data interface{}, pretty bool) error {
+	compacted, err := withCompactArrays(data)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(compacted)
+	if err != nil {
+		return err
+	}
+	value, err := selectJSONPath(raw, selectPath)
+	if err != nil {
+		return fmt.Errorf("--select: %w", err)
+	}
+
+	if text, ok := value.(string); ok {
+		_, err := fmt.Fprintln(os.Stdout, text)
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetEscapeHTML(false)
+	if pretty {
+		encoder.SetIndent("", "  ")
+	}
+	return encoder.Encode(value)
+}
+
+// selectJSONPath resolves a dotted path such as "data.attributes.name"
+// against raw JSON. A numeric segment indexes into an array; any other
+// segment reached at an array is applied to each element and the results are
+// collected into an array. Elements the rest of the path doesn't resolve for
+// become null, but the path must resolve for at least one element.
+func selectJSONPath(raw []byte, path string) (interface{}, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("path is empty")
+	}
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid path %q", path)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var root interface{}
+	if err := decoder.Decode(&root); err != nil {
+		return nil, err
+	}
+
+	value, _, err := selectValue(root, segments)
+	if err != nil {
+		return nil, fmt.Errorf("path %q: %w", path, err)
+	}
+	return value, nil
+}
+
+// selectValue walks segments from value. It reports whether the result was
+// collected from an array, so nested collections flatten into one array.
+func selectValue(value interface{}, segments []string) (interface{}, bool, error) {
+	if len(segments) == 0 {
+		return value, false, nil
+	}
+	segment := segments[0]
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		next, ok := typed[segment]
+		if !ok {
+			return nil, false, fmt.Errorf("%q not found", segment)
+		}
+		return selectValue(next, segments[1:])
+	case []interface{}:
+		if index, err := strconv.Atoi(segment); err == nil {
+			if index < 0 || index >= len(typed) {
+				return nil, false, fmt.Errorf("index %d out of range (length %d)", index, len(typed))
+			}
+			return selectValue(typed[index], segments[1:])
+		}
+		results := make([]interface{}, 0, len(typed))
+		var firstErr error
+		resolved := len(typed) == 0
+		for _, item := range typed {
+			selected, collected, err := selectValue(item, segments)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				results = append(results, nil)
+				continue
+			}
+			resolved = true
+			if nested, ok := selected.([]interface{}); ok && collected {
+				results = append(results, nested...)
+				continue
+			}
+			results = append(results, selected)
+		}
+		if !resolved {
+			return nil, false, firstErr
+		}
+		return results, true, nil
+	default:
+		return nil, false, fmt.Errorf("%q not found: not an object", segment)
+	}
+}
@@ -0,0 +1,107 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const selectTestJSON = `{"data":[{"id":"run-1","attributes":{"number":7,"executionProgress":"COMPLETE"},"relationships":{"builds":{"data":[{"type":"builds","id":"b1"},{"type":"builds","id":"b2"}]}}},{"id":"run-2","attributes":{"number":8}}],"links":{"self":"https://example.com"}}`
+
+func TestSelectJSONPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "object field", path: "links.self", want: `"https://example.com"`},
+		{name: "index", path: "data.1.id", want: `"run-2"`},
+		{name: "crosses slice", path: "data.id", want: `["run-1","run-2"]`},
+		{name: "number kept exact", path: "data.0.attributes.number", want: `7`},
+		{name: "missing in some elements", path: "data.attributes.executionProgress", want: `["COMPLETE",null]`},
+		{name: "nested slices flatten", path: "data.relationships.builds.data.id", want: `["b1","b2",null]`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := selectJSONPath([]byte(selectTestJSON), test.path)
+			if err != nil {
+				t.Fatalf("selectJSONPath() error: %v", err)
+			}
+			got, err := json.Marshal(value)
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			if string(got) != test.want {
+				t.Fatalf("selectJSONPath(%q) = %s, want %s", test.path, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSelectJSONPathErrors(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantErr string
+	}{
+		{path: "data.nope", wantErr: `"nope" not found`},
+		{path: "data.5", wantErr: "index 5 out of range"},
+		{path: "links.self.host", wantErr: "not an object"},
+		{path: "data..id", wantErr: "invalid path"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			_, err := selectJSONPath([]byte(selectTestJSON), test.path)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPrintOutputSelect(t *testing.T) {
+	t.Cleanup(func() {
+		selectPath = ""
+	})
+	data := map[string]any{
+		"data": map[string]any{"id": "run-1", "attributes": map[string]any{"tags": []any{"a", "b"}}},
+	}
+
+	selectPath = "data.id"
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(data, "json", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout != "run-1\n" {
+		t.Fatalf("expected raw string value, got %q", stdout)
+	}
+
+	selectPath = "data.attributes.tags"
+	stdout, _ = captureOutput(t, func() {
+		if err := printOutput(data, "json", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout != "[\"a\",\"b\"]\n" {
+		t.Fatalf("expected JSON array, got %q", stdout)
+	}
+
+	selectPath = "data.missing"
+	if err := printOutput(data, "json", false); err == nil || !strings.Contains(err.Error(), "--select") {
+		t.Fatalf("expected --select error, got %v", err)
+	}
+
+	selectPath = "data.id"
+	for _, format := range []string{"table", "markdown", "yaml", "jsonl"} {
+		err := printOutput(data, format, false)
+		if err == nil || !strings.Contains(err.Error(), "--select can only be used with --output json") {
+			t.Fatalf("expected --select/--output %s error, got %v", format, err)
+		}
+		if !errors.Is(err, ErrUsage) {
+			t.Fatalf("expected usage error for --output %s, got %v", format, err)
+		}
+	}
+}
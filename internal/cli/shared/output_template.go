@@ -0,0 +1,138 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+// outputTemplateUsage is the help text of the --template flag added next to
+// every standard --output flag.
+const outputTemplateUsage = "Go text/template for --output template (inline, or @file.tmpl)"
+
+var outputTemplate string
+
+// BindTemplateFlags adds --template to root and every command below it that
+// has the standard --output flag.
+func BindTemplateFlags(root *ffcli.Command) {
+	if root == nil {
+		return
+	}
+	if fs := root.FlagSet; fs != nil && fs.Lookup("template") == nil {
+		if f := fs.Lookup("output"); f != nil && f.Usage == outputFormatUsage {
+			fs.StringVar(&outputTemplate, "template", "", outputTemplateUsage)
+		}
+	}
+	for _, sub := range root.Subcommands {
+		BindTemplateFlags(sub)
+	}
+}
+
+// printTemplate executes the --template template against data, the same
+// value the other formats marshal, and writes the result to stdout. Fields
+// use Go names (e.g. {{range .Data}}{{.Attributes.Name}}{{end}}).
+func printTemplate(data interface{}) error {
+	tmpl, err := parseOutputTemplate(outputTemplate)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("--template: %w", err)
+	}
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	_, err = os.Stdout.Write(buf.Bytes())
+	return err
+}
+
+// parseOutputTemplate parses an inline template, or the contents of a file
+// when value starts with "@".
+func parseOutputTemplate(value string) (*template.Template, error) {
+	text := value
+	if strings.TrimSpace(text) == "" {
+		return nil, UsageErrorf("--template is required with --output template")
+	}
+	if path, ok := strings.CutPrefix(strings.TrimSpace(value), "@"); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("--template: %w", err)
+		}
+		text = string(data)
+	}
+	tmpl, err := template.New("template").
+		Option("missingkey=error").
+		Funcs(outputTemplateFuncs).
+		Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("--template: %w", err)
+	}
+	return tmpl, nil
+}
+
+var outputTemplateFuncs = template.FuncMap{
+	"join":    templateJoin,
+	"default": templateDefault,
+	"json":    templateJSON,
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+}
+
+// templateJoin joins the elements of any slice with sep, dereferencing
+// pointers: {{join ", " .Items}} or {{.Items | join ", "}}.
+func templateJoin(sep string, items interface{}) (string, error) {
+	value := reflect.ValueOf(items)
+	if !value.IsValid() {
+		return "", nil
+	}
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return "", fmt.Errorf("join: expected a list, got %s", value.Type())
+	}
+	parts := make([]string, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		parts = append(parts, fmt.Sprint(templateIndirect(value.Index(i))))
+	}
+	return strings.Join(parts, sep), nil
+}
+
+// templateDefault returns value, dereferenced, or fallback when value is nil
+// or the zero value: {{default "-" .Attributes.Name}}.
+func templateDefault(fallback, value interface{}) interface{} {
+	v := templateIndirect(reflect.ValueOf(value))
+	if v == nil {
+		return fallback
+	}
+	if reflect.ValueOf(v).IsZero() {
+		return fallback
+	}
+	return v
+}
+
+func templateJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("json: %w", err)
+	}
+	return string(data), nil
+}
+
+// templateIndirect follows pointers and interfaces, returning nil for nil.
+func templateIndirect(value reflect.Value) interface{} {
+	for value.IsValid() && (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return nil
+	}
+	return value.Interface()
+}
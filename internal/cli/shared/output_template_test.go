@@ -0,0 +1,100 @@
+package shared
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func setOutputTemplate(t *testing.T, value string) {
+	t.Helper()
+	previous := outputTemplate
+	outputTemplate = value
+	t.Cleanup(func() { outputTemplate = previous })
+}
+
+func TestPrintOutputTemplateRendersResponse(t *testing.T) {
+	setOutputTemplate(t, `{{range .Data}}{{.ID}}={{.Attributes.Name}} {{end}}`)
+	resp := &asc.AppsResponse{Data: []asc.Resource[asc.AppAttributes]{
+		{ID: "1", Attributes: asc.AppAttributes{Name: "One"}},
+		{ID: "2", Attributes: asc.AppAttributes{Name: "Two"}},
+	}}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(resp, "template", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout != "1=One 2=Two \n" {
+		t.Fatalf("unexpected template output %q", stdout)
+	}
+}
+
+func TestPrintOutputTemplateFromFileWithFuncs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.tmpl")
+	if err := os.WriteFile(path, []byte(`{{join ", " .Names}}|{{default "none" .Missing}}|{{default "-" .Count}}|{{json .Names}}`+"\n"), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+	setOutputTemplate(t, "@"+path)
+
+	count := 3
+	data := struct {
+		Names   []string
+		Missing *string
+		Count   *int
+	}{Names: []string{"a", "b"}, Count: &count}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(data, "template", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout != "a, b|none|3|[\"a\",\"b\"]\n" {
+		t.Fatalf("unexpected template output %q", stdout)
+	}
+}
+
+func TestPrintOutputTemplateMissingFieldErrors(t *testing.T) {
+	setOutputTemplate(t, `{{.Nope}}`)
+
+	err := printOutput(&asc.AppsResponse{}, "template", false)
+	if err == nil || !strings.Contains(err.Error(), "can't evaluate field Nope") {
+		t.Fatalf("expected missing field error, got %v", err)
+	}
+}
+
+func TestPrintOutputTemplateRequiresTemplate(t *testing.T) {
+	setOutputTemplate(t, "")
+
+	err := printOutput(&asc.AppsResponse{}, "template", false)
+	if err == nil || !strings.Contains(err.Error(), "--template is required with --output template") {
+		t.Fatalf("expected missing template error, got %v", err)
+	}
+}
+
+func TestBindTemplateFlagsOnlyAddsToStandardOutputFlag(t *testing.T) {
+	standard := flag.NewFlagSet("list", flag.ContinueOnError)
+	standard.String("output", "json", outputFormatUsage)
+	custom := flag.NewFlagSet("whoami", flag.ContinueOnError)
+	custom.String("output", "text", "Output format: text (default), json")
+
+	root := &ffcli.Command{
+		Name:        "asc",
+		FlagSet:     flag.NewFlagSet("asc", flag.ContinueOnError),
+		Subcommands: []*ffcli.Command{{Name: "list", FlagSet: standard}, {Name: "whoami", FlagSet: custom}},
+	}
+	BindTemplateFlags(root)
+
+	if standard.Lookup("template") == nil {
+		t.Fatal("expected --template on command with standard --output flag")
+	}
+	if custom.Lookup("template") != nil || root.FlagSet.Lookup("template") != nil {
+		t.Fatal("expected --template only next to the standard --output flag")
+	}
+}
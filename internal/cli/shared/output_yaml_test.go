@@ -0,0 +1,18 @@
+package shared
+
+import "testing"
+
+func TestPrintOutputYAML(t *testing.T) {
+	data := map[string]any{"data": map[string]any{"id": "app-1", "name": "Demo"}}
+
+	for _, pretty := range []bool{false, true} {
+		stdout, _ := captureOutput(t, func() {
+			if err := printOutput(data, "yaml", pretty); err != nil {
+				t.Fatalf("printOutput() error: %v", err)
+			}
+		})
+		if stdout != "data:\n  id: app-1\n  name: Demo\n" {
+			t.Fatalf("unexpected yaml (pretty=%t): %q", pretty, stdout)
+		}
+	}
+}
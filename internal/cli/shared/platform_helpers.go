@@ -1,7 +1,6 @@
 package shared
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
@@ -18,11 +17,11 @@ var platformValues = map[string]asc.Platform{
 func NormalizePlatform(value string) (asc.Platform, error) {
 	normalized := strings.ToUpper(strings.TrimSpace(value))
 	if normalized == "" {
-		return "", fmt.Errorf("--platform is required")
+		return "", UsageErrorf("--platform is required")
 	}
 	platform, ok := platformValues[normalized]
 	if !ok {
-		return "", fmt.Errorf("--platform must be one of: %s", strings.Join(platformList(), ", "))
+		return "", UsageErrorf("--platform must be one of: %s", strings.Join(platformList(), ", "))
 	}
 	return platform, nil
 }
@@ -39,7 +38,7 @@ func NormalizePlatforms(values []string) ([]string, error) {
 			continue
 		}
 		if _, ok := platformValues[trimmed]; !ok {
-			return nil, fmt.Errorf("--platform must be one of: %s", strings.Join(platformList(), ", "))
+			return nil, UsageErrorf("--platform must be one of: %s", strings.Join(platformList(), ", "))
 		}
 		normalized = append(normalized, trimmed)
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -35,7 +34,7 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 	pricePointID := fs.String("price-point", "", "App price point ID")
 	baseTerritory := fs.String("base-territory", "", "Base territory ID (e.g., USA)")
 	startDate := fs.String("start-date", "", config.StartDateHelp)
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -48,19 +47,16 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return FlagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			pricePointValue := strings.TrimSpace(*pricePointID)
 			if pricePointValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --price-point is required")
-				return flag.ErrHelp
+				return FlagErrorf("--price-point is required")
 			}
 
 			baseTerritoryValue := strings.TrimSpace(*baseTerritory)
 			if config.RequireBaseTerritory && baseTerritoryValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --base-territory is required")
-				return flag.ErrHelp
+				return FlagErrorf("--base-territory is required")
 			}
 
 			startDateValue := strings.TrimSpace(*startDate)
@@ -68,8 +64,7 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 				if config.StartDateDefaultToday {
 					startDateValue = time.Now().Format("2006-01-02")
 				} else {
-					fmt.Fprintln(os.Stderr, "Error: --start-date is required")
-					return flag.ErrHelp
+					return FlagErrorf("--start-date is required")
 				}
 			}
 
@@ -111,11 +106,11 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 func normalizePricingStartDate(value string) (string, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
-		return "", fmt.Errorf("--start-date is required")
+		return "", UsageErrorf("--start-date is required")
 	}
 	parsed, err := time.Parse("2006-01-02", trimmed)
 	if err != nil {
-		return "", fmt.Errorf("--start-date must be in YYYY-MM-DD format")
+		return "", UsageErrorf("--start-date must be in YYYY-MM-DD format")
 	}
 	return parsed.Format("2006-01-02"), nil
 }
@@ -129,7 +124,7 @@ func resolveBaseTerritoryID(ctx context.Context, client *asc.Client, appID strin
 	schedule, err := client.GetAppPriceSchedule(ctx, appID)
 	if err != nil {
 		if asc.IsNotFound(err) {
-			return "", fmt.Errorf("--base-territory is required when app price schedule is missing")
+			return "", UsageErrorf("--base-territory is required when app price schedule is missing")
 		}
 		return "", fmt.Errorf("get app price schedule: %w", err)
 	}
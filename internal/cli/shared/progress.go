@@ -0,0 +1,84 @@
+package shared
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// progressInterval limits how often the progress line is redrawn.
+const progressInterval = 200 * time.Millisecond
+
+// ProgressReader wraps r so reads report progress on stderr as a percentage
+// of total plus the transfer rate. r is returned unchanged when progress is
+// disabled (stderr is not a terminal) or total is unknown.
+func ProgressReader(r io.Reader, total int64, label string) io.Reader {
+	if total <= 0 || !ProgressEnabled() {
+		return r
+	}
+	return newProgressReader(r, os.Stderr, total, label, time.Now)
+}
+
+type progressReader struct {
+	reader  io.Reader
+	out     io.Writer
+	label   string
+	total   int64
+	read    int64
+	now     func() time.Time
+	started time.Time
+	drawn   time.Time
+	done    bool
+}
+
+func newProgressReader(r io.Reader, out io.Writer, total int64, label string, now func() time.Time) *progressReader {
+	started := now()
+	return &progressReader{reader: r, out: out, label: label, total: total, now: now, started: started}
+}
+
+func (p *progressReader) Read(buf []byte) (int, error) {
+	n, err := p.reader.Read(buf)
+	p.read += int64(n)
+	if p.done {
+		return n, err
+	}
+
+	finished := err == io.EOF || (err == nil && p.read >= p.total)
+	current := p.now()
+	if finished || current.Sub(p.drawn) >= progressInterval {
+		p.drawn = current
+		p.draw(current)
+	}
+	if finished || (err != nil && err != io.EOF) {
+		p.done = true
+		fmt.Fprintln(p.out)
+	}
+	return n, err
+}
+
+func (p *progressReader) draw(current time.Time) {
+	percent := float64(p.read) * 100 / float64(p.total)
+	if percent > 100 {
+		percent = 100
+	}
+	rate := float64(0)
+	if elapsed := current.Sub(p.started).Seconds(); elapsed > 0 {
+		rate = float64(p.read) / elapsed
+	}
+	fmt.Fprintf(p.out, "\r%s %3.0f%% (%s / %s, %s/s)", p.label, percent, formatByteSize(float64(p.read)), formatByteSize(float64(p.total)), formatByteSize(rate))
+}
+
+// formatByteSize renders a byte count with a binary unit (e.g. "1.5 MiB").
+func formatByteSize(size float64) string {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	unit := 0
+	for size >= 1024 && unit < len(units)-1 {
+		size /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%.0f %s", size, units[unit])
+	}
+	return fmt.Sprintf("%.1f %s", size, units[unit])
+}
@@ -0,0 +1,73 @@
+package shared
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProgressReaderReportsPercentAndRate(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time {
+		clock = clock.Add(time.Second)
+		return clock
+	}
+
+	var out bytes.Buffer
+	data := strings.Repeat("x", 2048)
+	reader := newProgressReader(strings.NewReader(data), &out, int64(len(data)), "App.xcarchive.zip", now)
+
+	copied, err := io.Copy(io.Discard, io.LimitReader(reader, 1024))
+	if err != nil || copied != 1024 {
+		t.Fatalf("first copy = %d, %v", copied, err)
+	}
+	if !strings.Contains(out.String(), "App.xcarchive.zip  50% (1.0 KiB / 2.0 KiB, 1.0 KiB/s)") {
+		t.Fatalf("unexpected progress line %q", out.String())
+	}
+
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		t.Fatalf("copy error: %v", err)
+	}
+	final := out.String()
+	if !strings.Contains(final, "100% (2.0 KiB / 2.0 KiB") {
+		t.Fatalf("expected final 100%% line, got %q", final)
+	}
+	if strings.Count(final, "\n") != 1 || !strings.HasSuffix(final, "\n") {
+		t.Fatalf("expected a single trailing newline, got %q", final)
+	}
+}
+
+func TestProgressReaderPassThroughWhenSizeUnknown(t *testing.T) {
+	source := strings.NewReader("data")
+	if got := ProgressReader(source, 0, "artifact"); got != io.Reader(source) {
+		t.Fatalf("expected reader to be returned unchanged for unknown size")
+	}
+}
+
+func TestProgressReaderPassThroughWhenDisabled(t *testing.T) {
+	SetNoProgress(true)
+	t.Cleanup(func() { SetNoProgress(false) })
+
+	source := strings.NewReader("data")
+	if got := ProgressReader(source, 4, "artifact"); got != io.Reader(source) {
+		t.Fatalf("expected reader to be returned unchanged when progress is disabled")
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	tests := map[float64]string{
+		0:                "0 B",
+		512:              "512 B",
+		1536:             "1.5 KiB",
+		5 * 1024 * 1024:  "5.0 MiB",
+		3 * (1 << 30):    "3.0 GiB",
+		2048 * (1 << 40): "2048.0 TiB",
+	}
+	for size, want := range tests {
+		if got := formatByteSize(size); got != want {
+			t.Fatalf("formatByteSize(%v) = %q, want %q", size, got, want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+)
+
+var noResult bool
+
+// actionResultTypePattern matches the result types of action-style commands
+// (e.g. BuildUploadResult, CiArtifactDownloadResult, AppDeleteResult).
+var actionResultTypePattern = regexp.MustCompile(`(Delete|Upload|Download)[A-Za-z]*Result$`)
+
+// SetNoResult sets --no-result (tests only).
+func SetNoResult(value bool) {
+	noResult = value
+}
+
+// suppressResult reports whether data is an action result that --no-result
+// keeps off stdout. Errors are unaffected and still reach stderr.
+func suppressResult(data interface{}) bool {
+	if !noResult || data == nil {
+		return false
+	}
+	return isActionResult(data)
+}
+
+func isActionResult(data interface{}) bool {
+	t := reflect.TypeOf(data)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return actionResultTypePattern.MatchString(t.Name())
+}
+
+// validateOutputFormat applies printOutput's format checks without printing,
+// so a suppressed result still rejects a bad --output or --pretty.
+func validateOutputFormat(format string, pretty bool) error {
+	if selectPath != "" && format != "json" {
+		return UsageErrorf("--select can only be used with --output json")
+	}
+	switch format {
+	case "json", "yaml", "yml":
+		return nil
+	case "markdown", "md", "table", "jsonl", "template":
+		if pretty {
+			return UsageErrorf("--pretty is only valid with JSON output")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
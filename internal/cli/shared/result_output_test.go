@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestIsActionResult(t *testing.T) {
+	tests := []struct {
+		data interface{}
+		want bool
+	}{
+		{data: &asc.BuildUploadResult{}, want: true},
+		{data: &asc.CiArtifactDownloadResult{}, want: true},
+		{data: &asc.GameCenterAchievementDeleteResult{}, want: true},
+		{data: &asc.LocalizationUploadLocaleResult{}, want: true},
+		{data: &asc.AppsResponse{}, want: false},
+		{data: &asc.GameCenterAchievementCreateResult{}, want: false},
+		{data: map[string]string{}, want: false},
+	}
+	for _, test := range tests {
+		if got := isActionResult(test.data); got != test.want {
+			t.Fatalf("isActionResult(%T) = %v, want %v", test.data, got, test.want)
+		}
+	}
+}
+
+func TestPrintOutputNoResult(t *testing.T) {
+	SetNoResult(true)
+	t.Cleanup(func() { SetNoResult(false) })
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(&asc.GameCenterAchievementDeleteResult{ID: "ach-1", Deleted: true}, "table", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout != "" {
+		t.Fatalf("expected no output, got %q", stdout)
+	}
+
+	if err := printOutput(&asc.GameCenterAchievementDeleteResult{ID: "ach-1"}, "xml", false); err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Fatalf("expected unsupported format error, got %v", err)
+	}
+
+	stdout, _ = captureOutput(t, func() {
+		if err := printOutput(&asc.GameCenterAchievementCreateResult{}, "json", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout == "" {
+		t.Fatal("expected non-action results to still print")
+	}
+}
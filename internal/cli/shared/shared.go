@@ -42,6 +42,10 @@ const (
 
 var ErrMissingAuth = errors.New("missing authentication")
 
+// credentialPrecedence documents where credentials are looked up, for the
+// missing authentication error.
+const credentialPrecedence = "Credentials are resolved from flags (--profile), then ASC_KEY_ID/ASC_ISSUER_ID/ASC_PRIVATE_KEY_PATH, then the config file (--config, ASC_CONFIG_PATH, ./.asc/config.json, ~/.asc/config.json, or ~/.config/asc/config.toml)"
+
 type missingAuthError struct {
 	msg string
 }
@@ -61,16 +65,53 @@ var (
 	selectedProfile     string
 	strictAuth          bool
 	retryLog            OptionalBool
+	dryRun              bool
+	verbose             bool
+	recordDir           string
+	replayDir           string
+	configCheck         bool
+	logFormat           string
 )
 
 var isTerminal = term.IsTerminal
 var noProgress bool
+var noColor bool
 
 // BindRootFlags registers root-level flags that affect shared CLI behavior.
 func BindRootFlags(fs *flag.FlagSet) {
+	_ = config.SetPathOverride("")
+	fs.Func("config", "Config file to read credentials and defaults from (.json or .toml; overrides ASC_CONFIG_PATH)", config.SetPathOverride)
 	fs.StringVar(&selectedProfile, "profile", "", "Use named authentication profile")
 	fs.BoolVar(&strictAuth, "strict-auth", false, "Fail when credentials are resolved from multiple sources")
 	fs.Var(&retryLog, "retry-log", "Enable retry logging to stderr (overrides ASC_RETRY_LOG/config when set)")
+	asc.SetTimeoutOverride(0)
+	fs.Func("timeout", "Cap each command's API calls at this duration, e.g. 90s or 5m (overrides ASC_TIMEOUT, ASC_UPLOAD_TIMEOUT, and config)", setTimeoutFlag)
+	fs.BoolVar(&dryRun, "dry-run", false, "Print create/update/delete requests to stderr instead of sending them")
+	fs.BoolVar(&verbose, "verbose", false, "Log each API request's method, path, status, duration, and rate-limit header to stderr")
+	fs.StringVar(&recordDir, "record", "", "Write each API request and response to a JSON file in this directory (credentials stripped)")
+	fs.StringVar(&replayDir, "replay", "", "Serve API responses from files written by --record instead of the network")
+	fs.BoolVar(&configCheck, "config-check", false, "Warn about unrecognized or misspelled ASC_* environment variables")
+	fs.StringVar(&logFormat, "log-format", "", "Diagnostic log format on stderr: text (default) or json (or ASC_LOG_FORMAT env)")
+	fs.BoolVar(&envelopeOutput, "envelope", false, "Wrap JSON output in a versioned {apiVersion, command, data} envelope")
+	fs.BoolVar(&strictValidation, "strict-validation", false, "Reject --file JSON payloads that contain unknown keys")
+	fs.BoolVar(&compactArrays, "compact-arrays", false, "Collapse single-element relationship data arrays in JSON output")
+	fs.BoolVar(&compactArrays, "flatten-single", false, "Alias for --compact-arrays")
+	fs.BoolVar(&noResult, "no-result", false, "Print nothing on success for download, upload, and delete commands; rely on the exit code")
+	fs.BoolVar(&noColor, "no-color", false, "Disable colored output (or set NO_COLOR)")
+	fs.StringVar(&selectPath, "select", "", "Print only the value at a dotted JSON path, e.g. data.id or data.attributes.name (JSON output only)")
+}
+
+// setTimeoutFlag parses the global --timeout flag.
+func setTimeoutFlag(value string) error {
+	timeout, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return fmt.Errorf("must be a duration such as 90s or 5m")
+	}
+	if timeout < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	asc.SetTimeoutOverride(timeout)
+	return nil
 }
 
 // SelectedProfile returns the current profile override.
@@ -112,13 +153,30 @@ func Bold(s string) string {
 }
 
 func supportsANSI() bool {
+	return ansiAllowed(os.Stderr)
+}
+
+// StdoutIsTerminal reports whether stdout is an interactive terminal.
+func StdoutIsTerminal() bool {
+	return isTerminal(int(os.Stdout.Fd()))
+}
+
+// StdoutSupportsANSI reports whether ANSI styling may be written to stdout.
+func StdoutSupportsANSI() bool {
+	return ansiAllowed(os.Stdout)
+}
+
+func ansiAllowed(f *os.File) bool {
+	if noColor {
+		return false
+	}
 	if _, ok := os.LookupEnv("NO_COLOR"); ok {
 		return false
 	}
 	if strings.EqualFold(os.Getenv("TERM"), "dumb") {
 		return false
 	}
-	return isTerminal(int(os.Stderr.Fd()))
+	return isTerminal(int(f.Fd()))
 }
 
 // DefaultUsageFunc returns a usage string with bold section headers
@@ -275,6 +333,22 @@ func resolveCredentials() (resolvedCredentials, error) {
 
 	// Priority 1: Stored credentials (keychain/config)
 	cfg, storedSource, err := auth.GetCredentialsWithSource(profile)
+	if err == nil && storedSource == "config" && profile == "" && !envResolved {
+		// Complete env credentials take precedence over the config file.
+		resolved, err := resolveEnvCredentials()
+		if err != nil {
+			return resolvedCredentials{}, fmt.Errorf("invalid private key environment: %w", err)
+		}
+		envCreds = resolved
+		envResolved = true
+		if envCreds.complete {
+			return resolvedCredentials{
+				keyID:    envCreds.keyID,
+				issuerID: envCreds.issuerID,
+				keyPath:  envCreds.keyPath,
+			}, nil
+		}
+	}
 	if err != nil {
 		if profile != "" {
 			return resolvedCredentials{}, err
@@ -313,9 +387,9 @@ func resolveCredentials() (resolvedCredentials, error) {
 
 	if actualKeyID == "" || actualIssuerID == "" || actualKeyPath == "" {
 		if path, err := config.Path(); err == nil {
-			return resolvedCredentials{}, missingAuthError{msg: fmt.Sprintf("missing authentication. Run 'asc auth login' or create %s (see 'asc auth init')", path)}
+			return resolvedCredentials{}, missingAuthError{msg: fmt.Sprintf("missing authentication. Run 'asc auth login' or create %s (see 'asc auth init'). %s", path, credentialPrecedence)}
 		}
-		return resolvedCredentials{}, missingAuthError{msg: "missing authentication. Run 'asc auth login' or 'asc auth init'"}
+		return resolvedCredentials{}, missingAuthError{msg: "missing authentication. Run 'asc auth login' or 'asc auth init'. " + credentialPrecedence}
 	}
 	if err := checkMixedCredentialSources(sources); err != nil {
 		return resolvedCredentials{}, err
@@ -329,8 +403,22 @@ func resolveCredentials() (resolvedCredentials, error) {
 }
 
 func getASCClient() (*asc.Client, error) {
+	recordValue := strings.TrimSpace(recordDir)
+	replayValue := strings.TrimSpace(replayDir)
+	if recordValue != "" && replayValue != "" {
+		return nil, UsageErrorf("--record and --replay cannot be used together")
+	}
+	asc.SetRecordDir(recordValue)
+	asc.SetReplayDir(replayValue)
+
 	resolved, err := resolveCredentials()
 	if err != nil {
+		// Replay never reaches the API, so it works without credentials.
+		if replayValue != "" && errors.Is(err, ErrMissingAuth) {
+			asc.SetDryRun(dryRun)
+			asc.SetVerbose(verbose)
+			return asc.NewReplayClient(), nil
+		}
 		return nil, err
 	}
 	if retryLog.IsSet() {
@@ -339,6 +427,8 @@ func getASCClient() (*asc.Client, error) {
 	} else {
 		asc.SetRetryLogOverride(nil)
 	}
+	asc.SetDryRun(dryRun)
+	asc.SetVerbose(verbose)
 	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
 }
 
@@ -353,16 +443,15 @@ func checkMixedCredentialSources(sources credentialSource) error {
 		return nil
 	}
 
-	message := fmt.Sprintf(
-		"Warning: credentials loaded from multiple sources:\n  Key ID: %s\n  Issuer ID: %s\n  Private Key: %s\n",
+	if strictAuthEnabled() {
+		return fmt.Errorf("mixed authentication sources detected:\n  Key ID: %s\n  Issuer ID: %s\n  Private Key: %s", keyIDSource, issuerSource, keyPathSource)
+	}
+	Warnf(
+		"credentials loaded from multiple sources:\n  Key ID: %s\n  Issuer ID: %s\n  Private Key: %s",
 		keyIDSource,
 		issuerSource,
 		keyPathSource,
 	)
-	if strictAuthEnabled() {
-		return fmt.Errorf("mixed authentication sources detected:\n  Key ID: %s\n  Issuer ID: %s\n  Private Key: %s", keyIDSource, issuerSource, keyPathSource)
-	}
-	fmt.Fprint(os.Stderr, message)
 	return nil
 }
 
@@ -378,10 +467,17 @@ func resolvePrivateKeyPath() (string, error) {
 		if err != nil {
 			return "", fmt.Errorf("%s: %w", privateKeyBase64EnvVar, err)
 		}
+		if _, err := auth.ParsePrivateKey(decoded); err != nil {
+			return "", fmt.Errorf("%s: %w", privateKeyBase64EnvVar, err)
+		}
 		return writeTempPrivateKey(decoded)
 	}
 	if value := strings.TrimSpace(os.Getenv(privateKeyEnvVar)); value != "" {
-		return writeTempPrivateKey([]byte(normalizePrivateKeyValue(value)))
+		data := []byte(normalizePrivateKeyValue(value))
+		if _, err := auth.ParsePrivateKey(data); err != nil {
+			return "", fmt.Errorf("%s: %w", privateKeyEnvVar, err)
+		}
+		return writeTempPrivateKey(data)
 	}
 	return "", nil
 }
@@ -475,27 +571,84 @@ func strictAuthEnabled() bool {
 
 func printOutput(data interface{}, format string, pretty bool) error {
 	format = strings.ToLower(format)
+	if suppressResult(data) {
+		return validateOutputFormat(format, pretty)
+	}
+	if selectPath != "" {
+		if err := validateOutputFormat(format, pretty); err != nil {
+			return err
+		}
+		return printSelected(data, pretty)
+	}
 	switch format {
 	case "json":
+		compacted, err := withCompactArrays(data)
+		if err != nil {
+			return err
+		}
+		data = withOutputEnvelope(compacted)
 		if pretty {
 			return asc.PrintPrettyJSON(data)
 		}
 		return asc.PrintJSON(data)
+	case "yaml", "yml":
+		// YAML is always readable, so --pretty has nothing to do.
+		compacted, err := withCompactArrays(data)
+		if err != nil {
+			return err
+		}
+		return asc.PrintYAML(withOutputEnvelope(compacted))
+	case "jsonl":
+		if pretty {
+			return UsageErrorf("--pretty is only valid with JSON output")
+		}
+		return printJSONLines(data)
 	case "markdown", "md":
 		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
+			return UsageErrorf("--pretty is only valid with JSON output")
+		}
+		if err := asc.PrintMarkdown(data); err != nil {
+			return err
 		}
-		return asc.PrintMarkdown(data)
+		return printPaginationFooter(data)
 	case "table":
 		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
+			return UsageErrorf("--pretty is only valid with JSON output")
+		}
+		// Color status columns only on a terminal, never when piped.
+		asc.SetTableColor(StdoutSupportsANSI())
+		if err := asc.PrintTable(data); err != nil {
+			return err
+		}
+		return printPaginationFooter(data)
+	case "template":
+		if pretty {
+			return UsageErrorf("--pretty is only valid with JSON output")
 		}
-		return asc.PrintTable(data)
+		return printTemplate(data)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
 }
 
+// printPaginationFooter notes how many items and pages a --paginate response
+// aggregated, below its table or markdown output.
+func printPaginationFooter(data interface{}) error {
+	meta, ok := asc.PaginationMetaFor(data)
+	if !ok {
+		return nil
+	}
+	items, pages := "items", "pages"
+	if meta.Items == 1 {
+		items = "item"
+	}
+	if meta.Pages == 1 {
+		pages = "page"
+	}
+	_, err := fmt.Fprintf(os.Stdout, "\nFetched %d %s across %d %s\n", meta.Items, items, meta.Pages, pages)
+	return err
+}
+
 func normalizeDate(value, flagName string) (string, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -607,7 +760,7 @@ func validateNextURL(next string) error {
 		return fmt.Errorf("--next must be a valid URL: %w", err)
 	}
 	if parsed.Scheme != "https" || parsed.Host != "api.appstoreconnect.apple.com" {
-		return fmt.Errorf("--next must be an App Store Connect URL")
+		return UsageErrorf("--next must be an App Store Connect URL")
 	}
 	return nil
 }
@@ -622,7 +775,7 @@ func validateSort(value string, allowed ...string) error {
 			return nil
 		}
 	}
-	return fmt.Errorf("--sort must be one of: %s", strings.Join(allowed, ", "))
+	return UsageErrorf("--sort must be one of: %s", strings.Join(allowed, ", "))
 }
 
 // Exported wrappers for shared helpers.
@@ -634,6 +787,16 @@ func ResolveProfileName() string {
 	return resolveProfileName()
 }
 
+// ResolveCredentials returns the key ID, issuer ID, and private key path that
+// API commands authenticate with.
+func ResolveCredentials() (keyID, issuerID, keyPath string, err error) {
+	resolved, err := resolveCredentials()
+	if err != nil {
+		return "", "", "", err
+	}
+	return resolved.keyID, resolved.issuerID, resolved.keyPath, nil
+}
+
 func ResolvePrivateKeyPath() (string, error) {
 	return resolvePrivateKeyPath()
 }
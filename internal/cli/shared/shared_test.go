@@ -13,7 +13,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
 )
 
@@ -121,6 +123,76 @@ func TestProgressEnabled_EnabledWhenTTYAndNotDisabled(t *testing.T) {
 	}
 }
 
+func TestStdoutSupportsANSI_RespectsNoColor(t *testing.T) {
+	prevNoColor := noColor
+	prevIsTerminal := isTerminal
+	t.Cleanup(func() {
+		noColor = prevNoColor
+		isTerminal = prevIsTerminal
+	})
+
+	isTerminal = func(int) bool { return true }
+	t.Setenv("TERM", "xterm-256color")
+
+	noColor = false
+	if !StdoutSupportsANSI() {
+		t.Fatal("expected ANSI on a terminal")
+	}
+
+	noColor = true
+	if StdoutSupportsANSI() {
+		t.Fatal("expected ANSI to be disabled by --no-color")
+	}
+
+	noColor = false
+	t.Setenv("NO_COLOR", "1")
+	if StdoutSupportsANSI() {
+		t.Fatal("expected ANSI to be disabled by NO_COLOR")
+	}
+}
+
+func TestPrintOutputTableNoColorWhenPiped(t *testing.T) {
+	prevIsTerminal := isTerminal
+	t.Cleanup(func() {
+		isTerminal = prevIsTerminal
+		asc.SetTableColor(false)
+	})
+	isTerminal = func(int) bool { return false }
+
+	resp := &asc.CiBuildRunsResponse{Data: []asc.CiBuildRunResource{{
+		ID:         "run-1",
+		Attributes: asc.CiBuildRunAttributes{CompletionStatus: asc.CiBuildRunCompletionStatusFailed},
+	}}}
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(resp, "table", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+
+	if strings.Contains(stdout, "\033[") {
+		t.Fatalf("expected no ANSI codes when stdout is not a terminal, got %q", stdout)
+	}
+}
+
+func TestSetTimeoutFlag(t *testing.T) {
+	t.Cleanup(func() { asc.SetTimeoutOverride(0) })
+
+	if err := setTimeoutFlag("90s"); err != nil {
+		t.Fatalf("setTimeoutFlag() error: %v", err)
+	}
+	if got := asc.TimeoutOverride(); got != 90*time.Second {
+		t.Fatalf("expected 90s override, got %v", got)
+	}
+	for _, value := range []string{"-5s", "soon", ""} {
+		if err := setTimeoutFlag(value); err == nil {
+			t.Fatalf("expected %q to be rejected", value)
+		}
+	}
+	if got := asc.TimeoutOverride(); got != 90*time.Second {
+		t.Fatalf("expected rejected values to keep the previous override, got %v", got)
+	}
+}
+
 func TestResolvePrivateKeyPathPrefersPath(t *testing.T) {
 	resetPrivateKeyTemp(t)
 	t.Setenv("ASC_PRIVATE_KEY_PATH", "/tmp/AuthKey.p8")
@@ -141,7 +213,8 @@ func TestResolvePrivateKeyPathFromBase64(t *testing.T) {
 	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
 	t.Setenv("ASC_PRIVATE_KEY", "")
 
-	encoded := base64.StdEncoding.EncodeToString([]byte("key-data"))
+	keyData := ecdsaPEM(t)
+	encoded := base64.StdEncoding.EncodeToString(keyData)
 	t.Setenv("ASC_PRIVATE_KEY_B64", encoded)
 
 	path, err := resolvePrivateKeyPath()
@@ -152,8 +225,8 @@ func TestResolvePrivateKeyPathFromBase64(t *testing.T) {
 	if err != nil {
 		t.Fatalf("ReadFile() error: %v", err)
 	}
-	if string(data) != "key-data" {
-		t.Fatalf("expected key data %q, got %q", "key-data", string(data))
+	if !bytes.Equal(data, keyData) {
+		t.Fatalf("expected key data %q, got %q", string(keyData), string(data))
 	}
 	info, err := os.Stat(path)
 	if err != nil {
@@ -169,7 +242,8 @@ func TestResolvePrivateKeyPathFromRawValue(t *testing.T) {
 	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
 	t.Setenv("ASC_PRIVATE_KEY_B64", "")
 
-	t.Setenv("ASC_PRIVATE_KEY", "line1\\nline2")
+	keyData := ecdsaPEM(t)
+	t.Setenv("ASC_PRIVATE_KEY", strings.ReplaceAll(string(keyData), "\n", "\\n"))
 	path, err := resolvePrivateKeyPath()
 	if err != nil {
 		t.Fatalf("resolvePrivateKeyPath() error: %v", err)
@@ -178,17 +252,50 @@ func TestResolvePrivateKeyPathFromRawValue(t *testing.T) {
 	if err != nil {
 		t.Fatalf("ReadFile() error: %v", err)
 	}
-	if string(data) != "line1\nline2" {
+	if !bytes.Equal(data, keyData) {
 		t.Fatalf("expected newline expansion, got %q", string(data))
 	}
 }
 
+func TestResolvePrivateKeyPathInvalidRawValue(t *testing.T) {
+	resetPrivateKeyTemp(t)
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_PRIVATE_KEY_B64", "")
+	t.Setenv("ASC_PRIVATE_KEY", "not-a-key")
+
+	path, err := resolvePrivateKeyPath()
+	if err == nil {
+		t.Fatal("expected error for invalid private key")
+	}
+	if !strings.Contains(err.Error(), "ASC_PRIVATE_KEY: invalid PEM data") {
+		t.Fatalf("expected invalid PEM error, got %v", err)
+	}
+	if path != "" || privateKeyTempPath != "" {
+		t.Fatalf("expected no temp key file, got %q", privateKeyTempPath)
+	}
+}
+
+func TestResolvePrivateKeyPathInvalidBase64Key(t *testing.T) {
+	resetPrivateKeyTemp(t)
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_PRIVATE_KEY", "")
+	t.Setenv("ASC_PRIVATE_KEY_B64", base64.StdEncoding.EncodeToString([]byte("key-data")))
+
+	_, err := resolvePrivateKeyPath()
+	if err == nil {
+		t.Fatal("expected error for invalid private key")
+	}
+	if !strings.Contains(err.Error(), "ASC_PRIVATE_KEY_B64: invalid PEM data") {
+		t.Fatalf("expected invalid PEM error, got %v", err)
+	}
+}
+
 func TestCleanupTempPrivateKeysRemovesFile(t *testing.T) {
 	resetPrivateKeyTemp(t)
 	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
 	t.Setenv("ASC_PRIVATE_KEY", "")
 
-	encoded := base64.StdEncoding.EncodeToString([]byte("key-data"))
+	encoded := base64.StdEncoding.EncodeToString(ecdsaPEM(t))
 	t.Setenv("ASC_PRIVATE_KEY_B64", encoded)
 
 	path, err := resolvePrivateKeyPath()
@@ -369,6 +476,14 @@ func resetPrivateKeyTemp(t *testing.T) {
 func writeECDSAPEM(t *testing.T, path string) {
 	t.Helper()
 
+	if err := os.WriteFile(path, ecdsaPEM(t), 0o600); err != nil {
+		t.Fatalf("write key file error: %v", err)
+	}
+}
+
+func ecdsaPEM(t *testing.T) []byte {
+	t.Helper()
+
 	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
 		t.Fatalf("GenerateKey() error: %v", err)
@@ -381,9 +496,7 @@ func writeECDSAPEM(t *testing.T, path string) {
 	if data == nil {
 		t.Fatal("failed to encode PEM")
 	}
-	if err := os.WriteFile(path, data, 0o600); err != nil {
-		t.Fatalf("write key file error: %v", err)
-	}
+	return data
 }
 
 func TestProgressEnabled_DisabledByFlag(t *testing.T) {
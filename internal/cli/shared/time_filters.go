@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseRelativeTime parses a cutoff expressed as a date (YYYY-MM-DD), an
+// RFC3339 timestamp, or a relative duration (e.g. 12h, 7d, 2w, 3m) measured
+// back from now.
+func ParseRelativeTime(value, flagName string, now time.Time) (time.Time, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return time.Time{}, fmt.Errorf("%s must not be empty", flagName)
+	}
+	if parsed, err := time.Parse("2006-01-02", trimmed); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, trimmed); err == nil {
+		return parsed, nil
+	}
+	duration, err := parseRelativeDuration(trimmed, flagName)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return now.Add(-duration), nil
+}
+
+func parseRelativeDuration(value, flagName string) (time.Duration, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(value))
+	invalid := fmt.Errorf("%s must be a duration like 90d, 2w, or 3m", flagName)
+	if len(trimmed) < 2 {
+		return 0, invalid
+	}
+	unit := trimmed[len(trimmed)-1]
+	number := strings.TrimSpace(trimmed[:len(trimmed)-1])
+	valueInt, err := strconv.Atoi(number)
+	if err != nil || valueInt <= 0 {
+		return 0, invalid
+	}
+
+	switch unit {
+	case 'h':
+		return time.Duration(valueInt) * time.Hour, nil
+	case 'd':
+		return time.Duration(valueInt) * 24 * time.Hour, nil
+	case 'w':
+		return time.Duration(valueInt) * 7 * 24 * time.Hour, nil
+	case 'm':
+		return time.Duration(valueInt) * 30 * 24 * time.Hour, nil
+	default:
+		return 0, invalid
+	}
+}
+
+// ParseTimestamp parses an App Store Connect RFC3339 timestamp.
+func ParseTimestamp(value string) (time.Time, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return time.Time{}, fmt.Errorf("timestamp is empty")
+	}
+	if parsed, err := time.Parse(time.RFC3339, trimmed); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339Nano, trimmed); err == nil {
+		return parsed, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", trimmed)
+}
+
+// CreatedOnOrAfter reports whether timestamp is at or after cutoff.
+// Missing or unparseable timestamps never match.
+func CreatedOnOrAfter(timestamp string, cutoff time.Time) bool {
+	parsed, err := ParseTimestamp(timestamp)
+	if err != nil {
+		return false
+	}
+	return !parsed.Before(cutoff)
+}
+
+// ParseRFC3339Flag parses a flag value given as an RFC3339 timestamp.
+func ParseRFC3339Flag(value, flagName string) (time.Time, error) {
+	trimmed := strings.TrimSpace(value)
+	if parsed, err := time.Parse(time.RFC3339, trimmed); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339Nano, trimmed); err == nil {
+		return parsed, nil
+	}
+	return time.Time{}, fmt.Errorf("%s must be in RFC3339 format (e.g., 2026-02-01T08:00:00Z)", flagName)
+}
@@ -0,0 +1,74 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRelativeTime(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Time
+	}{
+		{name: "hours", value: "12h", want: now.Add(-12 * time.Hour)},
+		{name: "days", value: "7d", want: now.Add(-7 * 24 * time.Hour)},
+		{name: "weeks", value: "2W", want: now.Add(-14 * 24 * time.Hour)},
+		{name: "months", value: "1m", want: now.Add(-30 * 24 * time.Hour)},
+		{name: "date", value: "2026-03-01", want: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "rfc3339", value: "2026-03-01T08:30:00Z", want: time.Date(2026, 3, 1, 8, 30, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseRelativeTime(test.value, "--since", now)
+			if err != nil {
+				t.Fatalf("ParseRelativeTime() error: %v", err)
+			}
+			if !got.Equal(test.want) {
+				t.Fatalf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseRelativeTimeInvalid(t *testing.T) {
+	now := time.Now()
+	for _, value := range []string{"", "d", "0d", "-3d", "7y", "abc"} {
+		_, err := ParseRelativeTime(value, "--since", now)
+		if err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+		if !strings.Contains(err.Error(), "--since") {
+			t.Fatalf("expected flag name in error, got %v", err)
+		}
+	}
+}
+
+func TestCreatedOnOrAfter(t *testing.T) {
+	cutoff := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		timestamp string
+		want      bool
+	}{
+		{name: "before cutoff", timestamp: "2026-02-28T23:59:59Z", want: false},
+		{name: "exactly at cutoff", timestamp: "2026-03-01T00:00:00Z", want: true},
+		{name: "after cutoff", timestamp: "2026-03-01T00:00:01.5Z", want: true},
+		{name: "offset timezone", timestamp: "2026-02-28T20:00:00-05:00", want: true},
+		{name: "empty", timestamp: "", want: false},
+		{name: "invalid", timestamp: "yesterday", want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := CreatedOnOrAfter(test.timestamp, cutoff); got != test.want {
+				t.Fatalf("CreatedOnOrAfter(%q) = %v, want %v", test.timestamp, got, test.want)
+			}
+		})
+	}
+}
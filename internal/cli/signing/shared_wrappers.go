@@ -32,3 +32,11 @@ func printOutput(data interface{}, format string, pretty bool) error {
 func splitCSV(value string) []string {
 	return shared.SplitCSV(value)
 }
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
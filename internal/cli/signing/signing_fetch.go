@@ -52,19 +52,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			bundle := strings.TrimSpace(*bundleID)
 			if bundle == "" {
-				fmt.Fprintln(os.Stderr, "Error: --bundle-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--bundle-id is required")
 			}
 
 			profType := strings.TrimSpace(*profileType)
 			if profType == "" {
-				fmt.Fprintln(os.Stderr, "Error: --profile-type is required")
-				return flag.ErrHelp
+				return flagErrorf("--profile-type is required")
 			}
 			profType = strings.ToUpper(profType)
 			if *createMissing && isDevelopmentProfile(profType) && strings.TrimSpace(*deviceIDs) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --device is required for development profiles")
-				return flag.ErrHelp
+				return flagErrorf("--device is required for development profiles")
 			}
 
 			outputDir := strings.TrimSpace(*outputPath)
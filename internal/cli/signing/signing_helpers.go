@@ -1,7 +1,6 @@
 package signing
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
@@ -17,11 +16,11 @@ var signingPlatformValues = map[string]asc.Platform{
 func normalizePlatform(value string) (asc.Platform, error) {
 	normalized := strings.ToUpper(strings.TrimSpace(value))
 	if normalized == "" {
-		return "", fmt.Errorf("--platform is required")
+		return "", usageErrorf("--platform is required")
 	}
 	platform, ok := signingPlatformValues[normalized]
 	if !ok {
-		return "", fmt.Errorf("--platform must be one of: %s", strings.Join(signingPlatformList(), ", "))
+		return "", usageErrorf("--platform must be one of: %s", strings.Join(signingPlatformList(), ", "))
 	}
 	return platform, nil
 }
@@ -37,7 +36,7 @@ func normalizePlatforms(values []string) ([]string, error) {
 			continue
 		}
 		if _, ok := signingPlatformValues[trimmed]; !ok {
-			return nil, fmt.Errorf("--platform must be one of: %s", strings.Join(signingPlatformList(), ", "))
+			return nil, usageErrorf("--platform must be one of: %s", strings.Join(signingPlatformList(), ", "))
 		}
 		normalized = append(normalized, trimmed)
 	}
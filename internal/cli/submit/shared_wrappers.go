@@ -40,3 +40,11 @@ func resolveAppStoreVersionID(ctx context.Context, client *asc.Client, appID, ve
 func resolveAppStoreVersionState(attrs asc.AppStoreVersionAttributes) string {
 	return shared.ResolveAppStoreVersionState(attrs)
 }
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
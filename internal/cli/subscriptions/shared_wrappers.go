@@ -2,6 +2,7 @@ package subscriptions
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -25,10 +26,25 @@ func contextWithTimeout(ctx context.Context) (context.Context, context.CancelFun
 	return shared.ContextWithTimeout(ctx)
 }
 
+func createLocalizationOrRollback[T any](
+	ctx context.Context,
+	resource string,
+	resourceID string,
+	keepOnFailure bool,
+	create func(context.Context) (T, error),
+	rollback func(context.Context) error,
+) (T, error) {
+	return shared.CreateLocalizationOrRollback(ctx, resource, resourceID, keepOnFailure, create, rollback)
+}
+
 func printOutput(data interface{}, format string, pretty bool) error {
 	return shared.PrintOutput(data, format, pretty)
 }
 
+func paginateOutput(ctx context.Context, firstPage asc.PaginatedResponse, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	return shared.PaginateOutput(ctx, firstPage, fetchNext, format, pretty)
+}
+
 func validateNextURL(next string) error {
 	return shared.ValidateNextURL(next)
 }
@@ -44,3 +60,23 @@ func splitCSVUpper(value string) []string {
 func parseCommaSeparatedIDs(value string) []string {
 	return shared.SplitCSV(value)
 }
+
+func hasInclude(values []string, include string) bool {
+	return shared.HasInclude(values, include)
+}
+
+func readJSONFilePayloadFor(path string, target any) (json.RawMessage, error) {
+	return shared.ReadJSONFilePayloadFor(path, target)
+}
+
+func confirmDestructive(resourceDescription string) (bool, error) {
+	return shared.ConfirmDestructive(resourceDescription)
+}
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -27,8 +26,12 @@ Examples:
   asc subscriptions groups create --app "APP_ID" --reference-name "Premium"
   asc subscriptions list --group "GROUP_ID"
   asc subscriptions create --group "GROUP_ID" --ref-name "Monthly" --product-id "com.example.sub.monthly"
+  asc subscriptions localizations list --id "SUB_ID"
   asc subscriptions prices add --id "SUB_ID" --price-point "PRICE_POINT_ID"
-  asc subscriptions availability set --id "SUB_ID" --territory "USA,CAN"`,
+  asc subscriptions price-points list --id "SUB_ID" --show-usd
+  asc subscriptions availability set --id "SUB_ID" --territory "USA,CAN"
+  asc subscriptions offers list --id "SUB_ID"
+  asc subscriptions intro-offers list --id "SUB_ID"`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -38,8 +41,12 @@ Examples:
 			SubscriptionsGetCommand(),
 			SubscriptionsUpdateCommand(),
 			SubscriptionsDeleteCommand(),
+			SubscriptionsLocalizationsCommand(),
 			SubscriptionsPricesCommand(),
+			SubscriptionsPricePointsCommand(),
 			SubscriptionsAvailabilityCommand(),
+			SubscriptionsOffersCommand(),
+			SubscriptionsIntroOffersCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -85,7 +92,8 @@ func SubscriptionsGroupsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	nameContains := fs.String("name-contains", "", "Only show groups whose reference name contains this text (case-insensitive)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -96,12 +104,16 @@ func SubscriptionsGroupsListCommand() *ffcli.Command {
 
 Examples:
   asc subscriptions groups list --app "APP_ID"
-  asc subscriptions groups list --app "APP_ID" --paginate`,
+  asc subscriptions groups list --app "APP_ID" --paginate
+  asc subscriptions groups list --app "APP_ID" --paginate --name-contains "premium" --output table
+
+--name-contains filters client-side, so combine it with --paginate to search
+every group. Table and markdown output include a subscription count per group.`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("subscriptions groups list: --limit must be between 1 and 200")
+				return usageErrorf("subscriptions groups list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("subscriptions groups list: %w", err)
@@ -109,8 +121,7 @@ Examples:
 
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -125,6 +136,14 @@ Examples:
 				asc.WithSubscriptionGroupsLimit(*limit),
 				asc.WithSubscriptionGroupsNextURL(*next),
 			}
+			if subscriptionGroupsShowCounts(*output) {
+				// Including subscriptions gives each group's relationship a linkage list and
+				// paging total, so the table can show a count without extra requests.
+				opts = append(opts,
+					asc.WithSubscriptionGroupsInclude([]string{"subscriptions"}),
+					asc.WithSubscriptionGroupsSubscriptionsLimit(50),
+				)
+			}
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithSubscriptionGroupsLimit(200))
@@ -139,6 +158,9 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("subscriptions groups list: %w", err)
 				}
+				if groups, ok := resp.(*asc.SubscriptionGroupsResponse); ok {
+					filterSubscriptionGroupsByName(groups, *nameContains)
+				}
 
 				return printOutput(resp, *output, *pretty)
 			}
@@ -147,6 +169,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("subscriptions groups list: failed to fetch: %w", err)
 			}
+			filterSubscriptionGroupsByName(resp, *nameContains)
 
 			return printOutput(resp, *output, *pretty)
 		},
@@ -159,7 +182,7 @@ func SubscriptionsGroupsCreateCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	referenceName := fs.String("reference-name", "", "Reference name")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -175,14 +198,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			name := strings.TrimSpace(*referenceName)
 			if name == "" {
-				fmt.Fprintln(os.Stderr, "Error: --reference-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--reference-name is required")
 			}
 
 			client, err := getASCClient()
@@ -212,7 +233,7 @@ func SubscriptionsGroupsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("groups get", flag.ExitOnError)
 
 	groupID := fs.String("id", "", "Subscription group ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -228,8 +249,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*groupID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -256,7 +276,7 @@ func SubscriptionsGroupsUpdateCommand() *ffcli.Command {
 
 	groupID := fs.String("id", "", "Subscription group ID")
 	referenceName := fs.String("reference-name", "", "Reference name")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -272,14 +292,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*groupID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			name := strings.TrimSpace(*referenceName)
 			if name == "" {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			client, err := getASCClient()
@@ -310,7 +328,7 @@ func SubscriptionsGroupsDeleteCommand() *ffcli.Command {
 
 	groupID := fs.String("id", "", "Subscription group ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -326,12 +344,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*groupID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("subscription group %q", id))
+				if err != nil {
+					return fmt.Errorf("subscriptions groups delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -361,10 +383,13 @@ func SubscriptionsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	groupID := fs.String("group", "", "Subscription group ID")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(subscriptionIncludeList(), ", "))
+	pricesLimit := fs.Int("prices-limit", 0, "Maximum included prices per subscription (1-50)")
+	localizationsLimit := fs.Int("localizations-limit", 0, "Maximum included localizations per subscription (1-50)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -375,21 +400,38 @@ func SubscriptionsListCommand() *ffcli.Command {
 
 Examples:
   asc subscriptions list --group "GROUP_ID"
-  asc subscriptions list --group "GROUP_ID" --paginate`,
+  asc subscriptions list --group "GROUP_ID" --paginate
+  asc subscriptions list --group "GROUP_ID" --include prices,localizations --prices-limit 10 --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("subscriptions list: --limit must be between 1 and 200")
+				return usageErrorf("subscriptions list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("subscriptions list: %w", err)
 			}
+			if *pricesLimit != 0 && (*pricesLimit < 1 || *pricesLimit > 50) {
+				return usageErrorf("subscriptions list: --prices-limit must be between 1 and 50")
+			}
+			if *localizationsLimit != 0 && (*localizationsLimit < 1 || *localizationsLimit > 50) {
+				return usageErrorf("subscriptions list: --localizations-limit must be between 1 and 50")
+			}
+
+			includeValue, err := normalizeSubscriptionInclude(*include)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+			if *pricesLimit != 0 && !hasInclude(includeValue, "prices") {
+				return flagErrorf("--prices-limit requires --include prices")
+			}
+			if *localizationsLimit != 0 && !hasInclude(includeValue, "subscriptionLocalizations") {
+				return flagErrorf("--localizations-limit requires --include localizations")
+			}
 
 			id := strings.TrimSpace(*groupID)
 			if id == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --group is required")
-				return flag.ErrHelp
+				return flagErrorf("--group is required")
 			}
 
 			client, err := getASCClient()
@@ -403,6 +445,9 @@ Examples:
 			opts := []asc.SubscriptionsOption{
 				asc.WithSubscriptionsLimit(*limit),
 				asc.WithSubscriptionsNextURL(*next),
+				asc.WithSubscriptionsInclude(includeValue),
+				asc.WithSubscriptionsPricesLimit(*pricesLimit),
+				asc.WithSubscriptionsLocalizationsLimit(*localizationsLimit),
 			}
 
 			if *paginate {
@@ -412,14 +457,13 @@ Examples:
 					return fmt.Errorf("subscriptions list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptions(ctx, id, asc.WithSubscriptionsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("subscriptions list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetSubscriptions(requestCtx, id, opts...)
@@ -439,7 +483,11 @@ func SubscriptionsCreateCommand() *ffcli.Command {
 	groupID := fs.String("group", "", "Subscription group ID")
 	refName := fs.String("ref-name", "", "Reference name")
 	productID := fs.String("product-id", "", "Product ID (e.g., com.example.sub)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	locale := fs.String("locale", "", "Also create a localization for this locale (e.g., en-US)")
+	locName := fs.String("name", "", "Localized display name (requires --locale)")
+	locDescription := fs.String("description", "", "Localized description (requires --locale)")
+	keepOnFailure := fs.Bool("keep-on-failure", false, "Keep the subscription if creating the localization fails")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -448,27 +496,37 @@ func SubscriptionsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a subscription.",
 		LongHelp: `Create a subscription.
 
+With --locale and --name, the subscription's first localization is created
+right after the subscription. If that fails, the new subscription is deleted
+again unless --keep-on-failure is set.
+
 Examples:
-  asc subscriptions create --group "GROUP_ID" --ref-name "Monthly" --product-id "com.example.sub.monthly"`,
+  asc subscriptions create --group "GROUP_ID" --ref-name "Monthly" --product-id "com.example.sub.monthly"
+  asc subscriptions create --group "GROUP_ID" --ref-name "Monthly" --product-id "com.example.sub.monthly" --locale en-US --name "Monthly Pro" --description "All features, billed monthly"`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			group := strings.TrimSpace(*groupID)
 			if group == "" {
-				fmt.Fprintln(os.Stderr, "Error: --group is required")
-				return flag.ErrHelp
+				return flagErrorf("--group is required")
 			}
 
 			name := strings.TrimSpace(*refName)
 			if name == "" {
-				fmt.Fprintln(os.Stderr, "Error: --ref-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--ref-name is required")
 			}
 
 			product := strings.TrimSpace(*productID)
 			if product == "" {
-				fmt.Fprintln(os.Stderr, "Error: --product-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--product-id is required")
+			}
+
+			localization, err := subscriptionCreateLocalization(*locale, *locName, *locDescription)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+			if *keepOnFailure && localization == nil {
+				return flagErrorf("--keep-on-failure requires --locale")
 			}
 
 			client, err := getASCClient()
@@ -488,18 +546,55 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("subscriptions create: failed to create: %w", err)
 			}
+			if localization == nil {
+				return printOutput(resp, *output, *pretty)
+			}
 
-			return printOutput(resp, *output, *pretty)
+			subID := resp.Data.ID
+			locResp, err := createLocalizationOrRollback(requestCtx, "subscription", subID, *keepOnFailure,
+				func(ctx context.Context) (*asc.SubscriptionLocalizationResponse, error) {
+					return client.CreateSubscriptionLocalization(ctx, subID, *localization)
+				},
+				func(ctx context.Context) error {
+					return client.DeleteSubscription(ctx, subID)
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("subscriptions create: %w", err)
+			}
+
+			return printOutput(&asc.SubscriptionCreateResult{Subscription: resp, Localization: locResp}, *output, *pretty)
 		},
 	}
 }
 
+// subscriptionCreateLocalization builds the localization requested on
+// subscriptions create. It returns nil when no localization flags are set;
+// --locale and --name are required together, --description is optional.
+func subscriptionCreateLocalization(locale, name, description string) (*asc.SubscriptionLocalizationCreateAttributes, error) {
+	attrs := asc.SubscriptionLocalizationCreateAttributes{
+		Locale:      strings.TrimSpace(locale),
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+	}
+	if attrs == (asc.SubscriptionLocalizationCreateAttributes{}) {
+		return nil, nil
+	}
+	if attrs.Locale == "" {
+		return nil, usageErrorf("--locale is required when creating a localization")
+	}
+	if attrs.Name == "" {
+		return nil, usageErrorf("--name is required when creating a localization")
+	}
+	return &attrs, nil
+}
+
 // SubscriptionsGetCommand returns the subscriptions get subcommand.
 func SubscriptionsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	subID := fs.String("id", "", "Subscription ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -515,8 +610,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*subID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -543,7 +637,8 @@ func SubscriptionsUpdateCommand() *ffcli.Command {
 
 	subID := fs.String("id", "", "Subscription ID")
 	refName := fs.String("ref-name", "", "Reference name")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	groupLevel := fs.Int("group-level", 0, "Level (rank) within the subscription group, starting at 1")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -552,21 +647,33 @@ func SubscriptionsUpdateCommand() *ffcli.Command {
 		ShortHelp:  "Update a subscription.",
 		LongHelp: `Update a subscription.
 
+--group-level sets the subscription's rank within its group; level 1 is the
+highest level of service.
+
 Examples:
-  asc subscriptions update --id "SUB_ID" --ref-name "New Name"`,
+  asc subscriptions update --id "SUB_ID" --ref-name "New Name"
+  asc subscriptions update --id "SUB_ID" --group-level 2`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*subID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
+			groupLevelSet := false
+			fs.Visit(func(f *flag.Flag) {
+				if f.Name == "group-level" {
+					groupLevelSet = true
+				}
+			})
+
 			name := strings.TrimSpace(*refName)
-			if name == "" {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+			if name == "" && !groupLevelSet {
+				return flagErrorf("at least one update flag is required")
+			}
+			if groupLevelSet && *groupLevel < 1 {
+				return flagErrorf("--group-level must be a positive integer")
 			}
 
 			client, err := getASCClient()
@@ -577,8 +684,12 @@ Examples:
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
-			attrs := asc.SubscriptionUpdateAttributes{
-				Name: &name,
+			attrs := asc.SubscriptionUpdateAttributes{}
+			if name != "" {
+				attrs.Name = &name
+			}
+			if groupLevelSet {
+				attrs.GroupLevel = groupLevel
 			}
 
 			resp, err := client.UpdateSubscription(requestCtx, id, attrs)
@@ -597,7 +708,7 @@ func SubscriptionsDeleteCommand() *ffcli.Command {
 
 	subID := fs.String("id", "", "Subscription ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -613,12 +724,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*subID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("subscription %q", id))
+				if err != nil {
+					return fmt.Errorf("subscriptions delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -654,11 +769,17 @@ func SubscriptionsPricesCommand() *ffcli.Command {
 		LongHelp: `Manage subscription pricing.
 
 Examples:
-  asc subscriptions prices add --id "SUB_ID" --price-point "PRICE_POINT_ID"`,
+  asc subscriptions prices list --id "SUB_ID"
+  asc subscriptions prices add --id "SUB_ID" --price-point "PRICE_POINT_ID"
+  asc subscriptions prices bulk --id "SUB_ID" --file prices.json
+  asc subscriptions prices delete --price-id "PRICE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
+			SubscriptionsPricesListCommand(),
 			SubscriptionsPricesAddCommand(),
+			SubscriptionsPricesBulkCommand(),
+			SubscriptionsPricesDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -666,6 +787,142 @@ Examples:
 	}
 }
 
+// SubscriptionsPricesListCommand returns the subscriptions prices list subcommand.
+func SubscriptionsPricesListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("prices list", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	territory := fs.String("territory", "", "Only list prices for this territory (e.g., USA)")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions prices list --id \"SUB_ID\" [flags]",
+		ShortHelp:  "List prices for a subscription.",
+		LongHelp: `List prices for a subscription, including prices scheduled to start later.
+
+Use the listed IDs with "asc subscriptions prices delete".
+
+Examples:
+  asc subscriptions prices list --id "SUB_ID"
+  asc subscriptions prices list --id "SUB_ID" --territory USA --output table
+  asc subscriptions prices list --id "SUB_ID" --paginate`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return usageErrorf("subscriptions prices list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions prices list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				return flagErrorf("--id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions prices list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionPricesOption{
+				asc.WithSubscriptionPricesLimit(*limit),
+				asc.WithSubscriptionPricesNextURL(*next),
+				asc.WithSubscriptionPricesTerritory(*territory),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionPricesLimit(200))
+				firstPage, err := client.GetSubscriptionPrices(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions prices list: failed to fetch: %w", err)
+				}
+
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionPrices(ctx, id, asc.WithSubscriptionPricesNextURL(nextURL))
+				}, *output, *pretty)
+				if err != nil {
+					return fmt.Errorf("subscriptions prices list: %w", err)
+				}
+				return nil
+			}
+
+			resp, err := client.GetSubscriptionPrices(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions prices list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsPricesDeleteCommand returns the subscriptions prices delete subcommand.
+func SubscriptionsPricesDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("prices delete", flag.ExitOnError)
+
+	priceID := fs.String("price-id", "", "Subscription price ID (from prices list)")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions prices delete --price-id \"PRICE_ID\" --confirm",
+		ShortHelp:  "Delete a scheduled subscription price.",
+		LongHelp: `Delete a subscription price, such as one added by mistake that has not
+taken effect yet.
+
+Examples:
+  asc subscriptions prices delete --price-id "PRICE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*priceID)
+			if id == "" {
+				return flagErrorf("--price-id is required")
+			}
+			if !*confirm {
+				confirmed, err := confirmDestructive(fmt.Sprintf("subscription price %q", id))
+				if err != nil {
+					return fmt.Errorf("subscriptions prices delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions prices delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionPrice(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions prices delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionPriceDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
 // SubscriptionsPricesAddCommand returns the subscriptions prices add subcommand.
 func SubscriptionsPricesAddCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("prices add", flag.ExitOnError)
@@ -674,7 +931,7 @@ func SubscriptionsPricesAddCommand() *ffcli.Command {
 	pricePointID := fs.String("price-point", "", "Subscription price point ID")
 	startDate := fs.String("start-date", "", "Start date (YYYY-MM-DD)")
 	preserved := fs.Bool("preserved", false, "Preserve existing prices")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -690,14 +947,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*subID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			pricePoint := strings.TrimSpace(*pricePointID)
 			if pricePoint == "" {
-				fmt.Fprintln(os.Stderr, "Error: --price-point is required")
-				return flag.ErrHelp
+				return flagErrorf("--price-point is required")
 			}
 
 			client, err := getASCClient()
@@ -755,7 +1010,8 @@ func SubscriptionsAvailabilitySetCommand() *ffcli.Command {
 	subID := fs.String("id", "", "Subscription ID")
 	territories := fs.String("territory", "", "Territory IDs, comma-separated")
 	availableInNew := fs.Bool("available-in-new-territories", false, "Include new territories automatically")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	resolveNames := fs.Bool("resolve-names", false, "List the territories with their country names in the output")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -764,21 +1020,24 @@ func SubscriptionsAvailabilitySetCommand() *ffcli.Command {
 		ShortHelp:  "Set subscription availability in territories.",
 		LongHelp: `Set subscription availability in territories.
 
+With --resolve-names, the output lists each territory with its country name
+(e.g. USA, United States). Names come from a table bundled with the CLI, so
+no extra requests are made.
+
 Examples:
-  asc subscriptions availability set --id "SUB_ID" --territory "USA,CAN"`,
+  asc subscriptions availability set --id "SUB_ID" --territory "USA,CAN"
+  asc subscriptions availability set --id "SUB_ID" --territory "USA,CAN" --resolve-names --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*subID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			territoryIDs := parseCommaSeparatedIDs(*territories)
 			if len(territoryIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --territory is required")
-				return flag.ErrHelp
+				return flagErrorf("--territory is required")
 			}
 
 			client, err := getASCClient()
@@ -798,7 +1057,66 @@ Examples:
 				return fmt.Errorf("subscriptions availability set: failed to set: %w", err)
 			}
 
+			if *resolveNames {
+				result, err := asc.NewSubscriptionAvailabilityNamedResult(resp, territoryIDs)
+				if err != nil {
+					return fmt.Errorf("subscriptions availability set: %w", err)
+				}
+				return printOutput(result, *output, *pretty)
+			}
+
 			return printOutput(resp, *output, *pretty)
 		},
 	}
 }
+
+func subscriptionIncludeList() []string {
+	return []string{"prices", "localizations"}
+}
+
+// normalizeSubscriptionInclude validates --include values and maps them to
+// the relationship names the API expects.
+func normalizeSubscriptionInclude(value string) ([]string, error) {
+	values := splitCSV(value)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	include := make([]string, 0, len(values))
+	for _, item := range values {
+		switch item {
+		case "prices":
+			include = append(include, "prices")
+		case "localizations":
+			include = append(include, "subscriptionLocalizations")
+		default:
+			return nil, usageErrorf("--include must be one of: %s", strings.Join(subscriptionIncludeList(), ", "))
+		}
+	}
+	return include, nil
+}
+
+// filterSubscriptionGroupsByName keeps groups whose reference name contains
+// query, ignoring case. An empty query keeps every group.
+func filterSubscriptionGroupsByName(resp *asc.SubscriptionGroupsResponse, query string) {
+	needle := strings.ToLower(strings.TrimSpace(query))
+	if resp == nil || needle == "" {
+		return
+	}
+	filtered := resp.Data[:0]
+	for _, group := range resp.Data {
+		if strings.Contains(strings.ToLower(group.Attributes.ReferenceName), needle) {
+			filtered = append(filtered, group)
+		}
+	}
+	resp.Data = filtered
+}
+
+func subscriptionGroupsShowCounts(output string) bool {
+	switch strings.ToLower(strings.TrimSpace(output)) {
+	case "table", "markdown", "md":
+		return true
+	default:
+		return false
+	}
+}
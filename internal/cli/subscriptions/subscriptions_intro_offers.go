@@ -0,0 +1,296 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsIntroOffersCommand returns the subscriptions intro-offers command group.
+func SubscriptionsIntroOffersCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("intro-offers", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "intro-offers",
+		ShortUsage: "asc subscriptions intro-offers <subcommand> [flags]",
+		ShortHelp:  "Manage subscription introductory offers.",
+		LongHelp: `Manage subscription introductory offers.
+
+Examples:
+  asc subscriptions intro-offers list --id "SUB_ID"
+  asc subscriptions intro-offers create --id "SUB_ID" --territory USA --duration ONE_WEEK --offer-mode FREE_TRIAL
+  asc subscriptions intro-offers create --id "SUB_ID" --territory USA --price-point "PRICE_POINT_ID" --duration ONE_MONTH --number-of-periods 3 --offer-mode PAY_AS_YOU_GO --start-date 2026-03-01
+  asc subscriptions intro-offers delete --offer-id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsIntroOffersListCommand(),
+			SubscriptionsIntroOffersCreateCommand(),
+			SubscriptionsIntroOffersDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsIntroOffersListCommand returns the subscriptions intro-offers list subcommand.
+func SubscriptionsIntroOffersListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("intro-offers list", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	territory := fs.String("territory", "", "Filter by territory ID(s), comma-separated")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions intro-offers list --id \"SUB_ID\" [flags]",
+		ShortHelp:  "List introductory offers for a subscription.",
+		LongHelp: `List introductory offers for a subscription.
+
+Examples:
+  asc subscriptions intro-offers list --id "SUB_ID"
+  asc subscriptions intro-offers list --id "SUB_ID" --territory USA
+  asc subscriptions intro-offers list --id "SUB_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return usageErrorf("subscriptions intro-offers list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions intro-offers list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				return flagErrorf("--id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionIntroductoryOffersOption{
+				asc.WithSubscriptionIntroductoryOffersLimit(*limit),
+				asc.WithSubscriptionIntroductoryOffersNextURL(*next),
+				asc.WithSubscriptionIntroductoryOffersTerritories(splitCSVUpper(*territory)),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionIntroductoryOffersLimit(200))
+				firstPage, err := client.GetSubscriptionIntroductoryOffers(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions intro-offers list: failed to fetch: %w", err)
+				}
+
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionIntroductoryOffers(ctx, id, asc.WithSubscriptionIntroductoryOffersNextURL(nextURL))
+				}, *output, *pretty)
+				if err != nil {
+					return fmt.Errorf("subscriptions intro-offers list: %w", err)
+				}
+				return nil
+			}
+
+			resp, err := client.GetSubscriptionIntroductoryOffers(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsIntroOffersCreateCommand returns the subscriptions intro-offers create subcommand.
+func SubscriptionsIntroOffersCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("intro-offers create", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	territory := fs.String("territory", "", "Territory ID (e.g., USA)")
+	pricePoint := fs.String("price-point", "", "Subscription price point ID for the offer price (not used for FREE_TRIAL)")
+	duration := fs.String("duration", "", "Offer period duration: "+strings.Join(subscriptionOfferDurationList(), ", "))
+	numberOfPeriods := fs.Int("number-of-periods", 1, "Number of offer periods")
+	offerMode := fs.String("offer-mode", "", "Offer mode: "+strings.Join(subscriptionOfferModeList(), ", "))
+	startDate := fs.String("start-date", "", "Offer start date (YYYY-MM-DD or RFC3339)")
+	endDate := fs.String("end-date", "", "Offer end date (YYYY-MM-DD or RFC3339)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	shared.RegisterFlagValues(fs, "duration", subscriptionOfferDurationList()...)
+	shared.RegisterFlagValues(fs, "offer-mode", subscriptionOfferModeList()...)
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions intro-offers create --id \"SUB_ID\" --territory TERRITORY --duration DURATION --offer-mode MODE [flags]",
+		ShortHelp:  "Create an introductory offer.",
+		LongHelp: `Create an introductory offer for a subscription in a territory.
+
+--price-point is required for PAY_AS_YOU_GO and PAY_UP_FRONT offers.
+Use "asc subscriptions price-points list" to look up price point IDs.
+Dates are sent to App Store Connect as YYYY-MM-DD.
+
+Examples:
+  asc subscriptions intro-offers create --id "SUB_ID" --territory USA --duration ONE_WEEK --offer-mode FREE_TRIAL
+  asc subscriptions intro-offers create --id "SUB_ID" --territory USA --price-point "PRICE_POINT_ID" --duration ONE_MONTH --number-of-periods 3 --offer-mode PAY_AS_YOU_GO --start-date 2026-03-01 --end-date 2026-06-01`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				return flagErrorf("--id is required")
+			}
+
+			territoryID := strings.ToUpper(strings.TrimSpace(*territory))
+			if territoryID == "" {
+				return flagErrorf("--territory is required")
+			}
+
+			durationValue, err := normalizeSubscriptionOfferDuration(*duration)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+
+			modeValue, err := normalizeSubscriptionOfferMode(*offerMode)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+
+			pricePointID := strings.TrimSpace(*pricePoint)
+			if pricePointID == "" && modeValue != asc.SubscriptionOfferModeFreeTrial {
+				return flagErrorf("--price-point is required unless --offer-mode is FREE_TRIAL")
+			}
+
+			if *numberOfPeriods < 1 {
+				return usageErrorf("subscriptions intro-offers create: --number-of-periods must be greater than 0")
+			}
+
+			startValue, err := normalizeSubscriptionOfferDate("--start-date", *startDate)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+			endValue, err := normalizeSubscriptionOfferDate("--end-date", *endDate)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+			// YYYY-MM-DD values compare correctly as strings.
+			if startValue != "" && endValue != "" && endValue < startValue {
+				return usageErrorf("subscriptions intro-offers create: --end-date must not be before --start-date")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			attrs := asc.SubscriptionIntroductoryOfferCreateAttributes{
+				StartDate:       startValue,
+				EndDate:         endValue,
+				Duration:        durationValue,
+				OfferMode:       modeValue,
+				NumberOfPeriods: *numberOfPeriods,
+			}
+
+			resp, err := client.CreateSubscriptionIntroductoryOffer(requestCtx, id, attrs, territoryID, pricePointID)
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsIntroOffersDeleteCommand returns the subscriptions intro-offers delete subcommand.
+func SubscriptionsIntroOffersDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("intro-offers delete", flag.ExitOnError)
+
+	offerID := fs.String("offer-id", "", "Introductory offer ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions intro-offers delete --offer-id \"OFFER_ID\" --confirm",
+		ShortHelp:  "Delete an introductory offer.",
+		LongHelp: `Delete an introductory offer.
+
+Examples:
+  asc subscriptions intro-offers delete --offer-id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				return flagErrorf("--offer-id is required")
+			}
+			if !*confirm {
+				confirmed, err := confirmDestructive(fmt.Sprintf("introductory offer %q", id))
+				if err != nil {
+					return fmt.Errorf("subscriptions intro-offers delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions intro-offers delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionIntroductoryOffer(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions intro-offers delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionIntroductoryOfferDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// normalizeSubscriptionOfferDate accepts YYYY-MM-DD or RFC3339 and returns the
+// YYYY-MM-DD form App Store Connect expects for offer dates.
+func normalizeSubscriptionOfferDate(flagName, value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", nil
+	}
+	if parsed, err := time.Parse("2006-01-02", trimmed); err == nil {
+		return parsed.Format("2006-01-02"), nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, trimmed); err == nil {
+		return parsed.Format("2006-01-02"), nil
+	}
+	if parsed, err := time.Parse(time.RFC3339Nano, trimmed); err == nil {
+		return parsed.Format("2006-01-02"), nil
+	}
+	return "", fmt.Errorf("%s must be in YYYY-MM-DD or RFC3339 format (e.g., 2026-02-01)", flagName)
+}
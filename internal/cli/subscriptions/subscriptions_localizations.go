@@ -0,0 +1,289 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// SubscriptionsLocalizationsCommand returns the subscriptions localizations command group.
+func SubscriptionsLocalizationsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "localizations",
+		ShortUsage: "asc subscriptions localizations <subcommand> [flags]",
+		ShortHelp:  "Manage subscription localizations.",
+		LongHelp: `Manage subscription display names and descriptions per locale.
+
+Examples:
+  asc subscriptions localizations list --id "SUB_ID"
+  asc subscriptions localizations create --id "SUB_ID" --locale "en-US" --name "Monthly" --description "Full access, billed monthly"
+  asc subscriptions localizations update --localization-id "LOC_ID" --description "Full access"
+  asc subscriptions localizations delete --localization-id "LOC_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsLocalizationsListCommand(),
+			SubscriptionsLocalizationsCreateCommand(),
+			SubscriptionsLocalizationsUpdateCommand(),
+			SubscriptionsLocalizationsDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsLocalizationsListCommand returns the subscriptions localizations list subcommand.
+func SubscriptionsLocalizationsListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations list", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions localizations list --id \"SUB_ID\" [flags]",
+		ShortHelp:  "List localizations for a subscription.",
+		LongHelp: `List localizations for a subscription.
+
+Examples:
+  asc subscriptions localizations list --id "SUB_ID"
+  asc subscriptions localizations list --id "SUB_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return usageErrorf("subscriptions localizations list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions localizations list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				return flagErrorf("--id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionLocalizationsOption{
+				asc.WithSubscriptionLocalizationsLimit(*limit),
+				asc.WithSubscriptionLocalizationsNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionLocalizationsLimit(200))
+				firstPage, err := client.GetSubscriptionLocalizations(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions localizations list: failed to fetch: %w", err)
+				}
+
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionLocalizations(ctx, id, asc.WithSubscriptionLocalizationsNextURL(nextURL))
+				}, *output, *pretty)
+				if err != nil {
+					return fmt.Errorf("subscriptions localizations list: %w", err)
+				}
+				return nil
+			}
+
+			resp, err := client.GetSubscriptionLocalizations(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsLocalizationsCreateCommand returns the subscriptions localizations create subcommand.
+func SubscriptionsLocalizationsCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations create", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	locale := fs.String("locale", "", "Locale (e.g., en-US)")
+	name := fs.String("name", "", "Display name")
+	description := fs.String("description", "", "Description")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions localizations create --id \"SUB_ID\" --locale LOCALE --name NAME [flags]",
+		ShortHelp:  "Create a subscription localization.",
+		LongHelp: `Create a subscription localization.
+
+Examples:
+  asc subscriptions localizations create --id "SUB_ID" --locale "en-US" --name "Monthly"
+  asc subscriptions localizations create --id "SUB_ID" --locale "de-DE" --name "Monatlich" --description "Voller Zugriff"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				return flagErrorf("--id is required")
+			}
+
+			localeValue := strings.TrimSpace(*locale)
+			if localeValue == "" {
+				return flagErrorf("--locale is required")
+			}
+
+			nameValue := strings.TrimSpace(*name)
+			if nameValue == "" {
+				return flagErrorf("--name is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			attrs := asc.SubscriptionLocalizationCreateAttributes{
+				Locale:      localeValue,
+				Name:        nameValue,
+				Description: strings.TrimSpace(*description),
+			}
+
+			resp, err := client.CreateSubscriptionLocalization(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsLocalizationsUpdateCommand returns the subscriptions localizations update subcommand.
+func SubscriptionsLocalizationsUpdateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations update", flag.ExitOnError)
+
+	localizationID := fs.String("localization-id", "", "Subscription localization ID")
+	name := fs.String("name", "", "Display name")
+	description := fs.String("description", "", "Description")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "update",
+		ShortUsage: "asc subscriptions localizations update --localization-id \"LOC_ID\" [flags]",
+		ShortHelp:  "Update a subscription localization.",
+		LongHelp: `Update a subscription localization.
+
+Examples:
+  asc subscriptions localizations update --localization-id "LOC_ID" --name "Monthly Plus"
+  asc subscriptions localizations update --localization-id "LOC_ID" --description "Full access, billed monthly"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*localizationID)
+			if id == "" {
+				return flagErrorf("--localization-id is required")
+			}
+
+			attrs := asc.SubscriptionLocalizationUpdateAttributes{}
+			if value := strings.TrimSpace(*name); value != "" {
+				attrs.Name = &value
+			}
+			if value := strings.TrimSpace(*description); value != "" {
+				attrs.Description = &value
+			}
+			if attrs.Name == nil && attrs.Description == nil {
+				return flagErrorf("at least one update flag is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations update: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.UpdateSubscriptionLocalization(requestCtx, id, attrs)
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations update: failed to update: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsLocalizationsDeleteCommand returns the subscriptions localizations delete subcommand.
+func SubscriptionsLocalizationsDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations delete", flag.ExitOnError)
+
+	localizationID := fs.String("localization-id", "", "Subscription localization ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions localizations delete --localization-id \"LOC_ID\" --confirm",
+		ShortHelp:  "Delete a subscription localization.",
+		LongHelp: `Delete a subscription localization.
+
+Examples:
+  asc subscriptions localizations delete --localization-id "LOC_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*localizationID)
+			if id == "" {
+				return flagErrorf("--localization-id is required")
+			}
+			if !*confirm {
+				confirmed, err := confirmDestructive(fmt.Sprintf("subscription localization %q", id))
+				if err != nil {
+					return fmt.Errorf("subscriptions localizations delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions localizations delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionLocalization(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions localizations delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionLocalizationDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
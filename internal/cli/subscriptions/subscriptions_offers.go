@@ -0,0 +1,353 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsOffersCommand returns the subscriptions offers command group.
+func SubscriptionsOffersCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offers", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "offers",
+		ShortUsage: "asc subscriptions offers <subcommand> [flags]",
+		ShortHelp:  "Manage subscription promotional offers.",
+		LongHelp: `Manage subscription promotional offers.
+
+Examples:
+  asc subscriptions offers list --id "SUB_ID"
+  asc subscriptions offers create --id "SUB_ID" --name "Win back" --offer-code "WINBACK50" --duration ONE_MONTH --number-of-periods 3 --offer-mode PAY_AS_YOU_GO --price-point "PRICE_POINT_ID"
+  asc subscriptions offers get --offer-id "OFFER_ID"
+  asc subscriptions offers delete --offer-id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsOffersListCommand(),
+			SubscriptionsOffersCreateCommand(),
+			SubscriptionsOffersGetCommand(),
+			SubscriptionsOffersDeleteCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsOffersListCommand returns the subscriptions offers list subcommand.
+func SubscriptionsOffersListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offers list", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions offers list --id \"SUB_ID\" [flags]",
+		ShortHelp:  "List promotional offers for a subscription.",
+		LongHelp: `List promotional offers for a subscription.
+
+Examples:
+  asc subscriptions offers list --id "SUB_ID"
+  asc subscriptions offers list --id "SUB_ID" --paginate --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return usageErrorf("subscriptions offers list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions offers list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				return flagErrorf("--id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offers list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionPromotionalOffersOption{
+				asc.WithSubscriptionPromotionalOffersLimit(*limit),
+				asc.WithSubscriptionPromotionalOffersNextURL(*next),
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionPromotionalOffersLimit(200))
+				firstPage, err := client.GetSubscriptionPromotionalOffers(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions offers list: failed to fetch: %w", err)
+				}
+
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionPromotionalOffers(ctx, id, asc.WithSubscriptionPromotionalOffersNextURL(nextURL))
+				}, *output, *pretty)
+				if err != nil {
+					return fmt.Errorf("subscriptions offers list: %w", err)
+				}
+				return nil
+			}
+
+			resp, err := client.GetSubscriptionPromotionalOffers(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("subscriptions offers list: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOffersCreateCommand returns the subscriptions offers create subcommand.
+func SubscriptionsOffersCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offers create", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	name := fs.String("name", "", "Offer reference name")
+	offerCode := fs.String("offer-code", "", "Offer identifier used by the app to redeem the offer")
+	duration := fs.String("duration", "", "Offer period duration: "+strings.Join(subscriptionOfferDurationList(), ", "))
+	numberOfPeriods := fs.Int("number-of-periods", 0, "Number of offer periods")
+	offerMode := fs.String("offer-mode", "", "Offer mode: "+strings.Join(subscriptionOfferModeList(), ", "))
+	pricePoints := fs.String("price-point", "", "Subscription price point ID(s) for the offer price, comma-separated (one per territory)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	shared.RegisterFlagValues(fs, "duration", subscriptionOfferDurationList()...)
+	shared.RegisterFlagValues(fs, "offer-mode", subscriptionOfferModeList()...)
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc subscriptions offers create --id \"SUB_ID\" --name NAME --offer-code CODE --duration DURATION --number-of-periods N --offer-mode MODE [flags]",
+		ShortHelp:  "Create a promotional offer.",
+		LongHelp: `Create a promotional offer for a subscription.
+
+Each --price-point becomes an offer price for that price point's territory.
+Use "asc subscriptions price-points list" to look up price point IDs.
+
+Examples:
+  asc subscriptions offers create --id "SUB_ID" --name "Win back" --offer-code "WINBACK50" --duration ONE_MONTH --number-of-periods 3 --offer-mode PAY_AS_YOU_GO --price-point "PRICE_POINT_ID"
+  asc subscriptions offers create --id "SUB_ID" --name "Free week" --offer-code "FREEWEEK" --duration ONE_WEEK --number-of-periods 1 --offer-mode FREE_TRIAL`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				return flagErrorf("--id is required")
+			}
+
+			nameValue := strings.TrimSpace(*name)
+			if nameValue == "" {
+				return flagErrorf("--name is required")
+			}
+
+			code := strings.TrimSpace(*offerCode)
+			if code == "" {
+				return flagErrorf("--offer-code is required")
+			}
+
+			durationValue, err := normalizeSubscriptionOfferDuration(*duration)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+
+			if *numberOfPeriods == 0 {
+				return flagErrorf("--number-of-periods is required")
+			}
+			if *numberOfPeriods < 0 {
+				return usageErrorf("subscriptions offers create: --number-of-periods must be greater than 0")
+			}
+
+			modeValue, err := normalizeSubscriptionOfferMode(*offerMode)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offers create: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			attrs := asc.SubscriptionPromotionalOfferCreateAttributes{
+				Name:            nameValue,
+				OfferCode:       code,
+				Duration:        durationValue,
+				OfferMode:       modeValue,
+				NumberOfPeriods: *numberOfPeriods,
+			}
+
+			resp, err := client.CreateSubscriptionPromotionalOffer(requestCtx, id, attrs, parseCommaSeparatedIDs(*pricePoints))
+			if err != nil {
+				return fmt.Errorf("subscriptions offers create: failed to create: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOffersGetCommand returns the subscriptions offers get subcommand.
+func SubscriptionsOffersGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offers get", flag.ExitOnError)
+
+	offerID := fs.String("offer-id", "", "Promotional offer ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc subscriptions offers get --offer-id \"OFFER_ID\"",
+		ShortHelp:  "Get a promotional offer by ID.",
+		LongHelp: `Get a promotional offer by ID.
+
+Examples:
+  asc subscriptions offers get --offer-id "OFFER_ID"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				return flagErrorf("--offer-id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offers get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetSubscriptionPromotionalOffer(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions offers get: failed to fetch: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// SubscriptionsOffersDeleteCommand returns the subscriptions offers delete subcommand.
+func SubscriptionsOffersDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offers delete", flag.ExitOnError)
+
+	offerID := fs.String("offer-id", "", "Promotional offer ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc subscriptions offers delete --offer-id \"OFFER_ID\" --confirm",
+		ShortHelp:  "Delete a promotional offer.",
+		LongHelp: `Delete a promotional offer.
+
+Examples:
+  asc subscriptions offers delete --offer-id "OFFER_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerID)
+			if id == "" {
+				return flagErrorf("--offer-id is required")
+			}
+			if !*confirm {
+				confirmed, err := confirmDestructive(fmt.Sprintf("promotional offer %q", id))
+				if err != nil {
+					return fmt.Errorf("subscriptions offers delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offers delete: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteSubscriptionPromotionalOffer(requestCtx, id); err != nil {
+				return fmt.Errorf("subscriptions offers delete: failed to delete: %w", err)
+			}
+
+			result := &asc.SubscriptionPromotionalOfferDeleteResult{
+				ID:      id,
+				Deleted: true,
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+var subscriptionOfferModes = map[string]asc.SubscriptionOfferMode{
+	string(asc.SubscriptionOfferModePayAsYouGo): asc.SubscriptionOfferModePayAsYouGo,
+	string(asc.SubscriptionOfferModePayUpFront): asc.SubscriptionOfferModePayUpFront,
+	string(asc.SubscriptionOfferModeFreeTrial):  asc.SubscriptionOfferModeFreeTrial,
+}
+
+var subscriptionOfferDurations = map[string]asc.SubscriptionOfferDuration{
+	string(asc.SubscriptionOfferDurationThreeDays):   asc.SubscriptionOfferDurationThreeDays,
+	string(asc.SubscriptionOfferDurationOneWeek):     asc.SubscriptionOfferDurationOneWeek,
+	string(asc.SubscriptionOfferDurationTwoWeeks):    asc.SubscriptionOfferDurationTwoWeeks,
+	string(asc.SubscriptionOfferDurationOneMonth):    asc.SubscriptionOfferDurationOneMonth,
+	string(asc.SubscriptionOfferDurationTwoMonths):   asc.SubscriptionOfferDurationTwoMonths,
+	string(asc.SubscriptionOfferDurationThreeMonths): asc.SubscriptionOfferDurationThreeMonths,
+	string(asc.SubscriptionOfferDurationSixMonths):   asc.SubscriptionOfferDurationSixMonths,
+	string(asc.SubscriptionOfferDurationOneYear):     asc.SubscriptionOfferDurationOneYear,
+}
+
+func subscriptionOfferModeList() []string {
+	return []string{"PAY_AS_YOU_GO", "PAY_UP_FRONT", "FREE_TRIAL"}
+}
+
+func subscriptionOfferDurationList() []string {
+	return []string{"THREE_DAYS", "ONE_WEEK", "TWO_WEEKS", "ONE_MONTH", "TWO_MONTHS", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}
+}
+
+func normalizeSubscriptionOfferMode(value string) (asc.SubscriptionOfferMode, error) {
+	trimmed := strings.ToUpper(strings.TrimSpace(value))
+	if trimmed == "" {
+		return "", usageErrorf("--offer-mode is required")
+	}
+	mode, ok := subscriptionOfferModes[trimmed]
+	if !ok {
+		return "", usageErrorf("--offer-mode must be one of: %s", strings.Join(subscriptionOfferModeList(), ", "))
+	}
+	return mode, nil
+}
+
+func normalizeSubscriptionOfferDuration(value string) (asc.SubscriptionOfferDuration, error) {
+	trimmed := strings.ToUpper(strings.TrimSpace(value))
+	if trimmed == "" {
+		return "", usageErrorf("--duration is required")
+	}
+	duration, ok := subscriptionOfferDurations[trimmed]
+	if !ok {
+		return "", usageErrorf("--duration must be one of: %s", strings.Join(subscriptionOfferDurationList(), ", "))
+	}
+	return duration, nil
+}
@@ -0,0 +1,135 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// SubscriptionsPricePointsCommand returns the subscriptions price-points command group.
+func SubscriptionsPricePointsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("price-points", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "price-points",
+		ShortUsage: "asc subscriptions price-points <subcommand> [flags]",
+		ShortHelp:  "List subscription price points.",
+		LongHelp: `List subscription price points.
+
+Examples:
+  asc subscriptions price-points list --id "SUB_ID" --territory "USA"
+  asc subscriptions price-points list --id "SUB_ID" --paginate --show-usd --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubscriptionsPricePointsListCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubscriptionsPricePointsListCommand returns the subscriptions price-points list subcommand.
+func SubscriptionsPricePointsListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("price-points list", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	territory := fs.String("territory", "", "Filter by territory (e.g., USA)")
+	showUSD := fs.Bool("show-usd", false, "Add each price point's currency and an approximate USD price")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc subscriptions price-points list [flags]",
+		ShortHelp:  "List price points available to a subscription.",
+		LongHelp: `List price points available to a subscription.
+
+With --show-usd, each price point includes its territory, currency, and an
+approximate customer price in US dollars. The conversion uses a bundled table
+of indicative exchange rates; it is meant for comparing tiers across
+territories, not for financial reporting. Unknown currencies are left blank.
+
+Examples:
+  asc subscriptions price-points list --id "SUB_ID"
+  asc subscriptions price-points list --id "SUB_ID" --territory "USA"
+  asc subscriptions price-points list --id "SUB_ID" --paginate --show-usd --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return usageErrorf("subscriptions price-points list: --limit must be between 1 and 200")
+			}
+			if err := validateNextURL(*next); err != nil {
+				return fmt.Errorf("subscriptions price-points list: %w", err)
+			}
+
+			id := strings.TrimSpace(*subID)
+			if id == "" && strings.TrimSpace(*next) == "" {
+				return flagErrorf("--id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions price-points list: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.SubscriptionPricePointsOption{
+				asc.WithSubscriptionPricePointsLimit(*limit),
+				asc.WithSubscriptionPricePointsNextURL(*next),
+				asc.WithSubscriptionPricePointsTerritory(*territory),
+			}
+			if *showUSD {
+				opts = append(opts, asc.WithSubscriptionPricePointsInclude([]string{"territory"}))
+			}
+
+			var resp *asc.SubscriptionPricePointsResponse
+			if *paginate {
+				paginateOpts := append(opts, asc.WithSubscriptionPricePointsLimit(200))
+				firstPage, err := client.GetSubscriptionPricePoints(requestCtx, id, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions price-points list: failed to fetch: %w", err)
+				}
+
+				all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionPricePoints(ctx, id, asc.WithSubscriptionPricePointsNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("subscriptions price-points list: %w", err)
+				}
+				points, ok := all.(*asc.SubscriptionPricePointsResponse)
+				if !ok {
+					return fmt.Errorf("subscriptions price-points list: unexpected response type %T", all)
+				}
+				resp = points
+			} else {
+				resp, err = client.GetSubscriptionPricePoints(requestCtx, id, opts...)
+				if err != nil {
+					return fmt.Errorf("subscriptions price-points list: failed to fetch: %w", err)
+				}
+			}
+
+			if !*showUSD {
+				return printOutput(resp, *output, *pretty)
+			}
+
+			result, err := asc.NewSubscriptionPricePointsUSDResult(resp)
+			if err != nil {
+				return fmt.Errorf("subscriptions price-points list: %w", err)
+			}
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
@@ -0,0 +1,301 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	subscriptionPriceBulkCreated = "created"
+	subscriptionPriceBulkFailed  = "failed"
+	subscriptionPriceBulkSkipped = "skipped"
+)
+
+// subscriptionPriceBulkFile is the payload read by subscriptions prices bulk.
+type subscriptionPriceBulkFile struct {
+	Prices []subscriptionPriceBulkEntry `json:"prices"`
+}
+
+// subscriptionPriceBulkEntry is one price to create. It names either a price
+// point directly or a territory plus the customer price to look up.
+type subscriptionPriceBulkEntry struct {
+	PricePoint    string `json:"pricePoint,omitempty"`
+	Territory     string `json:"territory,omitempty"`
+	CustomerPrice string `json:"customerPrice,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	Preserved     bool   `json:"preserved,omitempty"`
+}
+
+// SubscriptionsPricesBulkCommand returns the subscriptions prices bulk subcommand.
+func SubscriptionsPricesBulkCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("prices bulk", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	filePath := fs.String("file", "", "Path to a JSON file of prices to create")
+	stopOnError := fs.Bool("stop-on-error", false, "Stop at the first failed entry instead of continuing")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "bulk",
+		ShortUsage: "asc subscriptions prices bulk --id \"SUB_ID\" --file prices.json [flags]",
+		ShortHelp:  "Add subscription prices from a file.",
+		LongHelp: `Add subscription prices from a file.
+
+The file lists one entry per price. Each entry names either a price point ID
+("pricePoint") or a territory and the customer price to look up in that
+territory's price points ("territory" + "customerPrice"). "startDate"
+(YYYY-MM-DD) and "preserved" are optional.
+
+  {
+    "prices": [
+      {"pricePoint": "PRICE_POINT_ID", "startDate": "2026-03-01"},
+      {"territory": "CAN", "customerPrice": "12.99", "preserved": true}
+    ]
+  }
+
+Every entry is attempted and failures are reported in the summary; the
+command exits non-zero if any entry failed. Use --stop-on-error to stop at
+the first failure (remaining entries are reported as skipped).
+
+Examples:
+  asc subscriptions prices bulk --id "SUB_ID" --file prices.json
+  asc subscriptions prices bulk --id "SUB_ID" --file prices.json --stop-on-error --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				return flagErrorf("--id is required")
+			}
+
+			path := strings.TrimSpace(*filePath)
+			if path == "" {
+				return flagErrorf("--file is required")
+			}
+
+			entries, err := readSubscriptionPriceBulkFile(path)
+			if err != nil {
+				return fmt.Errorf("subscriptions prices bulk: %w", err)
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions prices bulk: %w", err)
+			}
+
+			requestCtx, cancel := contextWithTimeout(ctx)
+			defer cancel()
+
+			resolver := &subscriptionPricePointResolver{
+				fetch: func(ctx context.Context, territory string) ([]asc.Resource[asc.SubscriptionPricePointAttributes], error) {
+					firstPage, err := client.GetSubscriptionPricePoints(ctx, id,
+						asc.WithSubscriptionPricePointsTerritory(territory),
+						asc.WithSubscriptionPricePointsLimit(200),
+					)
+					if err != nil {
+						return nil, err
+					}
+					all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+						return client.GetSubscriptionPricePoints(ctx, id, asc.WithSubscriptionPricePointsNextURL(nextURL))
+					})
+					if err != nil {
+						return nil, err
+					}
+					points, ok := all.(*asc.SubscriptionPricePointsResponse)
+					if !ok {
+						return nil, fmt.Errorf("unexpected response type %T", all)
+					}
+					return points.Data, nil
+				},
+			}
+
+			result := applySubscriptionPriceBulk(requestCtx, id, entries, *stopOnError,
+				func(ctx context.Context, entry subscriptionPriceBulkEntry) (string, string, error) {
+					pricePointID := entry.PricePoint
+					if pricePointID == "" {
+						resolved, err := resolver.resolve(ctx, entry.Territory, entry.CustomerPrice)
+						if err != nil {
+							return "", "", err
+						}
+						pricePointID = resolved
+					}
+
+					attrs := asc.SubscriptionPriceCreateAttributes{StartDate: entry.StartDate}
+					if entry.Preserved {
+						preserved := true
+						attrs.Preserved = &preserved
+					}
+					resp, err := client.CreateSubscriptionPrice(ctx, id, pricePointID, attrs)
+					if err != nil {
+						return pricePointID, "", err
+					}
+					return pricePointID, resp.Data.ID, nil
+				},
+			)
+
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("subscriptions prices bulk: %d of %d prices failed", result.Failed, result.Total)
+			}
+
+			return nil
+		},
+	}
+}
+
+// readSubscriptionPriceBulkFile reads and validates a bulk price file. Every
+// entry is checked before any request is made so a malformed file changes
+// nothing.
+func readSubscriptionPriceBulkFile(path string) ([]subscriptionPriceBulkEntry, error) {
+	var file subscriptionPriceBulkFile
+	payload, err := readJSONFilePayloadFor(path, &subscriptionPriceBulkFile{})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(payload, &file); err != nil {
+		return nil, fmt.Errorf("invalid prices file: %w", err)
+	}
+	if len(file.Prices) == 0 {
+		return nil, fmt.Errorf("prices file has no entries in \"prices\"")
+	}
+
+	entries := make([]subscriptionPriceBulkEntry, 0, len(file.Prices))
+	for i, entry := range file.Prices {
+		entry, err := normalizeSubscriptionPriceBulkEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("prices[%d]: %w", i, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func normalizeSubscriptionPriceBulkEntry(entry subscriptionPriceBulkEntry) (subscriptionPriceBulkEntry, error) {
+	entry.PricePoint = strings.TrimSpace(entry.PricePoint)
+	entry.Territory = strings.ToUpper(strings.TrimSpace(entry.Territory))
+	entry.CustomerPrice = strings.TrimSpace(entry.CustomerPrice)
+
+	switch {
+	case entry.PricePoint != "" && (entry.Territory != "" || entry.CustomerPrice != ""):
+		return entry, fmt.Errorf("use either pricePoint or territory and customerPrice, not both")
+	case entry.PricePoint == "" && entry.Territory == "" && entry.CustomerPrice == "":
+		return entry, fmt.Errorf("pricePoint or territory and customerPrice is required")
+	case entry.PricePoint == "" && entry.Territory == "":
+		return entry, fmt.Errorf("territory is required with customerPrice")
+	case entry.PricePoint == "" && entry.CustomerPrice == "":
+		return entry, fmt.Errorf("customerPrice is required with territory")
+	}
+	if entry.CustomerPrice != "" {
+		if _, err := strconv.ParseFloat(entry.CustomerPrice, 64); err != nil {
+			return entry, fmt.Errorf("customerPrice must be a number, got %q", entry.CustomerPrice)
+		}
+	}
+
+	if strings.TrimSpace(entry.StartDate) != "" {
+		startDate, err := shared.NormalizeDate(entry.StartDate, "startDate")
+		if err != nil {
+			return entry, err
+		}
+		entry.StartDate = startDate
+	}
+	return entry, nil
+}
+
+// applySubscriptionPriceBulk creates each entry with create, which returns the
+// price point used and the created price ID. With stopOnError, entries after
+// the first failure are reported as skipped.
+func applySubscriptionPriceBulk(
+	ctx context.Context,
+	subID string,
+	entries []subscriptionPriceBulkEntry,
+	stopOnError bool,
+	create func(context.Context, subscriptionPriceBulkEntry) (string, string, error),
+) *asc.SubscriptionPriceBulkResult {
+	result := &asc.SubscriptionPriceBulkResult{
+		SubscriptionID: subID,
+		Total:          len(entries),
+		Results:        make([]asc.SubscriptionPriceBulkItem, 0, len(entries)),
+	}
+
+	stopped := false
+	for i, entry := range entries {
+		item := asc.SubscriptionPriceBulkItem{
+			Index:         i,
+			PricePointID:  entry.PricePoint,
+			Territory:     entry.Territory,
+			CustomerPrice: entry.CustomerPrice,
+			StartDate:     entry.StartDate,
+			Preserved:     entry.Preserved,
+		}
+		if stopped {
+			item.Status = subscriptionPriceBulkSkipped
+			result.Skipped++
+			result.Results = append(result.Results, item)
+			continue
+		}
+
+		pricePointID, priceID, err := create(ctx, entry)
+		if pricePointID != "" {
+			item.PricePointID = pricePointID
+		}
+		if err != nil {
+			item.Status = subscriptionPriceBulkFailed
+			item.Error = err.Error()
+			result.Failed++
+			stopped = stopOnError
+		} else {
+			item.Status = subscriptionPriceBulkCreated
+			item.PriceID = priceID
+			result.Created++
+		}
+		result.Results = append(result.Results, item)
+	}
+	return result
+}
+
+// subscriptionPricePointResolver finds a territory's price point by customer
+// price, fetching each territory's price points at most once.
+type subscriptionPricePointResolver struct {
+	fetch  func(ctx context.Context, territory string) ([]asc.Resource[asc.SubscriptionPricePointAttributes], error)
+	points map[string][]asc.Resource[asc.SubscriptionPricePointAttributes]
+}
+
+func (r *subscriptionPricePointResolver) resolve(ctx context.Context, territory, customerPrice string) (string, error) {
+	points, ok := r.points[territory]
+	if !ok {
+		fetched, err := r.fetch(ctx, territory)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch price points for %s: %w", territory, err)
+		}
+		if r.points == nil {
+			r.points = make(map[string][]asc.Resource[asc.SubscriptionPricePointAttributes])
+		}
+		r.points[territory] = fetched
+		points = fetched
+	}
+
+	want, err := strconv.ParseFloat(customerPrice, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid customerPrice %q", customerPrice)
+	}
+	for _, point := range points {
+		price, err := strconv.ParseFloat(strings.TrimSpace(point.Attributes.CustomerPrice), 64)
+		if err == nil && price == want {
+			return point.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no price point with customer price %s in %s", customerPrice, territory)
+}
@@ -0,0 +1,202 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestFilterSubscriptionGroupsByName(t *testing.T) {
+	resp := &asc.SubscriptionGroupsResponse{
+		Data: []asc.Resource[asc.SubscriptionGroupAttributes]{
+			{ID: "g1", Attributes: asc.SubscriptionGroupAttributes{ReferenceName: "Premium Monthly"}},
+			{ID: "g2", Attributes: asc.SubscriptionGroupAttributes{ReferenceName: "Basic"}},
+			{ID: "g3", Attributes: asc.SubscriptionGroupAttributes{ReferenceName: "Family PREMIUM"}},
+		},
+	}
+
+	filterSubscriptionGroupsByName(resp, "  premium ")
+
+	if len(resp.Data) != 2 || resp.Data[0].ID != "g1" || resp.Data[1].ID != "g3" {
+		t.Fatalf("unexpected filtered groups: %+v", resp.Data)
+	}
+
+	filterSubscriptionGroupsByName(resp, "")
+	if len(resp.Data) != 2 {
+		t.Fatalf("expected empty query to keep all groups, got %d", len(resp.Data))
+	}
+}
+
+func TestSubscriptionCreateLocalization(t *testing.T) {
+	attrs, err := subscriptionCreateLocalization("", " ", "")
+	if err != nil || attrs != nil {
+		t.Fatalf("expected no localization without flags, got %+v, %v", attrs, err)
+	}
+
+	if _, err := subscriptionCreateLocalization("en-US", "", "Billed monthly"); err == nil || !strings.Contains(err.Error(), "--name") {
+		t.Fatalf("expected missing --name error, got %v", err)
+	}
+	if _, err := subscriptionCreateLocalization("", "Monthly Pro", ""); err == nil || !strings.Contains(err.Error(), "--locale") {
+		t.Fatalf("expected missing --locale error, got %v", err)
+	}
+
+	attrs, err = subscriptionCreateLocalization(" en-US ", "Monthly Pro", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attrs.Locale != "en-US" || attrs.Name != "Monthly Pro" || attrs.Description != "" {
+		t.Fatalf("unexpected attributes: %+v", attrs)
+	}
+}
+
+func TestCreateLocalizationOrRollbackDeletesSubscription(t *testing.T) {
+	createErr := errors.New("locale not supported")
+	failingCreate := func(context.Context) (*asc.SubscriptionLocalizationResponse, error) {
+		return nil, createErr
+	}
+
+	t.Run("success skips rollback", func(t *testing.T) {
+		resp, err := createLocalizationOrRollback(context.Background(), "subscription", "sub-1", false,
+			func(context.Context) (*asc.SubscriptionLocalizationResponse, error) {
+				return &asc.SubscriptionLocalizationResponse{}, nil
+			},
+			func(context.Context) error { t.Fatal("unexpected rollback"); return nil },
+		)
+		if err != nil || resp == nil {
+			t.Fatalf("expected localization, got %v, %v", resp, err)
+		}
+	})
+
+	t.Run("failure deletes subscription", func(t *testing.T) {
+		rolledBack := false
+		_, err := createLocalizationOrRollback(context.Background(), "subscription", "sub-1", false, failingCreate,
+			func(context.Context) error { rolledBack = true; return nil },
+		)
+		if !rolledBack {
+			t.Fatal("expected rollback")
+		}
+		if !errors.Is(err, createErr) || !strings.Contains(err.Error(), "subscription sub-1 was deleted") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("failure with keep-on-failure", func(t *testing.T) {
+		_, err := createLocalizationOrRollback(context.Background(), "subscription", "sub-1", true, failingCreate,
+			func(context.Context) error { t.Fatal("unexpected rollback"); return nil },
+		)
+		if !errors.Is(err, createErr) || !strings.Contains(err.Error(), "subscription sub-1 was kept") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestReadSubscriptionPriceBulkFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "prices.json")
+	content := `{"prices":[{"pricePoint":" pp-1 ","startDate":"2026-03-01"},{"territory":"can","customerPrice":"12.99","preserved":true}]}`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	entries, err := readSubscriptionPriceBulkFile(path)
+	if err != nil {
+		t.Fatalf("readSubscriptionPriceBulkFile() error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].PricePoint != "pp-1" || entries[0].StartDate != "2026-03-01" {
+		t.Fatalf("unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Territory != "CAN" || entries[1].CustomerPrice != "12.99" || !entries[1].Preserved {
+		t.Fatalf("unexpected second entry: %+v", entries[1])
+	}
+}
+
+func TestReadSubscriptionPriceBulkFileRejectsInvalidEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"empty", `{"prices":[]}`, "no entries"},
+		{"both", `{"prices":[{"pricePoint":"pp-1","territory":"USA","customerPrice":"1.99"}]}`, "prices[0]: use either pricePoint"},
+		{"missing price", `{"prices":[{"pricePoint":"pp-1"},{"territory":"USA"}]}`, "prices[1]: customerPrice is required"},
+		{"bad date", `{"prices":[{"pricePoint":"pp-1","startDate":"03/01/2026"}]}`, "startDate must be in YYYY-MM-DD format"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "prices.json")
+			if err := os.WriteFile(path, []byte(test.content), 0o600); err != nil {
+				t.Fatalf("write file: %v", err)
+			}
+			_, err := readSubscriptionPriceBulkFile(path)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestApplySubscriptionPriceBulk(t *testing.T) {
+	entries := []subscriptionPriceBulkEntry{
+		{PricePoint: "pp-1"},
+		{PricePoint: "pp-2"},
+		{PricePoint: "pp-3"},
+	}
+	create := func(_ context.Context, entry subscriptionPriceBulkEntry) (string, string, error) {
+		if entry.PricePoint == "pp-2" {
+			return entry.PricePoint, "", errors.New("price already exists")
+		}
+		return entry.PricePoint, "price-" + entry.PricePoint, nil
+	}
+
+	result := applySubscriptionPriceBulk(context.Background(), "sub-1", entries, false, create)
+	if result.Total != 3 || result.Created != 2 || result.Failed != 1 || result.Skipped != 0 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	if result.Results[1].Status != "failed" || result.Results[1].Error != "price already exists" {
+		t.Fatalf("unexpected failed item: %+v", result.Results[1])
+	}
+	if result.Results[2].Status != "created" || result.Results[2].PriceID != "price-pp-3" {
+		t.Fatalf("expected later entries to continue, got %+v", result.Results[2])
+	}
+
+	result = applySubscriptionPriceBulk(context.Background(), "sub-1", entries, true, create)
+	if result.Created != 1 || result.Failed != 1 || result.Skipped != 1 {
+		t.Fatalf("unexpected counts with stop-on-error: %+v", result)
+	}
+	if result.Results[2].Status != "skipped" {
+		t.Fatalf("expected remaining entry to be skipped, got %+v", result.Results[2])
+	}
+}
+
+func TestSubscriptionPricePointResolver(t *testing.T) {
+	fetches := 0
+	resolver := &subscriptionPricePointResolver{
+		fetch: func(_ context.Context, territory string) ([]asc.Resource[asc.SubscriptionPricePointAttributes], error) {
+			fetches++
+			return []asc.Resource[asc.SubscriptionPricePointAttributes]{
+				{ID: territory + "-999", Attributes: asc.SubscriptionPricePointAttributes{CustomerPrice: "9.99"}},
+				{ID: territory + "-1299", Attributes: asc.SubscriptionPricePointAttributes{CustomerPrice: "12.99"}},
+			}, nil
+		},
+	}
+
+	id, err := resolver.resolve(context.Background(), "CAN", "12.990")
+	if err != nil || id != "CAN-1299" {
+		t.Fatalf("expected CAN-1299, got %q, %v", id, err)
+	}
+	if _, err := resolver.resolve(context.Background(), "CAN", "4.99"); err == nil || !strings.Contains(err.Error(), "no price point") {
+		t.Fatalf("expected missing price point error, got %v", err)
+	}
+	if fetches != 1 {
+		t.Fatalf("expected price points to be fetched once per territory, got %d", fetches)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -73,7 +72,7 @@ func TestFlightAppsListCommand() *ffcli.Command {
 	bundleID := fs.String("bundle-id", "", "Filter by bundle ID(s), comma-separated")
 	name := fs.String("name", "", "Filter by app name(s), comma-separated")
 	sku := fs.String("sku", "", "Filter by SKU(s), comma-separated")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	sort := fs.String("sort", "", "Sort by name or -name, bundleId or -bundleId")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -99,7 +98,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("testflight apps list: --limit must be between 1 and 200")
+				return usageErrorf("testflight apps list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("testflight apps list: %w", err)
@@ -136,14 +135,13 @@ Examples:
 				}
 
 				// Fetch all remaining pages
-				apps, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("testflight apps list: %w", err)
 				}
-
-				return printOutput(apps, *output, *pretty)
+				return nil
 			}
 
 			apps, err := client.GetApps(requestCtx, opts...)
@@ -161,7 +159,7 @@ func TestFlightAppsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -177,8 +175,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := strings.TrimSpace(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required")
-				return flag.ErrHelp
+				return flagErrorf("--app is required")
 			}
 
 			client, err := getASCClient()
@@ -76,7 +76,7 @@ type testFlightPullOptions struct {
 }
 
 type testFlightSyncClient interface {
-	GetApp(ctx context.Context, appID string) (*asc.AppResponse, error)
+	GetApp(ctx context.Context, appID string, opts ...asc.AppOption) (*asc.AppResponse, error)
 	GetBetaGroups(ctx context.Context, appID string, opts ...asc.BetaGroupsOption) (*asc.BetaGroupsResponse, error)
 	GetBetaGroupBuilds(ctx context.Context, groupID string, opts ...asc.BetaGroupBuildsOption) (*asc.BuildsResponse, error)
 	GetBetaGroupTesters(ctx context.Context, groupID string, opts ...asc.BetaGroupTestersOption) (*asc.BetaTestersResponse, error)
@@ -133,25 +133,21 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 
 			outputValue := strings.TrimSpace(*output)
 			if outputValue == "" {
-				fmt.Fprintf(os.Stderr, "Error: --output is required\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--output is required")
 			}
 
 			buildFilters := splitCSV(*buildFilter)
 			testerFilters := splitCSV(*testerFilter)
 			if len(buildFilters) > 0 && !*includeBuilds {
-				fmt.Fprintf(os.Stderr, "Error: --build requires --include-builds\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--build requires --include-builds")
 			}
 			if len(testerFilters) > 0 && !*includeTesters {
-				fmt.Fprintf(os.Stderr, "Error: --tester requires --include-testers\n\n")
-				return flag.ErrHelp
+				return flagErrorf("--tester requires --include-testers")
 			}
 
 			resolvedOutputPath, err := resolveTestFlightOutputPath(outputValue)
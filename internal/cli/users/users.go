@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -51,7 +50,7 @@ func UsersListCommand() *ffcli.Command {
 
 	email := fs.String("email", "", "Filter by email/username")
 	role := fs.String("role", "", "Filter by role (comma-separated): ADMIN, DEVELOPER, APP_MANAGER, etc.")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -74,7 +73,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("users list: --limit must be between 1 and 200")
+				return usageErrorf("users list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("users list: %w", err)
@@ -102,14 +101,13 @@ Examples:
 					return fmt.Errorf("users list: failed to fetch: %w", err)
 				}
 
-				users, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetUsers(ctx, asc.WithUsersNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("users list: %w", err)
 				}
-
-				return printOutput(users, *output, *pretty)
+				return nil
 			}
 
 			users, err := client.GetUsers(requestCtx, opts...)
@@ -127,7 +125,7 @@ func UsersGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "User ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -143,8 +141,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -172,7 +169,7 @@ func UsersUpdateCommand() *ffcli.Command {
 	id := fs.String("id", "", "User ID")
 	roles := fs.String("roles", "", "Comma-separated role IDs")
 	visibleApps := fs.String("visible-app", "", "Comma-separated app IDs for visible apps")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -189,14 +186,12 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			roleValues := splitCSV(*roles)
 			if len(roleValues) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --roles is required")
-				return flag.ErrHelp
+				return flagErrorf("--roles is required")
 			}
 
 			visibleAppIDs := parseCommaSeparatedIDs(*visibleApps)
@@ -239,7 +234,7 @@ func UsersDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "User ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -253,15 +248,19 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
-			}
-
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
+			}
+
+			if !*confirm {
+				confirmed, err := confirmDestructive(fmt.Sprintf("user %q", idValue))
+				if err != nil {
+					return fmt.Errorf("users delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -296,7 +295,7 @@ func UsersInviteCommand() *ffcli.Command {
 	roles := fs.String("roles", "", "Comma-separated role IDs")
 	allApps := fs.Bool("all-apps", false, "Grant access to all apps")
 	visibleApps := fs.String("visible-app", "", "Comma-separated app IDs for visible apps")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -313,38 +312,32 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			emailValue := strings.TrimSpace(*email)
 			if emailValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --email is required")
-				return flag.ErrHelp
+				return flagErrorf("--email is required")
 			}
 
 			firstNameValue := strings.TrimSpace(*firstName)
 			if firstNameValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --first-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--first-name is required")
 			}
 
 			lastNameValue := strings.TrimSpace(*lastName)
 			if lastNameValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --last-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--last-name is required")
 			}
 
 			roleValues := splitCSV(*roles)
 			if len(roleValues) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --roles is required")
-				return flag.ErrHelp
+				return flagErrorf("--roles is required")
 			}
 
 			if *allApps && strings.TrimSpace(*visibleApps) != "" {
-				fmt.Fprintln(os.Stderr, "Error: --all-apps and --visible-app cannot be used together")
-				return flag.ErrHelp
+				return flagErrorf("--all-apps and --visible-app cannot be used together")
 			}
 
 			visibleAppIDs := parseCommaSeparatedIDs(*visibleApps)
 
 			if !*allApps && len(visibleAppIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --all-apps or --visible-app is required")
-				return flag.ErrHelp
+				return flagErrorf("--all-apps or --visible-app is required")
 			}
 
 			client, err := getASCClient()
@@ -410,7 +403,7 @@ Examples:
 func UsersInvitesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -430,7 +423,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("users invites list: --limit must be between 1 and 200")
+				return usageErrorf("users invites list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("users invites list: %w", err)
@@ -456,14 +449,13 @@ Examples:
 					return fmt.Errorf("users invites list: failed to fetch: %w", err)
 				}
 
-				invites, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetUserInvitations(ctx, asc.WithUserInvitationsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("users invites list: %w", err)
 				}
-
-				return printOutput(invites, *output, *pretty)
+				return nil
 			}
 
 			invites, err := client.GetUserInvitations(requestCtx, opts...)
@@ -481,7 +473,7 @@ func UsersInvitesGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Invitation ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -497,8 +489,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -525,7 +516,7 @@ func UsersInvitesRevokeCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Invitation ID")
 	confirm := fs.Bool("confirm", false, "Confirm revocation")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -540,14 +531,12 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				return flagErrorf("--confirm is required")
 			}
 
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -4,12 +4,12 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 var validPhasedReleaseStates = map[string]asc.PhasedReleaseState{
@@ -25,6 +25,12 @@ var validCreateStates = []string{"INACTIVE", "ACTIVE"}
 // validUpdateStates are states allowed when updating a phased release
 var validUpdateStates = []string{"ACTIVE", "PAUSED", "COMPLETE"}
 
+// phasedReleaseStateAliases maps rollout actions to the state they set.
+var phasedReleaseStateAliases = map[string]string{
+	"PAUSE":  "PAUSED",
+	"RESUME": "ACTIVE",
+}
+
 // PhasedReleaseCommand returns the phased-release command group.
 func PhasedReleaseCommand() *ffcli.Command {
 	return &ffcli.Command{
@@ -42,6 +48,7 @@ Examples:
   asc versions phased-release get --version-id "VERSION_ID"
   asc versions phased-release create --version-id "VERSION_ID"
   asc versions phased-release update --id "PHASED_ID" --state PAUSED
+  asc versions phased-release update --version-id "VERSION_ID" --state resume
   asc versions phased-release delete --id "PHASED_ID" --confirm`,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -61,7 +68,7 @@ func PhasedReleaseGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("phased-release get", flag.ExitOnError)
 
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -77,8 +84,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			version := strings.TrimSpace(*versionID)
 			if version == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--version-id is required")
 			}
 
 			client, err := getASCClient()
@@ -105,7 +111,7 @@ func PhasedReleaseCreateCommand() *ffcli.Command {
 
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	state := fs.String("state", "", "Initial state: INACTIVE, ACTIVE (optional, defaults to INACTIVE)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -125,8 +131,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			version := strings.TrimSpace(*versionID)
 			if version == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--version-id is required")
 			}
 
 			var phasedState asc.PhasedReleaseState
@@ -135,8 +140,7 @@ Examples:
 				var ok bool
 				phasedState, ok = validPhasedReleaseStates[stateValue]
 				if !ok || (stateValue != "INACTIVE" && stateValue != "ACTIVE") {
-					fmt.Fprintf(os.Stderr, "Error: --state must be one of: %s\n", strings.Join(validCreateStates, ", "))
-					return flag.ErrHelp
+					return flagErrorf("--state must be one of: %s", strings.Join(validCreateStates, ", "))
 				}
 			}
 
@@ -162,45 +166,57 @@ Examples:
 func PhasedReleaseUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("phased-release update", flag.ExitOnError)
 
-	phasedID := fs.String("id", "", "Phased release ID (required)")
-	state := fs.String("state", "", "New state: ACTIVE, PAUSED, COMPLETE (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	phasedID := fs.String("id", "", "Phased release ID")
+	versionID := fs.String("version-id", "", "App Store version ID (alternative to --id)")
+	state := fs.String("state", "", "New state: ACTIVE, PAUSED, COMPLETE, or PAUSE/RESUME (required)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	shared.RegisterFlagValues(fs, "state", append(append([]string{}, validUpdateStates...), "PAUSE", "RESUME")...)
+
 	return &ffcli.Command{
 		Name:       "update",
 		ShortUsage: "asc versions phased-release update [flags]",
 		ShortHelp:  "Update a phased release state.",
 		LongHelp: `Update a phased release state.
 
+Identify the phased release with --id, or with --version-id to look it up
+from its App Store version.
+
 States:
-  ACTIVE   - Resume or continue the phased rollout
-  PAUSED   - Pause the rollout (users who already have the update keep it)
+  ACTIVE   - Resume or continue the phased rollout (alias: RESUME)
+  PAUSED   - Pause the rollout (users who already have the update keep it) (alias: PAUSE)
   COMPLETE - Release to all users immediately
 
 Examples:
   asc versions phased-release update --id "PHASED_ID" --state PAUSED
   asc versions phased-release update --id "PHASED_ID" --state ACTIVE
-  asc versions phased-release update --id "PHASED_ID" --state COMPLETE`,
+  asc versions phased-release update --id "PHASED_ID" --state COMPLETE
+  asc versions phased-release update --version-id "VERSION_ID" --state pause
+  asc versions phased-release update --version-id "VERSION_ID" --state resume`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*phasedID)
-			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+			versionIDValue := strings.TrimSpace(*versionID)
+			if id == "" && versionIDValue == "" {
+				return flagErrorf("--id or --version-id is required")
+			}
+			if id != "" && versionIDValue != "" {
+				return flagErrorf("--id and --version-id are mutually exclusive")
 			}
 
 			stateValue := strings.TrimSpace(strings.ToUpper(*state))
 			if stateValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --state is required")
-				return flag.ErrHelp
+				return flagErrorf("--state is required")
+			}
+			if alias, ok := phasedReleaseStateAliases[stateValue]; ok {
+				stateValue = alias
 			}
 
 			phasedState, ok := validPhasedReleaseStates[stateValue]
 			if !ok || stateValue == "INACTIVE" {
-				fmt.Fprintf(os.Stderr, "Error: --state must be one of: %s\n", strings.Join(validUpdateStates, ", "))
-				return flag.ErrHelp
+				return flagErrorf("--state must be one of: %s (or PAUSE, RESUME)", strings.Join(validUpdateStates, ", "))
 			}
 
 			client, err := getASCClient()
@@ -211,6 +227,14 @@ Examples:
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
+			if id == "" {
+				current, err := client.GetAppStoreVersionPhasedRelease(requestCtx, versionIDValue)
+				if err != nil {
+					return fmt.Errorf("phased-release update: failed to find phased release for version %s: %w", versionIDValue, err)
+				}
+				id = current.Data.ID
+			}
+
 			resp, err := client.UpdateAppStoreVersionPhasedRelease(requestCtx, id, phasedState)
 			if err != nil {
 				return fmt.Errorf("phased-release update: %w", err)
@@ -227,7 +251,7 @@ func PhasedReleaseDeleteCommand() *ffcli.Command {
 
 	phasedID := fs.String("id", "", "Phased release ID (required)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion (required)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -246,13 +270,17 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*phasedID)
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("phased release %q", id))
+				if err != nil {
+					return fmt.Errorf("phased-release delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
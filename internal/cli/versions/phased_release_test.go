@@ -130,6 +130,39 @@ func TestPhasedReleaseUpdateCommand_ValidStates(t *testing.T) {
 	}
 }
 
+func TestPhasedReleaseUpdateCommand_IDAndVersionIDMutuallyExclusive(t *testing.T) {
+	cmd := PhasedReleaseUpdateCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--id", "123", "--version-id", "456", "--state", "PAUSED"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	err := cmd.Exec(context.Background(), []string{})
+	if err != flag.ErrHelp {
+		t.Errorf("expected flag.ErrHelp when --id and --version-id are both set, got %v", err)
+	}
+}
+
+func TestPhasedReleaseUpdateCommand_ActionAliases(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+
+	for _, state := range []string{"PAUSE", "RESUME", "pause", "resume"} {
+		t.Run(state, func(t *testing.T) {
+			cmd := PhasedReleaseUpdateCommand()
+
+			if err := cmd.FlagSet.Parse([]string{"--version-id", "123", "--state", state}); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+
+			err := cmd.Exec(context.Background(), []string{})
+			// Should not be flag.ErrHelp for valid aliases (will fail later due to no auth)
+			if err == flag.ErrHelp {
+				t.Errorf("state %s should be valid but got flag.ErrHelp", state)
+			}
+		})
+	}
+}
+
 func TestPhasedReleaseDeleteCommand_MissingID(t *testing.T) {
 	cmd := PhasedReleaseDeleteCommand()
 
@@ -177,7 +210,7 @@ func TestPhasedReleaseCommand_FlagDefinitions(t *testing.T) {
 
 	// Test update command flags
 	updateCmd := PhasedReleaseUpdateCommand()
-	expectedUpdateFlags := []string{"id", "state", "output", "pretty"}
+	expectedUpdateFlags := []string{"id", "version-id", "state", "output", "pretty"}
 	for _, name := range expectedUpdateFlags {
 		if updateCmd.FlagSet.Lookup(name) == nil {
 			t.Errorf("update: expected flag --%s to be defined", name)
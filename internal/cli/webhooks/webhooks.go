@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -59,7 +58,7 @@ func WebhooksListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -77,11 +76,10 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if *limit != 0 && (*limit < 1 || *limit > webhooksMaxLimit) {
-				return fmt.Errorf("webhooks list: --limit must be between 1 and %d", webhooksMaxLimit)
+				return usageErrorf("webhooks list: --limit must be between 1 and %d", webhooksMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("webhooks list: %w", err)
@@ -102,21 +100,20 @@ Examples:
 
 			if *paginate {
 				if resolvedAppID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-					return flag.ErrHelp
+					return flagErrorf("--app is required (or set ASC_APP_ID)")
 				}
 				paginateOpts := append(opts, asc.WithWebhooksLimit(webhooksMaxLimit))
 				firstPage, err := client.GetAppWebhooks(requestCtx, resolvedAppID, paginateOpts...)
 				if err != nil {
 					return fmt.Errorf("webhooks list: failed to fetch: %w", err)
 				}
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppWebhooks(ctx, resolvedAppID, asc.WithWebhooksNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("webhooks list: %w", err)
 				}
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			webhooks, err := client.GetAppWebhooks(requestCtx, resolvedAppID, opts...)
@@ -134,7 +131,7 @@ func WebhooksGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	webhookID := fs.String("webhook-id", "", "Webhook ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -150,8 +147,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*webhookID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--webhook-id is required")
 			}
 
 			client, err := getASCClient()
@@ -183,7 +179,7 @@ func WebhooksCreateCommand() *ffcli.Command {
 	events := fs.String("events", "", "Webhook event types (comma-separated)")
 	var enabled shared.OptionalBool
 	fs.Var(&enabled, "enabled", "Enable or disable the webhook: true or false")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -199,28 +195,22 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := resolveAppID(*appID)
 			if resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required (or set ASC_APP_ID)")
 			}
 			if strings.TrimSpace(*name) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --name is required")
-				return flag.ErrHelp
+				return flagErrorf("--name is required")
 			}
 			if strings.TrimSpace(*url) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --url is required")
-				return flag.ErrHelp
+				return flagErrorf("--url is required")
 			}
 			if strings.TrimSpace(*secret) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --secret is required")
-				return flag.ErrHelp
+				return flagErrorf("--secret is required")
 			}
 			if strings.TrimSpace(*events) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --events is required")
-				return flag.ErrHelp
+				return flagErrorf("--events is required")
 			}
 			if !enabled.IsSet() {
-				fmt.Fprintln(os.Stderr, "Error: --enabled is required")
-				return flag.ErrHelp
+				return flagErrorf("--enabled is required")
 			}
 
 			eventTypes, err := normalizeWebhookEvents(*events)
@@ -265,7 +255,7 @@ func WebhooksUpdateCommand() *ffcli.Command {
 	events := fs.String("events", "", "Webhook event types (comma-separated)")
 	var enabled shared.OptionalBool
 	fs.Var(&enabled, "enabled", "Enable or disable the webhook: true or false")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -282,8 +272,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*webhookID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--webhook-id is required")
 			}
 
 			attrs := asc.WebhookUpdateAttributes{}
@@ -319,8 +308,7 @@ Examples:
 			}
 
 			if !hasUpdate {
-				fmt.Fprintln(os.Stderr, "Error: --name, --url, --secret, --events, or --enabled is required")
-				return flag.ErrHelp
+				return flagErrorf("--name, --url, --secret, --events, or --enabled is required")
 			}
 
 			client, err := getASCClient()
@@ -347,7 +335,7 @@ func WebhooksDeleteCommand() *ffcli.Command {
 
 	webhookID := fs.String("webhook-id", "", "Webhook ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -361,14 +349,18 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
-			}
 			trimmedID := strings.TrimSpace(*webhookID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--webhook-id is required")
+			}
+			if !*confirm {
+				confirmed, err := confirmDestructive(fmt.Sprintf("webhook %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("webhooks delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -399,7 +391,7 @@ func WebhookDeliveriesCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -421,8 +413,7 @@ Examples:
 			trimmedID := strings.TrimSpace(*webhookID)
 			trimmedNext := strings.TrimSpace(*next)
 			if trimmedID == "" && trimmedNext == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--webhook-id is required")
 			}
 			filterCount := 0
 			if strings.TrimSpace(*createdAfter) != "" {
@@ -433,16 +424,14 @@ Examples:
 			}
 			if trimmedNext == "" {
 				if filterCount == 0 {
-					fmt.Fprintln(os.Stderr, "Error: --created-after or --created-before is required")
-					return flag.ErrHelp
+					return flagErrorf("--created-after or --created-before is required")
 				}
 				if filterCount > 1 {
-					fmt.Fprintln(os.Stderr, "Error: only one of --created-after or --created-before can be used")
-					return flag.ErrHelp
+					return flagErrorf("only one of --created-after or --created-before can be used")
 				}
 			}
 			if *limit != 0 && (*limit < 1 || *limit > webhooksMaxLimit) {
-				return fmt.Errorf("webhooks deliveries: --limit must be between 1 and %d", webhooksMaxLimit)
+				return usageErrorf("webhooks deliveries: --limit must be between 1 and %d", webhooksMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("webhooks deliveries: %w", err)
@@ -463,37 +452,34 @@ Examples:
 			if strings.TrimSpace(*createdAfter) != "" {
 				values := splitCSV(*createdAfter)
 				if len(values) == 0 {
-					fmt.Fprintln(os.Stderr, "Error: --created-after must include at least one value")
-					return flag.ErrHelp
+					return flagErrorf("--created-after must include at least one value")
 				}
 				opts = append(opts, asc.WithWebhookDeliveriesCreatedAfter(values))
 			}
 			if strings.TrimSpace(*createdBefore) != "" {
 				values := splitCSV(*createdBefore)
 				if len(values) == 0 {
-					fmt.Fprintln(os.Stderr, "Error: --created-before must include at least one value")
-					return flag.ErrHelp
+					return flagErrorf("--created-before must include at least one value")
 				}
 				opts = append(opts, asc.WithWebhookDeliveriesCreatedBefore(values))
 			}
 
 			if *paginate {
 				if trimmedID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-					return flag.ErrHelp
+					return flagErrorf("--webhook-id is required")
 				}
 				paginateOpts := append(opts, asc.WithWebhookDeliveriesLimit(webhooksMaxLimit))
 				firstPage, err := client.GetWebhookDeliveries(requestCtx, trimmedID, paginateOpts...)
 				if err != nil {
 					return fmt.Errorf("webhooks deliveries: failed to fetch: %w", err)
 				}
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetWebhookDeliveries(ctx, trimmedID, asc.WithWebhookDeliveriesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("webhooks deliveries: %w", err)
 				}
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			deliveries, err := client.GetWebhookDeliveries(requestCtx, trimmedID, opts...)
@@ -511,7 +497,7 @@ func WebhookDeliveriesRedeliverCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("redeliver", flag.ExitOnError)
 
 	deliveryID := fs.String("delivery-id", "", "Webhook delivery ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -527,8 +513,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*deliveryID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --delivery-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--delivery-id is required")
 			}
 
 			client, err := getASCClient()
@@ -554,7 +539,7 @@ func WebhookPingCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("ping", flag.ExitOnError)
 
 	webhookID := fs.String("webhook-id", "", "Webhook ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -570,8 +555,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*webhookID)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--webhook-id is required")
 			}
 
 			client, err := getASCClient()
@@ -1,7 +1,6 @@
 package webhooks
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
@@ -10,7 +9,7 @@ import (
 func normalizeWebhookEvents(value string) ([]asc.WebhookEventType, error) {
 	values := splitCSV(value)
 	if len(values) == 0 {
-		return nil, fmt.Errorf("--events must include at least one value")
+		return nil, usageErrorf("--events must include at least one value")
 	}
 
 	normalized := make([]asc.WebhookEventType, 0, len(values))
@@ -23,7 +22,7 @@ func normalizeWebhookEvents(value string) ([]asc.WebhookEventType, error) {
 	}
 
 	if len(normalized) == 0 {
-		return nil, fmt.Errorf("--events must include at least one value")
+		return nil, usageErrorf("--events must include at least one value")
 	}
 
 	return normalized, nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -118,7 +117,7 @@ func WinBackOffersListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -135,13 +134,13 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > winBackOffersMaxLimit) {
-				return fmt.Errorf("win-back-offers list: --limit must be between 1 and %d", winBackOffersMaxLimit)
+				return usageErrorf("win-back-offers list: --limit must be between 1 and %d", winBackOffersMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("win-back-offers list: %w", err)
 			}
 			if *pricesLimit != 0 && (*pricesLimit < 1 || *pricesLimit > winBackOffersPricesMaxLimit) {
-				return fmt.Errorf("win-back-offers list: --prices-limit must be between 1 and %d", winBackOffersPricesMaxLimit)
+				return usageErrorf("win-back-offers list: --prices-limit must be between 1 and %d", winBackOffersPricesMaxLimit)
 			}
 
 			fieldsValue, err := normalizeWinBackOfferFields(*fields, "--fields")
@@ -157,18 +156,15 @@ Examples:
 				return fmt.Errorf("win-back-offers list: %w", err)
 			}
 			if len(priceFieldsValue) > 0 && !hasInclude(includeValue, "prices") {
-				fmt.Fprintln(os.Stderr, "Error: --price-fields requires --include prices")
-				return flag.ErrHelp
+				return flagErrorf("--price-fields requires --include prices")
 			}
 			if *pricesLimit != 0 && !hasInclude(includeValue, "prices") {
-				fmt.Fprintln(os.Stderr, "Error: --prices-limit requires --include prices")
-				return flag.ErrHelp
+				return flagErrorf("--prices-limit requires --include prices")
 			}
 
 			id := strings.TrimSpace(*subscriptionID)
 			if id == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
-				return flag.ErrHelp
+				return flagErrorf("--subscription is required")
 			}
 
 			client, err := getASCClient()
@@ -195,14 +191,13 @@ Examples:
 					return fmt.Errorf("win-back-offers list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionWinBackOffers(ctx, id, asc.WithWinBackOffersNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("win-back-offers list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetSubscriptionWinBackOffers(requestCtx, id, opts...)
@@ -220,7 +215,7 @@ func WinBackOffersGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Win-back offer ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -236,8 +231,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*id)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -282,7 +276,7 @@ func WinBackOffersCreateCommand() *ffcli.Command {
 	priority := fs.String("priority", "", "Offer priority: "+strings.Join(winBackOfferPriorityValues, ", "))
 	promotionIntent := fs.String("promotion-intent", "", "Promotion intent: "+strings.Join(winBackOfferPromotionIntentValues, ", "))
 	priceIDs := fs.String("price", "", "Win-back offer price ID(s), comma-separated")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -298,25 +292,21 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			subscription := strings.TrimSpace(*subscriptionID)
 			if subscription == "" {
-				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
-				return flag.ErrHelp
+				return flagErrorf("--subscription is required")
 			}
 
 			name := strings.TrimSpace(*referenceName)
 			if name == "" {
-				fmt.Fprintln(os.Stderr, "Error: --reference-name is required")
-				return flag.ErrHelp
+				return flagErrorf("--reference-name is required")
 			}
 
 			offer := strings.TrimSpace(*offerID)
 			if offer == "" {
-				fmt.Fprintln(os.Stderr, "Error: --offer-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--offer-id is required")
 			}
 
 			if strings.TrimSpace(*duration) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --duration is required")
-				return flag.ErrHelp
+				return flagErrorf("--duration is required")
 			}
 			durationValue, err := normalizeWinBackOfferDuration(*duration)
 			if err != nil {
@@ -324,8 +314,7 @@ Examples:
 			}
 
 			if strings.TrimSpace(*offerMode) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --offer-mode is required")
-				return flag.ErrHelp
+				return flagErrorf("--offer-mode is required")
 			}
 			offerModeValue, err := normalizeWinBackOfferMode(*offerMode)
 			if err != nil {
@@ -333,24 +322,21 @@ Examples:
 			}
 
 			if !periodCount.set {
-				fmt.Fprintln(os.Stderr, "Error: --period-count is required")
-				return flag.ErrHelp
+				return flagErrorf("--period-count is required")
 			}
 			if periodCount.value <= 0 {
-				return fmt.Errorf("win-back-offers create: --period-count must be greater than 0")
+				return usageErrorf("win-back-offers create: --period-count must be greater than 0")
 			}
 
 			if !eligibilityPaidMonths.set {
-				fmt.Fprintln(os.Stderr, "Error: --eligibility-paid-months is required")
-				return flag.ErrHelp
+				return flagErrorf("--eligibility-paid-months is required")
 			}
 			if eligibilityPaidMonths.value < 0 {
-				return fmt.Errorf("win-back-offers create: --eligibility-paid-months must be 0 or greater")
+				return usageErrorf("win-back-offers create: --eligibility-paid-months must be 0 or greater")
 			}
 
 			if !eligibilityLastSubscribedMin.set && !eligibilityLastSubscribedMax.set {
-				fmt.Fprintln(os.Stderr, "Error: --eligibility-last-subscribed-min or --eligibility-last-subscribed-max is required")
-				return flag.ErrHelp
+				return flagErrorf("--eligibility-last-subscribed-min or --eligibility-last-subscribed-max is required")
 			}
 			if eligibilityLastSubscribedMin.set && eligibilityLastSubscribedMin.value < 0 {
 				return fmt.Errorf("win-back-offers create: eligibility last subscribed min must be 0 or greater")
@@ -371,8 +357,7 @@ Examples:
 			}
 
 			if strings.TrimSpace(*startDate) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --start-date is required")
-				return flag.ErrHelp
+				return flagErrorf("--start-date is required")
 			}
 			normalizedStartDate, err := normalizeDate(*startDate, "--start-date")
 			if err != nil {
@@ -380,8 +365,7 @@ Examples:
 			}
 
 			if strings.TrimSpace(*priority) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --priority is required")
-				return flag.ErrHelp
+				return flagErrorf("--priority is required")
 			}
 			priorityValue, err := normalizeWinBackOfferPriority(*priority)
 			if err != nil {
@@ -390,12 +374,11 @@ Examples:
 
 			prices := parseCommaSeparatedIDs(*priceIDs)
 			if len(prices) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --price is required")
-				return flag.ErrHelp
+				return flagErrorf("--price is required")
 			}
 
 			if eligibilityWaitMonths.set && eligibilityWaitMonths.value < 0 {
-				return fmt.Errorf("win-back-offers create: --eligibility-wait-months must be 0 or greater")
+				return usageErrorf("win-back-offers create: --eligibility-wait-months must be 0 or greater")
 			}
 
 			normalizedEndDate := ""
@@ -497,7 +480,7 @@ func WinBackOffersUpdateCommand() *ffcli.Command {
 	endDate := fs.String("end-date", "", "End date (YYYY-MM-DD)")
 	priority := fs.String("priority", "", "Offer priority: "+strings.Join(winBackOfferPriorityValues, ", "))
 	promotionIntent := fs.String("promotion-intent", "", "Promotion intent: "+strings.Join(winBackOfferPromotionIntentValues, ", "))
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -514,8 +497,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*id)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			hasUpdates := false
@@ -523,7 +505,7 @@ Examples:
 
 			if eligibilityPaidMonths.set {
 				if eligibilityPaidMonths.value < 0 {
-					return fmt.Errorf("win-back-offers update: --eligibility-paid-months must be 0 or greater")
+					return usageErrorf("win-back-offers update: --eligibility-paid-months must be 0 or greater")
 				}
 				attrs.CustomerEligibilityPaidSubscriptionDurationInMonths = &eligibilityPaidMonths.value
 				hasUpdates = true
@@ -552,7 +534,7 @@ Examples:
 
 			if eligibilityWaitMonths.set {
 				if eligibilityWaitMonths.value < 0 {
-					return fmt.Errorf("win-back-offers update: --eligibility-wait-months must be 0 or greater")
+					return usageErrorf("win-back-offers update: --eligibility-wait-months must be 0 or greater")
 				}
 				attrs.CustomerEligibilityWaitBetweenOffersInMonths = &eligibilityWaitMonths.value
 				hasUpdates = true
@@ -595,8 +577,7 @@ Examples:
 			}
 
 			if !hasUpdates {
-				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
-				return flag.ErrHelp
+				return flagErrorf("at least one update flag is required")
 			}
 
 			client, err := getASCClient()
@@ -623,7 +604,7 @@ func WinBackOffersDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Win-back offer ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -639,12 +620,16 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*id)
 			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("win-back offer %q", trimmedID))
+				if err != nil {
+					return fmt.Errorf("win-back-offers delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -682,7 +667,7 @@ func WinBackOffersPricesCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -698,7 +683,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > winBackOffersMaxLimit) {
-				return fmt.Errorf("win-back-offers prices: --limit must be between 1 and %d", winBackOffersMaxLimit)
+				return usageErrorf("win-back-offers prices: --limit must be between 1 and %d", winBackOffersMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("win-back-offers prices: %w", err)
@@ -706,8 +691,7 @@ Examples:
 
 			trimmedID := strings.TrimSpace(*id)
 			if trimmedID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			fieldsValue, err := normalizeWinBackOfferPriceFields(*fields, "--fields")
@@ -727,12 +711,10 @@ Examples:
 				return fmt.Errorf("win-back-offers prices: %w", err)
 			}
 			if len(territoryFieldsValue) > 0 && !hasInclude(includeValue, "territory") {
-				fmt.Fprintln(os.Stderr, "Error: --territory-fields requires --include territory")
-				return flag.ErrHelp
+				return flagErrorf("--territory-fields requires --include territory")
 			}
 			if len(pricePointFieldsValue) > 0 && !hasInclude(includeValue, "subscriptionPricePoint") {
-				fmt.Fprintln(os.Stderr, "Error: --price-point-fields requires --include subscriptionPricePoint")
-				return flag.ErrHelp
+				return flagErrorf("--price-point-fields requires --include subscriptionPricePoint")
 			}
 
 			client, err := getASCClient()
@@ -760,14 +742,13 @@ Examples:
 					return fmt.Errorf("win-back-offers prices: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetWinBackOfferPrices(ctx, trimmedID, asc.WithWinBackOfferPricesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("win-back-offers prices: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetWinBackOfferPrices(requestCtx, trimmedID, opts...)
@@ -788,7 +769,7 @@ func WinBackOffersPricesRelationshipsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -804,7 +785,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > winBackOffersMaxLimit) {
-				return fmt.Errorf("win-back-offers prices-relationships: --limit must be between 1 and %d", winBackOffersMaxLimit)
+				return usageErrorf("win-back-offers prices-relationships: --limit must be between 1 and %d", winBackOffersMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("win-back-offers prices-relationships: %w", err)
@@ -812,8 +793,7 @@ Examples:
 
 			trimmedID := strings.TrimSpace(*id)
 			if trimmedID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -836,14 +816,13 @@ Examples:
 					return fmt.Errorf("win-back-offers prices-relationships: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetWinBackOfferPricesRelationships(ctx, trimmedID, asc.WithLinkagesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("win-back-offers prices-relationships: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetWinBackOfferPricesRelationships(requestCtx, trimmedID, opts...)
@@ -864,7 +843,7 @@ func WinBackOffersRelationshipsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -880,7 +859,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > winBackOffersMaxLimit) {
-				return fmt.Errorf("win-back-offers relationships: --limit must be between 1 and %d", winBackOffersMaxLimit)
+				return usageErrorf("win-back-offers relationships: --limit must be between 1 and %d", winBackOffersMaxLimit)
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("win-back-offers relationships: %w", err)
@@ -888,8 +867,7 @@ Examples:
 
 			trimmedID := strings.TrimSpace(*subscriptionID)
 			if trimmedID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --subscription is required")
-				return flag.ErrHelp
+				return flagErrorf("--subscription is required")
 			}
 
 			client, err := getASCClient()
@@ -912,14 +890,13 @@ Examples:
 					return fmt.Errorf("win-back-offers relationships: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionWinBackOffersRelationships(ctx, trimmedID, asc.WithLinkagesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("win-back-offers relationships: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetSubscriptionWinBackOffersRelationships(requestCtx, trimmedID, opts...)
@@ -962,7 +939,7 @@ func normalizeWinBackOfferDuration(value string) (asc.SubscriptionOfferDuration,
 	if duration, ok := winBackOfferDurationMap[normalized]; ok {
 		return duration, nil
 	}
-	return "", fmt.Errorf("--duration must be one of: %s", strings.Join(winBackOfferDurationValues, ", "))
+	return "", usageErrorf("--duration must be one of: %s", strings.Join(winBackOfferDurationValues, ", "))
 }
 
 func normalizeWinBackOfferMode(value string) (asc.SubscriptionOfferMode, error) {
@@ -973,7 +950,7 @@ func normalizeWinBackOfferMode(value string) (asc.SubscriptionOfferMode, error)
 	if mode, ok := winBackOfferModeMap[normalized]; ok {
 		return mode, nil
 	}
-	return "", fmt.Errorf("--offer-mode must be one of: %s", strings.Join(winBackOfferModeValues, ", "))
+	return "", usageErrorf("--offer-mode must be one of: %s", strings.Join(winBackOfferModeValues, ", "))
 }
 
 func normalizeWinBackOfferPriority(value string) (asc.WinBackOfferPriority, error) {
@@ -984,7 +961,7 @@ func normalizeWinBackOfferPriority(value string) (asc.WinBackOfferPriority, erro
 	if priority, ok := winBackOfferPriorityMap[normalized]; ok {
 		return priority, nil
 	}
-	return "", fmt.Errorf("--priority must be one of: %s", strings.Join(winBackOfferPriorityValues, ", "))
+	return "", usageErrorf("--priority must be one of: %s", strings.Join(winBackOfferPriorityValues, ", "))
 }
 
 func normalizeWinBackOfferPromotionIntent(value string) (asc.WinBackOfferPromotionIntent, error) {
@@ -995,7 +972,7 @@ func normalizeWinBackOfferPromotionIntent(value string) (asc.WinBackOfferPromoti
 	if intent, ok := winBackOfferPromotionIntentMap[normalized]; ok {
 		return intent, nil
 	}
-	return "", fmt.Errorf("--promotion-intent must be one of: %s", strings.Join(winBackOfferPromotionIntentValues, ", "))
+	return "", usageErrorf("--promotion-intent must be one of: %s", strings.Join(winBackOfferPromotionIntentValues, ", "))
 }
 
 func normalizeEnumValue(value string) string {
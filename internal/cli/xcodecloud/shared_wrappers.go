@@ -2,6 +2,9 @@ package xcodecloud
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
+	"io"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -32,3 +35,47 @@ func printOutput(data interface{}, format string, pretty bool) error {
 func validateNextURL(next string) error {
 	return shared.ValidateNextURL(next)
 }
+
+func readJSONFilePayload(path string) (json.RawMessage, error) {
+	return shared.ReadJSONFilePayload(path)
+}
+
+func validateJSONPayloadFor(payload json.RawMessage, target any) error {
+	return shared.ValidateJSONPayloadFor(payload, target)
+}
+
+func validateSort(value string, allowed ...string) error {
+	return shared.ValidateSort(value, allowed...)
+}
+
+func registerFlagValues(fs *flag.FlagSet, name string, values ...string) {
+	shared.RegisterFlagValues(fs, name, values...)
+}
+
+func splitCSV(value string) []string {
+	return shared.SplitCSV(value)
+}
+
+func hasInclude(values []string, include string) bool {
+	return shared.HasInclude(values, include)
+}
+
+func paginateOutput(ctx context.Context, firstPage asc.PaginatedResponse, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	return shared.PaginateOutput(ctx, firstPage, fetchNext, format, pretty)
+}
+
+func progressReader(r io.Reader, total int64, label string) io.Reader {
+	return shared.ProgressReader(r, total, label)
+}
+
+func confirmDestructive(resourceDescription string) (bool, error) {
+	return shared.ConfirmDestructive(resourceDescription)
+}
+
+func usageErrorf(format string, args ...any) error {
+	return shared.UsageErrorf(format, args...)
+}
+
+func flagErrorf(format string, args ...any) error {
+	return shared.FlagErrorf(format, args...)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -29,6 +28,7 @@ Examples:
   asc xcode-cloud actions --run-id "BUILD_RUN_ID"
   asc xcode-cloud run --app "APP_ID" --workflow "WorkflowName" --branch "main"
   asc xcode-cloud run --workflow-id "WORKFLOW_ID" --git-reference-id "REF_ID"
+  asc xcode-cloud run --app "123456789" --workflow "Release" --branch "1.0" --git-reference-kind tag
   asc xcode-cloud run --app "APP_ID" --workflow "Deploy" --branch "main" --wait
   asc xcode-cloud status --run-id "BUILD_RUN_ID"
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait`,
@@ -62,12 +62,23 @@ func XcodeCloudRunCommand() *ffcli.Command {
 	workflowID := fs.String("workflow-id", "", "Workflow ID to trigger (alternative to --workflow)")
 	branch := fs.String("branch", "", "Branch or tag name to build")
 	gitReferenceID := fs.String("git-reference-id", "", "Git reference ID to build (alternative to --branch)")
+	gitReferenceKind := fs.String("git-reference-kind", "", "Kind of reference --branch names: branch or tag")
 	wait := fs.Bool("wait", false, "Wait for build to complete")
 	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval when waiting")
-	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	timeout := fs.Duration("timeout", 0, "Timeout for each Xcode Cloud request (0 = use ASC_TIMEOUT or 30m default)")
+	waitTimeout := fs.Duration("wait-timeout", defaultXcodeCloudWaitTimeout, "Overall time budget for --wait")
+	exitCodeMap := fs.String("exit-code-map", "", "Map completion statuses to exit codes (e.g., FAILED=10,ERRORED=11,CANCELED=12)")
+	downloadArtifacts := fs.Bool("download-artifacts", false, "Download all action artifacts after the build completes (requires --wait)")
+	artifactsDir := fs.String("artifacts-dir", "", "Directory for downloaded artifacts (requires --download-artifacts)")
+	artifactsOnFailure := fs.Bool("artifacts-on-failure", false, "Download artifacts even when the build does not succeed")
+	notifyURL := fs.String("notify-url", "", "POST a JSON summary to this URL when the build completes (requires --wait)")
+	notifyOn := fs.String("notify-on", notifyOnAlways, "When to send --notify-url: "+strings.Join(notifyOnValues, ", "))
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	registerFlagValues(fs, "notify-on", notifyOnValues...)
+	registerFlagValues(fs, "git-reference-kind", gitReferenceKindValues...)
+
 	return &ffcli.Command{
 		Name:       "run",
 		ShortUsage: "asc xcode-cloud run [flags]",
@@ -76,12 +87,30 @@ func XcodeCloudRunCommand() *ffcli.Command {
 
 You can specify the workflow by name (requires --app) or by ID (--workflow-id).
 You can specify the branch/tag by name (--branch) or by ID (--git-reference-id).
+When a branch and a tag share a name, add --git-reference-kind to pick one.
 
 Examples:
   asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main"
   asc xcode-cloud run --workflow-id "WORKFLOW_ID" --git-reference-id "REF_ID"
   asc xcode-cloud run --app "123456789" --workflow "Deploy" --branch "release/1.0" --wait
-  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --poll-interval 30s --timeout 1h`,
+  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --poll-interval 30s --wait-timeout 4h
+  asc xcode-cloud run --workflow-id "WORKFLOW_ID" --branch "main" --wait --exit-code-map "FAILED=10,ERRORED=11,CANCELED=12"
+  asc xcode-cloud run --app "123456789" --workflow "Release" --branch "main" --wait --download-artifacts --artifacts-dir ./out
+  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --notify-url "https://hooks.example.com/ci" --notify-on failure
+
+With --wait, --timeout bounds each individual API request while --wait-timeout
+(default 2h) bounds the whole wait for the build to finish. A slow request
+fails with a --timeout error; a build still running when the budget runs out
+fails with a --wait-timeout error.
+
+With --download-artifacts, every artifact from every action is downloaded into
+--artifacts-dir (one subdirectory per action) once the build succeeds. Failed
+builds skip the download unless --artifacts-on-failure is set.
+
+With --notify-url, a JSON payload (buildRunId, completionStatus, succeeded,
+durationSeconds, ...) is POSTed to the URL when the build completes. Delivery
+is retried on transient failures; if it still fails a warning is printed but
+the command's exit status is unaffected.`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -92,30 +121,68 @@ Examples:
 			hasGitRefID := strings.TrimSpace(*gitReferenceID) != ""
 
 			if hasWorkflowName && hasWorkflowID {
-				return fmt.Errorf("xcode-cloud run: --workflow and --workflow-id are mutually exclusive")
+				return usageErrorf("xcode-cloud run: --workflow and --workflow-id are mutually exclusive")
 			}
 			if !hasWorkflowName && !hasWorkflowID {
-				fmt.Fprintln(os.Stderr, "Error: --workflow or --workflow-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--workflow or --workflow-id is required")
 			}
 			if hasBranch && hasGitRefID {
-				return fmt.Errorf("xcode-cloud run: --branch and --git-reference-id are mutually exclusive")
+				return usageErrorf("xcode-cloud run: --branch and --git-reference-id are mutually exclusive")
 			}
 			if !hasBranch && !hasGitRefID {
-				fmt.Fprintln(os.Stderr, "Error: --branch or --git-reference-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--branch or --git-reference-id is required")
+			}
+			refKind, err := normalizeGitReferenceKind(*gitReferenceKind)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud run: %w", err)
+			}
+			if refKind != "" && !hasBranch {
+				return usageErrorf("xcode-cloud run: --git-reference-kind requires --branch")
 			}
 			if *timeout < 0 {
-				return fmt.Errorf("xcode-cloud run: --timeout must be greater than or equal to 0")
+				return usageErrorf("xcode-cloud run: --timeout must be greater than or equal to 0")
+			}
+			if *wait && *waitTimeout <= 0 {
+				return usageErrorf("xcode-cloud run: --wait-timeout must be greater than 0")
 			}
 			if *wait && *pollInterval <= 0 {
-				return fmt.Errorf("xcode-cloud run: --poll-interval must be greater than 0")
+				return usageErrorf("xcode-cloud run: --poll-interval must be greater than 0")
+			}
+			exitCodes, err := parseExitCodeMap(*exitCodeMap)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud run: %w", err)
+			}
+			if exitCodes != nil && !*wait {
+				return usageErrorf("xcode-cloud run: --exit-code-map requires --wait")
+			}
+			artifactsDirValue := strings.TrimSpace(*artifactsDir)
+			if *downloadArtifacts {
+				if !*wait {
+					return usageErrorf("xcode-cloud run: --download-artifacts requires --wait")
+				}
+				if artifactsDirValue == "" {
+					return flagErrorf("--artifacts-dir is required with --download-artifacts")
+				}
+			} else if artifactsDirValue != "" || *artifactsOnFailure {
+				return usageErrorf("xcode-cloud run: --artifacts-dir and --artifacts-on-failure require --download-artifacts")
+			}
+			notifyURLValue := strings.TrimSpace(*notifyURL)
+			notifyOnValue, err := normalizeNotifyOn(*notifyOn)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud run: %w", err)
+			}
+			if notifyURLValue != "" {
+				if !*wait {
+					return usageErrorf("xcode-cloud run: --notify-url requires --wait")
+				}
+				if err := validateNotifyURL(notifyURLValue); err != nil {
+					return fmt.Errorf("xcode-cloud run: %w", err)
+				}
 			}
 
 			resolvedAppID := resolveAppID(*appID)
 			if hasWorkflowName && resolvedAppID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required when using --workflow (or set ASC_APP_ID)")
-				return flag.ErrHelp
+				return flagErrorf("--app is required when using --workflow (or set ASC_APP_ID)")
 			}
 
 			client, err := getASCClient()
@@ -125,6 +192,7 @@ Examples:
 
 			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
 			defer cancel()
+			waitOpts := buildRunWaitOptions{PollInterval: *pollInterval, RequestTimeout: *timeout, WaitTimeout: *waitTimeout}
 
 			// Resolve workflow ID
 			resolvedWorkflowID := strings.TrimSpace(*workflowID)
@@ -156,7 +224,7 @@ Examples:
 					return fmt.Errorf("xcode-cloud run: failed to get workflow repository: %w", err)
 				}
 
-				gitRef, err := client.ResolveGitReferenceByName(requestCtx, repo.ID, strings.TrimSpace(*branch))
+				gitRef, err := client.ResolveGitReferenceByName(requestCtx, repo.ID, strings.TrimSpace(*branch), refKind)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud run: %w", err)
 				}
@@ -185,31 +253,87 @@ Examples:
 				return fmt.Errorf("xcode-cloud run: failed to trigger build: %w", err)
 			}
 
-			result := &asc.XcodeCloudRunResult{
-				BuildRunID:        resp.Data.ID,
-				BuildNumber:       resp.Data.Attributes.Number,
-				WorkflowID:        resolvedWorkflowID,
-				WorkflowName:      workflowNameForOutput,
-				GitReferenceID:    resolvedGitRefID,
-				GitReferenceName:  refNameForOutput,
-				ExecutionProgress: string(resp.Data.Attributes.ExecutionProgress),
-				CompletionStatus:  string(resp.Data.Attributes.CompletionStatus),
-				StartReason:       resp.Data.Attributes.StartReason,
-				CreatedDate:       resp.Data.Attributes.CreatedDate,
-				StartedDate:       resp.Data.Attributes.StartedDate,
-				FinishedDate:      resp.Data.Attributes.FinishedDate,
-			}
+			result := newXcodeCloudRunResult(resp, resolvedWorkflowID, workflowNameForOutput, resolvedGitRefID, refNameForOutput)
 
 			if !*wait {
 				return printOutput(result, *output, *pretty)
 			}
 
-			// Wait for completion
-			return waitForBuildCompletion(requestCtx, client, resp.Data.ID, *pollInterval, *output, *pretty)
+			if !*downloadArtifacts && notifyURLValue == "" {
+				return waitForBuildCompletion(ctx, client, resp.Data.ID, waitOpts, exitCodes, *output, *pretty)
+			}
+
+			finished, err := pollBuildRunUntilComplete(ctx, client, resp.Data.ID, waitOpts)
+			if err != nil {
+				return err
+			}
+			status := finished.Data.Attributes.CompletionStatus
+			if notifyURLValue != "" {
+				notifyBuildCompletion(ctx, notifyURLValue, notifyOnValue, finished)
+			}
+			if !*downloadArtifacts {
+				if err := printOutput(buildStatusResult(finished), *output, *pretty); err != nil {
+					return err
+				}
+				return buildCompletionError(resp.Data.ID, status, exitCodes)
+			}
+			artifactsResult := &asc.XcodeCloudRunArtifactsResult{
+				XcodeCloudStatusResult: *buildStatusResult(finished),
+				ArtifactsDir:           artifactsDirValue,
+				Artifacts:              []asc.CiArtifactDownloadResult{},
+			}
+			if asc.IsBuildRunSuccessful(status) || *artifactsOnFailure {
+				downloadCtx, downloadCancel := contextWithXcodeCloudTimeout(ctx, *timeout)
+				defer downloadCancel()
+				downloaded, err := downloadBuildRunArtifacts(downloadCtx, client, resp.Data.ID, artifactsDirValue)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud run: %w", err)
+				}
+				artifactsResult.Artifacts = downloaded
+			}
+			if err := printOutput(artifactsResult, *output, *pretty); err != nil {
+				return err
+			}
+			return buildCompletionError(resp.Data.ID, status, exitCodes)
 		},
 	}
 }
 
+// newXcodeCloudRunResult describes a newly created build run.
+func newXcodeCloudRunResult(resp *asc.CiBuildRunResponse, workflowID, workflowName, gitReferenceID, gitReferenceName string) *asc.XcodeCloudRunResult {
+	return &asc.XcodeCloudRunResult{
+		BuildRunID:        resp.Data.ID,
+		BuildNumber:       resp.Data.Attributes.Number,
+		WorkflowID:        workflowID,
+		WorkflowName:      workflowName,
+		GitReferenceID:    gitReferenceID,
+		GitReferenceName:  gitReferenceName,
+		ExecutionProgress: string(resp.Data.Attributes.ExecutionProgress),
+		CompletionStatus:  string(resp.Data.Attributes.CompletionStatus),
+		StartReason:       resp.Data.Attributes.StartReason,
+		CreatedDate:       resp.Data.Attributes.CreatedDate,
+		StartedDate:       resp.Data.Attributes.StartedDate,
+		FinishedDate:      resp.Data.Attributes.FinishedDate,
+	}
+}
+
+var gitReferenceKindValues = []string{"branch", "tag"}
+
+// normalizeGitReferenceKind maps --git-reference-kind to the API's reference
+// kind. An empty value means any kind.
+func normalizeGitReferenceKind(value string) (asc.ScmGitReferenceKind, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return "", nil
+	case "branch":
+		return asc.ScmGitReferenceKindBranch, nil
+	case "tag":
+		return asc.ScmGitReferenceKindTag, nil
+	default:
+		return "", usageErrorf("--git-reference-kind must be one of: %s", strings.Join(gitReferenceKindValues, ", "))
+	}
+}
+
 // XcodeCloudStatusCommand returns the xcode-cloud status subcommand.
 func XcodeCloudStatusCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("status", flag.ExitOnError)
@@ -217,8 +341,10 @@ func XcodeCloudStatusCommand() *ffcli.Command {
 	runID := fs.String("run-id", "", "Build run ID to check")
 	wait := fs.Bool("wait", false, "Wait for build to complete")
 	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval when waiting")
-	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	timeout := fs.Duration("timeout", 0, "Timeout for each Xcode Cloud request (0 = use ASC_TIMEOUT or 30m default)")
+	waitTimeout := fs.Duration("wait-timeout", defaultXcodeCloudWaitTimeout, "Overall time budget for --wait")
+	exitCodeMap := fs.String("exit-code-map", "", "Map completion statuses to exit codes (e.g., FAILED=10,ERRORED=11,CANCELED=12)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -231,19 +357,29 @@ Examples:
   asc xcode-cloud status --run-id "BUILD_RUN_ID"
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --output table
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait
-  asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait --poll-interval 30s --timeout 1h`,
+  asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait --poll-interval 30s --wait-timeout 4h
+  asc xcode-cloud status --run-id "BUILD_RUN_ID" --exit-code-map "FAILED=10,ERRORED=11,CANCELED=12"
+
+With --wait, --timeout applies to each status request and --wait-timeout
+(default 2h) to the wait as a whole.`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*runID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --run-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--run-id is required")
 			}
 			if *timeout < 0 {
-				return fmt.Errorf("xcode-cloud status: --timeout must be greater than or equal to 0")
+				return usageErrorf("xcode-cloud status: --timeout must be greater than or equal to 0")
+			}
+			if *wait && *waitTimeout <= 0 {
+				return usageErrorf("xcode-cloud status: --wait-timeout must be greater than 0")
 			}
 			if *wait && *pollInterval <= 0 {
-				return fmt.Errorf("xcode-cloud status: --poll-interval must be greater than 0")
+				return usageErrorf("xcode-cloud status: --poll-interval must be greater than 0")
+			}
+			exitCodes, err := parseExitCodeMap(*exitCodeMap)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud status: %w", err)
 			}
 
 			client, err := getASCClient()
@@ -251,13 +387,14 @@ Examples:
 				return fmt.Errorf("xcode-cloud status: %w", err)
 			}
 
-			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
-			defer cancel()
-
 			if *wait {
-				return waitForBuildCompletion(requestCtx, client, strings.TrimSpace(*runID), *pollInterval, *output, *pretty)
+				waitOpts := buildRunWaitOptions{PollInterval: *pollInterval, RequestTimeout: *timeout, WaitTimeout: *waitTimeout}
+				return waitForBuildCompletion(ctx, client, strings.TrimSpace(*runID), waitOpts, exitCodes, *output, *pretty)
 			}
 
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
+			defer cancel()
+
 			// Single status check
 			resp, err := getCiBuildRunWithRetry(requestCtx, client, strings.TrimSpace(*runID))
 			if err != nil {
@@ -265,7 +402,15 @@ Examples:
 			}
 
 			result := buildStatusResult(resp)
-			return printOutput(result, *output, *pretty)
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+
+			// Only surface completion exit codes when explicitly requested.
+			if exitCodes != nil && asc.IsBuildRunComplete(resp.Data.Attributes.ExecutionProgress) {
+				return buildCompletionError(resp.Data.ID, resp.Data.Attributes.CompletionStatus, exitCodes)
+			}
+			return nil
 		},
 	}
 }
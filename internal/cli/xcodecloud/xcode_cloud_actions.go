@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -17,7 +16,7 @@ func xcodeCloudActionsListFlags(fs *flag.FlagSet) (runID *string, limit *int, ne
 	limit = fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next = fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate = fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output = fs.String("output", "json", "Output format: json (default), table, markdown")
+	output = fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty = fs.Bool("pretty", false, "Pretty-print JSON output")
 	return
 }
@@ -42,6 +41,8 @@ Examples:
   asc xcode-cloud actions list --run-id "BUILD_RUN_ID"
   asc xcode-cloud actions get --id "ACTION_ID"
   asc xcode-cloud actions build-run --id "ACTION_ID"
+  asc xcode-cloud actions logs --id "ACTION_ID" --path ./logs.zip
+  asc xcode-cloud actions wait --id "ACTION_ID" --poll-interval 15s --wait-timeout 30m
   asc xcode-cloud actions --run-id "BUILD_RUN_ID" --output table
   asc xcode-cloud actions --run-id "BUILD_RUN_ID" --limit 50
   asc xcode-cloud actions --run-id "BUILD_RUN_ID" --paginate`,
@@ -51,6 +52,8 @@ Examples:
 			XcodeCloudActionsListCommand(),
 			XcodeCloudActionsGetCommand(),
 			XcodeCloudActionsBuildRunCommand(),
+			XcodeCloudActionsLogsCommand(),
+			XcodeCloudActionsWaitCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return xcodeCloudActionsList(ctx, *runID, *limit, *next, *paginate, *output, *pretty)
@@ -86,25 +89,62 @@ func XcodeCloudActionsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Build action ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(ciBuildActionIncludeList(), ", "))
+	artifactsLimit := fs.Int("artifacts-limit", 0, "Maximum included artifacts (1-200)")
+	issuesLimit := fs.Int("issues-limit", 0, "Maximum included issues (1-200)")
+	testResultsLimit := fs.Int("test-results-limit", 0, "Maximum included test results (1-200)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	registerFlagValues(fs, "include", ciBuildActionIncludeList()...)
+
 	return &ffcli.Command{
 		Name:       "get",
-		ShortUsage: "asc xcode-cloud actions get --id \"ACTION_ID\"",
+		ShortUsage: "asc xcode-cloud actions get --id \"ACTION_ID\" [flags]",
 		ShortHelp:  "Get details for a build action.",
 		LongHelp: `Get details for a build action.
 
+With --include, the action's artifacts, issues, and/or test results are
+returned alongside it (under "artifacts", "issues", and "testResults" in JSON).
+Each included relationship returns one page; use the per-relationship limit
+flags to change its size.
+
 Examples:
   asc xcode-cloud actions get --id "ACTION_ID"
-  asc xcode-cloud actions get --id "ACTION_ID" --output table`,
+  asc xcode-cloud actions get --id "ACTION_ID" --output table
+  asc xcode-cloud actions get --id "ACTION_ID" --include artifacts,issues,testResults
+  asc xcode-cloud actions get --id "ACTION_ID" --include testResults --test-results-limit 200 --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
+			}
+
+			includeValues, err := normalizeCiBuildActionInclude(*include)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud actions get: %w", err)
+			}
+			limits := []struct {
+				flag    string
+				include string
+				value   int
+			}{
+				{"--artifacts-limit", "artifacts", *artifactsLimit},
+				{"--issues-limit", "issues", *issuesLimit},
+				{"--test-results-limit", "testResults", *testResultsLimit},
+			}
+			for _, limit := range limits {
+				if limit.value == 0 {
+					continue
+				}
+				if limit.value < 1 || limit.value > 200 {
+					return fmt.Errorf("xcode-cloud actions get: %s must be between 1 and 200", limit.flag)
+				}
+				if !hasInclude(includeValues, limit.include) {
+					return flagErrorf("%s requires --include %s", limit.flag, limit.include)
+				}
 			}
 
 			client, err := getASCClient()
@@ -119,17 +159,63 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("xcode-cloud actions get: %w", err)
 			}
+			if len(includeValues) == 0 {
+				return printOutput(resp, *output, *pretty)
+			}
 
-			return printOutput(resp, *output, *pretty)
+			result := &asc.CiBuildActionDetailsResult{Data: resp.Data}
+			if hasInclude(includeValues, "artifacts") {
+				result.Artifacts, err = client.GetCiBuildActionArtifacts(requestCtx, idValue, asc.WithCiArtifactsLimit(*artifactsLimit))
+				if err != nil {
+					return fmt.Errorf("xcode-cloud actions get: failed to fetch artifacts: %w", err)
+				}
+			}
+			if hasInclude(includeValues, "issues") {
+				result.Issues, err = client.GetCiBuildActionIssues(requestCtx, idValue, asc.WithCiIssuesLimit(*issuesLimit))
+				if err != nil {
+					return fmt.Errorf("xcode-cloud actions get: failed to fetch issues: %w", err)
+				}
+			}
+			if hasInclude(includeValues, "testResults") {
+				result.TestResults, err = client.GetCiBuildActionTestResults(requestCtx, idValue, asc.WithCiTestResultsLimit(*testResultsLimit))
+				if err != nil {
+					return fmt.Errorf("xcode-cloud actions get: failed to fetch test results: %w", err)
+				}
+			}
+
+			return printOutput(result, *output, *pretty)
 		},
 	}
 }
 
+func ciBuildActionIncludeList() []string {
+	return []string{"artifacts", "issues", "testResults"}
+}
+
+func normalizeCiBuildActionInclude(value string) ([]string, error) {
+	values := splitCSV(value)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	allowed := map[string]struct{}{}
+	for _, include := range ciBuildActionIncludeList() {
+		allowed[include] = struct{}{}
+	}
+	for _, include := range values {
+		if _, ok := allowed[include]; !ok {
+			return nil, usageErrorf("--include must be one of: %s", strings.Join(ciBuildActionIncludeList(), ", "))
+		}
+	}
+
+	return values, nil
+}
+
 func XcodeCloudActionsBuildRunCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("build-run", flag.ExitOnError)
 
 	id := fs.String("id", "", "Build action ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -146,8 +232,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -170,7 +255,7 @@ Examples:
 
 func xcodeCloudActionsList(ctx context.Context, runID string, limit int, next string, paginate bool, output string, pretty bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
-		return fmt.Errorf("xcode-cloud actions: --limit must be between 1 and 200")
+		return usageErrorf("xcode-cloud actions: --limit must be between 1 and 200")
 	}
 	if err := validateNextURL(next); err != nil {
 		return fmt.Errorf("xcode-cloud actions: %w", err)
@@ -178,8 +263,7 @@ func xcodeCloudActionsList(ctx context.Context, runID string, limit int, next st
 
 	resolvedRunID := strings.TrimSpace(runID)
 	if resolvedRunID == "" && strings.TrimSpace(next) == "" {
-		fmt.Fprintln(os.Stderr, "Error: --run-id is required")
-		return flag.ErrHelp
+		return flagErrorf("--run-id is required")
 	}
 
 	client, err := getASCClient()
@@ -202,14 +286,13 @@ func xcodeCloudActionsList(ctx context.Context, runID string, limit int, next st
 			return fmt.Errorf("xcode-cloud actions: failed to fetch: %w", err)
 		}
 
-		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetCiBuildActions(ctx, resolvedRunID, asc.WithCiBuildActionsNextURL(nextURL))
-		})
+		}, output, pretty)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud actions: %w", err)
 		}
-
-		return printOutput(resp, output, pretty)
+		return nil
 	}
 
 	resp, err := client.GetCiBuildActions(requestCtx, resolvedRunID, opts...)
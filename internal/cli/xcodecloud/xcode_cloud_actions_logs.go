@@ -0,0 +1,109 @@
+package xcodecloud
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// ciArtifactFileTypeLogBundle is the artifact fileType of build logs.
+const ciArtifactFileTypeLogBundle = "LOG_BUNDLE"
+
+// XcodeCloudActionsLogsCommand returns the xcode-cloud actions logs subcommand.
+func XcodeCloudActionsLogsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("logs", flag.ExitOnError)
+
+	id := fs.String("id", "", "Build action ID")
+	path := fs.String("path", "", "Output file for the log bundle (directory when the action has several)")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing files")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "logs",
+		ShortUsage: "asc xcode-cloud actions logs --id \"ACTION_ID\" --path ./logs.zip",
+		ShortHelp:  "Download the build logs of a build action.",
+		LongHelp: `Download the build logs of a build action.
+
+The action's log bundle artifact is written to --path. When the action has
+more than one log bundle, --path is treated as a directory and each bundle is
+saved there, named by its artifact ID.
+
+Examples:
+  asc xcode-cloud actions logs --id "ACTION_ID" --path ./logs.zip
+  asc xcode-cloud actions logs --id "ACTION_ID" --path ./logs.zip --overwrite
+  asc xcode-cloud actions logs --id "ACTION_ID" --path ./logs --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				return flagErrorf("--id is required")
+			}
+			pathValue := strings.TrimSpace(*path)
+			if pathValue == "" {
+				return flagErrorf("--path is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud actions logs: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			artifacts, err := fetchActionArtifacts(requestCtx, client, idValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud actions logs: %w", err)
+			}
+			logs := logArtifacts(artifacts)
+			if len(logs) == 0 {
+				return fmt.Errorf("xcode-cloud actions logs: action %s has no log artifacts", idValue)
+			}
+
+			result := &asc.CiActionLogsResult{
+				ActionID:   idValue,
+				OutputPath: pathValue,
+				Logs:       make([]asc.CiArtifactDownloadResult, 0, len(logs)),
+			}
+			for _, artifact := range logs {
+				downloaded, err := downloadArtifactFile(requestCtx, client, artifact, logArtifactPath(pathValue, artifact, len(logs) > 1), *overwrite)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud actions logs: %w", err)
+				}
+				result.Logs = append(result.Logs, downloaded)
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// logArtifacts returns the log bundle artifacts among artifacts.
+func logArtifacts(artifacts []asc.CiArtifactResource) []asc.CiArtifactResource {
+	logs := make([]asc.CiArtifactResource, 0, 1)
+	for _, artifact := range artifacts {
+		if strings.EqualFold(artifact.Attributes.FileType, ciArtifactFileTypeLogBundle) {
+			logs = append(logs, artifact)
+		}
+	}
+	return logs
+}
+
+// logArtifactPath returns where a log bundle is written: path itself for a
+// single bundle, or a file inside path named by artifact ID when there are
+// several. The file name keeps the extension of the artifact's file name.
+func logArtifactPath(path string, artifact asc.CiArtifactResource, multiple bool) string {
+	if !multiple {
+		return path
+	}
+	name := artifactPathComponent(artifact.ID, "log") + filepath.Ext(artifactPathComponent(artifact.Attributes.FileName, ""))
+	return filepath.Join(path, name)
+}
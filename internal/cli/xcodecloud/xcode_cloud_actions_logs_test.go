@@ -0,0 +1,38 @@
+package xcodecloud
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestLogArtifacts(t *testing.T) {
+	artifacts := []asc.CiArtifactResource{
+		{ID: "art-1", Attributes: asc.CiArtifactAttributes{FileType: "ARCHIVE"}},
+		{ID: "art-2", Attributes: asc.CiArtifactAttributes{FileType: "LOG_BUNDLE"}},
+		{ID: "art-3", Attributes: asc.CiArtifactAttributes{FileType: "RESULT_BUNDLE"}},
+		{ID: "art-4", Attributes: asc.CiArtifactAttributes{FileType: "log_bundle"}},
+	}
+
+	logs := logArtifacts(artifacts)
+	if len(logs) != 2 || logs[0].ID != "art-2" || logs[1].ID != "art-4" {
+		t.Fatalf("unexpected log artifacts: %+v", logs)
+	}
+}
+
+func TestLogArtifactPath(t *testing.T) {
+	artifact := asc.CiArtifactResource{ID: "art-1", Attributes: asc.CiArtifactAttributes{FileName: "Logs for Build 12.zip"}}
+
+	if got := logArtifactPath("./logs.zip", artifact, false); got != "./logs.zip" {
+		t.Fatalf("single log path = %q, want ./logs.zip", got)
+	}
+	if got := logArtifactPath("./logs", artifact, true); got != filepath.Join("./logs", "art-1.zip") {
+		t.Fatalf("multiple log path = %q", got)
+	}
+
+	noName := asc.CiArtifactResource{ID: "art-2"}
+	if got := logArtifactPath("./logs", noName, true); got != filepath.Join("./logs", "art-2") {
+		t.Fatalf("unnamed log path = %q", got)
+	}
+}
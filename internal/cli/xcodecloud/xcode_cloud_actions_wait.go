@@ -0,0 +1,89 @@
+package xcodecloud
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// XcodeCloudActionsWaitCommand returns the xcode-cloud actions wait subcommand.
+func XcodeCloudActionsWaitCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("wait", flag.ExitOnError)
+
+	id := fs.String("id", "", "Build action ID")
+	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval")
+	timeout := fs.Duration("timeout", 0, "Timeout for each Xcode Cloud request (0 = use ASC_TIMEOUT or 30m default)")
+	waitTimeout := fs.Duration("wait-timeout", defaultXcodeCloudWaitTimeout, "Overall time budget for the wait")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "wait",
+		ShortUsage: "asc xcode-cloud actions wait --id \"ACTION_ID\" [flags]",
+		ShortHelp:  "Wait for a single build action to complete.",
+		LongHelp: `Wait for a single build action to complete.
+
+Polls the build action until its execution progress is COMPLETE, then prints
+its final state. Exits 0 when the action succeeded and 1 for any other
+completion status, so a script can gate on one step (e.g. Archive) without
+waiting for the whole build run.
+
+--timeout applies to each status request and --wait-timeout (default 2h) to
+the wait as a whole.
+
+Examples:
+  asc xcode-cloud actions wait --id "ACTION_ID"
+  asc xcode-cloud actions wait --id "ACTION_ID" --poll-interval 15s --wait-timeout 30m
+  asc xcode-cloud actions wait --id "ACTION_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				return flagErrorf("--id is required")
+			}
+			if *timeout < 0 {
+				return usageErrorf("xcode-cloud actions wait: --timeout must be greater than or equal to 0")
+			}
+			if *waitTimeout <= 0 {
+				return usageErrorf("xcode-cloud actions wait: --wait-timeout must be greater than 0")
+			}
+			if *pollInterval <= 0 {
+				return usageErrorf("xcode-cloud actions wait: --poll-interval must be greater than 0")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud actions wait: %w", err)
+			}
+
+			waitOpts := buildRunWaitOptions{PollInterval: *pollInterval, RequestTimeout: *timeout, WaitTimeout: *waitTimeout}
+			resp, err := pollBuildActionUntilComplete(ctx, client, idValue, waitOpts)
+			if err != nil {
+				return err
+			}
+			if err := printOutput(resp, *output, *pretty); err != nil {
+				return err
+			}
+
+			return buildActionCompletionError(idValue, resp.Data.Attributes.CompletionStatus)
+		},
+	}
+}
+
+// buildActionCompletionError returns an error carrying a non-zero exit code
+// when a finished build action did not succeed.
+func buildActionCompletionError(buildActionID string, status asc.CiBuildRunCompletionStatus) error {
+	code := exitCodeForCompletionStatus(status, nil)
+	if code == 0 {
+		return nil
+	}
+	return shared.NewExitCodeError(fmt.Errorf("build action %s completed with status: %s", buildActionID, status), code)
+}
@@ -0,0 +1,72 @@
+package xcodecloud
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func buildActionWithProgress(progress asc.CiBuildRunExecutionProgress) *asc.CiBuildActionResponse {
+	return &asc.CiBuildActionResponse{Data: asc.CiBuildActionResource{ID: "action-1", Attributes: asc.CiBuildActionAttributes{ExecutionProgress: progress}}}
+}
+
+func TestPollUntilCompleteBuildAction(t *testing.T) {
+	calls := 0
+	opts := buildRunWaitOptions{PollInterval: time.Millisecond, RequestTimeout: time.Second, WaitTimeout: time.Second}
+	fetch := func(ctx context.Context) (*asc.CiBuildActionResponse, error) {
+		calls++
+		if calls < 2 {
+			return buildActionWithProgress(asc.CiBuildRunExecutionProgressRunning), nil
+		}
+		return buildActionWithProgress(asc.CiBuildRunExecutionProgressComplete), nil
+	}
+	progress := func(resp *asc.CiBuildActionResponse) asc.CiBuildRunExecutionProgress {
+		return resp.Data.Attributes.ExecutionProgress
+	}
+
+	resp, err := pollUntilComplete(context.Background(), "build action action-1", opts, fetch, progress)
+	if err != nil {
+		t.Fatalf("pollUntilComplete() error: %v", err)
+	}
+	if calls != 2 || resp.Data.ID != "action-1" {
+		t.Fatalf("unexpected result after %d calls: %+v", calls, resp.Data)
+	}
+}
+
+func TestPollUntilCompleteBuildActionWaitTimeout(t *testing.T) {
+	opts := buildRunWaitOptions{PollInterval: 5 * time.Millisecond, RequestTimeout: time.Hour, WaitTimeout: 20 * time.Millisecond}
+	fetch := func(ctx context.Context) (*asc.CiBuildActionResponse, error) {
+		return buildActionWithProgress(asc.CiBuildRunExecutionProgressPending), nil
+	}
+	progress := func(resp *asc.CiBuildActionResponse) asc.CiBuildRunExecutionProgress {
+		return resp.Data.Attributes.ExecutionProgress
+	}
+
+	_, err := pollUntilComplete(context.Background(), "build action action-1", opts, fetch, progress)
+	if err == nil || !strings.Contains(err.Error(), "timed out waiting for build action action-1 after 20ms (--wait-timeout; last status: PENDING)") {
+		t.Fatalf("expected wait timeout error, got %v", err)
+	}
+}
+
+func TestBuildActionCompletionError(t *testing.T) {
+	if err := buildActionCompletionError("ACTION", asc.CiBuildRunCompletionStatusSucceeded); err != nil {
+		t.Fatalf("expected nil error for success, got %v", err)
+	}
+
+	err := buildActionCompletionError("ACTION", asc.CiBuildRunCompletionStatusFailed)
+	var exitCoder shared.ExitCoder
+	if !errors.As(err, &exitCoder) {
+		t.Fatalf("expected ExitCoder, got %T", err)
+	}
+	if exitCoder.ExitCode() != 1 {
+		t.Fatalf("expected exit code 1, got %d", exitCoder.ExitCode())
+	}
+	if !strings.Contains(err.Error(), "build action ACTION completed with status: FAILED") {
+		t.Fatalf("unexpected error message: %v", err)
+	}
+}
@@ -29,13 +29,15 @@ func XcodeCloudArtifactsCommand() *ffcli.Command {
 Examples:
   asc xcode-cloud artifacts list --action-id "ACTION_ID"
   asc xcode-cloud artifacts get --id "ARTIFACT_ID"
-  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip`,
+  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip
+  asc xcode-cloud artifacts download-all --action-id "ACTION_ID" --dir ./artifacts`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			XcodeCloudArtifactsListCommand(),
 			XcodeCloudArtifactsGetCommand(),
 			XcodeCloudArtifactsDownloadCommand(),
+			XcodeCloudArtifactsDownloadAllCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -51,7 +53,7 @@ func XcodeCloudArtifactsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -69,7 +71,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud artifacts list: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud artifacts list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud artifacts list: %w", err)
@@ -77,8 +79,7 @@ Examples:
 
 			resolvedActionID := strings.TrimSpace(*actionID)
 			if resolvedActionID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --action-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--action-id is required")
 			}
 
 			client, err := getASCClient()
@@ -101,14 +102,13 @@ Examples:
 					return fmt.Errorf("xcode-cloud artifacts list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCiBuildActionArtifacts(ctx, resolvedActionID, asc.WithCiArtifactsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud artifacts list: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetCiBuildActionArtifacts(requestCtx, resolvedActionID, opts...)
@@ -126,7 +126,7 @@ func XcodeCloudArtifactsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Artifact ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -143,8 +143,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -170,9 +169,11 @@ func XcodeCloudArtifactsDownloadCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("download", flag.ExitOnError)
 
 	id := fs.String("id", "", "Artifact ID")
-	path := fs.String("path", "", "Output file path for the artifact")
+	path := fs.String("path", "", "Output file path for the artifact (directory with --unzip)")
 	overwrite := fs.Bool("overwrite", false, "Overwrite existing file")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	unzip := fs.Bool("unzip", false, "Extract the zip artifact into --path instead of saving the zip")
+	quiet := fs.Bool("quiet", false, "Do not show download progress on stderr")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -181,21 +182,29 @@ func XcodeCloudArtifactsDownloadCommand() *ffcli.Command {
 		ShortHelp:  "Download a build artifact.",
 		LongHelp: `Download a build artifact.
 
+With --unzip, the artifact is downloaded to a temporary file and extracted
+into --path, which is treated as a directory. Entries that would be written
+outside that directory are rejected.
+
+When stderr is a terminal and the artifact size is known, download progress
+is shown on stderr; use --quiet to hide it. The result is always printed to
+stdout.
+
 Examples:
   asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip
-  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip --overwrite`,
+  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip --overwrite
+  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact --unzip
+  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip --quiet`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			pathValue := strings.TrimSpace(*path)
 			if pathValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --path is required")
-				return flag.ErrHelp
+				return flagErrorf("--path is required")
 			}
 
 			client, err := getASCClient()
@@ -222,26 +231,46 @@ Examples:
 			}
 			defer download.Body.Close()
 
-			bytesWritten, err := writeArtifactFile(pathValue, download.Body, *overwrite)
-			if err != nil {
-				return fmt.Errorf("xcode-cloud artifacts download: %w", err)
+			var body io.Reader = download.Body
+			if !*quiet {
+				body = progressReader(download.Body, int64(artifactResp.Data.Attributes.FileSize), artifactPathComponent(artifactResp.Data.Attributes.FileName, idValue))
 			}
 
 			result := &asc.CiArtifactDownloadResult{
-				ID:           artifactResp.Data.ID,
-				FileName:     artifactResp.Data.Attributes.FileName,
-				FileType:     artifactResp.Data.Attributes.FileType,
-				FileSize:     artifactResp.Data.Attributes.FileSize,
-				OutputPath:   pathValue,
-				BytesWritten: bytesWritten,
+				ID:         artifactResp.Data.ID,
+				FileName:   artifactResp.Data.Attributes.FileName,
+				FileType:   artifactResp.Data.Attributes.FileType,
+				FileSize:   artifactResp.Data.Attributes.FileSize,
+				OutputPath: pathValue,
+			}
+
+			if *unzip {
+				bytesWritten, extracted, err := downloadAndExtractArtifact(pathValue, body, *overwrite, int64(result.FileSize))
+				if err != nil {
+					return fmt.Errorf("xcode-cloud artifacts download: %w", err)
+				}
+				result.BytesWritten = bytesWritten
+				result.Extracted = true
+				result.ExtractedFiles = extracted.Files
+				result.ExtractedBytes = extracted.Bytes
+				return printOutput(result, *output, *pretty)
 			}
 
+			bytesWritten, err := writeArtifactFile(pathValue, body, *overwrite, int64(result.FileSize))
+			if err != nil {
+				return fmt.Errorf("xcode-cloud artifacts download: %w", err)
+			}
+			result.BytesWritten = bytesWritten
+
 			return printOutput(result, *output, *pretty)
 		},
 	}
 }
 
-func writeArtifactFile(path string, reader io.Reader, overwrite bool) (int64, error) {
+// writeArtifactFile streams reader to path. When expectedSize is known (> 0)
+// a download of a different length is treated as truncated: the error reports
+// both sizes and no file is left at path.
+func writeArtifactFile(path string, reader io.Reader, overwrite bool, expectedSize int64) (int64, error) {
 	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
 		return 0, err
 	}
@@ -257,7 +286,12 @@ func writeArtifactFile(path string, reader io.Reader, overwrite bool) (int64, er
 		defer file.Close()
 
 		n, err := io.Copy(file, reader)
+		if err == nil {
+			err = verifyArtifactSize(expectedSize, n)
+		}
 		if err != nil {
+			file.Close()
+			_ = os.Remove(path)
 			return 0, err
 		}
 		if err := file.Sync(); err != nil {
@@ -298,6 +332,9 @@ func writeArtifactFile(path string, reader io.Reader, overwrite bool) (int64, er
 	if err != nil {
 		return 0, err
 	}
+	if err := verifyArtifactSize(expectedSize, n); err != nil {
+		return 0, err
+	}
 	if err := tempFile.Sync(); err != nil {
 		return 0, err
 	}
@@ -311,3 +348,156 @@ func writeArtifactFile(path string, reader io.Reader, overwrite bool) (int64, er
 	success = true
 	return n, nil
 }
+
+// downloadAndExtractArtifact saves a zip artifact to a temporary file and
+// extracts it into dir, returning the zip size and the extraction summary.
+// A zip whose size differs from a known expectedSize is not extracted.
+func downloadAndExtractArtifact(dir string, reader io.Reader, overwrite bool, expectedSize int64) (int64, zipExtractResult, error) {
+	tempFile, err := os.CreateTemp("", "asc-artifact-*.zip")
+	if err != nil {
+		return 0, zipExtractResult{}, err
+	}
+	tempPath := tempFile.Name()
+	defer os.Remove(tempPath)
+
+	n, err := io.Copy(tempFile, reader)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = verifyArtifactSize(expectedSize, n)
+	}
+	if err != nil {
+		return 0, zipExtractResult{}, err
+	}
+
+	extracted, err := extractZipArchive(tempPath, dir, overwrite)
+	if err != nil {
+		return n, extracted, fmt.Errorf("unzip artifact: %w", err)
+	}
+	return n, extracted, nil
+}
+
+// verifyArtifactSize compares the bytes downloaded with the artifact's
+// reported fileSize. An expected size of 0 means unknown and always passes.
+func verifyArtifactSize(expected, actual int64) error {
+	if expected > 0 && actual != expected {
+		return fmt.Errorf("artifact size mismatch: expected %d bytes, got %d bytes (download may be truncated)", expected, actual)
+	}
+	return nil
+}
+
+// downloadBuildRunArtifacts downloads every artifact from every action of a
+// build run into dir, grouped into one subdirectory per action.
+func downloadBuildRunArtifacts(ctx context.Context, client *asc.Client, buildRunID, dir string) ([]asc.CiArtifactDownloadResult, error) {
+	actions, err := fetchBuildRunActions(ctx, client, buildRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]asc.CiArtifactDownloadResult, 0)
+	for _, action := range actions {
+		artifacts, err := fetchActionArtifacts(ctx, client, action.ID)
+		if err != nil {
+			return results, err
+		}
+		downloaded, err := downloadActionArtifacts(ctx, client, action, artifacts, dir, false)
+		results = append(results, downloaded...)
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// fetchBuildRunActions returns every action of a build run.
+func fetchBuildRunActions(ctx context.Context, client *asc.Client, buildRunID string) ([]asc.CiBuildActionResource, error) {
+	firstActions, err := client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch actions: %w", err)
+	}
+	allActions, err := asc.PaginateAll(ctx, firstActions, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch actions: %w", err)
+	}
+	actions, ok := allActions.(*asc.CiBuildActionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected actions response type %T", allActions)
+	}
+	return actions.Data, nil
+}
+
+// fetchActionArtifacts returns every artifact of a build action.
+func fetchActionArtifacts(ctx context.Context, client *asc.Client, actionID string) ([]asc.CiArtifactResource, error) {
+	firstArtifacts, err := client.GetCiBuildActionArtifacts(ctx, actionID, asc.WithCiArtifactsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch artifacts for action %s: %w", actionID, err)
+	}
+	allArtifacts, err := asc.PaginateAll(ctx, firstArtifacts, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActionArtifacts(ctx, actionID, asc.WithCiArtifactsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch artifacts for action %s: %w", actionID, err)
+	}
+	artifacts, ok := allArtifacts.(*asc.CiArtifactsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected artifacts response type %T", allArtifacts)
+	}
+	return artifacts.Data, nil
+}
+
+// downloadActionArtifacts downloads an action's artifacts into a
+// subdirectory of dir named after the action.
+func downloadActionArtifacts(ctx context.Context, client *asc.Client, action asc.CiBuildActionResource, artifacts []asc.CiArtifactResource, dir string, overwrite bool) ([]asc.CiArtifactDownloadResult, error) {
+	results := make([]asc.CiArtifactDownloadResult, 0, len(artifacts))
+	actionDir := filepath.Join(dir, artifactPathComponent(action.Attributes.Name, action.ID))
+	for _, artifact := range artifacts {
+		outputPath := filepath.Join(actionDir, artifactPathComponent(artifact.Attributes.FileName, artifact.ID))
+		result, err := downloadArtifactFile(ctx, client, artifact, outputPath, overwrite)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// downloadArtifactFile streams a single artifact to outputPath.
+func downloadArtifactFile(ctx context.Context, client *asc.Client, artifact asc.CiArtifactResource, outputPath string, overwrite bool) (asc.CiArtifactDownloadResult, error) {
+	downloadURL := strings.TrimSpace(artifact.Attributes.DownloadURL)
+	if downloadURL == "" {
+		return asc.CiArtifactDownloadResult{}, fmt.Errorf("artifact %s has no download URL", artifact.ID)
+	}
+
+	download, err := client.DownloadCiArtifact(ctx, downloadURL)
+	if err != nil {
+		return asc.CiArtifactDownloadResult{}, fmt.Errorf("download artifact %s: %w", artifact.ID, err)
+	}
+	bytesWritten, err := writeArtifactFile(outputPath, download.Body, overwrite, int64(artifact.Attributes.FileSize))
+	download.Body.Close()
+	if err != nil {
+		return asc.CiArtifactDownloadResult{}, fmt.Errorf("write artifact %s: %w", artifact.ID, err)
+	}
+
+	return asc.CiArtifactDownloadResult{
+		ID:           artifact.ID,
+		FileName:     artifact.Attributes.FileName,
+		FileType:     artifact.Attributes.FileType,
+		FileSize:     artifact.Attributes.FileSize,
+		OutputPath:   outputPath,
+		BytesWritten: bytesWritten,
+	}, nil
+}
+
+// artifactPathComponent turns an API-provided name into a single safe path
+// element, falling back to the resource ID.
+func artifactPathComponent(name, fallback string) string {
+	cleaned := strings.TrimSpace(name)
+	cleaned = strings.NewReplacer("/", "_", "\\", "_").Replace(cleaned)
+	if cleaned == "" || cleaned == "." || cleaned == ".." {
+		return fallback
+	}
+	return cleaned
+}
@@ -0,0 +1,169 @@
+package xcodecloud
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// XcodeCloudArtifactsDownloadAllCommand returns the xcode-cloud artifacts download-all subcommand.
+func XcodeCloudArtifactsDownloadAllCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download-all", flag.ExitOnError)
+
+	actionID := fs.String("action-id", "", "Build action ID to download artifacts for")
+	dir := fs.String("dir", "", "Directory to save the artifacts in")
+	concurrency := fs.Int("concurrency", 4, "Artifacts to download in parallel")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing files")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "download-all",
+		ShortUsage: "asc xcode-cloud artifacts download-all --action-id \"ACTION_ID\" --dir ./artifacts [flags]",
+		ShortHelp:  "Download every artifact of a build action.",
+		LongHelp: `Download every artifact of a build action.
+
+Artifacts are saved as <dir>/<fileName>. When several artifacts share a file
+name, the artifact ID is appended to each of them (e.g. Logs-ARTIFACT_ID.zip)
+so none is overwritten.
+
+Every artifact is attempted, even after a failure. The summary lists what was
+downloaded and what failed, and the command exits non-zero if any download
+failed.
+
+Examples:
+  asc xcode-cloud artifacts download-all --action-id "ACTION_ID" --dir ./artifacts
+  asc xcode-cloud artifacts download-all --action-id "ACTION_ID" --dir ./artifacts --concurrency 8 --output table`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			actionIDValue := strings.TrimSpace(*actionID)
+			if actionIDValue == "" {
+				return flagErrorf("--action-id is required")
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				return flagErrorf("--dir is required")
+			}
+			if *concurrency < 1 {
+				return flagErrorf("--concurrency must be at least 1")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud artifacts download-all: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			artifacts, err := fetchActionArtifacts(requestCtx, client, actionIDValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud artifacts download-all: %w", err)
+			}
+
+			result := downloadArtifactsConcurrently(requestCtx, artifacts, artifactDownloadPaths(dirValue, artifacts), *concurrency,
+				func(ctx context.Context, artifact asc.CiArtifactResource, outputPath string) (asc.CiArtifactDownloadResult, error) {
+					return downloadArtifactFile(ctx, client, artifact, outputPath, *overwrite)
+				},
+			)
+			result.ActionID = actionIDValue
+			result.OutputDir = dirValue
+
+			if err := printOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("xcode-cloud artifacts download-all: %d of %d artifacts failed to download", result.Failed, result.Total)
+			}
+
+			return nil
+		},
+	}
+}
+
+// artifactDownloadPaths returns the output path for each artifact in dir.
+// Artifacts whose file names collide (ignoring case, for case-insensitive
+// file systems) get their artifact ID appended before the extension.
+func artifactDownloadPaths(dir string, artifacts []asc.CiArtifactResource) []string {
+	names := make([]string, len(artifacts))
+	counts := make(map[string]int, len(artifacts))
+	for i, artifact := range artifacts {
+		names[i] = artifactPathComponent(artifact.Attributes.FileName, artifact.ID)
+		counts[strings.ToLower(names[i])]++
+	}
+
+	paths := make([]string, len(artifacts))
+	for i, artifact := range artifacts {
+		name := names[i]
+		if counts[strings.ToLower(name)] > 1 {
+			ext := filepath.Ext(name)
+			name = strings.TrimSuffix(name, ext) + "-" + artifactPathComponent(artifact.ID, "artifact") + ext
+		}
+		paths[i] = filepath.Join(dir, name)
+	}
+	return paths
+}
+
+// downloadArtifactsConcurrently downloads each artifact to the matching path
+// with at most concurrency downloads in flight. Every artifact ends up in
+// either Downloads or Failures, in the order given, including artifacts that
+// were not started because ctx was done.
+func downloadArtifactsConcurrently(
+	ctx context.Context,
+	artifacts []asc.CiArtifactResource,
+	paths []string,
+	concurrency int,
+	download func(context.Context, asc.CiArtifactResource, string) (asc.CiArtifactDownloadResult, error),
+) *asc.CiArtifactsDownloadAllResult {
+	downloads := make([]asc.CiArtifactDownloadResult, len(artifacts))
+	errs := make([]error, len(artifacts))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := range artifacts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			select {
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			}
+
+			downloads[i], errs[i] = download(ctx, artifacts[i], paths[i])
+		}(i)
+	}
+	wg.Wait()
+
+	result := &asc.CiArtifactsDownloadAllResult{
+		Total:     len(artifacts),
+		Downloads: make([]asc.CiArtifactDownloadResult, 0, len(artifacts)),
+	}
+	for i, artifact := range artifacts {
+		if errs[i] != nil {
+			result.Failed++
+			result.Failures = append(result.Failures, asc.CiArtifactDownloadFailure{
+				ID:         artifact.ID,
+				FileName:   artifact.Attributes.FileName,
+				OutputPath: paths[i],
+				Error:      errs[i].Error(),
+			})
+			continue
+		}
+		result.Downloaded++
+		result.Downloads = append(result.Downloads, downloads[i])
+	}
+	return result
+}
@@ -0,0 +1,101 @@
+package xcodecloud
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func downloadAllTestArtifact(id, fileName string) asc.CiArtifactResource {
+	artifact := asc.CiArtifactResource{ID: id}
+	artifact.Attributes.FileName = fileName
+	return artifact
+}
+
+func TestArtifactDownloadPathsDisambiguatesDuplicates(t *testing.T) {
+	artifacts := []asc.CiArtifactResource{
+		downloadAllTestArtifact("a1", "Logs.zip"),
+		downloadAllTestArtifact("a2", "App.ipa"),
+		downloadAllTestArtifact("a3", "logs.zip"),
+		downloadAllTestArtifact("a4", ""),
+	}
+
+	got := artifactDownloadPaths("out", artifacts)
+	want := []string{
+		filepath.Join("out", "Logs-a1.zip"),
+		filepath.Join("out", "App.ipa"),
+		filepath.Join("out", "logs-a3.zip"),
+		filepath.Join("out", "a4"),
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("artifactDownloadPaths() = %v, want %v", got, want)
+	}
+}
+
+func TestDownloadArtifactsConcurrentlyReportsEveryArtifact(t *testing.T) {
+	artifacts := []asc.CiArtifactResource{
+		downloadAllTestArtifact("a1", "one.zip"),
+		downloadAllTestArtifact("a2", "two.zip"),
+		downloadAllTestArtifact("a3", "three.zip"),
+		downloadAllTestArtifact("a4", "four.zip"),
+	}
+	paths := artifactDownloadPaths("out", artifacts)
+
+	var inFlight, maxInFlight int32
+	download := func(ctx context.Context, artifact asc.CiArtifactResource, outputPath string) (asc.CiArtifactDownloadResult, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if artifact.ID == "a2" {
+			return asc.CiArtifactDownloadResult{}, errors.New("boom")
+		}
+		return asc.CiArtifactDownloadResult{ID: artifact.ID, OutputPath: outputPath}, nil
+	}
+
+	result := downloadArtifactsConcurrently(context.Background(), artifacts, paths, 2, download)
+
+	if result.Total != 4 || result.Downloaded != 3 || result.Failed != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	var ids []string
+	for _, downloaded := range result.Downloads {
+		ids = append(ids, downloaded.ID)
+	}
+	if strings.Join(ids, ",") != "a1,a3,a4" {
+		t.Fatalf("expected downloads in artifact order, got %v", ids)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].ID != "a2" || result.Failures[0].Error != "boom" || result.Failures[0].OutputPath != paths[1] {
+		t.Fatalf("unexpected failures: %+v", result.Failures)
+	}
+	if max := atomic.LoadInt32(&maxInFlight); max > 2 {
+		t.Fatalf("expected at most 2 concurrent downloads, got %d", max)
+	}
+}
+
+func TestDownloadArtifactsConcurrentlyCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	artifacts := []asc.CiArtifactResource{downloadAllTestArtifact("a1", "one.zip")}
+	download := func(ctx context.Context, artifact asc.CiArtifactResource, outputPath string) (asc.CiArtifactDownloadResult, error) {
+		return asc.CiArtifactDownloadResult{}, ctx.Err()
+	}
+
+	result := downloadArtifactsConcurrently(ctx, artifacts, artifactDownloadPaths("out", artifacts), 1, download)
+	if result.Failed != 1 || len(result.Failures) != 1 {
+		t.Fatalf("expected canceled artifact to be reported as failed, got %+v", result)
+	}
+}
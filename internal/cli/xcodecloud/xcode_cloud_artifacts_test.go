@@ -0,0 +1,49 @@
+package xcodecloud
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteArtifactFileVerifiesSize(t *testing.T) {
+	for _, overwrite := range []bool{false, true} {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "artifact.zip")
+
+		n, err := writeArtifactFile(path, strings.NewReader("12345"), overwrite, 5)
+		if err != nil || n != 5 {
+			t.Fatalf("overwrite=%t: writeArtifactFile() = %d, %v", overwrite, n, err)
+		}
+
+		truncated := filepath.Join(dir, "truncated.zip")
+		_, err = writeArtifactFile(truncated, strings.NewReader("123"), overwrite, 5)
+		if err == nil || !strings.Contains(err.Error(), "expected 5 bytes, got 3 bytes") {
+			t.Fatalf("overwrite=%t: expected size mismatch error, got %v", overwrite, err)
+		}
+		if _, statErr := os.Stat(truncated); !errors.Is(statErr, os.ErrNotExist) {
+			t.Fatalf("overwrite=%t: expected truncated file to be removed, stat err = %v", overwrite, statErr)
+		}
+	}
+}
+
+func TestWriteArtifactFileUnknownSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "artifact.zip")
+	n, err := writeArtifactFile(path, strings.NewReader("123"), false, 0)
+	if err != nil || n != 3 {
+		t.Fatalf("writeArtifactFile() = %d, %v", n, err)
+	}
+}
+
+func TestDownloadAndExtractArtifactRejectsTruncatedZip(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "out")
+	_, _, err := downloadAndExtractArtifact(dir, strings.NewReader("PK"), false, 10)
+	if err == nil || !strings.Contains(err.Error(), "artifact size mismatch") {
+		t.Fatalf("expected size mismatch error, got %v", err)
+	}
+	if _, statErr := os.Stat(dir); !errors.Is(statErr, os.ErrNotExist) {
+		t.Fatalf("expected nothing to be extracted, stat err = %v", statErr)
+	}
+}
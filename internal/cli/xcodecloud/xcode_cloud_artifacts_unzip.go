@@ -0,0 +1,220 @@
+package xcodecloud
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// zipExtractResult summarizes an extracted archive.
+type zipExtractResult struct {
+	Files int
+	Bytes int64
+}
+
+// extractZipArchive extracts the zip at zipPath into destDir. Entries that
+// would land outside destDir ("zip slip") are rejected. Symlinks are created
+// after all regular files, so nothing is ever written through one, and every
+// link must resolve inside destDir.
+func extractZipArchive(zipPath, destDir string, overwrite bool) (zipExtractResult, error) {
+	var result zipExtractResult
+
+	reader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return result, fmt.Errorf("open zip: %w", err)
+	}
+	defer reader.Close()
+
+	if info, err := os.Lstat(destDir); err == nil && !info.IsDir() {
+		return result, fmt.Errorf("output path %q is not a directory", destDir)
+	}
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return result, err
+	}
+	root, err := filepath.EvalSymlinks(destDir)
+	if err != nil {
+		return result, err
+	}
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return result, err
+	}
+
+	var links []*zip.File
+	for _, file := range reader.File {
+		target, err := zipEntryPath(root, file.Name)
+		if err != nil {
+			return result, err
+		}
+
+		mode := file.Mode()
+		switch {
+		case mode.IsDir():
+			if err := makeDirWithin(root, target); err != nil {
+				return result, err
+			}
+		case mode&os.ModeSymlink != 0:
+			links = append(links, file)
+		case mode.IsRegular():
+			n, err := extractZipFile(file, root, target, overwrite)
+			if err != nil {
+				return result, fmt.Errorf("extract %s: %w", file.Name, err)
+			}
+			result.Files++
+			result.Bytes += n
+		default:
+			return result, fmt.Errorf("zip entry %q has unsupported file type", file.Name)
+		}
+	}
+
+	created := make([]string, 0, len(links))
+	for _, file := range links {
+		target, _ := zipEntryPath(root, file.Name)
+		if err := extractZipSymlink(file, root, target, overwrite); err != nil {
+			removeAll(created)
+			return result, fmt.Errorf("extract %s: %w", file.Name, err)
+		}
+		created = append(created, target)
+		result.Files++
+	}
+	// Links may point through each other, so only check where they really
+	// lead once all of them exist.
+	for _, link := range created {
+		if resolved, err := filepath.EvalSymlinks(link); err == nil && !pathWithin(root, resolved) {
+			removeAll(created)
+			return result, fmt.Errorf("zip entry %q links outside the output directory", strings.TrimPrefix(link, root+string(filepath.Separator)))
+		}
+	}
+
+	return result, nil
+}
+
+// zipEntryPath maps a zip entry name to a path under root, rejecting names
+// that are absolute or climb out of root.
+func zipEntryPath(root, name string) (string, error) {
+	cleaned := filepath.FromSlash(strings.ReplaceAll(name, `\`, "/"))
+	if filepath.IsAbs(cleaned) || filepath.VolumeName(cleaned) != "" {
+		return "", fmt.Errorf("zip entry %q has an absolute path", name)
+	}
+	target := filepath.Join(root, cleaned)
+	if !pathWithin(root, target) {
+		return "", fmt.Errorf("zip entry %q escapes the output directory", name)
+	}
+	return target, nil
+}
+
+func extractZipFile(file *zip.File, root, target string, overwrite bool) (int64, error) {
+	if err := makeParentWithin(root, target); err != nil {
+		return 0, err
+	}
+	if overwrite {
+		if err := removeExistingFile(target); err != nil {
+			return 0, err
+		}
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+
+	perm := os.FileMode(0o644) | file.Mode().Perm()&0o111
+	dst, err := shared.OpenNewFileNoFollow(target, perm)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return 0, fmt.Errorf("output file already exists: %w", err)
+		}
+		return 0, err
+	}
+	defer dst.Close()
+
+	n, err := io.Copy(dst, src)
+	if err != nil {
+		return 0, err
+	}
+	return n, dst.Close()
+}
+
+func extractZipSymlink(file *zip.File, root, target string, overwrite bool) error {
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	data, err := io.ReadAll(io.LimitReader(src, 4096))
+	if err != nil {
+		return err
+	}
+
+	linkTarget := filepath.FromSlash(string(data))
+	if filepath.IsAbs(linkTarget) || !pathWithin(root, filepath.Join(filepath.Dir(target), linkTarget)) {
+		return fmt.Errorf("symlink target %q is outside the output directory", string(data))
+	}
+
+	if err := makeParentWithin(root, target); err != nil {
+		return err
+	}
+	if overwrite {
+		if err := removeExistingFile(target); err != nil {
+			return err
+		}
+	}
+	return os.Symlink(linkTarget, target)
+}
+
+func makeParentWithin(root, target string) error {
+	return makeDirWithin(root, filepath.Dir(target))
+}
+
+// makeDirWithin creates dir and verifies it really lives under root,
+// guarding against symlinks already present in the output directory.
+func makeDirWithin(root, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	if !pathWithin(root, resolved) {
+		return fmt.Errorf("directory %q resolves outside the output directory", dir)
+	}
+	return nil
+}
+
+// removeExistingFile deletes a file or symlink at path so it can be
+// replaced; directories are left alone and reported as conflicts.
+func removeExistingFile(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("output path %q is a directory", path)
+	}
+	return os.Remove(path)
+}
+
+func pathWithin(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+func removeAll(paths []string) {
+	for _, path := range paths {
+		_ = os.Remove(path)
+	}
+}
@@ -0,0 +1,159 @@
+package xcodecloud
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testZipEntry struct {
+	name string
+	body string
+	mode os.FileMode
+}
+
+func writeTestZip(t *testing.T, entries []testZipEntry) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "artifact.zip")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create zip: %v", err)
+	}
+	writer := zip.NewWriter(file)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name, Method: zip.Deflate}
+		mode := entry.mode
+		if mode == 0 {
+			mode = 0o644
+		}
+		header.SetMode(mode)
+		w, err := writer.CreateHeader(header)
+		if err != nil {
+			t.Fatalf("create entry %s: %v", entry.name, err)
+		}
+		if _, err := w.Write([]byte(entry.body)); err != nil {
+			t.Fatalf("write entry %s: %v", entry.name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close zip writer: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	return path
+}
+
+func TestExtractZipArchive(t *testing.T) {
+	zipPath := writeTestZip(t, []testZipEntry{
+		{name: "Products/", mode: os.ModeDir | 0o755},
+		{name: "Products/App.app/App", body: "binary", mode: 0o755},
+		{name: "Products/App.app/Info.plist", body: "<plist/>"},
+		{name: "Products/Current", body: "App.app", mode: os.ModeSymlink | 0o777},
+	})
+	dest := filepath.Join(t.TempDir(), "out")
+
+	result, err := extractZipArchive(zipPath, dest, false)
+	if err != nil {
+		t.Fatalf("extractZipArchive() error: %v", err)
+	}
+	if result.Files != 3 || result.Bytes != int64(len("binary")+len("<plist/>")) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	info, err := os.Stat(filepath.Join(dest, "Products", "App.app", "App"))
+	if err != nil {
+		t.Fatalf("stat extracted binary: %v", err)
+	}
+	if info.Mode().Perm()&0o100 == 0 {
+		t.Fatalf("expected executable bit to be preserved, got %v", info.Mode())
+	}
+	data, err := os.ReadFile(filepath.Join(dest, "Products", "Current", "Info.plist"))
+	if err != nil || string(data) != "<plist/>" {
+		t.Fatalf("expected symlinked directory to resolve, got %q, %v", data, err)
+	}
+
+	if _, err := extractZipArchive(zipPath, dest, false); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected existing file error without overwrite, got %v", err)
+	}
+	if _, err := extractZipArchive(zipPath, dest, true); err != nil {
+		t.Fatalf("expected overwrite to succeed, got %v", err)
+	}
+}
+
+func TestExtractZipArchiveRejectsEscapes(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []testZipEntry
+		wantErr string
+	}{
+		{
+			name:    "parent traversal",
+			entries: []testZipEntry{{name: "../evil.txt", body: "x"}},
+			wantErr: "escapes the output directory",
+		},
+		{
+			name:    "nested traversal",
+			entries: []testZipEntry{{name: "logs/../../evil.txt", body: "x"}},
+			wantErr: "escapes the output directory",
+		},
+		{
+			name:    "backslash traversal",
+			entries: []testZipEntry{{name: `..\evil.txt`, body: "x"}},
+			wantErr: "escapes the output directory",
+		},
+		{
+			name:    "absolute path",
+			entries: []testZipEntry{{name: "/tmp/evil.txt", body: "x"}},
+			wantErr: "absolute path",
+		},
+		{
+			name:    "symlink outside",
+			entries: []testZipEntry{{name: "link", body: "../..", mode: os.ModeSymlink | 0o777}},
+			wantErr: "outside the output directory",
+		},
+		{
+			name:    "absolute symlink",
+			entries: []testZipEntry{{name: "link", body: "/etc", mode: os.ModeSymlink | 0o777}},
+			wantErr: "outside the output directory",
+		},
+		{
+			name: "symlink chain outside",
+			entries: []testZipEntry{
+				{name: "sub/x", body: "a/../..", mode: os.ModeSymlink | 0o777},
+				{name: "sub/a", body: "..", mode: os.ModeSymlink | 0o777},
+			},
+			wantErr: "links outside the output directory",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parent := t.TempDir()
+			dest := filepath.Join(parent, "out")
+			zipPath := writeTestZip(t, test.entries)
+
+			_, err := extractZipArchive(zipPath, dest, false)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+			if _, err := os.Lstat(filepath.Join(parent, "evil.txt")); !os.IsNotExist(err) {
+				t.Fatalf("expected nothing written outside the output directory, got %v", err)
+			}
+		})
+	}
+}
+
+func TestExtractZipArchiveRejectsFileOutputPath(t *testing.T) {
+	zipPath := writeTestZip(t, []testZipEntry{{name: "a.txt", body: "x"}})
+	dest := filepath.Join(t.TempDir(), "out")
+	if err := os.WriteFile(dest, []byte("existing"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	if _, err := extractZipArchive(zipPath, dest, false); err == nil || !strings.Contains(err.Error(), "not a directory") {
+		t.Fatalf("expected not a directory error, got %v", err)
+	}
+}
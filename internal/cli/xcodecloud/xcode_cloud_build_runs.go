@@ -4,20 +4,25 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
-func xcodeCloudBuildRunsListFlags(fs *flag.FlagSet) (workflowID *string, limit *int, next *string, paginate *bool, output *string, pretty *bool) {
+func xcodeCloudBuildRunsListFlags(fs *flag.FlagSet) (workflowID *string, limit *int, next *string, paginate *bool, stats *bool, since *string, until *string, concurrency *int, output *string, pretty *bool) {
 	workflowID = fs.String("workflow-id", "", "Workflow ID to list build runs for")
 	limit = fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next = fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate = fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output = fs.String("output", "json", "Output format: json (default), table, markdown")
+	stats = fs.Bool("stats", false, "Print aggregate metrics (success rate, durations, status breakdown) over all pages instead of the runs")
+	since = fs.String("since", "", "Only include runs created at or after this RFC3339 timestamp (fetches all pages)")
+	until = fs.String("until", "", "Only include runs created at or before this RFC3339 timestamp (fetches all pages)")
+	concurrency = concurrencyFlag(fs)
+	output = fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty = fs.Bool("pretty", false, "Pretty-print JSON output")
 	return
 }
@@ -26,7 +31,7 @@ func xcodeCloudBuildRunsListFlags(fs *flag.FlagSet) (workflowID *string, limit *
 func XcodeCloudBuildRunsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("build-runs", flag.ExitOnError)
 
-	workflowID, limit, next, paginate, output, pretty := xcodeCloudBuildRunsListFlags(fs)
+	workflowID, limit, next, paginate, stats, since, until, concurrency, output, pretty := xcodeCloudBuildRunsListFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "build-runs",
@@ -37,17 +42,24 @@ func XcodeCloudBuildRunsCommand() *ffcli.Command {
 Examples:
   asc xcode-cloud build-runs --workflow-id "WORKFLOW_ID"
   asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID"
+  asc xcode-cloud build-runs get --run-id "BUILD_RUN_ID"
   asc xcode-cloud build-runs builds --run-id "BUILD_RUN_ID"
+  asc xcode-cloud build-runs watch --workflow-id "WORKFLOW_ID"
+  asc xcode-cloud build-runs retry --run-id "BUILD_RUN_ID" --wait
   asc xcode-cloud build-runs --workflow-id "WORKFLOW_ID" --limit 50
-  asc xcode-cloud build-runs --workflow-id "WORKFLOW_ID" --paginate`,
+  asc xcode-cloud build-runs --workflow-id "WORKFLOW_ID" --paginate
+  asc xcode-cloud build-runs --workflow-id "WORKFLOW_ID" --stats`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			XcodeCloudBuildRunsListCommand(),
+			XcodeCloudBuildRunsGetCommand(),
 			XcodeCloudBuildRunsBuildsCommand(),
+			XcodeCloudBuildRunsWatchCommand(),
+			XcodeCloudBuildRunsRetryCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			return xcodeCloudBuildRunsList(ctx, *workflowID, *limit, *next, *paginate, *output, *pretty)
+			return xcodeCloudBuildRunsList(ctx, *workflowID, *limit, *next, *paginate, *stats, *since, *until, *concurrency, *output, *pretty)
 		},
 	}
 }
@@ -55,7 +67,7 @@ Examples:
 func XcodeCloudBuildRunsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	workflowID, limit, next, paginate, output, pretty := xcodeCloudBuildRunsListFlags(fs)
+	workflowID, limit, next, paginate, stats, since, until, concurrency, output, pretty := xcodeCloudBuildRunsListFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -63,14 +75,73 @@ func XcodeCloudBuildRunsListCommand() *ffcli.Command {
 		ShortHelp:  "List Xcode Cloud build runs for a workflow.",
 		LongHelp: `List Xcode Cloud build runs for a workflow.
 
+With --stats, every page is fetched and aggregate metrics are printed instead
+of the runs: total runs, success rate over completed runs, average and median
+duration (finishedDate - startedDate), and a breakdown by completion status.
+Runs that have not completed are counted under their execution progress.
+
+--since and --until keep only runs whose createdDate falls within the window
+(both bounds inclusive). The filter is applied after fetching, so every page
+is fetched whenever either is set; it also narrows the runs used for --stats.
+
 Examples:
   asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID"
   asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID" --limit 50
-  asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID" --paginate`,
+  asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID" --paginate
+  asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID" --paginate --concurrency 4
+  asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID" --stats --output table
+  asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID" --since 2026-02-01T00:00:00Z --until 2026-02-08T00:00:00Z`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			return xcodeCloudBuildRunsList(ctx, *workflowID, *limit, *next, *paginate, *stats, *since, *until, *concurrency, *output, *pretty)
+		},
+	}
+}
+
+// XcodeCloudBuildRunsGetCommand returns the xcode-cloud build-runs get subcommand.
+func XcodeCloudBuildRunsGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+
+	runID := fs.String("run-id", "", "Build run ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc xcode-cloud build-runs get --run-id \"BUILD_RUN_ID\"",
+		ShortHelp:  "Get the full resource for a build run.",
+		LongHelp: `Get the full resource for a build run.
+
+Prints the build run exactly as App Store Connect returns it, including all
+attributes and relationship links. Use 'asc xcode-cloud status' for a
+condensed summary of a run's progress and result.
+
+Examples:
+  asc xcode-cloud build-runs get --run-id "BUILD_RUN_ID"
+  asc xcode-cloud build-runs get --run-id "BUILD_RUN_ID" --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return xcodeCloudBuildRunsList(ctx, *workflowID, *limit, *next, *paginate, *output, *pretty)
+			runIDValue := strings.TrimSpace(*runID)
+			if runIDValue == "" {
+				return flagErrorf("--run-id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs get: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			resp, err := client.GetCiBuildRun(requestCtx, runIDValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs get: %w", err)
+			}
+
+			return printOutput(resp, *output, *pretty)
 		},
 	}
 }
@@ -82,7 +153,7 @@ func XcodeCloudBuildRunsBuildsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -95,12 +166,16 @@ Examples:
   asc xcode-cloud build-runs builds --run-id "BUILD_RUN_ID"
   asc xcode-cloud build-runs builds --run-id "BUILD_RUN_ID" --output table
   asc xcode-cloud build-runs builds --run-id "BUILD_RUN_ID" --limit 50
-  asc xcode-cloud build-runs builds --run-id "BUILD_RUN_ID" --paginate`,
+  asc xcode-cloud build-runs builds --run-id "BUILD_RUN_ID" --paginate
+  asc xcode-cloud build-runs builds artifacts --run-id "BUILD_RUN_ID" --id "BUILD_ID" --path ./artifacts`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			XcodeCloudBuildRunsBuildsArtifactsCommand(),
+		},
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud build-runs builds: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud build-runs builds: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud build-runs builds: %w", err)
@@ -108,8 +183,7 @@ Examples:
 
 			runIDValue := strings.TrimSpace(*runID)
 			if runIDValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --run-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--run-id is required")
 			}
 
 			client, err := getASCClient()
@@ -132,14 +206,13 @@ Examples:
 					return fmt.Errorf("xcode-cloud build-runs builds: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCiBuildRunBuilds(ctx, runIDValue, asc.WithCiBuildRunBuildsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud build-runs builds: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetCiBuildRunBuilds(requestCtx, runIDValue, opts...)
@@ -152,9 +225,20 @@ Examples:
 	}
 }
 
-func xcodeCloudBuildRunsList(ctx context.Context, workflowID string, limit int, next string, paginate bool, output string, pretty bool) error {
+func xcodeCloudBuildRunsList(ctx context.Context, workflowID string, limit int, next string, paginate, stats bool, since, until string, concurrency int, output string, pretty bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
-		return fmt.Errorf("xcode-cloud build-runs: --limit must be between 1 and 200")
+		return usageErrorf("xcode-cloud build-runs: --limit must be between 1 and 200")
+	}
+	window, err := parseBuildRunsCreatedWindow(since, until)
+	if err != nil {
+		return flagErrorf("%v", err)
+	}
+	if window.active() {
+		// The window is applied client-side, so it needs every page.
+		paginate = true
+	}
+	if err := validateConcurrency(concurrency, paginate || stats); err != nil {
+		return err
 	}
 	if err := validateNextURL(next); err != nil {
 		return fmt.Errorf("xcode-cloud build-runs: %w", err)
@@ -162,8 +246,7 @@ func xcodeCloudBuildRunsList(ctx context.Context, workflowID string, limit int,
 
 	resolvedWorkflowID := strings.TrimSpace(workflowID)
 	if resolvedWorkflowID == "" && strings.TrimSpace(next) == "" {
-		fmt.Fprintln(os.Stderr, "Error: --workflow-id is required")
-		return flag.ErrHelp
+		return flagErrorf("--workflow-id is required")
 	}
 
 	client, err := getASCClient()
@@ -179,21 +262,40 @@ func xcodeCloudBuildRunsList(ctx context.Context, workflowID string, limit int,
 		asc.WithCiBuildRunsNextURL(next),
 	}
 
-	if paginate {
+	if paginate || stats {
 		paginateOpts := append(opts, asc.WithCiBuildRunsLimit(200))
 		firstPage, err := client.GetCiBuildRuns(requestCtx, resolvedWorkflowID, paginateOpts...)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud build-runs: failed to fetch: %w", err)
 		}
 
-		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		fetchNext := func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetCiBuildRuns(ctx, resolvedWorkflowID, asc.WithCiBuildRunsNextURL(nextURL))
-		})
+		}
+
+		if !stats && !window.active() && concurrency == 1 {
+			// Stream pages as they arrive for --output jsonl.
+			if err := paginateOutput(requestCtx, firstPage, fetchNext, output, pretty); err != nil {
+				return fmt.Errorf("xcode-cloud build-runs: %w", err)
+			}
+			return nil
+		}
+
+		resp, err := asc.PaginateAllConcurrent(requestCtx, firstPage, fetchNext, concurrency)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud build-runs: %w", err)
 		}
-
-		return printOutput(resp, output, pretty)
+		runs, ok := resp.(*asc.CiBuildRunsResponse)
+		if !ok {
+			return fmt.Errorf("xcode-cloud build-runs: unexpected response type %T", resp)
+		}
+		if window.active() {
+			runs.Data = window.filter(runs.Data)
+		}
+		if !stats {
+			return printOutput(runs, output, pretty)
+		}
+		return printOutput(computeCiBuildRunStats(resolvedWorkflowID, runs.Data), output, pretty)
 	}
 
 	resp, err := client.GetCiBuildRuns(requestCtx, resolvedWorkflowID, opts...)
@@ -203,3 +305,52 @@ func xcodeCloudBuildRunsList(ctx context.Context, workflowID string, limit int,
 
 	return printOutput(resp, output, pretty)
 }
+
+// buildRunsCreatedWindow bounds build runs by createdDate. Zero bounds are open.
+type buildRunsCreatedWindow struct {
+	since time.Time
+	until time.Time
+}
+
+func parseBuildRunsCreatedWindow(since, until string) (buildRunsCreatedWindow, error) {
+	var window buildRunsCreatedWindow
+	var err error
+	if strings.TrimSpace(since) != "" {
+		if window.since, err = shared.ParseRFC3339Flag(since, "--since"); err != nil {
+			return window, err
+		}
+	}
+	if strings.TrimSpace(until) != "" {
+		if window.until, err = shared.ParseRFC3339Flag(until, "--until"); err != nil {
+			return window, err
+		}
+	}
+	if !window.since.IsZero() && !window.until.IsZero() && window.since.After(window.until) {
+		return window, usageErrorf("--since must not be after --until")
+	}
+	return window, nil
+}
+
+func (w buildRunsCreatedWindow) active() bool {
+	return !w.since.IsZero() || !w.until.IsZero()
+}
+
+// filter returns the runs created within the window, both bounds inclusive.
+// Runs with a missing or unparseable createdDate are dropped.
+func (w buildRunsCreatedWindow) filter(runs []asc.CiBuildRunResource) []asc.CiBuildRunResource {
+	filtered := make([]asc.CiBuildRunResource, 0, len(runs))
+	for _, run := range runs {
+		created, err := shared.ParseTimestamp(run.Attributes.CreatedDate)
+		if err != nil {
+			continue
+		}
+		if !w.since.IsZero() && created.Before(w.since) {
+			continue
+		}
+		if !w.until.IsZero() && created.After(w.until) {
+			continue
+		}
+		filtered = append(filtered, run)
+	}
+	return filtered
+}
@@ -0,0 +1,157 @@
+package xcodecloud
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// XcodeCloudBuildRunsBuildsArtifactsCommand returns the build-runs builds artifacts subcommand.
+func XcodeCloudBuildRunsBuildsArtifactsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("artifacts", flag.ExitOnError)
+
+	runID := fs.String("run-id", "", "Build run ID that produced the build")
+	id := fs.String("id", "", "Build ID")
+	path := fs.String("path", "", "Download the artifacts into this directory (one subdirectory per action)")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing files when downloading")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "artifacts",
+		ShortUsage: "asc xcode-cloud build-runs builds artifacts --run-id \"BUILD_RUN_ID\" --id \"BUILD_ID\" [flags]",
+		ShortHelp:  "List or download the artifacts that produced a build.",
+		LongHelp: `List or download the artifacts of the archive actions that produced a build.
+
+The App Store Connect API does not link a build back to its build run or
+actions, so the build run is required. The command checks that the build
+belongs to the run, finds the run's archive actions, and collects their
+artifacts. With --path, the artifacts are downloaded as well.
+
+Examples:
+  asc xcode-cloud build-runs builds artifacts --run-id "BUILD_RUN_ID" --id "BUILD_ID"
+  asc xcode-cloud build-runs builds artifacts --run-id "BUILD_RUN_ID" --id "BUILD_ID" --output table
+  asc xcode-cloud build-runs builds artifacts --run-id "BUILD_RUN_ID" --id "BUILD_ID" --path ./artifacts`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			runIDValue := strings.TrimSpace(*runID)
+			if runIDValue == "" {
+				return flagErrorf("--run-id is required")
+			}
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				return flagErrorf("--id is required")
+			}
+			pathValue := strings.TrimSpace(*path)
+			if *overwrite && pathValue == "" {
+				return flagErrorf("--overwrite requires --path")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs builds artifacts: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			builds, err := fetchBuildRunBuilds(requestCtx, client, runIDValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs builds artifacts: %w", err)
+			}
+			if !buildRunHasBuild(builds, idValue) {
+				return fmt.Errorf("xcode-cloud build-runs builds artifacts: build %s is not part of build run %s", idValue, runIDValue)
+			}
+
+			actions, err := fetchBuildRunActions(requestCtx, client, runIDValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs builds artifacts: %w", err)
+			}
+			archiveActions := originatingArchiveActions(actions)
+			if len(builds) > 1 && len(archiveActions) > 1 {
+				fmt.Fprintf(os.Stderr, "Warning: build run %s produced %d builds; artifacts from all %d archive actions are included\n", runIDValue, len(builds), len(archiveActions))
+			}
+
+			result := &asc.CiBuildArtifactsResult{
+				BuildID:    idValue,
+				BuildRunID: runIDValue,
+				ActionIDs:  make([]string, 0, len(archiveActions)),
+				Artifacts:  make([]asc.CiArtifactResource, 0),
+			}
+			for _, action := range archiveActions {
+				result.ActionIDs = append(result.ActionIDs, action.ID)
+				artifacts, err := fetchActionArtifacts(requestCtx, client, action.ID)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud build-runs builds artifacts: %w", err)
+				}
+				result.Artifacts = append(result.Artifacts, artifacts...)
+
+				if pathValue == "" {
+					continue
+				}
+				downloaded, err := downloadActionArtifacts(requestCtx, client, action, artifacts, pathValue, *overwrite)
+				result.Downloads = append(result.Downloads, downloaded...)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud build-runs builds artifacts: %w", err)
+				}
+			}
+
+			if len(result.Artifacts) == 0 {
+				if len(archiveActions) == 0 {
+					fmt.Fprintf(os.Stderr, "No artifacts found for build %s: build run %s has no archive actions\n", idValue, runIDValue)
+				} else {
+					fmt.Fprintf(os.Stderr, "No artifacts found for build %s: the archive actions of build run %s produced no artifacts\n", idValue, runIDValue)
+				}
+			}
+
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// fetchBuildRunBuilds returns every build produced by a build run.
+func fetchBuildRunBuilds(ctx context.Context, client *asc.Client, buildRunID string) ([]asc.Resource[asc.BuildAttributes], error) {
+	firstPage, err := client.GetCiBuildRunBuilds(ctx, buildRunID, asc.WithCiBuildRunBuildsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch builds: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildRunBuilds(ctx, buildRunID, asc.WithCiBuildRunBuildsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch builds: %w", err)
+	}
+	builds, ok := all.(*asc.BuildsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected builds response type %T", all)
+	}
+	return builds.Data, nil
+}
+
+func buildRunHasBuild(builds []asc.Resource[asc.BuildAttributes], buildID string) bool {
+	for _, build := range builds {
+		if build.ID == buildID {
+			return true
+		}
+	}
+	return false
+}
+
+// originatingArchiveActions returns the archive actions of a build run; these
+// are the actions that produce its builds and their exported artifacts.
+func originatingArchiveActions(actions []asc.CiBuildActionResource) []asc.CiBuildActionResource {
+	archives := make([]asc.CiBuildActionResource, 0, len(actions))
+	for _, action := range actions {
+		if strings.EqualFold(action.Attributes.ActionType, "ARCHIVE") {
+			archives = append(archives, action)
+		}
+	}
+	return archives
+}
@@ -0,0 +1,34 @@
+package xcodecloud
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestOriginatingArchiveActions(t *testing.T) {
+	actions := []asc.CiBuildActionResource{
+		{ID: "build", Attributes: asc.CiBuildActionAttributes{ActionType: "BUILD"}},
+		{ID: "archive-ios", Attributes: asc.CiBuildActionAttributes{ActionType: "ARCHIVE"}},
+		{ID: "test", Attributes: asc.CiBuildActionAttributes{ActionType: "TEST"}},
+		{ID: "archive-macos", Attributes: asc.CiBuildActionAttributes{ActionType: "archive"}},
+	}
+
+	got := originatingArchiveActions(actions)
+	if len(got) != 2 || got[0].ID != "archive-ios" || got[1].ID != "archive-macos" {
+		t.Fatalf("unexpected archive actions: %+v", got)
+	}
+	if got := originatingArchiveActions(actions[:1]); len(got) != 0 {
+		t.Fatalf("expected no archive actions, got %+v", got)
+	}
+}
+
+func TestBuildRunHasBuild(t *testing.T) {
+	builds := []asc.Resource[asc.BuildAttributes]{{ID: "build-1"}, {ID: "build-2"}}
+	if !buildRunHasBuild(builds, "build-2") {
+		t.Fatal("expected build-2 to be found")
+	}
+	if buildRunHasBuild(builds, "build-3") {
+		t.Fatal("expected build-3 to be missing")
+	}
+}
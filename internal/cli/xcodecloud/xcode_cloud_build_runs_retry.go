@@ -0,0 +1,129 @@
+package xcodecloud
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// XcodeCloudBuildRunsRetryCommand returns the xcode-cloud build-runs retry subcommand.
+func XcodeCloudBuildRunsRetryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("retry", flag.ExitOnError)
+
+	runID := fs.String("run-id", "", "Build run ID to re-run (required)")
+	wait := fs.Bool("wait", false, "Wait for the new build to complete")
+	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval when waiting")
+	timeout := fs.Duration("timeout", 0, "Timeout for each Xcode Cloud request (0 = use ASC_TIMEOUT or 30m default)")
+	waitTimeout := fs.Duration("wait-timeout", defaultXcodeCloudWaitTimeout, "Overall time budget for --wait")
+	exitCodeMap := fs.String("exit-code-map", "", "Map completion statuses to exit codes (e.g., FAILED=10,ERRORED=11,CANCELED=12)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "retry",
+		ShortUsage: "asc xcode-cloud build-runs retry --run-id \"BUILD_RUN_ID\" [flags]",
+		ShortHelp:  "Start a new build run with the same workflow and git reference.",
+		LongHelp: `Start a new build run with the same workflow and git reference as an
+existing run. The workflow, source branch or tag, and pull request (if any) are
+taken from the original run, so nothing is re-resolved by name.
+
+Examples:
+  asc xcode-cloud build-runs retry --run-id "BUILD_RUN_ID"
+  asc xcode-cloud build-runs retry --run-id "BUILD_RUN_ID" --wait
+  asc xcode-cloud build-runs retry --run-id "BUILD_RUN_ID" --wait --exit-code-map "FAILED=10,ERRORED=11"`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			runIDValue := strings.TrimSpace(*runID)
+			if runIDValue == "" {
+				return flagErrorf("--run-id is required")
+			}
+			if *timeout < 0 {
+				return usageErrorf("xcode-cloud build-runs retry: --timeout must be greater than or equal to 0")
+			}
+			if *wait && *waitTimeout <= 0 {
+				return usageErrorf("xcode-cloud build-runs retry: --wait-timeout must be greater than 0")
+			}
+			if *wait && *pollInterval <= 0 {
+				return usageErrorf("xcode-cloud build-runs retry: --poll-interval must be greater than 0")
+			}
+			exitCodes, err := parseExitCodeMap(*exitCodeMap)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs retry: %w", err)
+			}
+			if exitCodes != nil && !*wait {
+				return usageErrorf("xcode-cloud build-runs retry: --exit-code-map requires --wait")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs retry: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
+			defer cancel()
+
+			original, err := client.GetCiBuildRun(requestCtx, runIDValue,
+				asc.WithCiBuildRunsInclude([]string{"workflow", "sourceBranchOrTag", "pullRequest"}))
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs retry: failed to fetch build run: %w", err)
+			}
+
+			req, err := retryBuildRunRequest(original.Data)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs retry: %w", err)
+			}
+
+			resp, err := client.CreateCiBuildRun(requestCtx, req)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs retry: failed to trigger build: %w", err)
+			}
+
+			if *wait {
+				waitOpts := buildRunWaitOptions{PollInterval: *pollInterval, RequestTimeout: *timeout, WaitTimeout: *waitTimeout}
+				return waitForBuildCompletion(ctx, client, resp.Data.ID, waitOpts, exitCodes, *output, *pretty)
+			}
+
+			relationships := req.Data.Relationships
+			result := newXcodeCloudRunResult(resp, relationships.Workflow.Data.ID, "", relationships.SourceBranchOrTag.Data.ID, "")
+			return printOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// retryBuildRunRequest builds a create request that re-runs run with the same
+// workflow, source branch or tag, and pull request.
+func retryBuildRunRequest(run asc.CiBuildRunResource) (asc.CiBuildRunCreateRequest, error) {
+	rel := run.Relationships
+	if rel == nil || rel.Workflow == nil || strings.TrimSpace(rel.Workflow.Data.ID) == "" {
+		return asc.CiBuildRunCreateRequest{}, fmt.Errorf("build run %s has no workflow", run.ID)
+	}
+	if rel.SourceBranchOrTag == nil || strings.TrimSpace(rel.SourceBranchOrTag.Data.ID) == "" {
+		return asc.CiBuildRunCreateRequest{}, fmt.Errorf("build run %s has no source branch or tag", run.ID)
+	}
+
+	relationships := &asc.CiBuildRunCreateRelationships{
+		Workflow: &asc.Relationship{
+			Data: asc.ResourceData{Type: asc.ResourceTypeCiWorkflows, ID: rel.Workflow.Data.ID},
+		},
+		SourceBranchOrTag: &asc.Relationship{
+			Data: asc.ResourceData{Type: asc.ResourceTypeScmGitReferences, ID: rel.SourceBranchOrTag.Data.ID},
+		},
+	}
+	if rel.PullRequest != nil && strings.TrimSpace(rel.PullRequest.Data.ID) != "" {
+		relationships.PullRequest = &asc.Relationship{Data: rel.PullRequest.Data}
+	}
+
+	return asc.CiBuildRunCreateRequest{
+		Data: asc.CiBuildRunCreateData{
+			Type:          asc.ResourceTypeCiBuildRuns,
+			Relationships: relationships,
+		},
+	}, nil
+}
@@ -0,0 +1,79 @@
+package xcodecloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestRetryBuildRunRequestCopiesRelationships(t *testing.T) {
+	run := asc.CiBuildRunResource{
+		ID: "run-1",
+		Relationships: &asc.CiBuildRunRelationships{
+			Workflow:          &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeCiWorkflows, ID: "wf-1"}},
+			SourceBranchOrTag: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeScmGitReferences, ID: "ref-1"}},
+			PullRequest:       &asc.Relationship{Data: asc.ResourceData{Type: "scmPullRequests", ID: "pr-1"}},
+			Product:           &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeCiProducts, ID: "product-1"}},
+		},
+	}
+
+	req, err := retryBuildRunRequest(run)
+	if err != nil {
+		t.Fatalf("retryBuildRunRequest() error: %v", err)
+	}
+	rel := req.Data.Relationships
+	if req.Data.Type != asc.ResourceTypeCiBuildRuns {
+		t.Fatalf("expected type ciBuildRuns, got %q", req.Data.Type)
+	}
+	if rel.Workflow.Data.ID != "wf-1" || rel.SourceBranchOrTag.Data.ID != "ref-1" {
+		t.Fatalf("unexpected relationships: %+v", rel)
+	}
+	if rel.PullRequest == nil || rel.PullRequest.Data.ID != "pr-1" {
+		t.Fatalf("expected pull request to be carried over, got %+v", rel.PullRequest)
+	}
+}
+
+func TestRetryBuildRunRequestWithoutPullRequest(t *testing.T) {
+	run := asc.CiBuildRunResource{
+		ID: "run-1",
+		Relationships: &asc.CiBuildRunRelationships{
+			Workflow:          &asc.Relationship{Data: asc.ResourceData{ID: "wf-1"}},
+			SourceBranchOrTag: &asc.Relationship{Data: asc.ResourceData{ID: "ref-1"}},
+		},
+	}
+
+	req, err := retryBuildRunRequest(run)
+	if err != nil {
+		t.Fatalf("retryBuildRunRequest() error: %v", err)
+	}
+	if req.Data.Relationships.PullRequest != nil {
+		t.Fatalf("expected no pull request, got %+v", req.Data.Relationships.PullRequest)
+	}
+	if req.Data.Relationships.Workflow.Data.Type != asc.ResourceTypeCiWorkflows {
+		t.Fatalf("expected workflow type to be set, got %q", req.Data.Relationships.Workflow.Data.Type)
+	}
+}
+
+func TestRetryBuildRunRequestRequiresWorkflowAndReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		rel     *asc.CiBuildRunRelationships
+		wantErr string
+	}{
+		{name: "no relationships", rel: nil, wantErr: "has no workflow"},
+		{
+			name:    "no source reference",
+			rel:     &asc.CiBuildRunRelationships{Workflow: &asc.Relationship{Data: asc.ResourceData{ID: "wf-1"}}},
+			wantErr: "has no source branch or tag",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := retryBuildRunRequest(asc.CiBuildRunResource{ID: "run-1", Relationships: test.rel})
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package xcodecloud
+
+import (
+	"sort"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// computeCiBuildRunStats aggregates reliability metrics over build runs.
+// Runs that have not completed yet are counted under their execution progress
+// and excluded from the success rate.
+func computeCiBuildRunStats(workflowID string, runs []asc.CiBuildRunResource) *asc.CiBuildRunStatsResult {
+	result := &asc.CiBuildRunStatsResult{
+		WorkflowID:         workflowID,
+		TotalRuns:          len(runs),
+		ByCompletionStatus: map[string]int{},
+	}
+
+	durations := make([]float64, 0, len(runs))
+	for _, run := range runs {
+		attrs := run.Attributes
+		result.ByCompletionStatus[ciBuildRunStatusKey(attrs)]++
+
+		if attrs.CompletionStatus != "" {
+			result.CompletedRuns++
+			if asc.IsBuildRunSuccessful(attrs.CompletionStatus) {
+				result.SucceededRuns++
+			}
+		}
+
+		if duration, ok := ciBuildRunDuration(attrs); ok {
+			durations = append(durations, duration.Seconds())
+		}
+	}
+
+	if result.CompletedRuns > 0 {
+		result.SuccessRate = float64(result.SucceededRuns) / float64(result.CompletedRuns)
+	}
+
+	result.TimedRuns = len(durations)
+	if len(durations) > 0 {
+		total := 0.0
+		for _, duration := range durations {
+			total += duration
+		}
+		result.AverageDurationSeconds = total / float64(len(durations))
+
+		sort.Float64s(durations)
+		mid := len(durations) / 2
+		if len(durations)%2 == 0 {
+			result.MedianDurationSeconds = (durations[mid-1] + durations[mid]) / 2
+		} else {
+			result.MedianDurationSeconds = durations[mid]
+		}
+	}
+
+	return result
+}
+
+// ciBuildRunDuration returns finishedDate - startedDate when both are set.
+func ciBuildRunDuration(attrs asc.CiBuildRunAttributes) (time.Duration, bool) {
+	if attrs.StartedDate == "" || attrs.FinishedDate == "" {
+		return 0, false
+	}
+	started, err := time.Parse(time.RFC3339, attrs.StartedDate)
+	if err != nil {
+		return 0, false
+	}
+	finished, err := time.Parse(time.RFC3339, attrs.FinishedDate)
+	if err != nil || finished.Before(started) {
+		return 0, false
+	}
+	return finished.Sub(started), true
+}
+
+// groupCiBuildRunsByWorkflow groups build runs by their workflow relationship.
+// Runs fetched without the workflow relationship are grouped under "unknown".
+func groupCiBuildRunsByWorkflow(productID string, runs []asc.CiBuildRunResource) *asc.CiBuildRunsByWorkflowResult {
+	result := &asc.CiBuildRunsByWorkflowResult{
+		ProductID: productID,
+		TotalRuns: len(runs),
+		Workflows: map[string]asc.CiWorkflowBuildRunsGroup{},
+	}
+
+	latest := map[string]asc.CiBuildRunResource{}
+	for _, run := range runs {
+		workflowID := "unknown"
+		if run.Relationships != nil && run.Relationships.Workflow != nil && run.Relationships.Workflow.Data.ID != "" {
+			workflowID = run.Relationships.Workflow.Data.ID
+		}
+
+		group, ok := result.Workflows[workflowID]
+		if !ok {
+			group = asc.CiWorkflowBuildRunsGroup{
+				WorkflowID:         workflowID,
+				ByCompletionStatus: map[string]int{},
+			}
+		}
+		group.TotalRuns++
+		group.ByCompletionStatus[ciBuildRunStatusKey(run.Attributes)]++
+		result.Workflows[workflowID] = group
+
+		if current, ok := latest[workflowID]; !ok || ciBuildRunIsNewer(run, current) {
+			latest[workflowID] = run
+		}
+	}
+
+	for workflowID, run := range latest {
+		group := result.Workflows[workflowID]
+		group.LatestRunID = run.ID
+		group.LatestBuildNumber = run.Attributes.Number
+		group.LatestStatus = ciBuildRunStatusKey(run.Attributes)
+		group.LatestCreatedDate = run.Attributes.CreatedDate
+		result.Workflows[workflowID] = group
+	}
+
+	return result
+}
+
+// ciBuildRunIsNewer orders runs by build number, then by creation date.
+func ciBuildRunIsNewer(candidate, current asc.CiBuildRunResource) bool {
+	if candidate.Attributes.Number != current.Attributes.Number {
+		return candidate.Attributes.Number > current.Attributes.Number
+	}
+	candidateCreated, candidateErr := time.Parse(time.RFC3339, candidate.Attributes.CreatedDate)
+	currentCreated, currentErr := time.Parse(time.RFC3339, current.Attributes.CreatedDate)
+	if candidateErr != nil || currentErr != nil {
+		return false
+	}
+	return candidateCreated.After(currentCreated)
+}
+
+// ciBuildRunStatusKey returns the completion status of a run, or its
+// execution progress when it has not completed yet.
+func ciBuildRunStatusKey(attrs asc.CiBuildRunAttributes) string {
+	if attrs.CompletionStatus != "" {
+		return string(attrs.CompletionStatus)
+	}
+	if attrs.ExecutionProgress != "" {
+		return string(attrs.ExecutionProgress)
+	}
+	return "UNKNOWN"
+}
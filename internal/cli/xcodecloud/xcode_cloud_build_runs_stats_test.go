@@ -0,0 +1,119 @@
+package xcodecloud
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestComputeCiBuildRunStats(t *testing.T) {
+	runs := []asc.CiBuildRunResource{
+		{ID: "run-1", Attributes: asc.CiBuildRunAttributes{
+			ExecutionProgress: asc.CiBuildRunExecutionProgressComplete,
+			CompletionStatus:  asc.CiBuildRunCompletionStatusSucceeded,
+			StartedDate:       "2026-01-01T10:00:00Z",
+			FinishedDate:      "2026-01-01T10:10:00Z",
+		}},
+		{ID: "run-2", Attributes: asc.CiBuildRunAttributes{
+			ExecutionProgress: asc.CiBuildRunExecutionProgressComplete,
+			CompletionStatus:  asc.CiBuildRunCompletionStatusSucceeded,
+			StartedDate:       "2026-01-02T10:00:00Z",
+			FinishedDate:      "2026-01-02T10:20:00Z",
+		}},
+		{ID: "run-3", Attributes: asc.CiBuildRunAttributes{
+			ExecutionProgress: asc.CiBuildRunExecutionProgressComplete,
+			CompletionStatus:  asc.CiBuildRunCompletionStatusFailed,
+			StartedDate:       "2026-01-03T10:00:00Z",
+			FinishedDate:      "2026-01-03T11:00:00Z",
+		}},
+		{ID: "run-4", Attributes: asc.CiBuildRunAttributes{
+			ExecutionProgress: asc.CiBuildRunExecutionProgressComplete,
+			CompletionStatus:  asc.CiBuildRunCompletionStatusCanceled,
+		}},
+		{ID: "run-5", Attributes: asc.CiBuildRunAttributes{
+			ExecutionProgress: asc.CiBuildRunExecutionProgressRunning,
+			StartedDate:       "2026-01-04T10:00:00Z",
+		}},
+	}
+
+	stats := computeCiBuildRunStats("wf-1", runs)
+
+	if stats.WorkflowID != "wf-1" || stats.TotalRuns != 5 {
+		t.Fatalf("unexpected totals: %+v", stats)
+	}
+	if stats.CompletedRuns != 4 || stats.SucceededRuns != 2 {
+		t.Fatalf("expected 4 completed and 2 succeeded runs, got %+v", stats)
+	}
+	if stats.SuccessRate != 0.5 {
+		t.Fatalf("expected success rate 0.5, got %v", stats.SuccessRate)
+	}
+	if stats.TimedRuns != 3 {
+		t.Fatalf("expected 3 timed runs, got %d", stats.TimedRuns)
+	}
+	if stats.AverageDurationSeconds != 1800 {
+		t.Fatalf("expected average 1800s, got %v", stats.AverageDurationSeconds)
+	}
+	if stats.MedianDurationSeconds != 1200 {
+		t.Fatalf("expected median 1200s, got %v", stats.MedianDurationSeconds)
+	}
+
+	want := map[string]int{"SUCCEEDED": 2, "FAILED": 1, "CANCELED": 1, "RUNNING": 1}
+	if len(stats.ByCompletionStatus) != len(want) {
+		t.Fatalf("expected %v, got %v", want, stats.ByCompletionStatus)
+	}
+	for status, count := range want {
+		if stats.ByCompletionStatus[status] != count {
+			t.Fatalf("expected %d %s runs, got %v", count, status, stats.ByCompletionStatus)
+		}
+	}
+}
+
+func TestComputeCiBuildRunStatsEvenMedianAndEmpty(t *testing.T) {
+	runs := []asc.CiBuildRunResource{
+		{Attributes: asc.CiBuildRunAttributes{CompletionStatus: asc.CiBuildRunCompletionStatusSucceeded, StartedDate: "2026-01-01T10:00:00Z", FinishedDate: "2026-01-01T10:01:00Z"}},
+		{Attributes: asc.CiBuildRunAttributes{CompletionStatus: asc.CiBuildRunCompletionStatusSucceeded, StartedDate: "2026-01-01T10:00:00Z", FinishedDate: "2026-01-01T10:03:00Z"}},
+	}
+	if stats := computeCiBuildRunStats("", runs); stats.MedianDurationSeconds != 120 || stats.SuccessRate != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	empty := computeCiBuildRunStats("", nil)
+	if empty.TotalRuns != 0 || empty.SuccessRate != 0 || empty.TimedRuns != 0 || empty.ByCompletionStatus == nil {
+		t.Fatalf("unexpected empty stats: %+v", empty)
+	}
+}
+
+func TestGroupCiBuildRunsByWorkflow(t *testing.T) {
+	workflow := func(id string) *asc.CiBuildRunRelationships {
+		return &asc.CiBuildRunRelationships{Workflow: &asc.Relationship{Data: asc.ResourceData{Type: "ciWorkflows", ID: id}}}
+	}
+	runs := []asc.CiBuildRunResource{
+		{ID: "run-1", Relationships: workflow("wf-1"), Attributes: asc.CiBuildRunAttributes{Number: 10, CompletionStatus: asc.CiBuildRunCompletionStatusFailed, CreatedDate: "2026-01-01T10:00:00Z"}},
+		{ID: "run-2", Relationships: workflow("wf-1"), Attributes: asc.CiBuildRunAttributes{Number: 12, CompletionStatus: asc.CiBuildRunCompletionStatusSucceeded, CreatedDate: "2026-01-02T10:00:00Z"}},
+		{ID: "run-3", Relationships: workflow("wf-2"), Attributes: asc.CiBuildRunAttributes{Number: 11, ExecutionProgress: asc.CiBuildRunExecutionProgressRunning, CreatedDate: "2026-01-03T10:00:00Z"}},
+		{ID: "run-4", Attributes: asc.CiBuildRunAttributes{Number: 9, CompletionStatus: asc.CiBuildRunCompletionStatusCanceled}},
+	}
+
+	result := groupCiBuildRunsByWorkflow("prod-1", runs)
+
+	if result.ProductID != "prod-1" || result.TotalRuns != 4 || len(result.Workflows) != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	wf1 := result.Workflows["wf-1"]
+	if wf1.TotalRuns != 2 || wf1.ByCompletionStatus["FAILED"] != 1 || wf1.ByCompletionStatus["SUCCEEDED"] != 1 {
+		t.Fatalf("unexpected wf-1 group: %+v", wf1)
+	}
+	if wf1.LatestRunID != "run-2" || wf1.LatestBuildNumber != 12 || wf1.LatestStatus != "SUCCEEDED" {
+		t.Fatalf("expected run-2 to be latest for wf-1, got %+v", wf1)
+	}
+
+	wf2 := result.Workflows["wf-2"]
+	if wf2.LatestStatus != "RUNNING" || wf2.ByCompletionStatus["RUNNING"] != 1 {
+		t.Fatalf("unexpected wf-2 group: %+v", wf2)
+	}
+
+	if unknown := result.Workflows["unknown"]; unknown.TotalRuns != 1 || unknown.LatestRunID != "run-4" {
+		t.Fatalf("expected run without workflow under unknown, got %+v", unknown)
+	}
+}
@@ -0,0 +1,79 @@
+package xcodecloud
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestBuildRunsCreatedWindowFilter(t *testing.T) {
+	runs := []asc.CiBuildRunResource{
+		{ID: "run-1", Attributes: asc.CiBuildRunAttributes{CreatedDate: "2026-01-31T23:59:59Z"}},
+		{ID: "run-2", Attributes: asc.CiBuildRunAttributes{CreatedDate: "2026-02-01T00:00:00Z"}},
+		{ID: "run-3", Attributes: asc.CiBuildRunAttributes{CreatedDate: "2026-02-03T12:00:00.5Z"}},
+		{ID: "run-4", Attributes: asc.CiBuildRunAttributes{CreatedDate: "2026-02-07T19:00:00-05:00"}},
+		{ID: "run-5", Attributes: asc.CiBuildRunAttributes{CreatedDate: "2026-02-08T00:00:01Z"}},
+		{ID: "run-6"},
+	}
+
+	tests := []struct {
+		name  string
+		since string
+		until string
+		want  []string
+	}{
+		{name: "since and until", since: "2026-02-01T00:00:00Z", until: "2026-02-08T00:00:00Z", want: []string{"run-2", "run-3", "run-4"}},
+		{name: "since only", since: "2026-02-03T12:00:00Z", want: []string{"run-3", "run-4", "run-5"}},
+		{name: "until only", until: "2026-02-01T00:00:00Z", want: []string{"run-1", "run-2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			window, err := parseBuildRunsCreatedWindow(test.since, test.until)
+			if err != nil {
+				t.Fatalf("parseBuildRunsCreatedWindow() error: %v", err)
+			}
+			if !window.active() {
+				t.Fatal("expected window to be active")
+			}
+			var got []string
+			for _, run := range window.filter(runs) {
+				got = append(got, run.ID)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("filter() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseBuildRunsCreatedWindow(t *testing.T) {
+	window, err := parseBuildRunsCreatedWindow("", " ")
+	if err != nil {
+		t.Fatalf("parseBuildRunsCreatedWindow() error: %v", err)
+	}
+	if window.active() {
+		t.Fatal("expected empty window to be inactive")
+	}
+
+	tests := []struct {
+		name    string
+		since   string
+		until   string
+		wantErr string
+	}{
+		{name: "invalid since", since: "2026-02-01", wantErr: "--since must be in RFC3339 format"},
+		{name: "invalid until", until: "yesterday", wantErr: "--until must be in RFC3339 format"},
+		{name: "since after until", since: "2026-02-08T00:00:00Z", until: "2026-02-01T00:00:00Z", wantErr: "--since must not be after --until"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseBuildRunsCreatedWindow(test.since, test.until)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package xcodecloud
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const clearScreen = "\033[H\033[2J"
+
+// XcodeCloudBuildRunsWatchCommand returns the build-runs watch subcommand.
+func XcodeCloudBuildRunsWatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+
+	workflowID := fs.String("workflow-id", "", "Workflow ID to watch build runs for")
+	interval := fs.Duration("interval", 10*time.Second, "Refresh interval")
+	limit := fs.Int("limit", 20, "Number of most recent build runs to show (1-200)")
+	output := fs.String("output", "", "Stream new build runs in this format instead of the live view: json, table, markdown, yaml, jsonl")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output (with --output)")
+
+	return &ffcli.Command{
+		Name:       "watch",
+		ShortUsage: "asc xcode-cloud build-runs watch --workflow-id \"WORKFLOW_ID\" [flags]",
+		ShortHelp:  "Watch a workflow's build run queue live.",
+		LongHelp: `Watch a workflow's build run queue live.
+
+Polls the workflow's build runs on an interval and redraws a table of runs
+with their execution progress and completion status. Runs that changed since
+the previous refresh are marked with "*" and listed under Changes. Requires
+an interactive terminal; press Ctrl-C to stop. Use build-runs list for
+one-off scripts.
+
+With --output, watch instead works like tail -f: it prints the most recent
+runs once, then on every refresh prints only runs created since the newest
+run already printed. Runs are printed at most once, oldest first, and the
+command keeps going until interrupted. Refresh failures are reported on
+stderr and retried on the next tick.
+
+Examples:
+  asc xcode-cloud build-runs watch --workflow-id "WORKFLOW_ID"
+  asc xcode-cloud build-runs watch --workflow-id "WORKFLOW_ID" --interval 30s --limit 10
+  asc xcode-cloud build-runs watch --workflow-id "WORKFLOW_ID" --interval 30s --output jsonl`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			workflowIDValue := strings.TrimSpace(*workflowID)
+			if workflowIDValue == "" {
+				return flagErrorf("--workflow-id is required")
+			}
+			if *interval <= 0 {
+				return usageErrorf("xcode-cloud build-runs watch: --interval must be greater than 0")
+			}
+			if *limit < 1 || *limit > 200 {
+				return usageErrorf("xcode-cloud build-runs watch: --limit must be between 1 and 200")
+			}
+			outputFormat := strings.TrimSpace(*output)
+			if outputFormat == "" && !shared.StdoutIsTerminal() {
+				return fmt.Errorf("xcode-cloud build-runs watch: requires an interactive terminal; use --output to stream new runs instead")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs watch: %w", err)
+			}
+
+			watchCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+			defer stop()
+
+			fetch := func(ctx context.Context) ([]asc.CiBuildRunResource, error) {
+				requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+				defer cancel()
+				opts := []asc.CiBuildRunsOption{asc.WithCiBuildRunsLimit(*limit)}
+				if outputFormat != "" {
+					// Newest first, so new runs are never pushed past --limit.
+					opts = append(opts, asc.WithCiBuildRunsSort("-number"))
+				}
+				resp, err := client.GetCiBuildRuns(requestCtx, workflowIDValue, opts...)
+				if err != nil {
+					return nil, err
+				}
+				return resp.Data, nil
+			}
+
+			if outputFormat != "" {
+				emit := func(runs []asc.CiBuildRunResource) error {
+					return printOutput(&asc.CiBuildRunsResponse{Data: runs}, outputFormat, *pretty)
+				}
+				if err := followBuildRuns(watchCtx, shared.Warnf, *interval, fetch, emit); err != nil {
+					return fmt.Errorf("xcode-cloud build-runs watch: %w", err)
+				}
+				return nil
+			}
+
+			if err := watchBuildRuns(watchCtx, os.Stdout, workflowIDValue, *interval, shared.StdoutSupportsANSI(), fetch); err != nil {
+				return fmt.Errorf("xcode-cloud build-runs watch: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// buildRunState is the part of a build run that watch tracks between
+// refreshes to detect transitions.
+type buildRunState struct {
+	progress asc.CiBuildRunExecutionProgress
+	status   asc.CiBuildRunCompletionStatus
+}
+
+func (s buildRunState) String() string {
+	if s.status != "" {
+		return string(s.progress) + "/" + string(s.status)
+	}
+	return string(s.progress)
+}
+
+// buildRunTransition records a run whose state changed between refreshes.
+type buildRunTransition struct {
+	number int
+	id     string
+	from   *buildRunState
+	to     buildRunState
+}
+
+// watchBuildRuns redraws the build run table every interval until ctx is
+// done. The first fetch must succeed; later failures are shown in the frame
+// and retried on the next tick.
+func watchBuildRuns(ctx context.Context, w io.Writer, workflowID string, interval time.Duration, color bool, fetch func(context.Context) ([]asc.CiBuildRunResource, error)) error {
+	runs, err := fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	var previous map[string]buildRunState
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var fetchErr error
+	for {
+		fmt.Fprint(w, clearScreen)
+		previous = renderBuildRunsWatch(w, workflowID, runs, previous, fetchErr, time.Now(), color)
+
+		select {
+		case <-ctx.Done():
+			fmt.Fprintln(w)
+			return nil
+		case <-ticker.C:
+		}
+
+		latest, err := fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				fmt.Fprintln(w)
+				return nil
+			}
+			fetchErr = err
+			continue
+		}
+		runs, fetchErr = latest, nil
+	}
+}
+
+// followBuildRuns emits the runs fetch returns, then every interval emits
+// only the runs not emitted before, until ctx is done. The first fetch must
+// succeed; later failures are reported through warn and retried on the next
+// tick.
+func followBuildRuns(ctx context.Context, warn func(format string, args ...any), interval time.Duration, fetch func(context.Context) ([]asc.CiBuildRunResource, error), emit func([]asc.CiBuildRunResource) error) error {
+	runs, err := fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	var follower buildRunsFollower
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if fresh := follower.next(runs); len(fresh) > 0 {
+			if err := emit(fresh); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		latest, err := fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			warn("refresh failed: %v", err)
+			runs = nil
+			continue
+		}
+		runs = latest
+	}
+}
+
+// buildRunsFollower remembers which build runs followBuildRuns has emitted
+// and the newest creation date among them.
+type buildRunsFollower struct {
+	seen   map[string]bool
+	latest time.Time
+}
+
+// next returns the runs in runs that have not been returned before and were
+// not created before the newest run already returned, oldest first. Runs
+// without a parseable creation date are only de-duplicated by ID.
+func (f *buildRunsFollower) next(runs []asc.CiBuildRunResource) []asc.CiBuildRunResource {
+	if f.seen == nil {
+		f.seen = make(map[string]bool)
+	}
+
+	var fresh []asc.CiBuildRunResource
+	newest := f.latest
+	for _, run := range runs {
+		if f.seen[run.ID] {
+			continue
+		}
+		created, err := shared.ParseTimestamp(run.Attributes.CreatedDate)
+		if err == nil {
+			if created.Before(f.latest) {
+				continue
+			}
+			if created.After(newest) {
+				newest = created
+			}
+		}
+		f.seen[run.ID] = true
+		fresh = append(fresh, run)
+	}
+	f.latest = newest
+
+	sort.SliceStable(fresh, func(i, j int) bool {
+		return fresh[i].Attributes.Number < fresh[j].Attributes.Number
+	})
+	return fresh
+}
+
+// renderBuildRunsWatch writes one frame of the watch view and returns the
+// run states to compare against on the next refresh. previous is nil on the
+// first frame, in which case no transitions are reported.
+func renderBuildRunsWatch(w io.Writer, workflowID string, runs []asc.CiBuildRunResource, previous map[string]buildRunState, fetchErr error, now time.Time, color bool) map[string]buildRunState {
+	current := make(map[string]buildRunState, len(runs))
+	var transitions []buildRunTransition
+	for _, run := range runs {
+		state := buildRunState{progress: run.Attributes.ExecutionProgress, status: run.Attributes.CompletionStatus}
+		current[run.ID] = state
+		if previous == nil {
+			continue
+		}
+		if before, ok := previous[run.ID]; !ok {
+			transitions = append(transitions, buildRunTransition{number: run.Attributes.Number, id: run.ID, to: state})
+		} else if before != state {
+			transitions = append(transitions, buildRunTransition{number: run.Attributes.Number, id: run.ID, from: &before, to: state})
+		}
+	}
+	changed := make(map[string]bool, len(transitions))
+	for _, transition := range transitions {
+		changed[transition.id] = true
+	}
+
+	fmt.Fprintf(w, "%s\n", styled(fmt.Sprintf("Workflow %s: %d build runs, updated %s (Ctrl-C to stop)", workflowID, len(runs), now.Format("15:04:05")), asc.ANSIBold, color))
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "  %-8s  %-9s  %-10s  %-9s  %s\n", "Build #", "Progress", "Status", "Duration", "ID")
+	for _, run := range runs {
+		attrs := run.Attributes
+		marker := " "
+		if changed[run.ID] {
+			marker = "*"
+		}
+		status := string(attrs.CompletionStatus)
+		if status == "" {
+			status = "-"
+		}
+		line := fmt.Sprintf("%s %-8d  %s  %s  %-9s  %s",
+			marker,
+			attrs.Number,
+			styled(fmt.Sprintf("%-9s", attrs.ExecutionProgress), progressColor(attrs.ExecutionProgress), color),
+			styled(fmt.Sprintf("%-10s", status), asc.StatusColor(string(attrs.CompletionStatus)), color),
+			buildRunWatchDuration(attrs, now),
+			run.ID,
+		)
+		if changed[run.ID] {
+			line = styled(line, asc.ANSIBold, color)
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	if len(transitions) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Changes:")
+		for _, transition := range transitions {
+			if transition.from == nil {
+				fmt.Fprintf(w, "  #%d new (%s)\n", transition.number, transition.to)
+				continue
+			}
+			fmt.Fprintf(w, "  #%d %s -> %s\n", transition.number, transition.from, transition.to)
+		}
+	}
+	if fetchErr != nil {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "%s\n", styled("Last refresh failed: "+fetchErr.Error(), asc.ANSIRed, color))
+	}
+	return current
+}
+
+// buildRunWatchDuration reports how long a run has been queued or running,
+// or its total duration once complete.
+func buildRunWatchDuration(attrs asc.CiBuildRunAttributes, now time.Time) string {
+	start := attrs.StartedDate
+	if start == "" {
+		start = attrs.CreatedDate
+	}
+	started, err := shared.ParseTimestamp(start)
+	if err != nil {
+		return "-"
+	}
+	end := now
+	if attrs.FinishedDate != "" {
+		if finished, err := shared.ParseTimestamp(attrs.FinishedDate); err == nil {
+			end = finished
+		}
+	}
+	if end.Before(started) {
+		return "-"
+	}
+	return end.Sub(started).Truncate(time.Second).String()
+}
+
+func progressColor(progress asc.CiBuildRunExecutionProgress) string {
+	switch progress {
+	case asc.CiBuildRunExecutionProgressPending:
+		return asc.ANSICyan
+	case asc.CiBuildRunExecutionProgressRunning:
+		return asc.ANSIYellow
+	default:
+		return ""
+	}
+}
+
+func styled(text, code string, color bool) string {
+	if !color || code == "" {
+		return text
+	}
+	return code + text + asc.ANSIReset
+}
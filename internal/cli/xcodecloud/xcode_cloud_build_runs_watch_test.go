@@ -0,0 +1,204 @@
+package xcodecloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func watchTestRun(id string, number int, progress asc.CiBuildRunExecutionProgress, status asc.CiBuildRunCompletionStatus) asc.CiBuildRunResource {
+	run := asc.CiBuildRunResource{ID: id}
+	run.Attributes.Number = number
+	run.Attributes.ExecutionProgress = progress
+	run.Attributes.CompletionStatus = status
+	run.Attributes.StartedDate = "2026-03-01T10:00:00Z"
+	return run
+}
+
+func TestRenderBuildRunsWatchHighlightsTransitions(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 5, 0, 0, time.UTC)
+	first := []asc.CiBuildRunResource{
+		watchTestRun("run-1", 1, asc.CiBuildRunExecutionProgressPending, ""),
+	}
+
+	var buf bytes.Buffer
+	previous := renderBuildRunsWatch(&buf, "WF_ID", first, nil, nil, now, false)
+	if strings.Contains(buf.String(), "Changes:") {
+		t.Fatalf("expected no changes on first frame, got %q", buf.String())
+	}
+
+	second := []asc.CiBuildRunResource{
+		watchTestRun("run-2", 2, asc.CiBuildRunExecutionProgressPending, ""),
+		watchTestRun("run-1", 1, asc.CiBuildRunExecutionProgressComplete, asc.CiBuildRunCompletionStatusSucceeded),
+	}
+	buf.Reset()
+	renderBuildRunsWatch(&buf, "WF_ID", second, previous, errors.New("boom"), now, false)
+	output := buf.String()
+
+	for _, want := range []string{
+		"Workflow WF_ID: 2 build runs",
+		"* 1 ",
+		"#1 PENDING -> COMPLETE/SUCCEEDED",
+		"#2 new (PENDING)",
+		"5m0s",
+		"Last refresh failed: boom",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "\033[") {
+		t.Fatalf("expected no ANSI codes without color, got %q", output)
+	}
+}
+
+func TestRenderBuildRunsWatchColorsStatus(t *testing.T) {
+	runs := []asc.CiBuildRunResource{
+		watchTestRun("run-1", 1, asc.CiBuildRunExecutionProgressComplete, asc.CiBuildRunCompletionStatusFailed),
+		watchTestRun("run-2", 2, asc.CiBuildRunExecutionProgressComplete, asc.CiBuildRunCompletionStatusCanceled),
+	}
+	var buf bytes.Buffer
+	renderBuildRunsWatch(&buf, "WF_ID", runs, nil, nil, time.Now(), true)
+	if !strings.Contains(buf.String(), asc.ANSIRed+"FAILED") {
+		t.Fatalf("expected failed status in red, got %q", buf.String())
+	}
+	// Statuses use the same colors as table output.
+	if !strings.Contains(buf.String(), asc.StatusColor("CANCELED")+"CANCELED") {
+		t.Fatalf("expected canceled status in its table color, got %q", buf.String())
+	}
+}
+
+func TestWatchBuildRunsStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	fetch := func(context.Context) ([]asc.CiBuildRunResource, error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return []asc.CiBuildRunResource{watchTestRun("run-1", 1, asc.CiBuildRunExecutionProgressRunning, "")}, nil
+	}
+
+	var buf bytes.Buffer
+	if err := watchBuildRuns(ctx, &buf, "WF_ID", time.Millisecond, false, fetch); err != nil {
+		t.Fatalf("watchBuildRuns() error: %v", err)
+	}
+	if calls < 2 {
+		t.Fatalf("expected at least 2 fetches, got %d", calls)
+	}
+	if strings.Count(buf.String(), clearScreen) < 2 {
+		t.Fatalf("expected the view to be redrawn, got %q", buf.String())
+	}
+}
+
+func TestWatchBuildRunsFailsWhenFirstFetchFails(t *testing.T) {
+	fetch := func(context.Context) ([]asc.CiBuildRunResource, error) {
+		return nil, errors.New("not found")
+	}
+	var buf bytes.Buffer
+	if err := watchBuildRuns(context.Background(), &buf, "WF_ID", time.Millisecond, false, fetch); err == nil {
+		t.Fatal("expected error when first fetch fails")
+	}
+}
+
+func followTestRun(id string, number int, created string) asc.CiBuildRunResource {
+	run := watchTestRun(id, number, asc.CiBuildRunExecutionProgressPending, "")
+	run.Attributes.CreatedDate = created
+	return run
+}
+
+func followedIDs(runs []asc.CiBuildRunResource) string {
+	ids := make([]string, 0, len(runs))
+	for _, run := range runs {
+		ids = append(ids, run.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestBuildRunsFollowerReturnsOnlyNewRuns(t *testing.T) {
+	var follower buildRunsFollower
+
+	first := follower.next([]asc.CiBuildRunResource{
+		followTestRun("run-2", 2, "2026-03-01T10:05:00Z"),
+		followTestRun("run-1", 1, "2026-03-01T10:00:00Z"),
+	})
+	if got := followedIDs(first); got != "run-1,run-2" {
+		t.Fatalf("expected initial runs oldest first, got %q", got)
+	}
+
+	second := follower.next([]asc.CiBuildRunResource{
+		followTestRun("run-3", 3, "2026-03-01T10:10:00Z"),
+		followTestRun("run-2", 2, "2026-03-01T10:05:00Z"),
+		followTestRun("run-0", 0, "2026-03-01T09:00:00Z"),
+	})
+	if got := followedIDs(second); got != "run-3" {
+		t.Fatalf("expected only the new run, got %q", got)
+	}
+
+	if third := follower.next(nil); len(third) != 0 {
+		t.Fatalf("expected no runs, got %q", followedIDs(third))
+	}
+}
+
+func TestFollowBuildRunsEmitsNewRunsUntilCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	polls := [][]asc.CiBuildRunResource{
+		{followTestRun("run-1", 1, "2026-03-01T10:00:00Z")},
+		nil,
+		{followTestRun("run-2", 2, "2026-03-01T10:05:00Z"), followTestRun("run-1", 1, "2026-03-01T10:00:00Z")},
+	}
+	calls := 0
+	fetch := func(context.Context) ([]asc.CiBuildRunResource, error) {
+		calls++
+		switch {
+		case calls == 2:
+			return nil, errors.New("boom")
+		case calls > len(polls):
+			cancel()
+			return nil, context.Canceled
+		}
+		return polls[calls-1], nil
+	}
+
+	var emitted []string
+	emit := func(runs []asc.CiBuildRunResource) error {
+		emitted = append(emitted, followedIDs(runs))
+		return nil
+	}
+
+	var warnings []string
+	warn := func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+	if err := followBuildRuns(ctx, warn, time.Millisecond, fetch, emit); err != nil {
+		t.Fatalf("followBuildRuns() error: %v", err)
+	}
+	if got := strings.Join(emitted, "|"); got != "run-1|run-2" {
+		t.Fatalf("expected each run emitted once, got %q", got)
+	}
+	if len(warnings) != 1 || warnings[0] != "refresh failed: boom" {
+		t.Fatalf("expected one refresh failure warning, got %q", warnings)
+	}
+}
+
+func TestFollowBuildRunsFailsWhenFirstFetchFails(t *testing.T) {
+	fetch := func(context.Context) ([]asc.CiBuildRunResource, error) {
+		return nil, errors.New("not found")
+	}
+	emit := func([]asc.CiBuildRunResource) error {
+		t.Fatal("unexpected emit")
+		return nil
+	}
+	warn := func(string, ...any) { t.Fatal("unexpected warning") }
+	if err := followBuildRuns(context.Background(), warn, time.Millisecond, fetch, emit); err == nil {
+		t.Fatal("expected error when first fetch fails")
+	}
+}
@@ -0,0 +1,79 @@
+package xcodecloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+var ciCompletionStatuses = []asc.CiBuildRunCompletionStatus{
+	asc.CiBuildRunCompletionStatusSucceeded,
+	asc.CiBuildRunCompletionStatusFailed,
+	asc.CiBuildRunCompletionStatusErrored,
+	asc.CiBuildRunCompletionStatusCanceled,
+	asc.CiBuildRunCompletionStatusSkipped,
+}
+
+// parseExitCodeMap parses values like "FAILED=10,ERRORED=11,CANCELED=12".
+func parseExitCodeMap(value string) (map[asc.CiBuildRunCompletionStatus]int, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	allowed := make([]string, 0, len(ciCompletionStatuses))
+	for _, status := range ciCompletionStatuses {
+		allowed = append(allowed, string(status))
+	}
+
+	mapping := make(map[asc.CiBuildRunCompletionStatus]int)
+	for _, entry := range strings.Split(trimmed, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, rawCode, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, usageErrorf("--exit-code-map entries must be STATUS=CODE (got %q)", entry)
+		}
+		status := asc.CiBuildRunCompletionStatus(strings.ToUpper(strings.TrimSpace(key)))
+		if !isKnownCompletionStatus(status) {
+			return nil, usageErrorf("--exit-code-map status must be one of: %s", strings.Join(allowed, ", "))
+		}
+		if _, exists := mapping[status]; exists {
+			return nil, fmt.Errorf("--exit-code-map has duplicate status %s", status)
+		}
+		code, err := strconv.Atoi(strings.TrimSpace(rawCode))
+		if err != nil || code < 0 || code > 255 {
+			return nil, usageErrorf("--exit-code-map code for %s must be an integer between 0 and 255", status)
+		}
+		mapping[status] = code
+	}
+	if len(mapping) == 0 {
+		return nil, nil
+	}
+	return mapping, nil
+}
+
+func isKnownCompletionStatus(status asc.CiBuildRunCompletionStatus) bool {
+	for _, known := range ciCompletionStatuses {
+		if status == known {
+			return true
+		}
+	}
+	return false
+}
+
+// exitCodeForCompletionStatus selects the process exit code for a finished run.
+// Mapped statuses win; otherwise success exits 0 and any other status exits 1.
+func exitCodeForCompletionStatus(status asc.CiBuildRunCompletionStatus, mapping map[asc.CiBuildRunCompletionStatus]int) int {
+	if code, ok := mapping[status]; ok {
+		return code
+	}
+	if asc.IsBuildRunSuccessful(status) {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,105 @@
+package xcodecloud
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func TestParseExitCodeMap(t *testing.T) {
+	mapping, err := parseExitCodeMap(" failed=10, ERRORED = 11 ,CANCELED=12,")
+	if err != nil {
+		t.Fatalf("parseExitCodeMap() error: %v", err)
+	}
+	want := map[asc.CiBuildRunCompletionStatus]int{
+		asc.CiBuildRunCompletionStatusFailed:   10,
+		asc.CiBuildRunCompletionStatusErrored:  11,
+		asc.CiBuildRunCompletionStatusCanceled: 12,
+	}
+	if len(mapping) != len(want) {
+		t.Fatalf("expected %d entries, got %v", len(want), mapping)
+	}
+	for status, code := range want {
+		if mapping[status] != code {
+			t.Fatalf("expected %s=%d, got %d", status, code, mapping[status])
+		}
+	}
+
+	empty, err := parseExitCodeMap("  ")
+	if err != nil || empty != nil {
+		t.Fatalf("expected nil mapping for empty input, got %v (%v)", empty, err)
+	}
+}
+
+func TestParseExitCodeMapInvalid(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr string
+	}{
+		{value: "FAILED", wantErr: "STATUS=CODE"},
+		{value: "BROKEN=3", wantErr: "status must be one of"},
+		{value: "FAILED=abc", wantErr: "between 0 and 255"},
+		{value: "FAILED=256", wantErr: "between 0 and 255"},
+		{value: "FAILED=-1", wantErr: "between 0 and 255"},
+		{value: "FAILED=2,failed=3", wantErr: "duplicate status"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			_, err := parseExitCodeMap(test.value)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestExitCodeForCompletionStatus(t *testing.T) {
+	mapping := map[asc.CiBuildRunCompletionStatus]int{
+		asc.CiBuildRunCompletionStatusFailed:   10,
+		asc.CiBuildRunCompletionStatusCanceled: 0,
+	}
+
+	tests := []struct {
+		status asc.CiBuildRunCompletionStatus
+		want   int
+	}{
+		{status: asc.CiBuildRunCompletionStatusSucceeded, want: 0},
+		{status: asc.CiBuildRunCompletionStatusFailed, want: 10},
+		{status: asc.CiBuildRunCompletionStatusErrored, want: 1},
+		{status: asc.CiBuildRunCompletionStatusCanceled, want: 0},
+		{status: asc.CiBuildRunCompletionStatusSkipped, want: 1},
+	}
+
+	for _, test := range tests {
+		if got := exitCodeForCompletionStatus(test.status, mapping); got != test.want {
+			t.Fatalf("exitCodeForCompletionStatus(%s) = %d, want %d", test.status, got, test.want)
+		}
+	}
+	if got := exitCodeForCompletionStatus(asc.CiBuildRunCompletionStatusFailed, nil); got != 1 {
+		t.Fatalf("expected default failure code 1, got %d", got)
+	}
+}
+
+func TestBuildCompletionErrorCarriesExitCode(t *testing.T) {
+	mapping := map[asc.CiBuildRunCompletionStatus]int{asc.CiBuildRunCompletionStatusErrored: 11}
+
+	if err := buildCompletionError("RUN", asc.CiBuildRunCompletionStatusSucceeded, mapping); err != nil {
+		t.Fatalf("expected nil error for success, got %v", err)
+	}
+
+	err := buildCompletionError("RUN", asc.CiBuildRunCompletionStatusErrored, mapping)
+	var exitCoder shared.ExitCoder
+	if !errors.As(err, &exitCoder) {
+		t.Fatalf("expected ExitCoder, got %T", err)
+	}
+	if exitCoder.ExitCode() != 11 {
+		t.Fatalf("expected exit code 11, got %d", exitCoder.ExitCode())
+	}
+	if !strings.Contains(err.Error(), "completed with status: ERRORED") {
+		t.Fatalf("unexpected error message: %v", err)
+	}
+}
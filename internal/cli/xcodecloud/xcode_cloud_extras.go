@@ -2,15 +2,15 @@ package xcodecloud
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 func xcodeCloudProductsListFlags(fs *flag.FlagSet) (appID *string, limit *int, next *string, paginate *bool, output *string, pretty *bool) {
@@ -80,7 +80,7 @@ func XcodeCloudProductsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Product ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -97,8 +97,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -123,7 +122,7 @@ func XcodeCloudProductsAppCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app", flag.ExitOnError)
 
 	id := fs.String("id", "", "Product ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -140,8 +139,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -169,7 +167,11 @@ func XcodeCloudProductsBuildRunsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	since := fs.String("since", "", "Only include runs created at or after this time (e.g., 7d, 12h, 2026-01-01, RFC3339)")
+	groupByWorkflow := fs.Bool("group-by-workflow", false, "Fetch all pages and print per-workflow run counts and latest status")
+	resolveNames := fs.Bool("resolve-names", false, "Look up workflow names (requires --group-by-workflow)")
+	concurrency := concurrencyFlag(fs)
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -178,24 +180,49 @@ func XcodeCloudProductsBuildRunsCommand() *ffcli.Command {
 		ShortHelp:  "List build runs for a product.",
 		LongHelp: `List build runs for a product.
 
+--since filters the fetched runs client-side by creation date. Combine it
+with --paginate to make sure every run in the window is considered;
+without --paginate only the first page is filtered.
+
+--group-by-workflow fetches every page and groups the runs by workflow,
+reporting run counts per completion status and the latest run of each
+workflow. Add --resolve-names to look up each workflow's name.
+
 Examples:
   asc xcode-cloud products build-runs --id "PRODUCT_ID"
   asc xcode-cloud products build-runs --id "PRODUCT_ID" --limit 50
-  asc xcode-cloud products build-runs --id "PRODUCT_ID" --paginate`,
+  asc xcode-cloud products build-runs --id "PRODUCT_ID" --paginate
+  asc xcode-cloud products build-runs --id "PRODUCT_ID" --paginate --since 7d
+  asc xcode-cloud products build-runs --id "PRODUCT_ID" --paginate --concurrency 4
+  asc xcode-cloud products build-runs --id "PRODUCT_ID" --group-by-workflow --resolve-names --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud products build-runs: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud products build-runs: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud products build-runs: %w", err)
 			}
 
+			var sinceCutoff time.Time
+			if strings.TrimSpace(*since) != "" {
+				cutoff, err := shared.ParseRelativeTime(*since, "--since", time.Now().UTC())
+				if err != nil {
+					return fmt.Errorf("xcode-cloud products build-runs: %w", err)
+				}
+				sinceCutoff = cutoff
+			}
+
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
+			}
+			if *resolveNames && !*groupByWorkflow {
+				return flagErrorf("--resolve-names requires --group-by-workflow")
+			}
+			if err := validateConcurrency(*concurrency, *paginate || *groupByWorkflow); err != nil {
+				return err
 			}
 
 			client, err := getASCClient()
@@ -211,6 +238,37 @@ Examples:
 				asc.WithCiBuildRunsNextURL(*next),
 			}
 
+			if *groupByWorkflow {
+				paginateOpts := append(opts, asc.WithCiBuildRunsLimit(200), asc.WithCiBuildRunsInclude([]string{"workflow"}))
+				firstPage, err := client.GetCiProductBuildRuns(requestCtx, idValue, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud products build-runs: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAllConcurrent(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetCiProductBuildRuns(ctx, idValue, asc.WithCiBuildRunsNextURL(nextURL))
+				}, *concurrency)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud products build-runs: %w", err)
+				}
+				runs, ok := resp.(*asc.CiBuildRunsResponse)
+				if !ok {
+					return fmt.Errorf("xcode-cloud products build-runs: unexpected response type %T", resp)
+				}
+				if !sinceCutoff.IsZero() {
+					filterCiBuildRunsSince(runs, sinceCutoff)
+				}
+
+				result := groupCiBuildRunsByWorkflow(idValue, runs.Data)
+				if *resolveNames {
+					if err := resolveCiWorkflowGroupNames(requestCtx, client, result); err != nil {
+						return fmt.Errorf("xcode-cloud products build-runs: %w", err)
+					}
+				}
+
+				return printOutput(result, *output, *pretty)
+			}
+
 			if *paginate {
 				paginateOpts := append(opts, asc.WithCiBuildRunsLimit(200))
 				firstPage, err := client.GetCiProductBuildRuns(requestCtx, idValue, paginateOpts...)
@@ -218,12 +276,15 @@ Examples:
 					return fmt.Errorf("xcode-cloud products build-runs: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				resp, err := asc.PaginateAllConcurrent(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCiProductBuildRuns(ctx, idValue, asc.WithCiBuildRunsNextURL(nextURL))
-				})
+				}, *concurrency)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud products build-runs: %w", err)
 				}
+				if runs, ok := resp.(*asc.CiBuildRunsResponse); ok && !sinceCutoff.IsZero() {
+					filterCiBuildRunsSince(runs, sinceCutoff)
+				}
 
 				return printOutput(resp, *output, *pretty)
 			}
@@ -232,12 +293,31 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("xcode-cloud products build-runs: %w", err)
 			}
+			if !sinceCutoff.IsZero() {
+				filterCiBuildRunsSince(resp, sinceCutoff)
+			}
 
 			return printOutput(resp, *output, *pretty)
 		},
 	}
 }
 
+// resolveCiWorkflowGroupNames fills in the workflow name of each group.
+func resolveCiWorkflowGroupNames(ctx context.Context, client *asc.Client, result *asc.CiBuildRunsByWorkflowResult) error {
+	for workflowID, group := range result.Workflows {
+		if workflowID == "unknown" {
+			continue
+		}
+		workflow, err := client.GetCiWorkflow(ctx, workflowID)
+		if err != nil {
+			return fmt.Errorf("failed to resolve workflow %s: %w", workflowID, err)
+		}
+		group.WorkflowName = workflow.Data.Attributes.Name
+		result.Workflows[workflowID] = group
+	}
+	return nil
+}
+
 func XcodeCloudProductsWorkflowsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("workflows", flag.ExitOnError)
 
@@ -245,7 +325,7 @@ func XcodeCloudProductsWorkflowsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -262,7 +342,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud products workflows: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud products workflows: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud products workflows: %w", err)
@@ -270,8 +350,7 @@ Examples:
 
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -294,14 +373,13 @@ Examples:
 					return fmt.Errorf("xcode-cloud products workflows: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCiWorkflows(ctx, idValue, asc.WithCiWorkflowsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud products workflows: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetCiWorkflows(requestCtx, idValue, opts...)
@@ -321,7 +399,7 @@ func XcodeCloudProductsPrimaryRepositoriesCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -338,7 +416,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud products primary-repositories: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud products primary-repositories: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud products primary-repositories: %w", err)
@@ -346,8 +424,7 @@ Examples:
 
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -370,14 +447,13 @@ Examples:
 					return fmt.Errorf("xcode-cloud products primary-repositories: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCiProductPrimaryRepositories(ctx, idValue, asc.WithCiProductRepositoriesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud products primary-repositories: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetCiProductPrimaryRepositories(requestCtx, idValue, opts...)
@@ -397,7 +473,7 @@ func XcodeCloudProductsAdditionalRepositoriesCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -414,7 +490,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud products additional-repositories: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud products additional-repositories: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud products additional-repositories: %w", err)
@@ -422,8 +498,7 @@ Examples:
 
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -446,14 +521,13 @@ Examples:
 					return fmt.Errorf("xcode-cloud products additional-repositories: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCiProductAdditionalRepositories(ctx, idValue, asc.WithCiProductRepositoriesNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud products additional-repositories: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetCiProductAdditionalRepositories(requestCtx, idValue, opts...)
@@ -471,7 +545,8 @@ func XcodeCloudProductsDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Product ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	cascade := fs.Bool("cascade", false, "Delete the product's workflows before deleting the product")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -480,19 +555,28 @@ func XcodeCloudProductsDeleteCommand() *ffcli.Command {
 		ShortHelp:  "Delete a product.",
 		LongHelp: `Delete a product.
 
+A product that still has workflows cannot be deleted. Use --cascade to delete
+every workflow of the product first; each deleted workflow is listed in the
+output.
+
 Examples:
-  asc xcode-cloud products delete --id "PRODUCT_ID" --confirm`,
+  asc xcode-cloud products delete --id "PRODUCT_ID" --confirm
+  asc xcode-cloud products delete --id "PRODUCT_ID" --cascade --confirm`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				confirmed, err := confirmDestructive(fmt.Sprintf("product %q", idValue))
+				if err != nil {
+					return fmt.Errorf("xcode-cloud products delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -503,19 +587,73 @@ Examples:
 			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
 			defer cancel()
 
+			result := &asc.CiProductDeleteResult{ID: idValue}
+			if *cascade {
+				deleted, err := deleteCiProductWorkflows(requestCtx, client, idValue)
+				result.Workflows = deleted
+				if err != nil {
+					if len(deleted) > 0 {
+						_ = printOutput(result, *output, *pretty)
+					}
+					return fmt.Errorf("xcode-cloud products delete: %w", err)
+				}
+			}
+
 			if err := client.DeleteCiProduct(requestCtx, idValue); err != nil {
+				if !*cascade && ciProductHasWorkflows(requestCtx, client, idValue) {
+					return fmt.Errorf("xcode-cloud products delete: failed to delete: %w (the product still has workflows; delete them first or re-run with --cascade)", err)
+				}
 				return fmt.Errorf("xcode-cloud products delete: failed to delete: %w", err)
 			}
 
-			result := &asc.CiProductDeleteResult{ID: idValue, Deleted: true}
+			result.Deleted = true
 			return printOutput(result, *output, *pretty)
 		},
 	}
 }
 
+// deleteCiProductWorkflows deletes every workflow of a product and returns
+// the workflows deleted so far, even when a later deletion fails.
+func deleteCiProductWorkflows(ctx context.Context, client *asc.Client, productID string) ([]asc.CiWorkflowDeleteResult, error) {
+	firstPage, err := client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+	workflows, ok := all.(*asc.CiWorkflowsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected workflows response type %T", all)
+	}
+
+	deleted := make([]asc.CiWorkflowDeleteResult, 0, len(workflows.Data))
+	for _, workflow := range workflows.Data {
+		if err := client.DeleteCiWorkflow(ctx, workflow.ID); err != nil {
+			return deleted, fmt.Errorf("failed to delete workflow %s after deleting %d of %d: %w", workflow.ID, len(deleted), len(workflows.Data), err)
+		}
+		deleted = append(deleted, asc.CiWorkflowDeleteResult{
+			ID:      workflow.ID,
+			Name:    workflow.Attributes.Name,
+			Deleted: true,
+		})
+	}
+	return deleted, nil
+}
+
+// ciProductHasWorkflows reports whether a product still has workflows. Lookup
+// failures report false so the original delete error is returned unchanged.
+func ciProductHasWorkflows(ctx context.Context, client *asc.Client, productID string) bool {
+	resp, err := client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsLimit(1))
+	return err == nil && len(resp.Data) > 0
+}
+
 func xcodeCloudProductsList(ctx context.Context, appID string, limit int, next string, paginate bool, output string, pretty bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
-		return fmt.Errorf("xcode-cloud products: --limit must be between 1 and 200")
+		return usageErrorf("xcode-cloud products: --limit must be between 1 and 200")
 	}
 	if err := validateNextURL(next); err != nil {
 		return fmt.Errorf("xcode-cloud products: %w", err)
@@ -545,14 +683,13 @@ func xcodeCloudProductsList(ctx context.Context, appID string, limit int, next s
 			return fmt.Errorf("xcode-cloud products: failed to fetch: %w", err)
 		}
 
-		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetCiProducts(ctx, asc.WithCiProductsNextURL(nextURL))
-		})
+		}, output, pretty)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud products: %w", err)
 		}
-
-		return printOutput(resp, output, pretty)
+		return nil
 	}
 
 	resp, err := client.GetCiProducts(requestCtx, opts...)
@@ -567,7 +704,7 @@ func xcodeCloudVersionListFlags(fs *flag.FlagSet) (limit *int, next *string, pag
 	limit = fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next = fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate = fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output = fs.String("output", "json", "Output format: json (default), table, markdown")
+	output = fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty = fs.Bool("pretty", false, "Pretty-print JSON output")
 	return
 }
@@ -629,7 +766,7 @@ func XcodeCloudMacOSVersionsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "macOS version ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -646,8 +783,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -675,7 +811,7 @@ func XcodeCloudMacOSVersionsXcodeVersionsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -692,7 +828,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud macos-versions xcode-versions: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud macos-versions xcode-versions: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud macos-versions xcode-versions: %w", err)
@@ -700,8 +836,7 @@ Examples:
 
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -724,14 +859,13 @@ Examples:
 					return fmt.Errorf("xcode-cloud macos-versions xcode-versions: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCiMacOsVersionXcodeVersions(ctx, idValue, asc.WithCiXcodeVersionsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud macos-versions xcode-versions: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetCiMacOsVersionXcodeVersions(requestCtx, idValue, opts...)
@@ -746,7 +880,7 @@ Examples:
 
 func xcodeCloudMacOSVersionsList(ctx context.Context, limit int, next string, paginate bool, output string, pretty bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
-		return fmt.Errorf("xcode-cloud macos-versions: --limit must be between 1 and 200")
+		return usageErrorf("xcode-cloud macos-versions: --limit must be between 1 and 200")
 	}
 	if err := validateNextURL(next); err != nil {
 		return fmt.Errorf("xcode-cloud macos-versions: %w", err)
@@ -772,14 +906,13 @@ func xcodeCloudMacOSVersionsList(ctx context.Context, limit int, next string, pa
 			return fmt.Errorf("xcode-cloud macos-versions: failed to fetch: %w", err)
 		}
 
-		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetCiMacOsVersions(ctx, asc.WithCiMacOsVersionsNextURL(nextURL))
-		})
+		}, output, pretty)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud macos-versions: %w", err)
 		}
-
-		return printOutput(resp, output, pretty)
+		return nil
 	}
 
 	resp, err := client.GetCiMacOsVersions(requestCtx, opts...)
@@ -795,6 +928,7 @@ func XcodeCloudXcodeVersionsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("xcode-versions", flag.ExitOnError)
 
 	limit, next, paginate, output, pretty := xcodeCloudVersionListFlags(fs)
+	filter := xcodeCloudXcodeVersionFilterFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "xcode-versions",
@@ -805,6 +939,7 @@ func XcodeCloudXcodeVersionsCommand() *ffcli.Command {
 Examples:
   asc xcode-cloud xcode-versions
   asc xcode-cloud xcode-versions list
+  asc xcode-cloud xcode-versions --name "16." --latest
   asc xcode-cloud xcode-versions get --id \"XCODE_VERSION_ID\"
   asc xcode-cloud xcode-versions macos-versions --id \"XCODE_VERSION_ID\"`,
 		FlagSet:   fs,
@@ -815,7 +950,7 @@ Examples:
 			XcodeCloudXcodeVersionsMacOSVersionsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			return xcodeCloudXcodeVersionsList(ctx, *limit, *next, *paginate, *output, *pretty)
+			return xcodeCloudXcodeVersionsList(ctx, *limit, *next, *paginate, filter.values(), *output, *pretty)
 		},
 	}
 }
@@ -824,6 +959,7 @@ func XcodeCloudXcodeVersionsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	limit, next, paginate, output, pretty := xcodeCloudVersionListFlags(fs)
+	filter := xcodeCloudXcodeVersionFilterFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -834,11 +970,17 @@ func XcodeCloudXcodeVersionsListCommand() *ffcli.Command {
 Examples:
   asc xcode-cloud xcode-versions list
   asc xcode-cloud xcode-versions list --limit 50
-  asc xcode-cloud xcode-versions list --paginate`,
+  asc xcode-cloud xcode-versions list --paginate
+  asc xcode-cloud xcode-versions list --name "16." --paginate
+  asc xcode-cloud xcode-versions list --name "16." --latest
+
+--name filters client-side on the version and name attributes (case-insensitive
+substring), so it only sees the fetched page unless --paginate is set.
+--latest fetches every page and keeps only the highest version number.`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return xcodeCloudXcodeVersionsList(ctx, *limit, *next, *paginate, *output, *pretty)
+			return xcodeCloudXcodeVersionsList(ctx, *limit, *next, *paginate, filter.values(), *output, *pretty)
 		},
 	}
 }
@@ -847,7 +989,7 @@ func XcodeCloudXcodeVersionsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Xcode version ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -864,8 +1006,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -893,7 +1034,7 @@ func XcodeCloudXcodeVersionsMacOSVersionsCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -910,7 +1051,7 @@ Examples:
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud xcode-versions macos-versions: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud xcode-versions macos-versions: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud xcode-versions macos-versions: %w", err)
@@ -918,8 +1059,7 @@ Examples:
 
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -942,14 +1082,13 @@ Examples:
 					return fmt.Errorf("xcode-cloud xcode-versions macos-versions: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				err = paginateOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCiXcodeVersionMacOsVersions(ctx, idValue, asc.WithCiMacOsVersionsNextURL(nextURL))
-				})
+				}, *output, *pretty)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud xcode-versions macos-versions: %w", err)
 				}
-
-				return printOutput(resp, *output, *pretty)
+				return nil
 			}
 
 			resp, err := client.GetCiXcodeVersionMacOsVersions(requestCtx, idValue, opts...)
@@ -962,13 +1101,41 @@ Examples:
 	}
 }
 
-func xcodeCloudXcodeVersionsList(ctx context.Context, limit int, next string, paginate bool, output string, pretty bool) error {
+// xcodeVersionFilter holds the client-side filters for xcode-versions list.
+type xcodeVersionFilter struct {
+	Name   string
+	Latest bool
+}
+
+type xcodeVersionFilterFlags struct {
+	name   *string
+	latest *bool
+}
+
+func xcodeCloudXcodeVersionFilterFlags(fs *flag.FlagSet) xcodeVersionFilterFlags {
+	return xcodeVersionFilterFlags{
+		name:   fs.String("name", "", "Only show Xcode versions whose version or name contains this text (case-insensitive)"),
+		latest: fs.Bool("latest", false, "Only show the highest matching Xcode version (fetches all pages)"),
+	}
+}
+
+func (f xcodeVersionFilterFlags) values() xcodeVersionFilter {
+	return xcodeVersionFilter{Name: strings.TrimSpace(*f.name), Latest: *f.latest}
+}
+
+func xcodeCloudXcodeVersionsList(ctx context.Context, limit int, next string, paginate bool, filter xcodeVersionFilter, output string, pretty bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
-		return fmt.Errorf("xcode-cloud xcode-versions: --limit must be between 1 and 200")
+		return usageErrorf("xcode-cloud xcode-versions: --limit must be between 1 and 200")
 	}
 	if err := validateNextURL(next); err != nil {
 		return fmt.Errorf("xcode-cloud xcode-versions: %w", err)
 	}
+	if filter.Latest {
+		if strings.TrimSpace(next) != "" {
+			return usageErrorf("xcode-cloud xcode-versions: --latest and --next are mutually exclusive")
+		}
+		paginate = true
+	}
 
 	client, err := getASCClient()
 	if err != nil {
@@ -997,6 +1164,14 @@ func xcodeCloudXcodeVersionsList(ctx context.Context, limit int, next string, pa
 			return fmt.Errorf("xcode-cloud xcode-versions: %w", err)
 		}
 
+		if filter.Name != "" || filter.Latest {
+			versions, ok := resp.(*asc.CiXcodeVersionsResponse)
+			if !ok {
+				return fmt.Errorf("xcode-cloud xcode-versions: unexpected paginated response %T", resp)
+			}
+			applyXcodeVersionFilter(versions, filter)
+		}
+
 		return printOutput(resp, output, pretty)
 	}
 
@@ -1005,30 +1180,13 @@ func xcodeCloudXcodeVersionsList(ctx context.Context, limit int, next string, pa
 		return fmt.Errorf("xcode-cloud xcode-versions: %w", err)
 	}
 
+	applyXcodeVersionFilter(resp, filter)
 	return printOutput(resp, output, pretty)
 }
 
-func readJSONFilePayload(path string) (json.RawMessage, error) {
-	info, err := os.Stat(path)
-	if err != nil {
-		return nil, err
-	}
-	if info.IsDir() {
-		return nil, fmt.Errorf("payload path must be a file")
-	}
-
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return nil, err
-	}
-	if strings.TrimSpace(string(data)) == "" {
-		return nil, fmt.Errorf("payload file is empty")
-	}
-
-	var payload map[string]interface{}
-	if err := json.Unmarshal(data, &payload); err != nil {
-		return nil, fmt.Errorf("invalid JSON: %w", err)
+func applyXcodeVersionFilter(resp *asc.CiXcodeVersionsResponse, filter xcodeVersionFilter) {
+	filterCiXcodeVersionsByName(resp, filter.Name)
+	if filter.Latest {
+		selectLatestCiXcodeVersion(resp)
 	}
-
-	return json.RawMessage(data), nil
 }
@@ -3,50 +3,129 @@ package xcodecloud
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
-	"net"
-	"syscall"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
+// buildRunWaitOptions controls how long --wait polls a build run.
+// RequestTimeout bounds each status request (0 = ASC_TIMEOUT or the 30m
+// default); WaitTimeout bounds the whole wait.
+type buildRunWaitOptions struct {
+	PollInterval   time.Duration
+	RequestTimeout time.Duration
+	WaitTimeout    time.Duration
+}
+
 // waitForBuildCompletion polls until the build run completes or times out.
-func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID string, pollInterval time.Duration, outputFormat string, pretty bool) error {
-	ticker := time.NewTicker(pollInterval)
+func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID string, opts buildRunWaitOptions, exitCodes map[asc.CiBuildRunCompletionStatus]int, outputFormat string, pretty bool) error {
+	resp, err := pollBuildRunUntilComplete(ctx, client, buildRunID, opts)
+	if err != nil {
+		return err
+	}
+
+	result := buildStatusResult(resp)
+	if err := printOutput(result, outputFormat, pretty); err != nil {
+		return err
+	}
+
+	return buildCompletionError(buildRunID, resp.Data.Attributes.CompletionStatus, exitCodes)
+}
+
+// pollBuildRunUntilComplete polls until the build run completes and returns
+// its final state.
+func pollBuildRunUntilComplete(ctx context.Context, client *asc.Client, buildRunID string, opts buildRunWaitOptions) (*asc.CiBuildRunResponse, error) {
+	return pollBuildRun(ctx, buildRunID, opts, func(ctx context.Context) (*asc.CiBuildRunResponse, error) {
+		return getCiBuildRunWithRetry(ctx, client, buildRunID)
+	})
+}
+
+// pollBuildRun calls fetch every PollInterval until the run completes.
+func pollBuildRun(ctx context.Context, buildRunID string, opts buildRunWaitOptions, fetch func(context.Context) (*asc.CiBuildRunResponse, error)) (*asc.CiBuildRunResponse, error) {
+	return pollUntilComplete(ctx, "build run "+buildRunID, opts, fetch, func(resp *asc.CiBuildRunResponse) asc.CiBuildRunExecutionProgress {
+		return resp.Data.Attributes.ExecutionProgress
+	})
+}
+
+// pollBuildActionUntilComplete polls until the build action completes and
+// returns its final state.
+func pollBuildActionUntilComplete(ctx context.Context, client *asc.Client, buildActionID string, opts buildRunWaitOptions) (*asc.CiBuildActionResponse, error) {
+	fetch := func(ctx context.Context) (*asc.CiBuildActionResponse, error) {
+		return getCiBuildActionWithRetry(ctx, client, buildActionID)
+	}
+	return pollUntilComplete(ctx, "build action "+buildActionID, opts, fetch, func(resp *asc.CiBuildActionResponse) asc.CiBuildRunExecutionProgress {
+		return resp.Data.Attributes.ExecutionProgress
+	})
+}
+
+// pollUntilComplete calls fetch every PollInterval until progress reports
+// COMPLETE. Each fetch gets its own RequestTimeout, so a slow request fails on
+// its own without eating into the overall WaitTimeout budget. subject names
+// what is being waited on in errors (e.g. "build run 123").
+func pollUntilComplete[T any](ctx context.Context, subject string, opts buildRunWaitOptions, fetch func(context.Context) (T, error), progress func(T) asc.CiBuildRunExecutionProgress) (T, error) {
+	var zero T
+	waitCtx := ctx
+	if opts.WaitTimeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, opts.WaitTimeout)
+		defer cancel()
+	}
+	requestTimeout := resolveXcodeCloudTimeout(opts.RequestTimeout)
+
+	ticker := time.NewTicker(opts.PollInterval)
 	defer ticker.Stop()
 
-	for {
-		resp, err := getCiBuildRunWithRetry(ctx, client, buildRunID)
-		if err != nil {
-			return fmt.Errorf("xcode-cloud: failed to check status: %w", err)
+	var lastProgress asc.CiBuildRunExecutionProgress
+	waitDone := func() error {
+		if errors.Is(waitCtx.Err(), context.Canceled) {
+			return fmt.Errorf("xcode-cloud: canceled waiting for %s (last status: %s)", subject, lastProgress)
 		}
+		return fmt.Errorf("xcode-cloud: timed out waiting for %s after %s (--wait-timeout; last status: %s)", subject, opts.WaitTimeout, lastProgress)
+	}
 
-		if asc.IsBuildRunComplete(resp.Data.Attributes.ExecutionProgress) {
-			result := buildStatusResult(resp)
-			if err := printOutput(result, outputFormat, pretty); err != nil {
-				return err
+	for {
+		requestCtx, cancel := context.WithTimeout(waitCtx, requestTimeout)
+		resp, err := fetch(requestCtx)
+		cancel()
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return zero, waitDone()
 			}
-
-			// Return error for failed builds
-			if !asc.IsBuildRunSuccessful(resp.Data.Attributes.CompletionStatus) {
-				return fmt.Errorf("build run %s completed with status: %s", buildRunID, resp.Data.Attributes.CompletionStatus)
+			if errors.Is(err, context.DeadlineExceeded) {
+				return zero, fmt.Errorf("xcode-cloud: status request for %s timed out after %s (--timeout)", subject, requestTimeout)
 			}
-			return nil
+			return zero, fmt.Errorf("xcode-cloud: failed to check status: %w", err)
+		}
+		lastProgress = progress(resp)
+
+		if asc.IsBuildRunComplete(lastProgress) {
+			return resp, nil
 		}
 
 		select {
-		case <-ctx.Done():
-			if errors.Is(ctx.Err(), context.Canceled) {
-				return fmt.Errorf("xcode-cloud: canceled waiting for build run %s (last status: %s)", buildRunID, resp.Data.Attributes.ExecutionProgress)
-			}
-			return fmt.Errorf("xcode-cloud: timed out waiting for build run %s (last status: %s)", buildRunID, resp.Data.Attributes.ExecutionProgress)
+		case <-waitCtx.Done():
+			return zero, waitDone()
 		case <-ticker.C:
 			// Continue polling
 		}
 	}
 }
 
+// buildCompletionError returns an error carrying the exit code for a finished
+// build run, or nil when the run should exit successfully.
+func buildCompletionError(buildRunID string, status asc.CiBuildRunCompletionStatus, exitCodes map[asc.CiBuildRunCompletionStatus]int) error {
+	code := exitCodeForCompletionStatus(status, exitCodes)
+	if code == 0 {
+		return nil
+	}
+	return shared.NewExitCodeError(fmt.Errorf("build run %s completed with status: %s", buildRunID, status), code)
+}
+
 // buildStatusResult converts a CiBuildRunResponse to XcodeCloudStatusResult.
 func buildStatusResult(resp *asc.CiBuildRunResponse) *asc.XcodeCloudStatusResult {
 	result := &asc.XcodeCloudStatusResult{
@@ -70,24 +149,50 @@ func buildStatusResult(resp *asc.CiBuildRunResponse) *asc.XcodeCloudStatusResult
 	return result
 }
 
-const defaultXcodeCloudTimeout = 30 * time.Minute
+const (
+	defaultXcodeCloudTimeout     = 30 * time.Minute
+	defaultXcodeCloudWaitTimeout = 2 * time.Hour
+)
 
+// contextWithXcodeCloudTimeout bounds a single Xcode Cloud request. A zero
+// timeout falls back to ASC_TIMEOUT or the 30m default.
 func contextWithXcodeCloudTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	return context.WithTimeout(ctx, resolveXcodeCloudTimeout(timeout))
+}
+
+func resolveXcodeCloudTimeout(timeout time.Duration) time.Duration {
 	if timeout <= 0 {
-		timeout = asc.ResolveTimeoutWithDefault(defaultXcodeCloudTimeout)
+		return asc.ResolveTimeoutWithDefault(defaultXcodeCloudTimeout)
 	}
-	return context.WithTimeout(ctx, timeout)
+	return timeout
 }
 
+// getCiBuildRunWithRetry adds retries for transient network errors while
+// polling; rate limits (429 with Retry-After) are already retried by the client.
 func getCiBuildRunWithRetry(ctx context.Context, client *asc.Client, buildRunID string) (*asc.CiBuildRunResponse, error) {
 	retryOpts := asc.ResolveRetryOptions()
 	return asc.WithRetry(ctx, func() (*asc.CiBuildRunResponse, error) {
 		resp, err := client.GetCiBuildRun(ctx, buildRunID)
 		if err != nil {
-			if isTransientNetworkError(err) {
+			if asc.IsTransientNetworkError(err) {
+				return nil, &asc.RetryableError{Err: err}
+			}
+			return nil, err
+		}
+		return resp, nil
+	}, retryOpts)
+}
+
+// getCiBuildActionWithRetry is getCiBuildRunWithRetry for build actions.
+func getCiBuildActionWithRetry(ctx context.Context, client *asc.Client, buildActionID string) (*asc.CiBuildActionResponse, error) {
+	retryOpts := asc.ResolveRetryOptions()
+	return asc.WithRetry(ctx, func() (*asc.CiBuildActionResponse, error) {
+		resp, err := client.GetCiBuildAction(ctx, buildActionID)
+		if err != nil {
+			if asc.IsTransientNetworkError(err) {
 				return nil, &asc.RetryableError{Err: err}
 			}
 			return nil, err
@@ -96,18 +201,118 @@ func getCiBuildRunWithRetry(ctx context.Context, client *asc.Client, buildRunID
 	}, retryOpts)
 }
 
-func isTransientNetworkError(err error) bool {
-	if err == nil {
-		return false
+// filterCiBuildRunsSince keeps only build runs created at or after cutoff.
+func filterCiBuildRunsSince(resp *asc.CiBuildRunsResponse, cutoff time.Time) {
+	if resp == nil {
+		return
+	}
+	filtered := make([]asc.CiBuildRunResource, 0, len(resp.Data))
+	for _, run := range resp.Data {
+		if shared.CreatedOnOrAfter(run.Attributes.CreatedDate, cutoff) {
+			filtered = append(filtered, run)
+		}
+	}
+	resp.Data = filtered
+}
+
+// countInProgressBuildRuns returns how many build runs have not completed yet.
+func countInProgressBuildRuns(resp *asc.CiBuildRunsResponse) int {
+	if resp == nil {
+		return 0
+	}
+	count := 0
+	for _, run := range resp.Data {
+		if !asc.IsBuildRunComplete(run.Attributes.ExecutionProgress) {
+			count++
+		}
+	}
+	return count
+}
+
+// filterCiXcodeVersionsByName keeps only Xcode versions whose version or
+// name contains query, ignoring case. An empty query keeps everything.
+func filterCiXcodeVersionsByName(resp *asc.CiXcodeVersionsResponse, query string) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if resp == nil || query == "" {
+		return
+	}
+	filtered := make([]asc.CiXcodeVersionResource, 0, len(resp.Data))
+	for _, version := range resp.Data {
+		attrs := version.Attributes
+		if strings.Contains(strings.ToLower(attrs.Version), query) || strings.Contains(strings.ToLower(attrs.Name), query) {
+			filtered = append(filtered, version)
+		}
+	}
+	resp.Data = filtered
+}
+
+// selectLatestCiXcodeVersion reduces resp to the Xcode version with the
+// highest version number. Ties keep the first version returned by the API.
+func selectLatestCiXcodeVersion(resp *asc.CiXcodeVersionsResponse) {
+	if resp == nil || len(resp.Data) == 0 {
+		return
 	}
-	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
-		return false
+	latest := resp.Data[0]
+	for _, version := range resp.Data[1:] {
+		if compareXcodeVersions(version.Attributes.Version, latest.Attributes.Version) > 0 {
+			latest = version
+		}
+	}
+	resp.Data = []asc.CiXcodeVersionResource{latest}
+}
+
+// compareXcodeVersions compares the leading dotted numbers of two version
+// strings (e.g. "16.2" or "16.3 beta 2"), treating missing parts as 0.
+func compareXcodeVersions(a, b string) int {
+	left, right := xcodeVersionParts(a), xcodeVersionParts(b)
+	for i := 0; i < len(left) || i < len(right); i++ {
+		var l, r int
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		if l != r {
+			if l < r {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func xcodeVersionParts(version string) []int {
+	fields := strings.Fields(version)
+	if len(fields) == 0 {
+		return nil
+	}
+	var parts []int
+	for _, part := range strings.Split(fields[0], ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+// concurrencyFlag registers --concurrency for the build run listings, which
+// are long enough to benefit from fetching pages in parallel.
+func concurrencyFlag(fs *flag.FlagSet) *int {
+	return fs.Int("concurrency", 1, "Pages to fetch in parallel when fetching all pages (needs offset-style next links; otherwise serial)")
+}
+
+// validateConcurrency checks --concurrency; values above 1 only apply when
+// every page is fetched.
+func validateConcurrency(concurrency int, fetchesAllPages bool) error {
+	if concurrency < 1 {
+		return flagErrorf("--concurrency must be at least 1")
 	}
-	var netErr net.Error
-	if errors.As(err, &netErr) {
-		return true
+	if concurrency > 1 && !fetchesAllPages {
+		return flagErrorf("--concurrency requires --paginate")
 	}
-	return errors.Is(err, syscall.ECONNRESET) ||
-		errors.Is(err, syscall.EPIPE) ||
-		errors.Is(err, syscall.ECONNREFUSED)
+	return nil
 }
@@ -0,0 +1,206 @@
+package xcodecloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestFilterCiBuildRunsSince(t *testing.T) {
+	resp := &asc.CiBuildRunsResponse{
+		Data: []asc.CiBuildRunResource{
+			{ID: "old", Attributes: asc.CiBuildRunAttributes{CreatedDate: "2026-02-28T23:59:59Z"}},
+			{ID: "boundary", Attributes: asc.CiBuildRunAttributes{CreatedDate: "2026-03-01T00:00:00Z"}},
+			{ID: "new", Attributes: asc.CiBuildRunAttributes{CreatedDate: "2026-03-02T10:00:00Z"}},
+			{ID: "missing"},
+		},
+	}
+
+	filterCiBuildRunsSince(resp, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	if len(resp.Data) != 2 {
+		t.Fatalf("expected 2 runs, got %d", len(resp.Data))
+	}
+	if resp.Data[0].ID != "boundary" || resp.Data[1].ID != "new" {
+		t.Fatalf("unexpected runs: %+v", resp.Data)
+	}
+}
+
+func TestCountInProgressBuildRuns(t *testing.T) {
+	resp := &asc.CiBuildRunsResponse{
+		Data: []asc.CiBuildRunResource{
+			{ID: "pending", Attributes: asc.CiBuildRunAttributes{ExecutionProgress: asc.CiBuildRunExecutionProgressPending}},
+			{ID: "running", Attributes: asc.CiBuildRunAttributes{ExecutionProgress: asc.CiBuildRunExecutionProgressRunning}},
+			{ID: "complete", Attributes: asc.CiBuildRunAttributes{ExecutionProgress: asc.CiBuildRunExecutionProgressComplete}},
+		},
+	}
+
+	if got := countInProgressBuildRuns(resp); got != 2 {
+		t.Fatalf("expected 2 in-progress runs, got %d", got)
+	}
+	if got := countInProgressBuildRuns(nil); got != 0 {
+		t.Fatalf("expected 0 for nil response, got %d", got)
+	}
+}
+
+func TestArtifactPathComponent(t *testing.T) {
+	tests := []struct {
+		name     string
+		fallback string
+		want     string
+	}{
+		{name: "Archive - iOS", fallback: "action-1", want: "Archive - iOS"},
+		{name: "../../etc/passwd", fallback: "artifact-1", want: ".._.._etc_passwd"},
+		{name: "..", fallback: "artifact-2", want: "artifact-2"},
+		{name: "  ", fallback: "artifact-3", want: "artifact-3"},
+	}
+	for _, test := range tests {
+		if got := artifactPathComponent(test.name, test.fallback); got != test.want {
+			t.Fatalf("artifactPathComponent(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func buildRunWithProgress(progress asc.CiBuildRunExecutionProgress) *asc.CiBuildRunResponse {
+	return &asc.CiBuildRunResponse{Data: asc.CiBuildRunResource{ID: "run-1", Attributes: asc.CiBuildRunAttributes{ExecutionProgress: progress}}}
+}
+
+func TestPollBuildRunCompletes(t *testing.T) {
+	calls := 0
+	opts := buildRunWaitOptions{PollInterval: time.Millisecond, RequestTimeout: time.Second, WaitTimeout: time.Second}
+	resp, err := pollBuildRun(context.Background(), "run-1", opts, func(ctx context.Context) (*asc.CiBuildRunResponse, error) {
+		calls++
+		if calls < 3 {
+			return buildRunWithProgress(asc.CiBuildRunExecutionProgressRunning), nil
+		}
+		return buildRunWithProgress(asc.CiBuildRunExecutionProgressComplete), nil
+	})
+	if err != nil {
+		t.Fatalf("pollBuildRun() error: %v", err)
+	}
+	if calls != 3 || resp.Data.Attributes.ExecutionProgress != asc.CiBuildRunExecutionProgressComplete {
+		t.Fatalf("unexpected result after %d calls: %+v", calls, resp.Data.Attributes)
+	}
+}
+
+func TestPollBuildRunRequestTimeout(t *testing.T) {
+	opts := buildRunWaitOptions{PollInterval: time.Millisecond, RequestTimeout: 20 * time.Millisecond, WaitTimeout: time.Hour}
+	_, err := pollBuildRun(context.Background(), "run-1", opts, func(ctx context.Context) (*asc.CiBuildRunResponse, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	if err == nil || !strings.Contains(err.Error(), "timed out after 20ms (--timeout)") {
+		t.Fatalf("expected per-request timeout error, got %v", err)
+	}
+}
+
+func TestPollBuildRunWaitTimeout(t *testing.T) {
+	calls := 0
+	opts := buildRunWaitOptions{PollInterval: 5 * time.Millisecond, RequestTimeout: time.Hour, WaitTimeout: 30 * time.Millisecond}
+	_, err := pollBuildRun(context.Background(), "run-1", opts, func(ctx context.Context) (*asc.CiBuildRunResponse, error) {
+		calls++
+		return buildRunWithProgress(asc.CiBuildRunExecutionProgressRunning), nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "timed out waiting for build run run-1 after 30ms (--wait-timeout; last status: RUNNING)") {
+		t.Fatalf("expected wait timeout error, got %v", err)
+	}
+	if calls < 2 {
+		t.Fatalf("expected polling to continue until the wait budget ran out, got %d calls", calls)
+	}
+}
+
+func TestPollBuildRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	opts := buildRunWaitOptions{PollInterval: time.Hour, RequestTimeout: time.Hour, WaitTimeout: time.Hour}
+	_, err := pollBuildRun(ctx, "run-1", opts, func(ctx context.Context) (*asc.CiBuildRunResponse, error) {
+		cancel()
+		return buildRunWithProgress(asc.CiBuildRunExecutionProgressPending), nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "canceled waiting for build run run-1") {
+		t.Fatalf("expected cancel error, got %v", err)
+	}
+}
+
+func xcodeVersions(versions ...string) *asc.CiXcodeVersionsResponse {
+	resp := &asc.CiXcodeVersionsResponse{}
+	for i, version := range versions {
+		resp.Data = append(resp.Data, asc.CiXcodeVersionResource{
+			ID:         fmt.Sprintf("xcode-%d", i+1),
+			Attributes: asc.CiXcodeVersionAttributes{Version: version, Name: "Xcode " + version},
+		})
+	}
+	return resp
+}
+
+func xcodeVersionIDs(resp *asc.CiXcodeVersionsResponse) []string {
+	ids := make([]string, 0, len(resp.Data))
+	for _, version := range resp.Data {
+		ids = append(ids, version.ID)
+	}
+	return ids
+}
+
+func TestFilterCiXcodeVersionsByName(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "16.", want: []string{"xcode-1", "xcode-2", "xcode-4"}},
+		{query: "BETA", want: []string{"xcode-4"}},
+		{query: "xcode 15", want: []string{"xcode-3"}},
+		{query: "", want: []string{"xcode-1", "xcode-2", "xcode-3", "xcode-4"}},
+		{query: "17", want: []string{}},
+	}
+	for _, test := range tests {
+		resp := xcodeVersions("16.2", "16.0", "15.4", "16.3 beta 2")
+		filterCiXcodeVersionsByName(resp, test.query)
+		if got := xcodeVersionIDs(resp); strings.Join(got, ",") != strings.Join(test.want, ",") {
+			t.Fatalf("filterCiXcodeVersionsByName(%q) = %v, want %v", test.query, got, test.want)
+		}
+	}
+}
+
+func TestSelectLatestCiXcodeVersion(t *testing.T) {
+	resp := xcodeVersions("16.2", "16.10", "15.4", "16.9.1")
+	selectLatestCiXcodeVersion(resp)
+	if got := xcodeVersionIDs(resp); len(got) != 1 || got[0] != "xcode-2" {
+		t.Fatalf("expected 16.10 to be latest, got %v", got)
+	}
+
+	empty := xcodeVersions()
+	selectLatestCiXcodeVersion(empty)
+	if len(empty.Data) != 0 {
+		t.Fatalf("expected empty response to stay empty, got %v", empty.Data)
+	}
+}
+
+func TestApplyXcodeVersionFilterCombinesNameAndLatest(t *testing.T) {
+	resp := xcodeVersions("16.2", "15.4", "16.3", "15.10")
+	applyXcodeVersionFilter(resp, xcodeVersionFilter{Name: "15.", Latest: true})
+	if got := xcodeVersionIDs(resp); len(got) != 1 || got[0] != "xcode-4" {
+		t.Fatalf("expected latest 15.x version, got %v", got)
+	}
+}
+
+func TestNormalizeCiBuildActionInclude(t *testing.T) {
+	values, err := normalizeCiBuildActionInclude("artifacts, testResults")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(values) != 2 || values[0] != "artifacts" || values[1] != "testResults" {
+		t.Fatalf("unexpected include values: %v", values)
+	}
+
+	values, err = normalizeCiBuildActionInclude("")
+	if err != nil || values != nil {
+		t.Fatalf("expected empty include, got %v (err %v)", values, err)
+	}
+
+	if _, err := normalizeCiBuildActionInclude("artifacts,logs"); err == nil || !strings.Contains(err.Error(), "--include must be one of") {
+		t.Fatalf("expected include validation error, got %v", err)
+	}
+}
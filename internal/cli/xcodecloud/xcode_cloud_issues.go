@@ -4,7 +4,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
+	"slices"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -24,12 +24,14 @@ func XcodeCloudIssuesCommand() *ffcli.Command {
 
 Examples:
   asc xcode-cloud issues list --action-id "ACTION_ID"
-  asc xcode-cloud issues get --id "ISSUE_ID"`,
+  asc xcode-cloud issues get --id "ISSUE_ID"
+  asc xcode-cloud issues summary --run-id "BUILD_RUN_ID" --junit --out issues.xml`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			XcodeCloudIssuesListCommand(),
 			XcodeCloudIssuesGetCommand(),
+			XcodeCloudIssuesSummaryCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -45,8 +47,10 @@ func XcodeCloudIssuesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	severity := fs.String("severity", "", "Only show issues of these types (comma-separated: "+strings.Join(issueSeverityValues, ", ")+")")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	registerFlagValues(fs, "severity", issueSeverityValues...)
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -54,16 +58,20 @@ func XcodeCloudIssuesListCommand() *ffcli.Command {
 		ShortHelp:  "List issues for a build action.",
 		LongHelp: `List issues for a build action.
 
+--severity keeps only issues whose type is listed. Filtering happens after
+fetching, so combine it with --paginate to filter across every page.
+
 Examples:
   asc xcode-cloud issues list --action-id "ACTION_ID"
   asc xcode-cloud issues list --action-id "ACTION_ID" --output table
   asc xcode-cloud issues list --action-id "ACTION_ID" --limit 50
-  asc xcode-cloud issues list --action-id "ACTION_ID" --paginate`,
+  asc xcode-cloud issues list --action-id "ACTION_ID" --paginate
+  asc xcode-cloud issues list --action-id "ACTION_ID" --paginate --severity ERROR`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud issues list: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud issues list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud issues list: %w", err)
@@ -71,8 +79,11 @@ Examples:
 
 			resolvedActionID := strings.TrimSpace(*actionID)
 			if resolvedActionID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --action-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--action-id is required")
+			}
+			severities, err := parseIssueSeverities(*severity)
+			if err != nil {
+				return flagErrorf("%v", err)
 			}
 
 			client, err := getASCClient()
@@ -101,6 +112,9 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("xcode-cloud issues list: %w", err)
 				}
+				if issues, ok := resp.(*asc.CiIssuesResponse); ok {
+					filterIssuesBySeverity(issues, severities)
+				}
 
 				return printOutput(resp, *output, *pretty)
 			}
@@ -109,18 +123,54 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("xcode-cloud issues list: %w", err)
 			}
+			filterIssuesBySeverity(resp, severities)
 
 			return printOutput(resp, *output, *pretty)
 		},
 	}
 }
 
+var issueSeverityValues = []string{"ERROR", "WARNING", "ANALYZER_WARNING", "TEST_FAILURE"}
+
+// parseIssueSeverities returns the issue types selected by --severity, or nil
+// when the flag is empty.
+func parseIssueSeverities(value string) (map[string]bool, error) {
+	items := splitCSV(value)
+	if len(items) == 0 {
+		return nil, nil
+	}
+	severities := make(map[string]bool, len(items))
+	for _, item := range items {
+		normalized := strings.ToUpper(item)
+		if !slices.Contains(issueSeverityValues, normalized) {
+			return nil, usageErrorf("--severity must be a comma-separated list of: %s", strings.Join(issueSeverityValues, ", "))
+		}
+		severities[normalized] = true
+	}
+	return severities, nil
+}
+
+// filterIssuesBySeverity drops issues whose type is not in severities. A nil
+// set keeps every issue.
+func filterIssuesBySeverity(resp *asc.CiIssuesResponse, severities map[string]bool) {
+	if resp == nil || severities == nil {
+		return
+	}
+	filtered := make([]asc.CiIssueResource, 0, len(resp.Data))
+	for _, issue := range resp.Data {
+		if severities[strings.ToUpper(issue.Attributes.IssueType)] {
+			filtered = append(filtered, issue)
+		}
+	}
+	resp.Data = filtered
+}
+
 // XcodeCloudIssuesGetCommand returns the xcode-cloud issues get subcommand.
 func XcodeCloudIssuesGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Issue ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -137,8 +187,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
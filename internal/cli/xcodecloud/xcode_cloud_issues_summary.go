@@ -0,0 +1,335 @@
+package xcodecloud
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// XcodeCloudIssuesSummaryCommand returns the xcode-cloud issues summary subcommand.
+func XcodeCloudIssuesSummaryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summary", flag.ExitOnError)
+
+	runID := fs.String("run-id", "", "Build run ID to summarize issues for")
+	junit := fs.Bool("junit", false, "Write a JUnit XML report of the issues (requires --out)")
+	out := fs.String("out", "", "Output path for the JUnit XML report")
+	failOn := fs.String("fail-on", "", "Exit non-zero when issues of these kinds exist (comma-separated: "+strings.Join(issueFailOnValues, ", ")+")")
+	allowlist := fs.String("allowlist", "", "File of known-acceptable issue messages to ignore with --fail-on (one per line)")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	registerFlagValues(fs, "fail-on", issueFailOnValues...)
+
+	return &ffcli.Command{
+		Name:       "summary",
+		ShortUsage: "asc xcode-cloud issues summary --run-id \"BUILD_RUN_ID\" [flags]",
+		ShortHelp:  "Summarize issues across all actions of a build run.",
+		LongHelp: `Summarize issues across all actions of a build run.
+
+Issues are fetched for every action in the build run and counted by type
+(errors, warnings, analyzer warnings, test failures).
+
+With --junit, a JUnit XML report is also written to --out: each action becomes
+a testsuite and each issue a failed testcase, so build errors and warnings
+show up in CI dashboards alongside test results.
+
+With --fail-on, the command exits non-zero when any issue of the selected
+kinds exists across the run's actions, and the offending issues are listed on
+stderr. Issues whose message contains a line of the --allowlist file are
+ignored by the gate (blank lines and lines starting with # are skipped); the
+summary counts are not affected.
+
+Examples:
+  asc xcode-cloud issues summary --run-id "BUILD_RUN_ID"
+  asc xcode-cloud issues summary --run-id "BUILD_RUN_ID" --output table
+  asc xcode-cloud issues summary --run-id "BUILD_RUN_ID" --junit --out issues.xml
+  asc xcode-cloud issues summary --run-id "BUILD_RUN_ID" --fail-on errors,warnings --allowlist known-warnings.txt`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			runIDValue := strings.TrimSpace(*runID)
+			if runIDValue == "" {
+				return flagErrorf("--run-id is required")
+			}
+			outPath := strings.TrimSpace(*out)
+			if *junit && outPath == "" {
+				return flagErrorf("--out is required with --junit")
+			}
+			if !*junit && outPath != "" {
+				return flagErrorf("--out requires --junit")
+			}
+			failOnTypes, err := parseIssueFailOn(*failOn)
+			if err != nil {
+				return flagErrorf("%v", err)
+			}
+			allowlistPath := strings.TrimSpace(*allowlist)
+			if allowlistPath != "" && len(failOnTypes) == 0 {
+				return flagErrorf("--allowlist requires --fail-on")
+			}
+			var allowed []string
+			if allowlistPath != "" {
+				allowed, err = readIssueAllowlist(allowlistPath)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud issues summary: %w", err)
+				}
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud issues summary: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			summary, err := fetchCiIssuesSummary(requestCtx, client, runIDValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud issues summary: %w", err)
+			}
+
+			if *junit {
+				if err := shared.WriteJUnitReport(outPath, buildIssuesJUnitReport(summary)); err != nil {
+					return fmt.Errorf("xcode-cloud issues summary: write junit report: %w", err)
+				}
+			}
+
+			if err := printOutput(summary, *output, *pretty); err != nil {
+				return err
+			}
+
+			if len(failOnTypes) == 0 {
+				return nil
+			}
+			failing := failingIssues(summary, failOnTypes, allowed)
+			if len(failing) == 0 {
+				return nil
+			}
+			for _, issue := range failing {
+				fmt.Fprintf(os.Stderr, "%s\n", issue)
+			}
+			return fmt.Errorf("xcode-cloud issues summary: %d issue(s) matched --fail-on %s", len(failing), strings.TrimSpace(*failOn))
+		},
+	}
+}
+
+var issueFailOnValues = []string{"errors", "warnings", "analyzerWarnings", "testFailures"}
+
+// issueFailOnTypes maps --fail-on values to CI issue types.
+var issueFailOnTypes = map[string]string{
+	"errors":           "ERROR",
+	"warnings":         "WARNING",
+	"analyzerWarnings": "ANALYZER_WARNING",
+	"testFailures":     "TEST_FAILURE",
+}
+
+// parseIssueFailOn returns the issue types selected by --fail-on.
+func parseIssueFailOn(value string) (map[string]bool, error) {
+	types := make(map[string]bool)
+	for _, item := range splitCSV(value) {
+		issueType := ""
+		for name, mapped := range issueFailOnTypes {
+			if strings.EqualFold(item, name) {
+				issueType = mapped
+				break
+			}
+		}
+		if issueType == "" {
+			return nil, usageErrorf("--fail-on must be a comma-separated list of: %s", strings.Join(issueFailOnValues, ", "))
+		}
+		types[issueType] = true
+	}
+	return types, nil
+}
+
+// readIssueAllowlist reads known-acceptable issue messages, one per line.
+// Blank lines and lines starting with # are ignored.
+func readIssueAllowlist(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read --allowlist: %w", err)
+	}
+	var entries []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	return entries, nil
+}
+
+// failingIssue is an issue that trips the --fail-on gate.
+type failingIssue struct {
+	action string
+	issue  asc.CiIssueResource
+}
+
+func (f failingIssue) String() string {
+	location := ""
+	if source := f.issue.Attributes.FileSource; source != nil && source.Path != "" {
+		location = " (" + source.Path
+		if source.LineNumber > 0 {
+			location += fmt.Sprintf(":%d", source.LineNumber)
+		}
+		location += ")"
+	}
+	return fmt.Sprintf("%s [%s] %s%s", f.action, f.issue.Attributes.IssueType, compactIssueMessage(f.issue.Attributes.Message), location)
+}
+
+func compactIssueMessage(message string) string {
+	return strings.Join(strings.Fields(message), " ")
+}
+
+// failingIssues returns the issues of the selected types whose messages do
+// not contain any allowlist entry.
+func failingIssues(summary *asc.CiIssuesSummaryResult, types map[string]bool, allowlist []string) []failingIssue {
+	var failing []failingIssue
+	for _, action := range summary.Actions {
+		name := strings.TrimSpace(action.Name)
+		if name == "" {
+			name = action.ActionID
+		}
+		for _, issue := range action.Issues {
+			if !types[strings.ToUpper(issue.Attributes.IssueType)] || issueAllowed(issue.Attributes.Message, allowlist) {
+				continue
+			}
+			failing = append(failing, failingIssue{action: name, issue: issue})
+		}
+	}
+	return failing
+}
+
+func issueAllowed(message string, allowlist []string) bool {
+	for _, entry := range allowlist {
+		if strings.Contains(message, entry) {
+			return true
+		}
+	}
+	return false
+}
+
+func fetchCiIssuesSummary(ctx context.Context, client *asc.Client, buildRunID string) (*asc.CiIssuesSummaryResult, error) {
+	firstActions, err := client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch actions: %w", err)
+	}
+	allActions, err := asc.PaginateAll(ctx, firstActions, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch actions: %w", err)
+	}
+	actions, ok := allActions.(*asc.CiBuildActionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected actions response type %T", allActions)
+	}
+
+	summary := &asc.CiIssuesSummaryResult{
+		BuildRunID: buildRunID,
+		Actions:    make([]asc.CiActionIssuesSummary, 0, len(actions.Data)),
+	}
+	for _, action := range actions.Data {
+		firstIssues, err := client.GetCiBuildActionIssues(ctx, action.ID, asc.WithCiIssuesLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch issues for action %s: %w", action.ID, err)
+		}
+		allIssues, err := asc.PaginateAll(ctx, firstIssues, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetCiBuildActionIssues(ctx, action.ID, asc.WithCiIssuesNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch issues for action %s: %w", action.ID, err)
+		}
+		issues, ok := allIssues.(*asc.CiIssuesResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected issues response type %T", allIssues)
+		}
+
+		summary.Actions = append(summary.Actions, summarizeActionIssues(action, issues.Data))
+	}
+
+	for _, action := range summary.Actions {
+		summary.Totals.Errors += action.Counts.Errors
+		summary.Totals.Warnings += action.Counts.Warnings
+		summary.Totals.AnalyzerWarnings += action.Counts.AnalyzerWarnings
+		summary.Totals.TestFailures += action.Counts.TestFailures
+	}
+	return summary, nil
+}
+
+func summarizeActionIssues(action asc.CiBuildActionResource, issues []asc.CiIssueResource) asc.CiActionIssuesSummary {
+	result := asc.CiActionIssuesSummary{
+		ActionID:   action.ID,
+		Name:       action.Attributes.Name,
+		ActionType: action.Attributes.ActionType,
+		Issues:     issues,
+	}
+	for _, issue := range issues {
+		switch strings.ToUpper(issue.Attributes.IssueType) {
+		case "ERROR":
+			result.Counts.Errors++
+		case "WARNING":
+			result.Counts.Warnings++
+		case "ANALYZER_WARNING":
+			result.Counts.AnalyzerWarnings++
+		case "TEST_FAILURE":
+			result.Counts.TestFailures++
+		}
+	}
+	return result
+}
+
+// buildIssuesJUnitReport maps each action to a testsuite and each issue to a
+// failed testcase.
+func buildIssuesJUnitReport(summary *asc.CiIssuesSummaryResult) shared.JUnitTestSuites {
+	report := shared.JUnitTestSuites{
+		Name:   "Xcode Cloud issues " + summary.BuildRunID,
+		Suites: make([]shared.JUnitTestSuite, 0, len(summary.Actions)),
+	}
+	for _, action := range summary.Actions {
+		suiteName := strings.TrimSpace(action.Name)
+		if suiteName == "" {
+			suiteName = action.ActionID
+		}
+		suite := shared.JUnitTestSuite{Name: suiteName}
+		for _, issue := range action.Issues {
+			testCase := shared.JUnitTestCase{
+				Name:      issueTestCaseName(issue),
+				ClassName: suiteName,
+				Failure: &shared.JUnitFailure{
+					Message: issue.Attributes.Message,
+					Type:    issue.Attributes.IssueType,
+					Body:    issue.Attributes.Message,
+				},
+			}
+			if issue.Attributes.FileSource != nil {
+				testCase.File = issue.Attributes.FileSource.Path
+				testCase.Line = issue.Attributes.FileSource.LineNumber
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+		report.Suites = append(report.Suites, suite)
+	}
+	return report
+}
+
+func issueTestCaseName(issue asc.CiIssueResource) string {
+	name := issue.Attributes.IssueType
+	if category := strings.TrimSpace(issue.Attributes.Category); category != "" {
+		name += " (" + category + ")"
+	}
+	if source := issue.Attributes.FileSource; source != nil && source.Path != "" {
+		if source.LineNumber > 0 {
+			return fmt.Sprintf("%s %s:%d", name, source.Path, source.LineNumber)
+		}
+		return name + " " + source.Path
+	}
+	return name + " " + issue.ID
+}
@@ -0,0 +1,130 @@
+package xcodecloud
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func TestSummarizeActionIssues(t *testing.T) {
+	action := asc.CiBuildActionResource{ID: "action-1", Attributes: asc.CiBuildActionAttributes{Name: "Build - iOS", ActionType: "BUILD"}}
+	issues := []asc.CiIssueResource{
+		{ID: "issue-1", Attributes: asc.CiIssueAttributes{IssueType: "ERROR"}},
+		{ID: "issue-2", Attributes: asc.CiIssueAttributes{IssueType: "WARNING"}},
+		{ID: "issue-3", Attributes: asc.CiIssueAttributes{IssueType: "WARNING"}},
+		{ID: "issue-4", Attributes: asc.CiIssueAttributes{IssueType: "ANALYZER_WARNING"}},
+	}
+
+	summary := summarizeActionIssues(action, issues)
+	want := asc.CiIssueCounts{Errors: 1, Warnings: 2, AnalyzerWarnings: 1}
+	if summary.Counts != want {
+		t.Fatalf("expected counts %+v, got %+v", want, summary.Counts)
+	}
+}
+
+func TestIssuesJUnitReportGolden(t *testing.T) {
+	summary := &asc.CiIssuesSummaryResult{
+		BuildRunID: "run-1",
+		Actions: []asc.CiActionIssuesSummary{
+			summarizeActionIssues(
+				asc.CiBuildActionResource{ID: "action-1", Attributes: asc.CiBuildActionAttributes{Name: "Build - iOS", ActionType: "BUILD"}},
+				[]asc.CiIssueResource{
+					{ID: "issue-1", Attributes: asc.CiIssueAttributes{
+						IssueType:  "ERROR",
+						Message:    "Use of undeclared identifier 'foo'",
+						FileSource: &asc.FileLocation{Path: "App/ContentView.swift", LineNumber: 42},
+					}},
+					{ID: "issue-2", Attributes: asc.CiIssueAttributes{
+						IssueType: "WARNING",
+						Category:  "Deprecation",
+						Message:   "'UIWebView' is deprecated",
+					}},
+				},
+			),
+			summarizeActionIssues(
+				asc.CiBuildActionResource{ID: "action-2", Attributes: asc.CiBuildActionAttributes{Name: "Analyze - iOS", ActionType: "ANALYZE"}},
+				nil,
+			),
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "issues.xml")
+	if err := shared.WriteJUnitReport(path, buildIssuesJUnitReport(summary)); err != nil {
+		t.Fatalf("WriteJUnitReport() error: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read report: %v", err)
+	}
+	want, err := os.ReadFile(filepath.Join("testdata", "issues_summary.junit.xml"))
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Fatalf("junit report mismatch\n--- got ---\n%s\n--- want ---\n%s", got, want)
+	}
+}
+
+func TestParseIssueFailOn(t *testing.T) {
+	types, err := parseIssueFailOn("errors, testFailures")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(types) != 2 || !types["ERROR"] || !types["TEST_FAILURE"] {
+		t.Fatalf("unexpected types: %+v", types)
+	}
+
+	if _, err := parseIssueFailOn("errors,notes"); err == nil {
+		t.Fatal("expected error for invalid value")
+	}
+}
+
+func TestFailingIssuesAppliesTypesAndAllowlist(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "allowlist.txt")
+	content := "# known warnings\n\nwas deprecated in iOS 17\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write allowlist: %v", err)
+	}
+	allowlist, err := readIssueAllowlist(path)
+	if err != nil {
+		t.Fatalf("readIssueAllowlist() error: %v", err)
+	}
+	if len(allowlist) != 1 {
+		t.Fatalf("expected 1 allowlist entry, got %v", allowlist)
+	}
+
+	summary := &asc.CiIssuesSummaryResult{
+		Actions: []asc.CiActionIssuesSummary{
+			summarizeActionIssues(
+				asc.CiBuildActionResource{ID: "action-1", Attributes: asc.CiBuildActionAttributes{Name: "Build - iOS"}},
+				[]asc.CiIssueResource{
+					{ID: "issue-1", Attributes: asc.CiIssueAttributes{IssueType: "WARNING", Message: "'foo' was deprecated in iOS 17"}},
+					{ID: "issue-2", Attributes: asc.CiIssueAttributes{
+						IssueType:  "WARNING",
+						Message:    "Unused variable 'bar'",
+						FileSource: &asc.FileLocation{Path: "App/Model.swift", LineNumber: 7},
+					}},
+					{ID: "issue-3", Attributes: asc.CiIssueAttributes{IssueType: "ERROR", Message: "Build failed"}},
+				},
+			),
+		},
+	}
+
+	failing := failingIssues(summary, map[string]bool{"WARNING": true}, allowlist)
+	if len(failing) != 1 {
+		t.Fatalf("expected 1 failing issue, got %d", len(failing))
+	}
+	want := "Build - iOS [WARNING] Unused variable 'bar' (App/Model.swift:7)"
+	if got := failing[0].String(); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	if failing := failingIssues(summary, map[string]bool{"TEST_FAILURE": true}, nil); len(failing) != 0 {
+		t.Fatalf("expected no failing issues, got %d", len(failing))
+	}
+}
@@ -0,0 +1,46 @@
+package xcodecloud
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseIssueSeverities(t *testing.T) {
+	severities, err := parseIssueSeverities("error, warning,ERROR")
+	if err != nil {
+		t.Fatalf("parseIssueSeverities() error: %v", err)
+	}
+	if len(severities) != 2 || !severities["ERROR"] || !severities["WARNING"] {
+		t.Fatalf("unexpected severities %v", severities)
+	}
+
+	if severities, err := parseIssueSeverities(""); err != nil || severities != nil {
+		t.Fatalf("expected no filter for empty value, got %v, %v", severities, err)
+	}
+	if _, err := parseIssueSeverities("ERROR,FATAL"); err == nil {
+		t.Fatal("expected error for unknown severity")
+	}
+}
+
+func TestFilterIssuesBySeverity(t *testing.T) {
+	newResponse := func() *asc.CiIssuesResponse {
+		return &asc.CiIssuesResponse{Data: []asc.CiIssueResource{
+			{ID: "issue-1", Attributes: asc.CiIssueAttributes{IssueType: "ERROR"}},
+			{ID: "issue-2", Attributes: asc.CiIssueAttributes{IssueType: "WARNING"}},
+			{ID: "issue-3", Attributes: asc.CiIssueAttributes{IssueType: "error"}},
+		}}
+	}
+
+	resp := newResponse()
+	filterIssuesBySeverity(resp, map[string]bool{"ERROR": true})
+	if len(resp.Data) != 2 || resp.Data[0].ID != "issue-1" || resp.Data[1].ID != "issue-3" {
+		t.Fatalf("unexpected filtered issues %+v", resp.Data)
+	}
+
+	resp = newResponse()
+	filterIssuesBySeverity(resp, nil)
+	if len(resp.Data) != 3 {
+		t.Fatalf("expected all issues without a filter, got %d", len(resp.Data))
+	}
+}
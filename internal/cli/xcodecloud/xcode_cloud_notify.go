@@ -0,0 +1,165 @@
+package xcodecloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	notifyOnAlways  = "always"
+	notifyOnSuccess = "success"
+	notifyOnFailure = "failure"
+
+	notifyAttempts       = 3
+	notifyRequestTimeout = 10 * time.Second
+)
+
+var notifyOnValues = []string{notifyOnAlways, notifyOnSuccess, notifyOnFailure}
+
+// notifyRetryDelay is the base delay between webhook attempts; it doubles
+// after each failure.
+var notifyRetryDelay = time.Second
+
+// buildCompletionNotification is the JSON payload POSTed to --notify-url.
+type buildCompletionNotification struct {
+	BuildRunID       string  `json:"buildRunId"`
+	BuildNumber      int     `json:"buildNumber,omitempty"`
+	WorkflowID       string  `json:"workflowId,omitempty"`
+	CompletionStatus string  `json:"completionStatus"`
+	Succeeded        bool    `json:"succeeded"`
+	StartedDate      string  `json:"startedDate,omitempty"`
+	FinishedDate     string  `json:"finishedDate,omitempty"`
+	DurationSeconds  float64 `json:"durationSeconds,omitempty"`
+}
+
+func validateNotifyURL(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return usageErrorf("--notify-url must be an absolute http(s) URL")
+	}
+	return nil
+}
+
+func normalizeNotifyOn(value string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	for _, allowed := range notifyOnValues {
+		if normalized == allowed {
+			return normalized, nil
+		}
+	}
+	return "", usageErrorf("--notify-on must be one of: %s", strings.Join(notifyOnValues, ", "))
+}
+
+func shouldNotify(notifyOn string, status asc.CiBuildRunCompletionStatus) bool {
+	switch notifyOn {
+	case notifyOnSuccess:
+		return asc.IsBuildRunSuccessful(status)
+	case notifyOnFailure:
+		return !asc.IsBuildRunSuccessful(status)
+	default:
+		return true
+	}
+}
+
+func newBuildCompletionNotification(resp *asc.CiBuildRunResponse) buildCompletionNotification {
+	attrs := resp.Data.Attributes
+	notification := buildCompletionNotification{
+		BuildRunID:       resp.Data.ID,
+		BuildNumber:      attrs.Number,
+		CompletionStatus: string(attrs.CompletionStatus),
+		Succeeded:        asc.IsBuildRunSuccessful(attrs.CompletionStatus),
+		StartedDate:      attrs.StartedDate,
+		FinishedDate:     attrs.FinishedDate,
+	}
+	if resp.Data.Relationships != nil && resp.Data.Relationships.Workflow != nil {
+		notification.WorkflowID = resp.Data.Relationships.Workflow.Data.ID
+	}
+	started, startErr := shared.ParseTimestamp(attrs.StartedDate)
+	finished, finishErr := shared.ParseTimestamp(attrs.FinishedDate)
+	if startErr == nil && finishErr == nil && !finished.Before(started) {
+		notification.DurationSeconds = finished.Sub(started).Seconds()
+	}
+	return notification
+}
+
+// notifyBuildCompletion POSTs the build outcome to notifyURL when notifyOn
+// matches the completion status. Delivery failures are reported as warnings
+// on stderr and never fail the command.
+func notifyBuildCompletion(ctx context.Context, notifyURL, notifyOn string, resp *asc.CiBuildRunResponse) {
+	if !shouldNotify(notifyOn, resp.Data.Attributes.CompletionStatus) {
+		return
+	}
+	if err := postBuildNotification(ctx, http.DefaultClient, notifyURL, newBuildCompletionNotification(resp)); err != nil {
+		shared.Warnf("build completion notification was not delivered: %v", err)
+	}
+}
+
+func postBuildNotification(ctx context.Context, httpClient *http.Client, notifyURL string, notification buildCompletionNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	delay := notifyRetryDelay
+	var lastErr error
+	for attempt := 1; attempt <= notifyAttempts; attempt++ {
+		retryable, err := postBuildNotificationOnce(ctx, httpClient, notifyURL, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retryable || attempt == notifyAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return lastErr
+}
+
+// postBuildNotificationOnce sends a single webhook request and reports
+// whether a failure is worth retrying.
+func postBuildNotificationOnce(ctx context.Context, httpClient *http.Client, notifyURL string, body []byte) (bool, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, notifyRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, notifyURL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// Drop the URL from the error: webhook URLs often embed secrets.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retryable, fmt.Errorf("webhook responded with HTTP %d", resp.StatusCode)
+}
@@ -0,0 +1,102 @@
+package xcodecloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestShouldNotify(t *testing.T) {
+	tests := []struct {
+		notifyOn string
+		status   asc.CiBuildRunCompletionStatus
+		want     bool
+	}{
+		{notifyOnAlways, asc.CiBuildRunCompletionStatusSucceeded, true},
+		{notifyOnAlways, asc.CiBuildRunCompletionStatusFailed, true},
+		{notifyOnSuccess, asc.CiBuildRunCompletionStatusSucceeded, true},
+		{notifyOnSuccess, asc.CiBuildRunCompletionStatusFailed, false},
+		{notifyOnFailure, asc.CiBuildRunCompletionStatusErrored, true},
+		{notifyOnFailure, asc.CiBuildRunCompletionStatusSucceeded, false},
+	}
+	for _, test := range tests {
+		if got := shouldNotify(test.notifyOn, test.status); got != test.want {
+			t.Fatalf("shouldNotify(%q, %q) = %t, want %t", test.notifyOn, test.status, got, test.want)
+		}
+	}
+}
+
+func TestNewBuildCompletionNotificationDuration(t *testing.T) {
+	resp := &asc.CiBuildRunResponse{}
+	resp.Data.ID = "run-1"
+	resp.Data.Attributes.CompletionStatus = asc.CiBuildRunCompletionStatusSucceeded
+	resp.Data.Attributes.StartedDate = "2026-03-01T10:00:00Z"
+	resp.Data.Attributes.FinishedDate = "2026-03-01T10:12:30Z"
+
+	notification := newBuildCompletionNotification(resp)
+	if notification.BuildRunID != "run-1" || !notification.Succeeded {
+		t.Fatalf("unexpected notification: %+v", notification)
+	}
+	if notification.DurationSeconds != 750 {
+		t.Fatalf("expected 750s duration, got %v", notification.DurationSeconds)
+	}
+}
+
+func TestPostBuildNotificationRetriesTransientFailures(t *testing.T) {
+	previousDelay := notifyRetryDelay
+	notifyRetryDelay = 0
+	t.Cleanup(func() { notifyRetryDelay = previousDelay })
+
+	var attempts int32
+	var received buildCompletionNotification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected JSON content type, got %q", r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decode payload: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := postBuildNotification(context.Background(), server.Client(), server.URL, buildCompletionNotification{
+		BuildRunID:       "run-1",
+		CompletionStatus: "FAILED",
+	})
+	if err != nil {
+		t.Fatalf("postBuildNotification() error: %v", err)
+	}
+	if atomic.LoadInt32(&attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+	if received.BuildRunID != "run-1" || received.CompletionStatus != "FAILED" {
+		t.Fatalf("unexpected payload: %+v", received)
+	}
+}
+
+func TestPostBuildNotificationDoesNotRetryClientErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	err := postBuildNotification(context.Background(), server.Client(), server.URL, buildCompletionNotification{BuildRunID: "run-1"})
+	if err == nil {
+		t.Fatal("expected error for HTTP 400")
+	}
+	if atomic.LoadInt32(&attempts) != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts)
+	}
+}
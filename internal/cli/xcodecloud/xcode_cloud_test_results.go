@@ -4,7 +4,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
+	"sort"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -24,12 +24,14 @@ func XcodeCloudTestResultsCommand() *ffcli.Command {
 
 Examples:
   asc xcode-cloud test-results list --action-id "ACTION_ID"
-  asc xcode-cloud test-results get --id "TEST_RESULT_ID"`,
+  asc xcode-cloud test-results get --id "TEST_RESULT_ID"
+  asc xcode-cloud test-results summary --action-id "ACTION_ID" --flaky-only`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			XcodeCloudTestResultsListCommand(),
 			XcodeCloudTestResultsGetCommand(),
+			XcodeCloudTestResultsSummaryCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -45,9 +47,12 @@ func XcodeCloudTestResultsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	sortValue := fs.String("sort", "", "Sort by: "+strings.Join(testResultSortValues, ", "))
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	registerFlagValues(fs, "sort", testResultSortValues...)
+
 	return &ffcli.Command{
 		Name:       "list",
 		ShortUsage: "asc xcode-cloud test-results list [flags]",
@@ -58,21 +63,25 @@ Examples:
   asc xcode-cloud test-results list --action-id "ACTION_ID"
   asc xcode-cloud test-results list --action-id "ACTION_ID" --output table
   asc xcode-cloud test-results list --action-id "ACTION_ID" --limit 50
-  asc xcode-cloud test-results list --action-id "ACTION_ID" --paginate`,
+  asc xcode-cloud test-results list --action-id "ACTION_ID" --paginate
+  asc xcode-cloud test-results list --action-id "ACTION_ID" --paginate --sort status`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
-				return fmt.Errorf("xcode-cloud test-results list: --limit must be between 1 and 200")
+				return usageErrorf("xcode-cloud test-results list: --limit must be between 1 and 200")
 			}
 			if err := validateNextURL(*next); err != nil {
 				return fmt.Errorf("xcode-cloud test-results list: %w", err)
 			}
+			sortField := strings.TrimSpace(*sortValue)
+			if err := validateSort(sortField, testResultSortValues...); err != nil {
+				return fmt.Errorf("xcode-cloud test-results list: %w", err)
+			}
 
 			resolvedActionID := strings.TrimSpace(*actionID)
 			if resolvedActionID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --action-id is required")
-				return flag.ErrHelp
+				return flagErrorf("--action-id is required")
 			}
 
 			client, err := getASCClient()
@@ -101,6 +110,9 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("xcode-cloud test-results list: %w", err)
 				}
+				if results, ok := resp.(*asc.CiTestResultsResponse); ok {
+					sortTestResults(results.Data, sortField)
+				}
 
 				return printOutput(resp, *output, *pretty)
 			}
@@ -109,6 +121,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("xcode-cloud test-results list: %w", err)
 			}
+			sortTestResults(resp.Data, sortField)
 
 			return printOutput(resp, *output, *pretty)
 		},
@@ -120,7 +133,7 @@ func XcodeCloudTestResultsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Test result ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -137,8 +150,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -158,3 +170,195 @@ Examples:
 		},
 	}
 }
+
+// XcodeCloudTestResultsSummaryCommand returns the xcode-cloud test-results summary subcommand.
+func XcodeCloudTestResultsSummaryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summary", flag.ExitOnError)
+
+	actionID := fs.String("action-id", "", "Build action ID to summarize test results for")
+	flakyOnly := fs.Bool("flaky-only", false, "Only list tests that both passed and failed")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "summary",
+		ShortUsage: "asc xcode-cloud test-results summary --action-id \"ACTION_ID\" [flags]",
+		ShortHelp:  "Summarize test results for a build action.",
+		LongHelp: `Summarize test results for a build action.
+
+Fetches every test result for the action and aggregates them by test
+identifier (class name and test name). A test is reported as flaky when it
+both passed and failed within the action, either across retries, across
+destinations, or because Xcode Cloud marked it MIXED.
+
+Examples:
+  asc xcode-cloud test-results summary --action-id "ACTION_ID"
+  asc xcode-cloud test-results summary --action-id "ACTION_ID" --output table
+  asc xcode-cloud test-results summary --action-id "ACTION_ID" --flaky-only`,
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			actionIDValue := strings.TrimSpace(*actionID)
+			if actionIDValue == "" {
+				return flagErrorf("--action-id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud test-results summary: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			firstPage, err := client.GetCiBuildActionTestResults(requestCtx, actionIDValue, asc.WithCiTestResultsLimit(200))
+			if err != nil {
+				return fmt.Errorf("xcode-cloud test-results summary: failed to fetch: %w", err)
+			}
+			all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetCiBuildActionTestResults(ctx, actionIDValue, asc.WithCiTestResultsNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("xcode-cloud test-results summary: %w", err)
+			}
+			results, ok := all.(*asc.CiTestResultsResponse)
+			if !ok {
+				return fmt.Errorf("xcode-cloud test-results summary: unexpected response type %T", all)
+			}
+
+			return printOutput(summarizeTestResults(actionIDValue, results.Data, *flakyOnly), *output, *pretty)
+		},
+	}
+}
+
+var testResultSortValues = []string{"name", "-name", "status"}
+
+// testResultStatusRank orders statuses so failures sort ahead of passes.
+var testResultStatusRank = map[asc.CiTestStatus]int{
+	asc.CiTestStatusFailure:         0,
+	asc.CiTestStatusMixed:           1,
+	asc.CiTestStatusExpectedFailure: 2,
+	asc.CiTestStatusSkipped:         3,
+	asc.CiTestStatusSuccess:         4,
+}
+
+func sortTestResults(results []asc.CiTestResultResource, sortField string) {
+	switch sortField {
+	case "name":
+		sort.SliceStable(results, func(i, j int) bool {
+			return testResultIdentifier(results[i]) < testResultIdentifier(results[j])
+		})
+	case "-name":
+		sort.SliceStable(results, func(i, j int) bool {
+			return testResultIdentifier(results[i]) > testResultIdentifier(results[j])
+		})
+	case "status":
+		sort.SliceStable(results, func(i, j int) bool {
+			left, right := testResultStatusOrder(results[i].Attributes.Status), testResultStatusOrder(results[j].Attributes.Status)
+			if left != right {
+				return left < right
+			}
+			return testResultIdentifier(results[i]) < testResultIdentifier(results[j])
+		})
+	}
+}
+
+func testResultStatusOrder(status asc.CiTestStatus) int {
+	if rank, ok := testResultStatusRank[status]; ok {
+		return rank
+	}
+	return len(testResultStatusRank)
+}
+
+func testResultIdentifier(result asc.CiTestResultResource) string {
+	className := strings.TrimSpace(result.Attributes.ClassName)
+	name := strings.TrimSpace(result.Attributes.Name)
+	switch {
+	case className == "":
+		return name
+	case name == "":
+		return className
+	default:
+		return className + "/" + name
+	}
+}
+
+// summarizeTestResults aggregates results by test identifier. Each
+// destination result counts as a separate attempt; results without
+// destination data fall back to their overall status. A test that recorded
+// at least one pass and one failure is flaky.
+func summarizeTestResults(actionID string, results []asc.CiTestResultResource, flakyOnly bool) *asc.CiTestResultsSummaryResult {
+	byIdentifier := make(map[string]*asc.CiTestSummaryItem)
+	order := make([]string, 0)
+	for _, result := range results {
+		identifier := testResultIdentifier(result)
+		if identifier == "" {
+			identifier = result.ID
+		}
+		item, ok := byIdentifier[identifier]
+		if !ok {
+			item = &asc.CiTestSummaryItem{
+				Identifier: identifier,
+				ClassName:  result.Attributes.ClassName,
+				Name:       result.Attributes.Name,
+			}
+			byIdentifier[identifier] = item
+			order = append(order, identifier)
+		}
+
+		statuses := make([]asc.CiTestStatus, 0, len(result.Attributes.DestinationTestResults))
+		for _, destination := range result.Attributes.DestinationTestResults {
+			if destination.Status != "" {
+				statuses = append(statuses, destination.Status)
+			}
+		}
+		if len(statuses) == 0 {
+			statuses = append(statuses, result.Attributes.Status)
+		}
+		for _, status := range statuses {
+			switch status {
+			case asc.CiTestStatusSuccess, asc.CiTestStatusExpectedFailure:
+				item.Passes++
+			case asc.CiTestStatusFailure:
+				item.Failures++
+			case asc.CiTestStatusMixed:
+				item.Passes++
+				item.Failures++
+			}
+		}
+		if item.Status == "" || testResultStatusOrder(result.Attributes.Status) < testResultStatusOrder(item.Status) {
+			item.Status = result.Attributes.Status
+		}
+	}
+
+	summary := &asc.CiTestResultsSummaryResult{
+		ActionID:  actionID,
+		FlakyOnly: flakyOnly,
+		Tests:     make([]asc.CiTestSummaryItem, 0, len(order)),
+	}
+	for _, identifier := range order {
+		item := byIdentifier[identifier]
+		item.Flaky = item.Passes > 0 && item.Failures > 0
+		if item.Flaky {
+			item.Status = asc.CiTestStatusMixed
+		}
+
+		summary.Total++
+		switch {
+		case item.Flaky:
+			summary.Flaky++
+		case item.Failures > 0:
+			summary.Failed++
+		case item.Passes > 0:
+			summary.Passed++
+		default:
+			summary.Skipped++
+		}
+
+		if flakyOnly && !item.Flaky {
+			continue
+		}
+		summary.Tests = append(summary.Tests, *item)
+	}
+	return summary
+}
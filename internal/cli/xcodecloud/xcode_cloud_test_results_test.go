@@ -0,0 +1,84 @@
+package xcodecloud
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func testResultFixture(id, className, name string, status asc.CiTestStatus, destinations ...asc.CiTestStatus) asc.CiTestResultResource {
+	result := asc.CiTestResultResource{
+		ID: id,
+		Attributes: asc.CiTestResultAttributes{
+			ClassName: className,
+			Name:      name,
+			Status:    status,
+		},
+	}
+	for _, destination := range destinations {
+		result.Attributes.DestinationTestResults = append(result.Attributes.DestinationTestResults, asc.CiTestDestinationResult{Status: destination})
+	}
+	return result
+}
+
+func TestSummarizeTestResultsDetectsFlakyTests(t *testing.T) {
+	results := []asc.CiTestResultResource{
+		// Retried: failed first, passed on the second attempt.
+		testResultFixture("r1", "LoginTests", "testSignIn", asc.CiTestStatusFailure),
+		testResultFixture("r2", "LoginTests", "testSignIn", asc.CiTestStatusSuccess),
+		// Mixed outcome across destinations.
+		testResultFixture("r3", "FeedTests", "testRefresh", asc.CiTestStatusMixed, asc.CiTestStatusSuccess, asc.CiTestStatusFailure),
+		// Consistently failing.
+		testResultFixture("r4", "FeedTests", "testEmpty", asc.CiTestStatusFailure, asc.CiTestStatusFailure, asc.CiTestStatusFailure),
+		// Consistently passing.
+		testResultFixture("r5", "FeedTests", "testLoad", asc.CiTestStatusSuccess),
+		testResultFixture("r6", "FeedTests", "testLoad", asc.CiTestStatusSuccess),
+		testResultFixture("r7", "FeedTests", "testSkipped", asc.CiTestStatusSkipped),
+	}
+
+	summary := summarizeTestResults("action-1", results, false)
+	if summary.Total != 5 || summary.Flaky != 2 || summary.Failed != 1 || summary.Passed != 1 || summary.Skipped != 1 {
+		t.Fatalf("unexpected totals: %+v", summary)
+	}
+	if len(summary.Tests) != 5 {
+		t.Fatalf("expected 5 tests, got %d", len(summary.Tests))
+	}
+	signIn := summary.Tests[0]
+	if signIn.Identifier != "LoginTests/testSignIn" || !signIn.Flaky || signIn.Passes != 1 || signIn.Failures != 1 {
+		t.Fatalf("unexpected testSignIn summary: %+v", signIn)
+	}
+	if signIn.Status != asc.CiTestStatusMixed {
+		t.Fatalf("expected flaky test to be reported as MIXED, got %s", signIn.Status)
+	}
+
+	flaky := summarizeTestResults("action-1", results, true)
+	if flaky.Flaky != 2 || len(flaky.Tests) != 2 {
+		t.Fatalf("expected only flaky tests, got %+v", flaky.Tests)
+	}
+	if flaky.Tests[0].Identifier != "LoginTests/testSignIn" || flaky.Tests[1].Identifier != "FeedTests/testRefresh" {
+		t.Fatalf("unexpected flaky tests: %+v", flaky.Tests)
+	}
+}
+
+func TestSortTestResults(t *testing.T) {
+	results := []asc.CiTestResultResource{
+		testResultFixture("b", "Suite", "testB", asc.CiTestStatusSuccess),
+		testResultFixture("c", "Suite", "testC", asc.CiTestStatusFailure),
+		testResultFixture("a", "Suite", "testA", asc.CiTestStatusMixed),
+	}
+
+	sortTestResults(results, "name")
+	if results[0].ID != "a" || results[1].ID != "b" || results[2].ID != "c" {
+		t.Fatalf("unexpected name order: %s %s %s", results[0].ID, results[1].ID, results[2].ID)
+	}
+
+	sortTestResults(results, "-name")
+	if results[0].ID != "c" || results[1].ID != "b" || results[2].ID != "a" {
+		t.Fatalf("unexpected -name order: %s %s %s", results[0].ID, results[1].ID, results[2].ID)
+	}
+
+	sortTestResults(results, "status")
+	if results[0].ID != "c" || results[1].ID != "a" || results[2].ID != "b" {
+		t.Fatalf("unexpected status order: %s %s %s", results[0].ID, results[1].ID, results[2].ID)
+	}
+}
@@ -2,10 +2,11 @@ package xcodecloud
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
+	"sync"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -17,16 +18,23 @@ func xcodeCloudWorkflowsListFlags(fs *flag.FlagSet) (appID *string, limit *int,
 	limit = fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next = fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate = fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output = fs.String("output", "json", "Output format: json (default), table, markdown")
+	output = fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty = fs.Bool("pretty", false, "Pretty-print JSON output")
 	return
 }
 
+func xcodeCloudWorkflowsLatestStatusFlags(fs *flag.FlagSet) (withLatestStatus *bool, workers *int) {
+	withLatestStatus = fs.Bool("with-latest-status", false, "Annotate each workflow with the status and date of its most recent build run")
+	workers = fs.Int("workers", 5, "Number of workflows to check in parallel (with --with-latest-status)")
+	return
+}
+
 // XcodeCloudWorkflowsCommand returns the xcode-cloud workflows subcommand.
 func XcodeCloudWorkflowsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("workflows", flag.ExitOnError)
 
 	appID, limit, next, paginate, output, pretty := xcodeCloudWorkflowsListFlags(fs)
+	withLatestStatus, workers := xcodeCloudWorkflowsLatestStatusFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "workflows",
@@ -39,8 +47,10 @@ Examples:
   asc xcode-cloud workflows list --app "APP_ID"
   asc xcode-cloud workflows get --id "WORKFLOW_ID"
   asc xcode-cloud workflows repository --id "WORKFLOW_ID"
+  asc xcode-cloud workflows disable --id "WORKFLOW_ID"
   asc xcode-cloud workflows --app "APP_ID" --limit 50
-  asc xcode-cloud workflows --app "APP_ID" --paginate`,
+  asc xcode-cloud workflows --app "APP_ID" --paginate
+  asc xcode-cloud workflows --app "APP_ID" --paginate --with-latest-status --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -49,10 +59,12 @@ Examples:
 			XcodeCloudWorkflowsRepositoryCommand(),
 			XcodeCloudWorkflowsCreateCommand(),
 			XcodeCloudWorkflowsUpdateCommand(),
+			XcodeCloudWorkflowsEnableCommand(),
+			XcodeCloudWorkflowsDisableCommand(),
 			XcodeCloudWorkflowsDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			return xcodeCloudWorkflowsList(ctx, *appID, *limit, *next, *paginate, *output, *pretty)
+			return xcodeCloudWorkflowsList(ctx, *appID, *limit, *next, *paginate, *withLatestStatus, *workers, *output, *pretty)
 		},
 	}
 }
@@ -61,6 +73,7 @@ func XcodeCloudWorkflowsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID, limit, next, paginate, output, pretty := xcodeCloudWorkflowsListFlags(fs)
+	withLatestStatus, workers := xcodeCloudWorkflowsLatestStatusFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -68,14 +81,20 @@ func XcodeCloudWorkflowsListCommand() *ffcli.Command {
 		ShortHelp:  "List Xcode Cloud workflows for an app.",
 		LongHelp: `List Xcode Cloud workflows for an app.
 
+With --with-latest-status, the most recent build run of each workflow is
+fetched (up to --workers at a time) and each workflow is annotated with its
+latest status, build number, and date (finished date, or created date while
+the run is in progress). Workflows without runs are marked "never run".
+
 Examples:
   asc xcode-cloud workflows list --app "APP_ID"
   asc xcode-cloud workflows list --app "APP_ID" --limit 50
-  asc xcode-cloud workflows list --app "APP_ID" --paginate`,
+  asc xcode-cloud workflows list --app "APP_ID" --paginate
+  asc xcode-cloud workflows list --app "APP_ID" --paginate --with-latest-status --output table`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return xcodeCloudWorkflowsList(ctx, *appID, *limit, *next, *paginate, *output, *pretty)
+			return xcodeCloudWorkflowsList(ctx, *appID, *limit, *next, *paginate, *withLatestStatus, *workers, *output, *pretty)
 		},
 	}
 }
@@ -84,7 +103,7 @@ func XcodeCloudWorkflowsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Workflow ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -101,8 +120,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -127,7 +145,7 @@ func XcodeCloudWorkflowsRepositoryCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("repository", flag.ExitOnError)
 
 	id := fs.String("id", "", "Workflow ID")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -144,8 +162,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 
 			client, err := getASCClient()
@@ -171,7 +188,7 @@ func XcodeCloudWorkflowsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	file := fs.String("file", "", "Path to workflow JSON payload")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -180,18 +197,22 @@ func XcodeCloudWorkflowsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a workflow.",
 		LongHelp: `Create a workflow.
 
+A saved "workflows get" document (JSON or YAML) is accepted as-is: its ID,
+links, and read-only attributes are dropped before the request is sent.
+
 Examples:
-  asc xcode-cloud workflows create --file ./workflow.json`,
+  asc xcode-cloud workflows create --file ./workflow.json
+  asc xcode-cloud workflows get --id "WORKFLOW_ID" --output yaml > workflow.yaml
+  asc xcode-cloud workflows create --file ./workflow.yaml`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			fileValue := strings.TrimSpace(*file)
 			if fileValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
-			payload, err := readJSONFilePayload(fileValue)
+			payload, err := readWorkflowPayload(fileValue, "")
 			if err != nil {
 				return fmt.Errorf("xcode-cloud workflows create: %w", err)
 			}
@@ -219,7 +240,7 @@ func XcodeCloudWorkflowsUpdateCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Workflow ID")
 	file := fs.String("file", "", "Path to workflow JSON payload")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -228,6 +249,9 @@ func XcodeCloudWorkflowsUpdateCommand() *ffcli.Command {
 		ShortHelp:  "Update a workflow.",
 		LongHelp: `Update a workflow.
 
+A saved "workflows get" document (JSON or YAML) is accepted as-is: links and
+read-only attributes are dropped, and data.id is set to --id.
+
 Examples:
   asc xcode-cloud workflows update --id "WORKFLOW_ID" --file ./workflow.json`,
 		FlagSet:   fs,
@@ -235,16 +259,14 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
 			fileValue := strings.TrimSpace(*file)
 			if fileValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --file is required")
-				return flag.ErrHelp
+				return flagErrorf("--file is required")
 			}
 
-			payload, err := readJSONFilePayload(fileValue)
+			payload, err := readWorkflowPayload(fileValue, idValue)
 			if err != nil {
 				return fmt.Errorf("xcode-cloud workflows update: %w", err)
 			}
@@ -267,12 +289,71 @@ Examples:
 	}
 }
 
+// XcodeCloudWorkflowsEnableCommand returns the workflows enable subcommand.
+func XcodeCloudWorkflowsEnableCommand() *ffcli.Command {
+	return xcodeCloudWorkflowsSetEnabledCommand("enable", true)
+}
+
+// XcodeCloudWorkflowsDisableCommand returns the workflows disable subcommand.
+func XcodeCloudWorkflowsDisableCommand() *ffcli.Command {
+	return xcodeCloudWorkflowsSetEnabledCommand("disable", false)
+}
+
+func xcodeCloudWorkflowsSetEnabledCommand(name string, enabled bool) *ffcli.Command {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+
+	id := fs.String("id", "", "Workflow ID")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	title := strings.ToUpper(name[:1]) + name[1:]
+	commandName := "xcode-cloud workflows " + name
+
+	return &ffcli.Command{
+		Name:       name,
+		ShortUsage: fmt.Sprintf("asc %s --id \"WORKFLOW_ID\"", commandName),
+		ShortHelp:  title + " a workflow.",
+		LongHelp: fmt.Sprintf(`%s a workflow.
+
+Only the workflow's isEnabled attribute is changed; the rest of its
+definition is left as is. Use workflows update to change anything else.
+
+Examples:
+  asc %s --id "WORKFLOW_ID"`, title, commandName),
+		FlagSet:   fs,
+		UsageFunc: DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				return flagErrorf("--id is required")
+			}
+
+			client, err := getASCClient()
+			if err != nil {
+				return fmt.Errorf("%s: %w", commandName, err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			defer cancel()
+
+			resp, err := client.SetCiWorkflowEnabled(requestCtx, idValue, enabled)
+			if err != nil {
+				return fmt.Errorf("%s: failed to update: %w", commandName, err)
+			}
+
+			return printOutput(resp, *output, *pretty)
+		},
+	}
+}
+
 func XcodeCloudWorkflowsDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 
 	id := fs.String("id", "", "Workflow ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", "json", "Output format: json (default), table, markdown")
+	force := fs.Bool("force", false, "Delete even if build runs are in progress")
+	dryRun := fs.Bool("dry-run", false, "Report what would be deleted without deleting")
+	output := fs.String("output", "json", "Output format: json (default), table, markdown, yaml, jsonl, template")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -281,19 +362,30 @@ func XcodeCloudWorkflowsDeleteCommand() *ffcli.Command {
 		ShortHelp:  "Delete a workflow.",
 		LongHelp: `Delete a workflow.
 
+Before deleting, recent build runs for the workflow are checked. If any are
+still pending or running, the deletion is refused unless --force is passed.
+Use --dry-run to report the workflow name and in-progress run count without
+deleting anything.
+
 Examples:
-  asc xcode-cloud workflows delete --id "WORKFLOW_ID" --confirm`,
+  asc xcode-cloud workflows delete --id "WORKFLOW_ID" --dry-run
+  asc xcode-cloud workflows delete --id "WORKFLOW_ID" --confirm
+  asc xcode-cloud workflows delete --id "WORKFLOW_ID" --confirm --force`,
 		FlagSet:   fs,
 		UsageFunc: DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+				return flagErrorf("--id is required")
 			}
-			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+			if !*confirm && !*dryRun {
+				confirmed, err := confirmDestructive(fmt.Sprintf("workflow %q", idValue))
+				if err != nil {
+					return fmt.Errorf("xcode-cloud workflows delete: %w", err)
+				}
+				if !confirmed {
+					return flagErrorf("--confirm is required")
+				}
 			}
 
 			client, err := getASCClient()
@@ -304,6 +396,30 @@ Examples:
 			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
 			defer cancel()
 
+			workflow, err := client.GetCiWorkflow(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows delete: failed to fetch workflow: %w", err)
+			}
+			runs, err := client.GetCiBuildRuns(requestCtx, idValue, asc.WithCiBuildRunsLimit(200))
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows delete: failed to fetch build runs: %w", err)
+			}
+			inProgress := countInProgressBuildRuns(runs)
+
+			if *dryRun {
+				result := &asc.CiWorkflowDeletePreview{
+					ID:             idValue,
+					Name:           workflow.Data.Attributes.Name,
+					InProgressRuns: inProgress,
+					DryRun:         true,
+				}
+				return printOutput(result, *output, *pretty)
+			}
+
+			if inProgress > 0 && !*force {
+				return fmt.Errorf("xcode-cloud workflows delete: workflow %q has %d build run(s) in progress; use --force to delete anyway", idValue, inProgress)
+			}
+
 			if err := client.DeleteCiWorkflow(requestCtx, idValue); err != nil {
 				return fmt.Errorf("xcode-cloud workflows delete: failed to delete: %w", err)
 			}
@@ -314,9 +430,12 @@ Examples:
 	}
 }
 
-func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next string, paginate bool, output string, pretty bool) error {
+func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next string, paginate, withLatestStatus bool, workers int, output string, pretty bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
-		return fmt.Errorf("xcode-cloud workflows: --limit must be between 1 and 200")
+		return usageErrorf("xcode-cloud workflows: --limit must be between 1 and 200")
+	}
+	if workers < 1 {
+		return flagErrorf("--workers must be at least 1")
 	}
 	nextURL := strings.TrimSpace(next)
 	if err := validateNextURL(nextURL); err != nil {
@@ -325,8 +444,7 @@ func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next
 
 	resolvedAppID := resolveAppID(appID)
 	if resolvedAppID == "" && nextURL == "" {
-		fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
-		return flag.ErrHelp
+		return flagErrorf("--app is required (or set ASC_APP_ID)")
 	}
 
 	client, err := getASCClient()
@@ -364,6 +482,13 @@ func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next
 		if err != nil {
 			return fmt.Errorf("xcode-cloud workflows: %w", err)
 		}
+		if withLatestStatus {
+			workflows, ok := resp.(*asc.CiWorkflowsResponse)
+			if !ok {
+				return fmt.Errorf("xcode-cloud workflows: unexpected response type %T", resp)
+			}
+			return printWorkflowsWithLatestStatus(requestCtx, client, workflows, workers, output, pretty)
+		}
 
 		return printOutput(resp, output, pretty)
 	}
@@ -372,6 +497,153 @@ func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next
 	if err != nil {
 		return fmt.Errorf("xcode-cloud workflows: %w", err)
 	}
+	if withLatestStatus {
+		return printWorkflowsWithLatestStatus(requestCtx, client, resp, workers, output, pretty)
+	}
 
 	return printOutput(resp, output, pretty)
 }
+
+func printWorkflowsWithLatestStatus(ctx context.Context, client *asc.Client, resp *asc.CiWorkflowsResponse, workers int, output string, pretty bool) error {
+	result := &asc.CiWorkflowsLatestStatusResult{
+		Data:  make([]asc.CiWorkflowLatestStatus, 0, len(resp.Data)),
+		Links: resp.Links,
+	}
+	for _, workflow := range resp.Data {
+		result.Data = append(result.Data, asc.CiWorkflowLatestStatus{CiWorkflowResource: workflow})
+	}
+
+	fetch := func(ctx context.Context, workflowID string) (*asc.CiBuildRunsResponse, error) {
+		return client.GetCiBuildRuns(ctx, workflowID, asc.WithCiBuildRunsSort("-number"), asc.WithCiBuildRunsLimit(1))
+	}
+	if err := resolveWorkflowLatestRuns(ctx, workers, result.Data, fetch); err != nil {
+		return fmt.Errorf("xcode-cloud workflows: %w", err)
+	}
+
+	return printOutput(result, output, pretty)
+}
+
+// resolveWorkflowLatestRuns fills in each workflow's most recent build run in
+// place, fetching at most workers workflows at a time. Workflows without
+// runs are marked as never run; any error aborts the listing.
+func resolveWorkflowLatestRuns(ctx context.Context, workers int, workflows []asc.CiWorkflowLatestStatus, fetch func(context.Context, string) (*asc.CiBuildRunsResponse, error)) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, workers)
+
+	for i := range workflows {
+		wg.Add(1)
+		go func(workflow *asc.CiWorkflowLatestStatus) {
+			defer wg.Done()
+
+			select {
+			case <-ctx.Done():
+				return
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			}
+
+			runs, err := fetch(ctx, workflow.ID)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("workflow %s: %w", workflow.ID, err)
+				}
+				mu.Unlock()
+				return
+			}
+			annotateWorkflowLatestRun(workflow, runs.Data)
+		}(&workflows[i])
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// annotateWorkflowLatestRun records the newest of runs on workflow.
+func annotateWorkflowLatestRun(workflow *asc.CiWorkflowLatestStatus, runs []asc.CiBuildRunResource) {
+	if len(runs) == 0 {
+		workflow.LatestStatus = asc.CiWorkflowNeverRun
+		return
+	}
+	latest := runs[0]
+	for _, run := range runs[1:] {
+		if ciBuildRunIsNewer(run, latest) {
+			latest = run
+		}
+	}
+	workflow.LatestRunID = latest.ID
+	workflow.LatestBuildNumber = latest.Attributes.Number
+	workflow.LatestStatus = ciBuildRunStatusKey(latest.Attributes)
+	workflow.LatestDate = latest.Attributes.FinishedDate
+	if workflow.LatestDate == "" {
+		workflow.LatestDate = latest.Attributes.CreatedDate
+	}
+}
+
+// workflowReadOnlyAttributes are returned by "workflows get" but rejected by
+// workflow create and update requests.
+var workflowReadOnlyAttributes = []string{"lastModifiedDate"}
+
+// readWorkflowPayload reads a workflows create or update --file payload. So
+// that "workflows get" output can be fed back in, top-level and resource
+// links and read-only attributes are dropped, and data.id is replaced by id
+// (or removed when id is empty, as create requests must not carry one).
+func readWorkflowPayload(path, id string) (json.RawMessage, error) {
+	payload, err := readJSONFilePayload(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &document); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	delete(document, "links")
+
+	var data map[string]json.RawMessage
+	if raw, ok := document["data"]; ok {
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, fmt.Errorf("invalid workflow payload: data must be an object")
+		}
+	}
+	if data != nil {
+		delete(data, "links")
+		delete(data, "id")
+		if id != "" {
+			encodedID, err := json.Marshal(id)
+			if err != nil {
+				return nil, err
+			}
+			data["id"] = encodedID
+		}
+
+		var attributes map[string]json.RawMessage
+		if raw, ok := data["attributes"]; ok && json.Unmarshal(raw, &attributes) == nil && attributes != nil {
+			for _, key := range workflowReadOnlyAttributes {
+				delete(attributes, key)
+			}
+			if data["attributes"], err = json.Marshal(attributes); err != nil {
+				return nil, err
+			}
+		}
+		if document["data"], err = json.Marshal(data); err != nil {
+			return nil, err
+		}
+	}
+
+	normalized, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateJSONPayloadFor(normalized, &asc.CiWorkflowPayload{}); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
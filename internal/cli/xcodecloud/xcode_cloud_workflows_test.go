@@ -0,0 +1,76 @@
+package xcodecloud
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestResolveWorkflowLatestRuns(t *testing.T) {
+	workflows := []asc.CiWorkflowLatestStatus{
+		{CiWorkflowResource: asc.CiWorkflowResource{ID: "wf-1"}},
+		{CiWorkflowResource: asc.CiWorkflowResource{ID: "wf-2"}},
+		{CiWorkflowResource: asc.CiWorkflowResource{ID: "wf-3"}},
+	}
+	runs := map[string][]asc.CiBuildRunResource{
+		"wf-1": {{ID: "run-9", Attributes: asc.CiBuildRunAttributes{
+			Number:           9,
+			CompletionStatus: asc.CiBuildRunCompletionStatus("SUCCEEDED"),
+			CreatedDate:      "2026-01-01T10:00:00Z",
+			FinishedDate:     "2026-01-01T10:20:00Z",
+		}}},
+		"wf-2": {{ID: "run-4", Attributes: asc.CiBuildRunAttributes{
+			Number:            4,
+			ExecutionProgress: asc.CiBuildRunExecutionProgress("RUNNING"),
+			CreatedDate:       "2026-01-02T08:00:00Z",
+		}}},
+	}
+
+	var inFlight, maxInFlight int32
+	fetch := func(ctx context.Context, workflowID string) (*asc.CiBuildRunsResponse, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		return &asc.CiBuildRunsResponse{Data: runs[workflowID]}, nil
+	}
+
+	if err := resolveWorkflowLatestRuns(context.Background(), 2, workflows, fetch); err != nil {
+		t.Fatalf("resolveWorkflowLatestRuns() error: %v", err)
+	}
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 concurrent fetches, got %d", maxInFlight)
+	}
+
+	if got := workflows[0]; got.LatestRunID != "run-9" || got.LatestBuildNumber != 9 || got.LatestStatus != "SUCCEEDED" || got.LatestDate != "2026-01-01T10:20:00Z" {
+		t.Fatalf("unexpected completed workflow status: %+v", got)
+	}
+	if got := workflows[1]; got.LatestStatus != "RUNNING" || got.LatestDate != "2026-01-02T08:00:00Z" {
+		t.Fatalf("unexpected running workflow status: %+v", got)
+	}
+	if got := workflows[2]; got.LatestStatus != asc.CiWorkflowNeverRun || got.LatestRunID != "" {
+		t.Fatalf("expected never run, got %+v", got)
+	}
+}
+
+func TestResolveWorkflowLatestRunsReturnsFetchError(t *testing.T) {
+	workflows := []asc.CiWorkflowLatestStatus{
+		{CiWorkflowResource: asc.CiWorkflowResource{ID: "wf-1"}},
+	}
+	fetch := func(ctx context.Context, workflowID string) (*asc.CiBuildRunsResponse, error) {
+		return nil, errors.New("boom")
+	}
+
+	err := resolveWorkflowLatestRuns(context.Background(), 1, workflows, fetch)
+	if err == nil || !strings.Contains(err.Error(), "workflow wf-1: boom") {
+		t.Fatalf("expected wrapped fetch error, got %v", err)
+	}
+}
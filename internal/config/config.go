@@ -15,6 +15,9 @@ const (
 	configDirName    = ".asc"
 	configFileName   = "config.json"
 	configPathEnvVar = "ASC_CONFIG_PATH"
+	xdgConfigDirName = ".config"
+	xdgAppDirName    = "asc"
+	tomlFileName     = "config.toml"
 	maxConfigRetries = 30
 )
 
@@ -137,6 +140,8 @@ type Config struct {
 	BaseDelay            string        `json:"base_delay"`
 	MaxDelay             string        `json:"max_delay"`
 	RetryLog             string        `json:"retry_log"`
+
+	DefaultOutput string `json:"default_output,omitempty"`
 }
 
 // ErrNotFound is returned when the config file doesn't exist
@@ -148,6 +153,9 @@ var ErrInvalidPath = errors.New("invalid config path")
 // ErrInvalidConfig is returned when config values fail validation.
 var ErrInvalidConfig = errors.New("invalid configuration")
 
+// pathOverride is the config file set with the global --config flag.
+var pathOverride string
+
 // configDir returns the path to the configuration directory
 func configDir() (string, error) {
 	home, err := os.UserHomeDir()
@@ -173,7 +181,46 @@ func GlobalPath() (string, error) {
 
 // Path returns the active configuration file path.
 func Path() (string, error) {
-	return resolvePath()
+	return resolvePath(true)
+}
+
+// WritePath returns the configuration file that changes are saved to. It is
+// Path, except that a config.toml found by the default lookup is read-only:
+// writes go to the global JSON config instead, which then takes precedence.
+func WritePath() (string, error) {
+	return resolvePath(false)
+}
+
+// TOMLPath returns the default TOML configuration file path
+// (~/.config/asc/config.toml).
+func TOMLPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, xdgConfigDirName, xdgAppDirName, tomlFileName), nil
+}
+
+// SetPathOverride sets the config file used instead of the usual lookup, as
+// given to the global --config flag. Relative paths are resolved against the
+// working directory; an empty path clears the override.
+func SetPathOverride(path string) error {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		pathOverride = ""
+		return nil
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPath, err)
+	}
+	pathOverride = abs
+	return nil
+}
+
+// PathOverride returns the config file set with SetPathOverride, if any.
+func PathOverride() string {
+	return pathOverride
 }
 
 // LocalPath returns the local configuration file path.
@@ -185,7 +232,10 @@ func LocalPath() (string, error) {
 	return filepath.Join(baseDir, configDirName, configFileName), nil
 }
 
-func resolvePath() (string, error) {
+func resolvePath(allowTOML bool) (string, error) {
+	if pathOverride != "" {
+		return pathOverride, nil
+	}
 	if envPath := strings.TrimSpace(os.Getenv(configPathEnvVar)); envPath != "" {
 		return cleanConfigPath(envPath)
 	}
@@ -198,7 +248,22 @@ func resolvePath() (string, error) {
 		return localPath, nil
 	}
 
-	return GlobalPath()
+	globalPath, err := GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(globalPath); err == nil {
+		return globalPath, nil
+	}
+	if !allowTOML {
+		return globalPath, nil
+	}
+	if tomlPath, err := TOMLPath(); err == nil {
+		if _, err := os.Stat(tomlPath); err == nil {
+			return tomlPath, nil
+		}
+	}
+	return globalPath, nil
 }
 
 func cleanConfigPath(path string) (string, error) {
@@ -274,7 +339,7 @@ func Load() (*Config, error) {
 
 // Save saves the configuration to the config file
 func Save(cfg *Config) error {
-	path, err := Path()
+	path, err := WritePath()
 	if err != nil {
 		return err
 	}
@@ -283,7 +348,7 @@ func Save(cfg *Config) error {
 
 // Remove removes the config file
 func Remove() error {
-	path, err := Path()
+	path, err := WritePath()
 	if err != nil {
 		return err
 	}
@@ -305,7 +370,11 @@ func LoadAt(path string) (*Config, error) {
 	}
 
 	var cfg Config
-	if err := json.Unmarshal(data, &cfg); err != nil {
+	if isTOMLPath(path) {
+		if err := unmarshalTOML(data, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config: %w", err)
+		}
+	} else if err := json.Unmarshal(data, &cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
@@ -402,6 +471,9 @@ func SaveAt(path string, cfg *Config) error {
 	if strings.TrimSpace(path) == "" {
 		return fmt.Errorf("failed to write config: empty path")
 	}
+	if isTOMLPath(path) {
+		return fmt.Errorf("failed to write config: %s is a TOML file; edit it by hand or use a .json config path", path)
+	}
 
 	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0o700); err != nil {
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func mustDurationValue(t *testing.T, raw string) DurationValue {
@@ -224,6 +225,100 @@ func TestPathFallsBackToGlobal(t *testing.T) {
 	}
 }
 
+func TestPathFallsBackToTOML(t *testing.T) {
+	tempDir := t.TempDir()
+	home := t.TempDir()
+	t.Setenv("ASC_CONFIG_PATH", "")
+	t.Setenv("HOME", home)
+
+	tomlPath := filepath.Join(home, ".config", "asc", "config.toml")
+	if err := os.MkdirAll(filepath.Dir(tomlPath), 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(tomlPath, []byte("app_id = \"APP123\"\n"), 0o600); err != nil {
+		t.Fatalf("write toml config: %v", err)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(cwd) })
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+
+	path, err := Path()
+	if err != nil {
+		t.Fatalf("Path() error: %v", err)
+	}
+	if path != tomlPath {
+		t.Fatalf("Path() mismatch: got %q want %q", path, tomlPath)
+	}
+
+	// Writes never target the read-only TOML file.
+	globalPath, err := GlobalPath()
+	if err != nil {
+		t.Fatalf("GlobalPath() error: %v", err)
+	}
+	writePath, err := WritePath()
+	if err != nil {
+		t.Fatalf("WritePath() error: %v", err)
+	}
+	if writePath != globalPath {
+		t.Fatalf("WritePath() mismatch: got %q want %q", writePath, globalPath)
+	}
+
+	// An existing ~/.asc/config.json still wins over the TOML file.
+	if err := SaveAt(globalPath, &Config{}); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+	path, err = Path()
+	if err != nil {
+		t.Fatalf("Path() error: %v", err)
+	}
+	if path != globalPath {
+		t.Fatalf("Path() mismatch: got %q want %q", path, globalPath)
+	}
+}
+
+func TestPathOverrideTakesPrecedenceOverEnv(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(tempDir, "env.json"))
+	t.Cleanup(func() { _ = SetPathOverride("") })
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(cwd) })
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+
+	if err := SetPathOverride("flag.toml"); err != nil {
+		t.Fatalf("SetPathOverride() error: %v", err)
+	}
+	path, err := Path()
+	if err != nil {
+		t.Fatalf("Path() error: %v", err)
+	}
+	if filepath.Base(path) != "flag.toml" || !filepath.IsAbs(path) {
+		t.Fatalf("expected absolute flag.toml path, got %q", path)
+	}
+
+	if err := SetPathOverride(""); err != nil {
+		t.Fatalf("SetPathOverride() error: %v", err)
+	}
+	path, err = Path()
+	if err != nil {
+		t.Fatalf("Path() error: %v", err)
+	}
+	if path != filepath.Join(tempDir, "env.json") {
+		t.Fatalf("expected env path after clearing override, got %q", path)
+	}
+}
+
 func TestLocalPathUsesRepoRoot(t *testing.T) {
 	tempDir := t.TempDir()
 	gitDir := filepath.Join(tempDir, ".git")
@@ -344,3 +439,193 @@ func TestLoadAtRejectsMaxDelayBelowBaseDelay(t *testing.T) {
 		t.Fatalf("expected ErrInvalidConfig, got %v", err)
 	}
 }
+
+func TestRenamedEnvVarsPointAtKnownNames(t *testing.T) {
+	for name, replacement := range renamedEnvVars {
+		if IsKnownEnvVar(name) {
+			t.Fatalf("renamed variable %s must not also be known", name)
+		}
+		if !IsKnownEnvVar(replacement) {
+			t.Fatalf("renamed variable %s points at unknown %s", name, replacement)
+		}
+	}
+}
+
+func TestRotateKeyUpdatesMatchingCredentials(t *testing.T) {
+	cfg := &Config{
+		KeyID:          "OLD",
+		IssuerID:       "ISS",
+		PrivateKeyPath: "/keys/old.p8",
+		DefaultKeyName: "Work",
+		Keys: []Credential{
+			{Name: "Work", KeyID: "OLD", IssuerID: "ISS", PrivateKeyPath: "/keys/old.p8"},
+			{Name: "Personal", KeyID: "OTHER", IssuerID: "ISS2", PrivateKeyPath: "/keys/other.p8"},
+		},
+	}
+
+	previous, err := RotateKey(cfg, "OLD", "NEW", "/keys/new.p8")
+	if err != nil {
+		t.Fatalf("RotateKey() error: %v", err)
+	}
+	if len(previous) != 1 || previous[0].Name != "Work" || previous[0].PrivateKeyPath != "/keys/old.p8" {
+		t.Fatalf("unexpected previous credentials: %+v", previous)
+	}
+	if cfg.KeyID != "NEW" || cfg.PrivateKeyPath != "/keys/new.p8" || cfg.IssuerID != "ISS" {
+		t.Fatalf("expected legacy fields rotated, got %+v", cfg)
+	}
+	if cfg.Keys[0].KeyID != "NEW" || cfg.Keys[0].PrivateKeyPath != "/keys/new.p8" {
+		t.Fatalf("expected Work key rotated, got %+v", cfg.Keys[0])
+	}
+	if cfg.Keys[1].KeyID != "OTHER" || cfg.Keys[1].PrivateKeyPath != "/keys/other.p8" {
+		t.Fatalf("expected Personal key unchanged, got %+v", cfg.Keys[1])
+	}
+}
+
+func TestRotateKeyLegacyOnlyAndMissingKey(t *testing.T) {
+	cfg := &Config{KeyID: "OLD", IssuerID: "ISS", PrivateKeyPath: "/keys/old.p8"}
+	previous, err := RotateKey(cfg, "OLD", "NEW", "/keys/new.p8")
+	if err != nil {
+		t.Fatalf("RotateKey() error: %v", err)
+	}
+	if len(previous) != 1 || previous[0].IssuerID != "ISS" || cfg.KeyID != "NEW" {
+		t.Fatalf("unexpected rotation: previous=%+v cfg=%+v", previous, cfg)
+	}
+
+	if _, err := RotateKey(cfg, "MISSING", "NEW2", "/keys/new2.p8"); err == nil {
+		t.Fatal("expected error for missing key")
+	}
+}
+
+func TestBackupAtCopiesConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	original := []byte(`{"key_id":"OLD"}`)
+	if err := os.WriteFile(path, original, 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	backupPath, err := BackupAt(path, now)
+	if err != nil {
+		t.Fatalf("BackupAt() error: %v", err)
+	}
+	if backupPath != path+".20260304T050607Z.bak" {
+		t.Fatalf("unexpected backup path %q", backupPath)
+	}
+	data, err := os.ReadFile(backupPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if string(data) != string(original) {
+		t.Fatalf("expected backup %q, got %q", original, data)
+	}
+	info, err := os.Stat(backupPath)
+	if err != nil {
+		t.Fatalf("Stat() error: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected 0600 backup permissions, got %v", info.Mode().Perm())
+	}
+
+	if _, err := BackupAt(filepath.Join(t.TempDir(), "missing.json"), now); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestBackupAtKeepsBackupsFromTheSameSecond(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"key_id":"FIRST"}`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	first, err := BackupAt(path, now)
+	if err != nil {
+		t.Fatalf("BackupAt() error: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"key_id":"SECOND"}`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	second, err := BackupAt(path, now.Add(500*time.Millisecond))
+	if err != nil {
+		t.Fatalf("BackupAt() error: %v", err)
+	}
+	if second != path+".20260304T050607Z-1.bak" {
+		t.Fatalf("unexpected second backup path %q", second)
+	}
+
+	data, err := os.ReadFile(first)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if string(data) != `{"key_id":"FIRST"}` {
+		t.Fatalf("expected first backup kept, got %q", data)
+	}
+}
+
+func TestLoadAtTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	data := `# asc settings
+key_id = "KEY123"
+issuer_id = 'ISSUER456'
+private_key_path = "/tmp/AuthKey.p8" # trailing comment
+app_id = "APP123"
+timeout = 90
+max_retries = 5
+default_output = "table"
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	cfg, err := LoadAt(path)
+	if err != nil {
+		t.Fatalf("LoadAt() error: %v", err)
+	}
+	if cfg.KeyID != "KEY123" || cfg.IssuerID != "ISSUER456" || cfg.PrivateKeyPath != "/tmp/AuthKey.p8" {
+		t.Fatalf("unexpected credentials: %+v", cfg)
+	}
+	if cfg.AppID != "APP123" {
+		t.Fatalf("AppID mismatch: got %q", cfg.AppID)
+	}
+	if timeout, ok := cfg.Timeout.Value(); !ok || timeout != 90*time.Second {
+		t.Fatalf("Timeout mismatch: got %v", cfg.Timeout)
+	}
+	if cfg.MaxRetries != "5" {
+		t.Fatalf("MaxRetries mismatch: got %q", cfg.MaxRetries)
+	}
+	if cfg.DefaultOutput != "table" {
+		t.Fatalf("DefaultOutput mismatch: got %q", cfg.DefaultOutput)
+	}
+}
+
+func TestLoadAtTOMLRejectsUnsupportedSyntax(t *testing.T) {
+	tests := map[string]string{
+		"table":         "[keys]\nname = \"ci\"\n",
+		"array":         "keys = [\"a\"]\n",
+		"missing value": "key_id =\n",
+		"no equals":     "key_id\n",
+		"unterminated":  "key_id = \"KEY\n",
+		"duplicate":     "key_id = \"A\"\nkey_id = \"B\"\n",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.toml")
+			if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+				t.Fatalf("write config: %v", err)
+			}
+			if _, err := LoadAt(path); err == nil {
+				t.Fatal("expected parse error, got nil")
+			}
+		})
+	}
+}
+
+func TestSaveAtRejectsTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := SaveAt(path, &Config{}); err == nil {
+		t.Fatal("expected error saving TOML config, got nil")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected no file to be written, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package config
+
+import "sort"
+
+// knownEnvVars lists every ASC_* environment variable the CLI reads.
+var knownEnvVars = []string{
+	"ASC_ANALYTICS_VENDOR_NUMBER",
+	"ASC_APP_ID",
+	"ASC_BASE_DELAY",
+	"ASC_BYPASS_KEYCHAIN",
+	"ASC_CONFIG_PATH",
+	"ASC_ISSUER_ID",
+	"ASC_KEY_ID",
+	"ASC_LOG_FORMAT",
+	"ASC_MAX_DELAY",
+	"ASC_MAX_RETRIES",
+	"ASC_PRIVATE_KEY",
+	"ASC_PRIVATE_KEY_B64",
+	"ASC_PRIVATE_KEY_PATH",
+	"ASC_PROFILE",
+	"ASC_RETRY_BASE",
+	"ASC_RETRY_JITTER",
+	"ASC_RETRY_LOG",
+	"ASC_RETRY_MAX",
+	"ASC_STRICT_AUTH",
+	"ASC_TIMEOUT",
+	"ASC_TIMEOUT_SECONDS",
+	"ASC_UPLOAD_TIMEOUT",
+	"ASC_UPLOAD_TIMEOUT_SECONDS",
+	"ASC_VENDOR_NUMBER",
+	// Used by the integration test suite.
+	"ASC_EXPIRE_BUILD_ID",
+	"ASC_RELEASE_VERSION_ID",
+}
+
+// renamedEnvVars maps deprecated names and common misspellings to the
+// variable the CLI actually reads.
+var renamedEnvVars = map[string]string{
+	"ASC_ISSUER":             "ASC_ISSUER_ID",
+	"ASC_ISSUERID":           "ASC_ISSUER_ID",
+	"ASC_API_ISSUER_ID":      "ASC_ISSUER_ID",
+	"ASC_KEY":                "ASC_KEY_ID",
+	"ASC_KEYID":              "ASC_KEY_ID",
+	"ASC_API_KEY_ID":         "ASC_KEY_ID",
+	"ASC_PRIVATE_KEY_FILE":   "ASC_PRIVATE_KEY_PATH",
+	"ASC_KEY_PATH":           "ASC_PRIVATE_KEY_PATH",
+	"ASC_KEY_FILE":           "ASC_PRIVATE_KEY_PATH",
+	"ASC_PRIVATE_KEY_BASE64": "ASC_PRIVATE_KEY_B64",
+	"ASC_APPID":              "ASC_APP_ID",
+	"ASC_VENDOR":             "ASC_VENDOR_NUMBER",
+	"ASC_CONFIG":             "ASC_CONFIG_PATH",
+	"ASC_CONFIG_FILE":        "ASC_CONFIG_PATH",
+}
+
+// KnownEnvVars returns the sorted list of ASC_* environment variables the CLI reads.
+func KnownEnvVars() []string {
+	names := append([]string(nil), knownEnvVars...)
+	sort.Strings(names)
+	return names
+}
+
+// IsKnownEnvVar reports whether name is an ASC_* variable the CLI reads.
+func IsKnownEnvVar(name string) bool {
+	for _, known := range knownEnvVars {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RenamedEnvVar returns the supported name for a deprecated or commonly
+// misspelled variable.
+func RenamedEnvVar(name string) (string, bool) {
+	replacement, ok := renamedEnvVars[name]
+	return replacement, ok
+}
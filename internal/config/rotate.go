@@ -0,0 +1,98 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// RotateKey points every credential in cfg that uses oldKeyID at newKeyID and
+// newKeyPath, including the legacy top-level key fields. It returns the
+// credentials as they were before the swap, or an error when no credential
+// uses oldKeyID. Only cfg is modified; callers persist it with SaveAt.
+func RotateKey(cfg *Config, oldKeyID, newKeyID, newKeyPath string) ([]Credential, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("rotate key: nil config")
+	}
+	oldKeyID = strings.TrimSpace(oldKeyID)
+	newKeyID = strings.TrimSpace(newKeyID)
+	newKeyPath = strings.TrimSpace(newKeyPath)
+	if oldKeyID == "" || newKeyID == "" || newKeyPath == "" {
+		return nil, fmt.Errorf("rotate key: old key ID, new key ID, and new key path are required")
+	}
+
+	var previous []Credential
+	for i := range cfg.Keys {
+		if strings.TrimSpace(cfg.Keys[i].KeyID) != oldKeyID {
+			continue
+		}
+		previous = append(previous, cfg.Keys[i])
+		cfg.Keys[i].KeyID = newKeyID
+		cfg.Keys[i].PrivateKeyPath = newKeyPath
+	}
+	if strings.TrimSpace(cfg.KeyID) == oldKeyID {
+		if len(previous) == 0 {
+			previous = append(previous, Credential{
+				Name:           cfg.DefaultKeyName,
+				KeyID:          cfg.KeyID,
+				IssuerID:       cfg.IssuerID,
+				PrivateKeyPath: cfg.PrivateKeyPath,
+			})
+		}
+		cfg.KeyID = newKeyID
+		cfg.PrivateKeyPath = newKeyPath
+	}
+
+	if len(previous) == 0 {
+		return nil, fmt.Errorf("rotate key: key %s not found in config", oldKeyID)
+	}
+	return previous, nil
+}
+
+// BackupAt copies the config file at path to a timestamped sibling file and
+// returns the backup path. The backup keeps the config's restricted permissions
+// and never replaces an existing file: a backup taken in the same second as an
+// earlier one gets a numeric suffix.
+func BackupAt(path string, now time.Time) (string, error) {
+	if strings.TrimSpace(path) == "" {
+		return "", fmt.Errorf("failed to back up config: empty path")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("failed to back up config: %w", err)
+	}
+
+	stamp := now.UTC().Format("20060102T150405Z")
+	for attempt := 0; attempt < maxBackupAttempts; attempt++ {
+		backupPath := fmt.Sprintf("%s.%s.bak", path, stamp)
+		if attempt > 0 {
+			backupPath = fmt.Sprintf("%s.%s-%d.bak", path, stamp, attempt)
+		}
+		file, err := os.OpenFile(backupPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			if os.IsExist(err) {
+				continue
+			}
+			return "", fmt.Errorf("failed to back up config: %w", err)
+		}
+		if _, err := file.Write(data); err != nil {
+			_ = file.Close()
+			_ = os.Remove(backupPath)
+			return "", fmt.Errorf("failed to back up config: %w", err)
+		}
+		if err := file.Close(); err != nil {
+			_ = os.Remove(backupPath)
+			return "", fmt.Errorf("failed to back up config: %w", err)
+		}
+		return backupPath, nil
+	}
+	return "", fmt.Errorf("failed to back up config: too many backups for %s", stamp)
+}
+
+// maxBackupAttempts bounds the suffixes BackupAt tries within one second.
+const maxBackupAttempts = 100
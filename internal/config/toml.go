@@ -0,0 +1,124 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// isTOMLPath reports whether path names a TOML config file.
+func isTOMLPath(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".toml")
+}
+
+// unmarshalTOML decodes a TOML config into cfg. Only flat key/value pairs are
+// supported (strings, numbers, and booleans), which covers every top-level
+// setting; named credentials ("keys") still need a JSON config.
+func unmarshalTOML(data []byte, cfg *Config) error {
+	values, err := parseFlatTOML(data)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, cfg)
+}
+
+// parseFlatTOML parses TOML key/value lines into a map of raw string values.
+func parseFlatTOML(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			return nil, fmt.Errorf("line %d: tables are not supported in TOML config", lineNumber)
+		}
+
+		key, rawValue, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+		}
+		key = strings.TrimSpace(key)
+		if unquoted, err := parseTOMLString(key); err == nil {
+			key = unquoted
+		}
+		if key == "" {
+			return nil, fmt.Errorf("line %d: missing key", lineNumber)
+		}
+		if _, exists := values[key]; exists {
+			return nil, fmt.Errorf("line %d: duplicate key %q", lineNumber, key)
+		}
+
+		value, err := parseTOMLValue(strings.TrimSpace(rawValue))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", lineNumber, key, err)
+		}
+		values[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// parseTOMLValue returns the string form of a scalar TOML value, dropping any
+// trailing comment.
+func parseTOMLValue(raw string) (string, error) {
+	if raw == "" {
+		return "", fmt.Errorf("missing value")
+	}
+	if raw[0] == '"' || raw[0] == '\'' {
+		end := closingQuote(raw)
+		if end < 0 {
+			return "", fmt.Errorf("unterminated string")
+		}
+		if rest := strings.TrimSpace(raw[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+			return "", fmt.Errorf("unexpected text after string: %s", rest)
+		}
+		return parseTOMLString(raw[:end+1])
+	}
+	if strings.HasPrefix(raw, "[") || strings.HasPrefix(raw, "{") {
+		return "", fmt.Errorf("arrays and inline tables are not supported")
+	}
+	if index := strings.Index(raw, "#"); index >= 0 {
+		raw = strings.TrimSpace(raw[:index])
+	}
+	return raw, nil
+}
+
+// closingQuote returns the index of the quote that closes the string starting
+// at raw[0], or -1.
+func closingQuote(raw string) int {
+	quote := raw[0]
+	for i := 1; i < len(raw); i++ {
+		switch raw[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// parseTOMLString unquotes a basic ("...") or literal ('...') TOML string.
+func parseTOMLString(raw string) (string, error) {
+	if len(raw) >= 2 && raw[0] == '\'' && raw[len(raw)-1] == '\'' {
+		return raw[1 : len(raw)-1], nil
+	}
+	if len(raw) >= 2 && raw[0] == '"' {
+		return strconv.Unquote(raw)
+	}
+	return "", fmt.Errorf("not a string: %s", raw)
+}